@@ -31,7 +31,9 @@ type Node interface {
 	json.Marshaler
 }
 
-func (*Program) node() {}
+func (*Program) node()           {}
+func (*PackageClause) node()     {}
+func (*ImportDeclaration) node() {}
 
 func (*BlockStatement) node()      {}
 func (*ExpressionStatement) node() {}
@@ -58,6 +60,7 @@ func (*DateTimeLiteral) node()        {}
 func (*DurationLiteral) node()        {}
 func (*FloatLiteral) node()           {}
 func (*IntegerLiteral) node()         {}
+func (*NullLiteral) node()            {}
 func (*PipeLiteral) node()            {}
 func (*RegexpLiteral) node()          {}
 func (*StringLiteral) node()          {}
@@ -69,12 +72,19 @@ type BaseNode struct {
 }
 
 // Location is the source location of the Node
-func (b *BaseNode) Location() *SourceLocation { return b.Loc }
+func (b *BaseNode) Location() *SourceLocation {
+	if b == nil {
+		return nil
+	}
+	return b.Loc
+}
 
 // Program represents a complete program source tree
 type Program struct {
 	*BaseNode
-	Body []Statement `json:"body"`
+	Package *PackageClause       `json:"package,omitempty"`
+	Imports []*ImportDeclaration `json:"imports,omitempty"`
+	Body    []Statement          `json:"body"`
 }
 
 // Type is the abstract type
@@ -83,6 +93,15 @@ func (*Program) Type() string { return "Program" }
 func (p *Program) Copy() Node {
 	np := new(Program)
 	*np = *p
+	if p.Package != nil {
+		np.Package = p.Package.Copy().(*PackageClause)
+	}
+	if len(p.Imports) > 0 {
+		np.Imports = make([]*ImportDeclaration, len(p.Imports))
+		for i, imp := range p.Imports {
+			np.Imports[i] = imp.Copy().(*ImportDeclaration)
+		}
+	}
 	if len(p.Body) > 0 {
 		np.Body = make([]Statement, len(p.Body))
 		for i, s := range p.Body {
@@ -92,6 +111,53 @@ func (p *Program) Copy() Node {
 	return np
 }
 
+// PackageClause declares the name of the package a program is part of
+type PackageClause struct {
+	*BaseNode
+	Name *Identifier `json:"name"`
+}
+
+// Type is the abstract type
+func (*PackageClause) Type() string { return "PackageClause" }
+
+func (c *PackageClause) Copy() Node {
+	if c == nil {
+		return c
+	}
+	nc := new(PackageClause)
+	*nc = *c
+
+	nc.Name = c.Name.Copy().(*Identifier)
+
+	return nc
+}
+
+// ImportDeclaration imports the package at Path into the scope of a program.
+// The package is named As when it is set, otherwise by the name it declares.
+type ImportDeclaration struct {
+	*BaseNode
+	As   *Identifier    `json:"as,omitempty"`
+	Path *StringLiteral `json:"path"`
+}
+
+// Type is the abstract type
+func (*ImportDeclaration) Type() string { return "ImportDeclaration" }
+
+func (d *ImportDeclaration) Copy() Node {
+	if d == nil {
+		return d
+	}
+	nd := new(ImportDeclaration)
+	*nd = *d
+
+	if d.As != nil {
+		nd.As = d.As.Copy().(*Identifier)
+	}
+	nd.Path = d.Path.Copy().(*StringLiteral)
+
+	return nd
+}
+
 // Statement Perhaps we don't even want statements nor expression statements
 type Statement interface {
 	Node
@@ -232,6 +298,7 @@ func (*FloatLiteral) expression()            {}
 func (*Identifier) expression()              {}
 func (*IntegerLiteral) expression()          {}
 func (*LogicalExpression) expression()       {}
+func (*NullLiteral) expression()             {}
 func (*MemberExpression) expression()        {}
 func (*ObjectExpression) expression()        {}
 func (*PipeExpression) expression()          {}
@@ -369,6 +436,7 @@ const (
 	NotEqualOperator
 	RegexpMatchOperator
 	NotRegexpMatchOperator
+	ExistsOperator
 	opEnd
 )
 
@@ -638,6 +706,7 @@ func (*DateTimeLiteral) literal()        {}
 func (*DurationLiteral) literal()        {}
 func (*FloatLiteral) literal()           {}
 func (*IntegerLiteral) literal()         {}
+func (*NullLiteral) literal()            {}
 func (*PipeLiteral) literal()            {}
 func (*RegexpLiteral) literal()          {}
 func (*StringLiteral) literal()          {}
@@ -695,6 +764,23 @@ func (l *BooleanLiteral) Copy() Node {
 	return nl
 }
 
+// NullLiteral represents the absence of a value
+type NullLiteral struct {
+	*BaseNode
+}
+
+// Type is the abstract type
+func (*NullLiteral) Type() string { return "NullLiteral" }
+
+func (l *NullLiteral) Copy() Node {
+	if l == nil {
+		return l
+	}
+	nl := new(NullLiteral)
+	*nl = *l
+	return nl
+}
+
 // FloatLiteral  represent floating point numbers according to the double representations defined by the IEEE-754-1985
 type FloatLiteral struct {
 	*BaseNode
@@ -768,11 +854,15 @@ func (l *RegexpLiteral) Copy() Node {
 }
 
 // DurationLiteral represents the elapsed time between two instants as an
-// int64 nanosecond count with syntax of golang's time.Duration
+// int64 nanosecond count with syntax of golang's time.Duration,
+// and the units d and w for days and weeks of 24 and 168 hours.
+// The calendar units mo and y are counted in months, whose lengths depend on the times they are added to.
 // TODO: this may be better as a class initialization
 type DurationLiteral struct {
 	*BaseNode
 	Value time.Duration `json:"value"`
+	// Months is the number of calendar months of the duration, which are added to its Value.
+	Months int64 `json:"months,omitempty"`
 }
 
 // Type is the abstract type
@@ -826,6 +916,7 @@ var OperatorTokens = map[OperatorKind]string{
 	NotEqualOperator:         "!=",
 	RegexpMatchOperator:      "=~",
 	NotRegexpMatchOperator:   "!~",
+	ExistsOperator:           "exists",
 }
 
 // LogicalOperatorTokens converts LogicalOperatorKind to string
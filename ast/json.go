@@ -42,6 +42,28 @@ func (p *Program) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+func (c *PackageClause) MarshalJSON() ([]byte, error) {
+	type Alias PackageClause
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  c.Type(),
+		Alias: (*Alias)(c),
+	}
+	return json.Marshal(raw)
+}
+func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias ImportDeclaration
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  d.Type(),
+		Alias: (*Alias)(d),
+	}
+	return json.Marshal(raw)
+}
 func (s *BlockStatement) MarshalJSON() ([]byte, error) {
 	type Alias BlockStatement
 	raw := struct {
@@ -598,6 +620,17 @@ func (l *BooleanLiteral) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(raw)
 }
+func (l *NullLiteral) MarshalJSON() ([]byte, error) {
+	type Alias NullLiteral
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  l.Type(),
+		Alias: (*Alias)(l),
+	}
+	return json.Marshal(raw)
+}
 func (l *FloatLiteral) MarshalJSON() ([]byte, error) {
 	type Alias FloatLiteral
 	raw := struct {
@@ -828,6 +861,10 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 	switch typ.Type {
 	case "Program":
 		node = new(Program)
+	case "PackageClause":
+		node = new(PackageClause)
+	case "ImportDeclaration":
+		node = new(ImportDeclaration)
 	case "BlockStatement":
 		node = new(BlockStatement)
 	case "ExpressionStatement":
@@ -864,6 +901,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(StringLiteral)
 	case "BooleanLiteral":
 		node = new(BooleanLiteral)
+	case "NullLiteral":
+		node = new(NullLiteral)
 	case "FloatLiteral":
 		node = new(FloatLiteral)
 	case "IntegerLiteral":
@@ -29,6 +29,29 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"Program","body":[{"type":"ExpressionStatement","expression":{"type":"StringLiteral","value":"hello"}}]}`,
 		},
+		{
+			name: "program with package and imports",
+			node: &ast.Program{
+				Package: &ast.PackageClause{
+					Name: &ast.Identifier{Name: "foo"},
+				},
+				Imports: []*ast.ImportDeclaration{
+					{
+						Path: &ast.StringLiteral{Value: "path/bar"},
+					},
+					{
+						As:   &ast.Identifier{Name: "b"},
+						Path: &ast.StringLiteral{Value: "baz"},
+					},
+				},
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.StringLiteral{Value: "hello"},
+					},
+				},
+			},
+			want: `{"type":"Program","package":{"type":"PackageClause","name":{"type":"Identifier","name":"foo"}},"imports":[{"type":"ImportDeclaration","path":{"type":"StringLiteral","value":"path/bar"}},{"type":"ImportDeclaration","as":{"type":"Identifier","name":"b"},"path":{"type":"StringLiteral","value":"baz"}}],"body":[{"type":"ExpressionStatement","expression":{"type":"StringLiteral","value":"hello"}}]}`,
+		},
 		{
 			name: "block statement",
 			node: &ast.BlockStatement{
@@ -190,6 +213,11 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"BooleanLiteral","value":true}`,
 		},
+		{
+			name: "null literal",
+			node: &ast.NullLiteral{},
+			want: `{"type":"NullLiteral"}`,
+		},
 		{
 			name: "float literal",
 			node: &ast.FloatLiteral{
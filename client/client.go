@@ -0,0 +1,171 @@
+// Package client implements an HTTP client for ifqld.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/pkg/errors"
+)
+
+// DefaultAddr is the default address of an ifqld server.
+const DefaultAddr = "http://localhost:8093"
+
+// Config configures a Client.
+type Config struct {
+	// Addr is the base URL of the ifqld server.
+	// If empty, DefaultAddr is used.
+	Addr string
+	// HTTPClient is used to make requests.
+	// If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// Client submits queries to an ifqld server.
+type Client struct {
+	url     *url.URL
+	client  *http.Client
+	decoder *execute.CSVResultDecoder
+}
+
+// New creates a new Client.
+func New(c Config) (*Client, error) {
+	addr := c.Addr
+	if addr == "" {
+		addr = DefaultAddr
+	}
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported address scheme %q", u.Scheme)
+	}
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	return &Client{
+		url:     u,
+		client:  hc,
+		decoder: execute.NewCSVResultDecoder(),
+	}, nil
+}
+
+// Query submits the IFQL query string for execution.
+// The returned Response streams the results as they are sent by the server,
+// and must be closed once the results have been consumed.
+// Canceling the context aborts the query.
+func (c *Client) Query(ctx context.Context, q string) (*Response, error) {
+	form := url.Values{"q": {q}}
+	req, err := http.NewRequest("POST", c.endpoint("/query"), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return c.query(ctx, req)
+}
+
+// QuerySpec submits the query spec for execution.
+// The returned Response streams the results as they are sent by the server,
+// and must be closed once the results have been consumed.
+// Canceling the context aborts the query.
+func (c *Client) QuerySpec(ctx context.Context, spec *query.Spec) (*Response, error) {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode query spec")
+	}
+	req, err := http.NewRequest("POST", c.endpoint("/query"), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.query(ctx, req)
+}
+
+func (c *Client) query(ctx context.Context, req *http.Request) (*Response, error) {
+	req.Header.Set("Accept", "text/csv")
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{
+		ResultIterator: c.decoder.Results(resp.Body),
+		body:           resp.Body,
+	}, nil
+}
+
+// QueryStatus describes a query that is active on the server.
+type QueryStatus struct {
+	ID    string
+	State string
+}
+
+// Queries lists the queries that are active on the server.
+func (c *Client) Queries(ctx context.Context) ([]QueryStatus, error) {
+	req, err := http.NewRequest("GET", c.endpoint("/queries"), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var queries struct {
+		Queries []QueryStatus
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&queries); err != nil {
+		return nil, errors.Wrap(err, "failed to decode queries")
+	}
+	return queries.Queries, nil
+}
+
+func (c *Client) endpoint(path string) string {
+	u := *c.url
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	return u.String()
+}
+
+// do performs the request, converting any non successful response into an *Error.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		// Error messages are short, do not read unbounded responses.
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		return nil, &Error{
+			Kind:       kindFromStatus(resp.StatusCode),
+			StatusCode: resp.StatusCode,
+			Message:    strings.TrimSpace(string(msg)),
+		}
+	}
+	return resp, nil
+}
+
+// Response streams the results of a query.
+// Results are produced in the order they are sent by the server, see execute.ResultIterator.
+// A query that fails while its results are sent ends them early, Err reports the error.
+type Response struct {
+	execute.ResultIterator
+	body io.ReadCloser
+}
+
+// Close releases the connection to the server.
+// Any results that have not been consumed are discarded.
+func (r *Response) Close() error {
+	return r.body.Close()
+}
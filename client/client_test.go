@@ -0,0 +1,288 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/ifql/client"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+)
+
+const encodedResult = `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string
+#kind,,,,time,value,tag
+#common,,,,false,false,true
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,,a
+,result,_start,_stop,_time,_value,t1
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000001Z,2,a
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000002Z,3,a
+`
+
+var wantResult = map[string][]*executetest.Block{
+	"_result": {{
+		Bnds: execute.Bounds{
+			Start: 0,
+			Stop:  100,
+		},
+		ColMeta: []execute.ColMeta{
+			{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+			{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+			{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		},
+		Data: [][]interface{}{
+			{execute.Time(10), 2.0, "a"},
+			{execute.Time(20), 3.0, "a"},
+		},
+	}},
+}
+
+func readResponse(t *testing.T, resp *client.Response) map[string][]*executetest.Block {
+	t.Helper()
+	defer resp.Close()
+	got := make(map[string][]*executetest.Block)
+	for resp.More() {
+		name, r := resp.Next()
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			got[name] = append(got[name], executetest.ConvertBlock(b))
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := resp.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestClient_Query(t *testing.T) {
+	q := `from(db:"mydb") |> range(start:-1h)`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/query" {
+			http.NotFound(w, r)
+			return
+		}
+		if got, want := r.Header.Get("Accept"), "text/csv"; got != want {
+			t.Errorf("unexpected Accept header got %q want %q", got, want)
+		}
+		if got := r.FormValue("q"); got != q {
+			t.Errorf("unexpected query got %q want %q", got, q)
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, encodedResult)
+	}))
+	defer ts.Close()
+
+	c, err := client.New(client.Config{Addr: ts.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Query(context.Background(), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readResponse(t, resp); !cmp.Equal(wantResult, got) {
+		t.Errorf("unexpected results -want/+got\n%s", cmp.Diff(wantResult, got))
+	}
+}
+
+func TestClient_QuerySpec(t *testing.T) {
+	spec := &query.Spec{
+		Operations: []*query.Operation{
+			{
+				ID: "from",
+				Spec: &functions.FromOpSpec{
+					Database: "mydb",
+				},
+			},
+		},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Content-Type"), "application/json"; got != want {
+			t.Errorf("unexpected Content-Type header got %q want %q", got, want)
+		}
+		got := new(query.Spec)
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !cmp.Equal(spec, got, cmpopts.IgnoreUnexported(query.Spec{})) {
+			t.Errorf("unexpected spec -want/+got\n%s", cmp.Diff(spec, got, cmpopts.IgnoreUnexported(query.Spec{})))
+		}
+		fmt.Fprint(w, encodedResult)
+	}))
+	defer ts.Close()
+
+	c, err := client.New(client.Config{Addr: ts.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.QuerySpec(context.Background(), spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readResponse(t, resp); !cmp.Equal(wantResult, got) {
+		t.Errorf("unexpected results -want/+got\n%s", cmp.Diff(wantResult, got))
+	}
+}
+
+func TestClient_Queries(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/queries" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"Queries":[{"ID":"1","State":"executing"},{"ID":"2","State":"queueing"}]}`)
+	}))
+	defer ts.Close()
+
+	c, err := client.New(client.Config{Addr: ts.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.Queries(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []client.QueryStatus{
+		{ID: "1", State: "executing"},
+		{ID: "2", State: "queueing"},
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected queries -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    int
+		message   string
+		want      client.ErrorKind
+		temporary bool
+	}{
+		{
+			name:    "invalid query",
+			status:  http.StatusBadRequest,
+			message: "Error parsing query spec",
+			want:    client.InvalidQueryError,
+		},
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			want:   client.NotFoundError,
+		},
+		{
+			name:      "unavailable",
+			status:    http.StatusServiceUnavailable,
+			want:      client.UnavailableError,
+			temporary: true,
+		},
+		{
+			name:    "internal",
+			status:  http.StatusInternalServerError,
+			message: "Error executing query",
+			want:    client.InternalError,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.message)
+			}))
+			defer ts.Close()
+
+			c, err := client.New(client.Config{Addr: ts.URL})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = c.Query(context.Background(), "from(db:\"mydb\")")
+			e, ok := err.(*client.Error)
+			if !ok {
+				t.Fatalf("expected *client.Error got %v", err)
+			}
+			if e.Kind != tc.want {
+				t.Errorf("unexpected error kind got %v want %v", e.Kind, tc.want)
+			}
+			if e.StatusCode != tc.status {
+				t.Errorf("unexpected status code got %d want %d", e.StatusCode, tc.status)
+			}
+			if e.Message != tc.message {
+				t.Errorf("unexpected message got %q want %q", e.Message, tc.message)
+			}
+			if e.Temporary() != tc.temporary {
+				t.Errorf("unexpected temporary got %t want %t", e.Temporary(), tc.temporary)
+			}
+		})
+	}
+}
+
+func TestClient_QueryExecutionError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, encodedResult+"\n")
+		// The query fails after the first result has been sent.
+		execute.NewCSVResultEncoder().EncodeError(w, errors.New("memory quota exceeded"))
+	}))
+	defer ts.Close()
+
+	c, err := client.New(client.Config{Addr: ts.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Query(context.Background(), "from(db:\"mydb\")")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Close()
+	n := 0
+	for resp.More() {
+		_, r := resp.Next()
+		r.Blocks().Do(func(b execute.Block) error {
+			n++
+			return nil
+		})
+	}
+	if n != 1 {
+		t.Errorf("unexpected number of blocks got %d want 1", n)
+	}
+	if err := resp.Err(); err == nil || err.Error() != "memory quota exceeded" {
+		t.Errorf("unexpected error got %v want %q", err, "memory quota exceeded")
+	}
+}
+
+func TestClient_QueryCanceled(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	c, err := client.New(client.Config{Addr: ts.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.Query(ctx, "from(db:\"mydb\")"); err == nil {
+		t.Fatal("expected error from canceled query")
+	}
+}
+
+func TestNew_InvalidAddr(t *testing.T) {
+	if _, err := client.New(client.Config{Addr: "localhost:8093"}); err == nil {
+		t.Fatal("expected error for address without scheme")
+	}
+}
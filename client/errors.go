@@ -0,0 +1,81 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ErrorKind classifies an error returned by the server.
+type ErrorKind int
+
+const (
+	// UnknownError is an error whose cause could not be determined.
+	UnknownError ErrorKind = iota
+	// InvalidQueryError indicates the query could not be compiled or is otherwise invalid.
+	InvalidQueryError
+	// NotFoundError indicates the requested resource does not exist.
+	NotFoundError
+	// UnavailableError indicates the server cannot accept the request right now,
+	// the request may be retried later.
+	UnavailableError
+	// InternalError indicates the server failed to process a valid request.
+	InternalError
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case UnknownError:
+		return "unknown error"
+	case InvalidQueryError:
+		return "invalid query"
+	case NotFoundError:
+		return "not found"
+	case UnavailableError:
+		return "unavailable"
+	case InternalError:
+		return "internal error"
+	default:
+		return fmt.Sprintf("error kind %d", int(k))
+	}
+}
+
+func kindFromStatus(code int) ErrorKind {
+	switch {
+	case code == http.StatusNotFound:
+		return NotFoundError
+	case code == http.StatusServiceUnavailable, code == http.StatusTooManyRequests:
+		return UnavailableError
+	case code >= 400 && code < 500:
+		return InvalidQueryError
+	case code >= 500:
+		return InternalError
+	default:
+		return UnknownError
+	}
+}
+
+// Error is returned when the server responds with a non successful status code.
+type Error struct {
+	Kind       ErrorKind
+	StatusCode int
+	// Message is the error message reported by the server.
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("%v: %d %s", e.Kind, e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("%v: %s", e.Kind, e.Message)
+}
+
+// Temporary reports whether the request may succeed if retried.
+func (e *Error) Temporary() bool {
+	return e.Kind == UnavailableError
+}
+
+// IsInvalidQuery reports whether err was caused by an invalid query.
+func IsInvalidQuery(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Kind == InvalidQueryError
+}
@@ -1,18 +1,27 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"math"
 	"os"
+	"path/filepath"
 	"runtime"
 
 	"github.com/influxdata/ifql"
+	"github.com/influxdata/ifql/format"
+	"github.com/influxdata/ifql/lsp"
+	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/repl"
 )
 
 var verbose = flag.Bool("v", false, "print verbose output")
+var writeAddr = flag.String("write-address", "http://localhost:8086", "The InfluxDB HTTP API address that the to function writes to.")
+var searchPath = flag.String("ifql-path", os.Getenv("IFQL_PATH"), "Directories searched for the packages that queries import, separated by ':'.")
+var csvDir = flag.String("csv-dir", "", "Directory that the fromCSV function reads files from. Reading files is disabled if no directory is given.")
 
 var hosts = make(hostList, 0)
 
@@ -35,6 +44,8 @@ var defaultStorageHosts = []string{"localhost:8082"}
 
 func usage() {
 	fmt.Println("Usage: ifql [OPTIONS] [query]")
+	fmt.Println("       ifql fmt [files...]")
+	fmt.Println("       ifql lsp")
 	fmt.Println()
 	fmt.Println("Runs queries using the IFQL engine.")
 	fmt.Println()
@@ -42,6 +53,11 @@ func usage() {
 	fmt.Println()
 	fmt.Println("The query argument is either a string query or a path to a file prefixed with an '@'.")
 	fmt.Println()
+	fmt.Println("The fmt command prints the files, or the standard input, in the canonical IFQL style.")
+	fmt.Println("Files with the .json extension are query specs, which are printed as IFQL programs.")
+	fmt.Println()
+	fmt.Println("The lsp command runs a Language Server Protocol server for editors over the standard input and output.")
+	fmt.Println()
 	fmt.Println("Options:")
 
 	flag.PrintDefaults()
@@ -51,14 +67,35 @@ func main() {
 	flag.Usage = usage
 	flag.Parse()
 
+	args := flag.Args()
+	if len(args) > 0 {
+		switch args[0] {
+		case "fmt":
+			if err := formatFiles(args[1:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		case "lsp":
+			loader := query.NewLoader(filepath.SplitList(*searchPath)...)
+			if err := lsp.NewServer(os.Stdin, os.Stdout, loader).Run(); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+	}
+
 	if len(hosts) == 0 {
 		hosts = defaultStorageHosts
 	}
 
 	c, err := ifql.NewController(ifql.Config{
 		Hosts:            hosts,
+		WriteAddr:        *writeAddr,
 		ConcurrencyQuota: runtime.NumCPU() * 2,
 		MemoryBytesQuota: math.MaxInt64,
+		SearchPath:       filepath.SplitList(*searchPath),
+		CSVDir:           *csvDir,
 		Verbose:          *verbose,
 	})
 	if err != nil {
@@ -66,7 +103,6 @@ func main() {
 	}
 	replCmd := repl.New(c)
 
-	args := flag.Args()
 	switch len(args) {
 	case 0:
 		replCmd.Run()
@@ -84,3 +120,49 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// formatFiles prints the formatted source of the files, or of the standard input if there are none.
+func formatFiles(files []string) error {
+	if len(files) == 0 {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		src, err := format.Source(string(data))
+		if err != nil {
+			return err
+		}
+		fmt.Print(src)
+		return nil
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var src string
+		if filepath.Ext(file) == ".json" {
+			src, err = decompile(data)
+		} else {
+			src, err = format.Source(string(data))
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+		fmt.Print(src)
+	}
+	return nil
+}
+
+// decompile formats the IFQL program of a JSON query spec.
+func decompile(data []byte) (string, error) {
+	spec := new(query.Spec)
+	if err := json.Unmarshal(data, spec); err != nil {
+		return "", err
+	}
+	prog, err := query.Decompile(spec)
+	if err != nil {
+		return "", err
+	}
+	return format.Format(prog), nil
+}
@@ -1,8 +1,8 @@
 /*
 IFQLD is a basic HTTP server that exposes a sinle endpoint
 for processing IFQL queries to 1 or more InfluxDB servers.
-It can return data in either line protocol or a new JSON
-lines format. Requests go here:
+It can return data in line protocol, a new JSON lines
+format or annotated CSV. Requests go here:
 
 http://localhost:8080/query?q=...&verbose=true&trace=true&format=line|json
 
@@ -10,5 +10,15 @@ q is the IFQL query string. Format specifies what the response
 format should be. JSON is the default. verbose and trace are optional
 parameters that will make the server output additional log
 information.
+
+The response format may also be selected with the Accept header,
+using application/json or text/csv.
+
+IFQL queries are formatted in the canonical style here:
+
+http://localhost:8080/format?q=...&lang=ifql|promql
+
+Query specs posted as JSON, and PromQL queries, are decompiled
+to IFQL programs.
 */
 package main
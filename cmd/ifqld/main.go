@@ -7,19 +7,26 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
 	"sync/atomic"
 	"time"
 
 	"github.com/influxdata/ifql"
+	"github.com/influxdata/ifql/diagnostic"
+	"github.com/influxdata/ifql/format"
 	"github.com/influxdata/ifql/idfile"
+	"github.com/influxdata/ifql/promql"
+	promapi "github.com/influxdata/ifql/promql/api"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/schedule"
 	"github.com/influxdata/ifql/tracing"
 	"github.com/influxdata/influxdb/models"
 	client "github.com/influxdata/usage-client/v1"
 	"github.com/jessevdk/go-flags"
 	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,12 +41,16 @@ var queryCount int64
 
 type options struct {
 	Hosts             []string       `long:"host" short:"h" description:"influx hosts to query from. Can be specified more than once for multiple hosts." default:"localhost:8082" env:"HOSTS" env-delim:","`
+	WriteAddr         string         `long:"write-address" description:"The InfluxDB HTTP API address that the to function writes to" default:"http://localhost:8086" env:"WRITE_ADDRESS"`
 	Addr              string         `long:"bind-address" short:"b" description:"The address to listen on for HTTP requests" default:":8093" env:"BIND_ADDRESS"`
 	IDFile            flags.Filename `long:"id-file" description:"Path to file that persists ifqld id" env:"ID_FILE" default:"./ifqld.id"`
+	TasksFile         flags.Filename `long:"tasks-file" description:"Path to file that persists scheduled tasks and their run history" env:"TASKS_FILE" default:"./ifqld.tasks"`
 	ReportingDisabled bool           `short:"r" long:"reporting-disabled" description:"Disable reporting of usage stats (os,arch,version,cluster_id,uptime,queryCount) once every 4hrs" env:"REPORTING_DISABLED"`
 	Verbose           bool           `short:"v" long:"verbose" description:"Log more verbose debugging output"`
 	ConcurrencyQuota  int            `short:"c" long:"concurrency-quota" description:"Maximum concurrency allowed" env:"CONCURRENCY_QUOTA"`
 	MemoryBytesQuota  int            `short:"m" long:"memory-quota" description:"Approximate maximum memory usage allowed in bytes" env:"MEMORY_BYTES_QUOTA"`
+	SearchPath        []string       `long:"ifql-path" description:"Directories searched for the packages that queries import. Can be specified more than once." env:"IFQL_PATH" env-delim:":"`
+	CSVDir            string         `long:"csv-dir" description:"Directory that the fromCSV function reads files from. Reading files is disabled if no directory is given." env:"CSV_DIR"`
 }
 
 var opts = options{
@@ -81,17 +92,34 @@ func main() {
 	}
 	c, err := ifql.NewController(ifql.Config{
 		Hosts:            opts.Hosts,
+		WriteAddr:        opts.WriteAddr,
 		ConcurrencyQuota: opts.ConcurrencyQuota,
 		MemoryBytesQuota: opts.MemoryBytesQuota,
+		SearchPath:       opts.SearchPath,
+		CSVDir:           opts.CSVDir,
 	})
 	if err != nil {
 		log.Fatal(err)
 	}
 	controller = c
 
+	scheduler, err := schedule.New(schedule.Config{
+		Controller: c,
+		Path:       string(opts.TasksFile),
+		Verbose:    opts.Verbose,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	http.Handle("/metrics", promhttp.Handler())
 	http.Handle("/query", http.HandlerFunc(HandleQuery))
 	http.Handle("/queries", http.HandlerFunc(HandleQueries))
+	http.Handle("/format", http.HandlerFunc(HandleFormat))
+	tasks := schedule.NewHandler(scheduler, "/tasks")
+	http.Handle("/tasks", tasks)
+	http.Handle("/tasks/", tasks)
+	http.Handle("/api/v1/", promapi.NewHandler(c, "/api/v1"))
 
 	if !opts.ReportingDisabled {
 		id := ID(string(opts.IDFile))
@@ -109,7 +137,7 @@ func main() {
 // TODO (pauldix): pull all this out into a server object that can
 //                 be tested. Alas, demo day waits for no person.
 
-// HandleQuery interprets and executes ifql syntax and returns results
+// HandleQuery interprets and executes ifql syntax, or PromQL when the lang parameter is promql, and returns results
 func HandleQuery(w http.ResponseWriter, req *http.Request) {
 	span, ctx := opentracing.StartSpanFromContext(req.Context(), "query")
 	defer span.Finish()
@@ -124,7 +152,7 @@ func HandleQuery(w http.ResponseWriter, req *http.Request) {
 	if req.Header.Get("Content-type") == "application/json" {
 		spec := new(query.Spec)
 		if err := json.NewDecoder(req.Body).Decode(spec); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte(fmt.Sprintf("Error parsing query spec %s", err.Error())))
 			log.Println("Error:", err)
 			return
@@ -143,21 +171,52 @@ func HandleQuery(w http.ResponseWriter, req *http.Request) {
 		}
 
 		analyze := req.FormValue("analyze") != ""
-		if analyze {
-			spec, err := query.Compile(ctx, queryStr)
+		switch lang := req.FormValue("lang"); lang {
+		case "", "ifql":
+			if analyze {
+				spec, err := query.Compile(ctx, queryStr, query.WithLoader(controller.Loader()))
+				if diags, ok := err.(diagnostic.List); ok {
+					writeDiagnostics(w, req, queryStr, diags)
+					return
+				}
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte(fmt.Sprintf("Error compiling query %s", err.Error())))
+					return
+				}
+				encodeJSON(w, http.StatusOK, spec)
+				return
+			}
+
+			q, err = controller.QueryWithCompile(ctx, queryStr)
+			if diags, ok := errors.Cause(err).(diagnostic.List); ok {
+				writeDiagnostics(w, req, queryStr, diags)
+				return
+			}
+		case "promql":
+			var spec *query.Spec
+			spec, err = promql.Build(queryStr)
 			if err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
+				w.WriteHeader(http.StatusBadRequest)
 				w.Write([]byte(fmt.Sprintf("Error compiling query %s", err.Error())))
 				return
 			}
-			encodeJSON(w, http.StatusOK, spec)
+			if analyze {
+				encodeJSON(w, http.StatusOK, spec)
+				return
+			}
+
+			q, err = controller.Query(ctx, spec)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("unknown query language %q, must be ifql or promql", lang)))
 			return
 		}
-
-		q, err = controller.QueryWithCompile(ctx, queryStr)
 	}
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		// The controller only fails to construct queries that do not compile or are invalid,
+		// errors while executing the query are reported once it runs.
+		w.WriteHeader(http.StatusBadRequest)
 		w.Write([]byte(fmt.Sprintf("Error constructing query %s", err.Error())))
 		return
 	}
@@ -190,11 +249,74 @@ func HandleQuery(w http.ResponseWriter, req *http.Request) {
 	switch req.Header.Get("Accept") {
 	case "application/json":
 		writeJSONChunks(results, w)
+	case "text/csv":
+		writeCSVResults(results, w)
 	default:
 		writeLineResults(results, w)
 	}
 }
 
+// HandleFormat returns ifql syntax in the canonical style.
+// Query specs posted as JSON and PromQL queries are decompiled to ifql.
+func HandleFormat(w http.ResponseWriter, req *http.Request) {
+	var spec *query.Spec
+	if req.Header.Get("Content-type") == "application/json" {
+		spec = new(query.Spec)
+		if err := json.NewDecoder(req.Body).Decode(spec); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("Error parsing query spec %s", err.Error())))
+			return
+		}
+	} else {
+		queryStr := req.FormValue("q")
+		if queryStr == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("must pass query in q parameter"))
+			return
+		}
+		switch lang := req.FormValue("lang"); lang {
+		case "", "ifql":
+			src, err := format.Source(queryStr)
+			if diags, ok := err.(diagnostic.List); ok {
+				writeDiagnostics(w, req, queryStr, diags)
+				return
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf("Error parsing query %s", err.Error())))
+				return
+			}
+			writeSource(w, src)
+			return
+		case "promql":
+			var err error
+			spec, err = promql.Build(queryStr)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf("Error compiling query %s", err.Error())))
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("unknown query language %q, must be ifql or promql", lang)))
+			return
+		}
+	}
+	prog, err := query.Decompile(spec)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf("Error decompiling query %s", err.Error())))
+		return
+	}
+	writeSource(w, format.Format(prog))
+}
+
+func writeSource(w http.ResponseWriter, src string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(src))
+}
+
 type QueriesResponse struct {
 	Queries []Query
 }
@@ -324,6 +446,13 @@ func writeJSONChunks(results map[string]execute.Result, w http.ResponseWriter) {
 					ch.Points[i].Time = time.Time().UnixNano()
 
 					for j, c := range rr.Cols() {
+						// Null values are written as null, and null strings are left out of the context.
+						if rr.IsNull(i, j) {
+							if c.IsValue() && (c.Common || c.Type != execute.TString) {
+								ch.Points[i].Value = nil
+							}
+							continue
+						}
 						if !c.Common && c.Type == execute.TString {
 							if ch.Points[i].Context == nil {
 								ch.Points[i].Context = make(map[string]string)
@@ -374,6 +503,40 @@ func writeJSONChunks(results map[string]execute.Result, w http.ResponseWriter) {
 	}
 }
 
+func writeCSVResults(results map[string]execute.Result, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	encoder := execute.NewCSVResultEncoder()
+	for i, name := range names {
+		if i > 0 {
+			// Separate results with an empty line, the same as blocks within a result.
+			if _, err := w.Write([]byte("\n")); err != nil {
+				log.Println("error writing newline: ", err.Error())
+				return
+			}
+		}
+		if err := encoder.Encode(w, name, results[name]); err != nil {
+			log.Println("Error encoding results:", err)
+			// The status has already been sent, so the error ends the results instead,
+			// which tells the client that they are incomplete.
+			if _, err := w.Write([]byte("\n")); err != nil {
+				return
+			}
+			if err := encoder.EncodeError(w, err); err != nil {
+				log.Println("Error encoding error:", err)
+			}
+			return
+		}
+		w.(http.Flusher).Flush()
+	}
+}
+
 func writeLineResults(results map[string]execute.Result, w http.ResponseWriter) {
 	for _, r := range results {
 		iterateResults(r, func(m, f string, tags map[string]string, val interface{}, t time.Time) {
@@ -429,6 +592,25 @@ func reportUsageStats(id string) {
 	}
 }
 
+// writeDiagnostics responds with the problems found in a query.
+// They are rendered as snippets of the query with the problems marked by carets,
+// unless JSON is accepted.
+func writeDiagnostics(w http.ResponseWriter, req *http.Request, queryStr string, diags diagnostic.List) {
+	if req.Header.Get("Accept") == "application/json" {
+		encodeJSON(w, http.StatusBadRequest, struct {
+			Error       string          `json:"error"`
+			Diagnostics diagnostic.List `json:"diagnostics"`
+		}{
+			Error:       diags.Error(),
+			Diagnostics: diags,
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	w.Write([]byte("Error compiling query\n" + diags.Render(queryStr)))
+}
+
 func encodeJSON(w http.ResponseWriter, status int, v interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
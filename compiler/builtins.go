@@ -0,0 +1,336 @@
+package compiler
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/ifql/semantic"
+)
+
+// Builtin is a native function that compiled functions can call.
+type Builtin struct {
+	// Signature is the signature of the function.
+	// A parameter of type Invalid accepts arguments of any type.
+	Signature semantic.FunctionSignature
+	// Defaults are the values of the parameters whose arguments are optional.
+	Defaults Scope
+	// Call calls the function with the values of its arguments by the names of its parameters,
+	// the arguments that are not passed have their default values.
+	Call func(args Scope) (Value, error)
+}
+
+// Builtins returns the native functions that compiled functions can call, by their names.
+// The members of a package are named by the name of the package and their own name, such as strings.toLower.
+// It is safe to modify the returned map.
+func Builtins() map[string]Builtin {
+	cpy := make(map[string]Builtin, len(builtins))
+	for name, b := range builtins {
+		cpy[name] = b
+	}
+	return cpy
+}
+
+func params(t semantic.Type, names ...string) map[string]semantic.Type {
+	params := make(map[string]semantic.Type, len(names))
+	for _, name := range names {
+		params[name] = t
+	}
+	return params
+}
+
+func mathFunc(f func(float64) float64) Builtin {
+	return Builtin{
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.Float, "x"),
+			ReturnType: semantic.Float,
+		},
+		Call: func(args Scope) (Value, error) {
+			return NewFloat(f(args.GetFloat("x"))), nil
+		},
+	}
+}
+
+func conversion(to semantic.Kind, convert func(v Value) (Value, error)) Builtin {
+	return Builtin{
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.Invalid, "v"),
+			ReturnType: to,
+		},
+		Call: func(args Scope) (Value, error) {
+			v := args["v"]
+			if v.Type() == to {
+				return v, nil
+			}
+			return convert(v)
+		},
+	}
+}
+
+// timeFunc is a function of a time in a location, the location is UTC unless another is passed.
+func timeFunc(ret semantic.Kind, f func(t time.Time) Value) Builtin {
+	return Builtin{
+		Signature: semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"t":        semantic.Time,
+				"location": semantic.String,
+			},
+			ReturnType: ret,
+		},
+		Defaults: Scope{
+			"location": NewString("UTC"),
+		},
+		Call: func(args Scope) (Value, error) {
+			t, err := localTime(args.GetTime("t"), args.GetString("location"))
+			if err != nil {
+				return nil, err
+			}
+			return f(t), nil
+		},
+	}
+}
+
+// locations caches the locations that are loaded by name.
+var locations sync.Map
+
+// localTime returns the time in the location, which is named by the IANA Time Zone database, such as America/New_York.
+func localTime(t Time, location string) (time.Time, error) {
+	loc, ok := locations.Load(location)
+	if !ok {
+		l, err := time.LoadLocation(location)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown location %q", location)
+		}
+		loc, _ = locations.LoadOrStore(location, l)
+	}
+	return time.Unix(0, int64(t)).In(loc.(*time.Location)), nil
+}
+
+// truncate truncates the time to a multiple of the unit in its location,
+// so that days start at the local midnight and weeks on the local Monday.
+func truncate(t time.Time, unit time.Duration) time.Time {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	wall = wall.Truncate(unit)
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), t.Location())
+}
+
+func cannotConvert(v Value, to semantic.Kind) error {
+	return fmt.Errorf("cannot convert %v to %v", v.Type(), to)
+}
+
+// Map of builtin functions
+var builtins = map[string]Builtin{
+	//------------------
+	// String Functions
+	//------------------
+	"strings.contains": {
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.String, "v", "substr"),
+			ReturnType: semantic.Bool,
+		},
+		Call: func(args Scope) (Value, error) {
+			return NewBool(strings.Contains(args.GetString("v"), args.GetString("substr"))), nil
+		},
+	},
+	"strings.hasPrefix": {
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.String, "v", "prefix"),
+			ReturnType: semantic.Bool,
+		},
+		Call: func(args Scope) (Value, error) {
+			return NewBool(strings.HasPrefix(args.GetString("v"), args.GetString("prefix"))), nil
+		},
+	},
+	"strings.toLower": {
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.String, "v"),
+			ReturnType: semantic.String,
+		},
+		Call: func(args Scope) (Value, error) {
+			return NewString(strings.ToLower(args.GetString("v"))), nil
+		},
+	},
+	"strings.replace": {
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.String, "v", "old", "new"),
+			ReturnType: semantic.String,
+		},
+		Call: func(args Scope) (Value, error) {
+			return NewString(strings.Replace(args.GetString("v"), args.GetString("old"), args.GetString("new"), -1)), nil
+		},
+	},
+	"strings.split": {
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.String, "v", "sep"),
+			ReturnType: semantic.NewArrayType(semantic.String),
+		},
+		Call: func(args Scope) (Value, error) {
+			arr := NewArray(semantic.String)
+			for _, s := range strings.Split(args.GetString("v"), args.GetString("sep")) {
+				arr.Append(NewString(s))
+			}
+			return arr, nil
+		},
+	},
+	//----------------
+	// Math Functions
+	//----------------
+	"math.abs":   mathFunc(math.Abs),
+	"math.sqrt":  mathFunc(math.Sqrt),
+	"math.log":   mathFunc(math.Log),
+	"math.round": mathFunc(math.Round),
+	"math.floor": mathFunc(math.Floor),
+	"math.pow": {
+		Signature: semantic.FunctionSignature{
+			Params:     params(semantic.Float, "x", "y"),
+			ReturnType: semantic.Float,
+		},
+		Call: func(args Scope) (Value, error) {
+			return NewFloat(math.Pow(args.GetFloat("x"), args.GetFloat("y"))), nil
+		},
+	},
+	//----------------
+	// Time Functions
+	//----------------
+	"year": timeFunc(semantic.Int, func(t time.Time) Value {
+		return NewInt(int64(t.Year()))
+	}),
+	// month is the month of the year, from 1 for January to 12 for December.
+	"month": timeFunc(semantic.Int, func(t time.Time) Value {
+		return NewInt(int64(t.Month()))
+	}),
+	// day is the day of the month.
+	"day": timeFunc(semantic.Int, func(t time.Time) Value {
+		return NewInt(int64(t.Day()))
+	}),
+	// weekday is the day of the week, from 0 for Sunday to 6 for Saturday.
+	"weekday": timeFunc(semantic.Int, func(t time.Time) Value {
+		return NewInt(int64(t.Weekday()))
+	}),
+	"hour": timeFunc(semantic.Int, func(t time.Time) Value {
+		return NewInt(int64(t.Hour()))
+	}),
+	"minute": timeFunc(semantic.Int, func(t time.Time) Value {
+		return NewInt(int64(t.Minute()))
+	}),
+	"truncate": {
+		Signature: semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"t":        semantic.Time,
+				"unit":     semantic.Duration,
+				"location": semantic.String,
+			},
+			ReturnType: semantic.Time,
+		},
+		Defaults: Scope{
+			"location": NewString("UTC"),
+		},
+		Call: func(args Scope) (Value, error) {
+			unit := time.Duration(args.GetDuration("unit"))
+			if unit <= 0 {
+				return nil, fmt.Errorf("unit must be positive, got %v", unit)
+			}
+			t, err := localTime(args.GetTime("t"), args.GetString("location"))
+			if err != nil {
+				return nil, err
+			}
+			return NewTime(Time(truncate(t, unit).UnixNano())), nil
+		},
+	},
+	//------------------
+	// Type Conversions
+	//------------------
+	"int": conversion(semantic.Int, func(v Value) (Value, error) {
+		switch v.Type().Kind() {
+		case semantic.UInt:
+			return NewInt(int64(v.UInt())), nil
+		case semantic.Float:
+			return NewInt(int64(v.Float())), nil
+		case semantic.Bool:
+			if v.Bool() {
+				return NewInt(1), nil
+			}
+			return NewInt(0), nil
+		case semantic.String:
+			i, err := strconv.ParseInt(v.Str(), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert string %q to int", v.Str())
+			}
+			return NewInt(i), nil
+		case semantic.Time:
+			return NewInt(int64(v.Time())), nil
+		case semantic.Duration:
+			return NewInt(int64(v.Duration())), nil
+		}
+		return nil, cannotConvert(v, semantic.Int)
+	}),
+	"float": conversion(semantic.Float, func(v Value) (Value, error) {
+		switch v.Type().Kind() {
+		case semantic.Int:
+			return NewFloat(float64(v.Int())), nil
+		case semantic.UInt:
+			return NewFloat(float64(v.UInt())), nil
+		case semantic.String:
+			f, err := strconv.ParseFloat(v.Str(), 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert string %q to float", v.Str())
+			}
+			return NewFloat(f), nil
+		}
+		return nil, cannotConvert(v, semantic.Float)
+	}),
+	"string": conversion(semantic.String, func(v Value) (Value, error) {
+		switch v.Type().Kind() {
+		case semantic.Bool:
+			return NewString(strconv.FormatBool(v.Bool())), nil
+		case semantic.Int:
+			return NewString(strconv.FormatInt(v.Int(), 10)), nil
+		case semantic.UInt:
+			return NewString(strconv.FormatUint(v.UInt(), 10)), nil
+		case semantic.Float:
+			return NewString(strconv.FormatFloat(v.Float(), 'f', -1, 64)), nil
+		case semantic.Time:
+			return NewString(time.Unix(0, int64(v.Time())).UTC().Format(time.RFC3339Nano)), nil
+		case semantic.Duration:
+			return NewString(time.Duration(v.Duration()).String()), nil
+		case semantic.Regexp:
+			return NewString(v.Regexp().String()), nil
+		}
+		return nil, cannotConvert(v, semantic.String)
+	}),
+	"bool": conversion(semantic.Bool, func(v Value) (Value, error) {
+		switch v.Type().Kind() {
+		case semantic.Int:
+			return NewBool(v.Int() != 0), nil
+		case semantic.UInt:
+			return NewBool(v.UInt() != 0), nil
+		case semantic.Float:
+			return NewBool(v.Float() != 0), nil
+		case semantic.String:
+			b, err := strconv.ParseBool(v.Str())
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert string %q to bool", v.Str())
+			}
+			return NewBool(b), nil
+		}
+		return nil, cannotConvert(v, semantic.Bool)
+	}),
+	"time": conversion(semantic.Time, func(v Value) (Value, error) {
+		switch v.Type().Kind() {
+		case semantic.Int:
+			return NewTime(Time(v.Int())), nil
+		case semantic.UInt:
+			return NewTime(Time(v.UInt())), nil
+		case semantic.String:
+			t, err := time.Parse(time.RFC3339Nano, v.Str())
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert string %q to time", v.Str())
+			}
+			return NewTime(Time(t.UnixNano())), nil
+		}
+		return nil, cannotConvert(v, semantic.Time)
+	}),
+}
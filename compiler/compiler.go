@@ -3,7 +3,10 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 
+	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/semantic"
 )
 
@@ -15,13 +18,15 @@ func Compile(f *semantic.FunctionExpression, inTypes map[string]semantic.Type) (
 	f = f.Copy().(*semantic.FunctionExpression)
 	semantic.ApplyNewDeclarations(f, declarations)
 
-	root, err := compile(f.Body)
-	if err != nil {
-		return nil, err
-	}
 	cpy := make(map[string]semantic.Type)
+	types := make(map[string]semantic.Type)
 	for k, v := range inTypes {
 		cpy[k] = v
+		types[k] = v
+	}
+	root, err := compile(f.Body, types)
+	if err != nil {
+		return nil, err
 	}
 	return compiledFn{
 		root:    root,
@@ -29,25 +34,31 @@ func Compile(f *semantic.FunctionExpression, inTypes map[string]semantic.Type) (
 	}, nil
 }
 
-func compile(n semantic.Node) (Evaluator, error) {
+// compile creates the evaluator of a node.
+// The types are the types of the variables in scope, the types of the values returned by calls are only known once they are compiled.
+func compile(n semantic.Node, types map[string]semantic.Type) (Evaluator, error) {
 	switch n := n.(type) {
 	case *semantic.BlockStatement:
 		body := make([]Evaluator, len(n.Body))
+		t := semantic.Type(semantic.Invalid)
 		for i, s := range n.Body {
-			node, err := compile(s)
+			node, err := compile(s, types)
 			if err != nil {
 				return nil, err
 			}
+			if _, ok := s.(*semantic.ReturnStatement); ok {
+				t = node.Type()
+			}
 			body[i] = node
 		}
 		return &blockEvaluator{
-			t:    n.ReturnStatement().Argument.Type(),
+			t:    t,
 			body: body,
 		}, nil
 	case *semantic.ExpressionStatement:
 		return nil, errors.New("statement does nothing, sideffects are not supported by the compiler")
 	case *semantic.ReturnStatement:
-		node, err := compile(n.Argument)
+		node, err := compile(n.Argument, types)
 		if err != nil {
 			return nil, err
 		}
@@ -55,43 +66,105 @@ func compile(n semantic.Node) (Evaluator, error) {
 			Evaluator: node,
 		}, nil
 	case *semantic.NativeVariableDeclaration:
-		node, err := compile(n.Init)
+		node, err := compile(n.Init, types)
 		if err != nil {
 			return nil, err
 		}
+		types[n.Identifier.Name] = node.Type()
 		return &declarationEvaluator{
-			t:    n.Init.Type(),
+			t:    node.Type(),
 			id:   n.Identifier.Name,
 			init: node,
 		}, nil
 	case *semantic.ObjectExpression:
 		properties := make(map[string]Evaluator, len(n.Properties))
+		propertyTypes := make(map[string]semantic.Type, len(n.Properties))
 		for _, p := range n.Properties {
-			node, err := compile(p.Value)
+			node, err := compile(p.Value, types)
 			if err != nil {
 				return nil, err
 			}
 			properties[p.Key.Name] = node
+			propertyTypes[p.Key.Name] = node.Type()
 		}
 		return &mapEvaluator{
-			t:          n.Type(),
+			t:          semantic.NewObjectType(propertyTypes),
 			properties: properties,
 		}, nil
+	case *semantic.ArrayExpression:
+		elements := make([]Evaluator, len(n.Elements))
+		t := n.Type()
+		for i, el := range n.Elements {
+			node, err := compile(el, types)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = node
+		}
+		if len(elements) > 0 {
+			t = semantic.NewArrayType(elements[0].Type())
+		}
+		return &arrayEvaluator{
+			t:        t,
+			elements: elements,
+		}, nil
 	case *semantic.IdentifierExpression:
+		t, ok := types[n.Name]
+		if !ok {
+			t = n.Type()
+		}
 		return &identifierEvaluator{
-			t:    n.Type(),
+			t:    t,
 			name: n.Name,
 		}, nil
 	case *semantic.MemberExpression:
-		object, err := compile(n.Object)
+		object, err := compile(n.Object, types)
 		if err != nil {
 			return nil, err
 		}
+		if ot := object.Type(); ot.Kind() == semantic.Array {
+			// Arrays are indexed by the integer properties, a[0] is the first element of a.
+			i, err := strconv.Atoi(n.Property)
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid array index %q", n.Property)
+			}
+			return &indexEvaluator{
+				t:     ot.ElementType(),
+				array: object,
+				index: i,
+			}, nil
+		}
+		t := semantic.Type(semantic.Invalid)
+		if ot := object.Type(); ot.Kind() == semantic.Object {
+			t = ot.PropertyType(n.Property)
+			if t == nil {
+				return nil, fmt.Errorf("unknown property %q", n.Property)
+			}
+		}
 		return &memberEvaluator{
-			t:        n.Type(),
+			t:        t,
 			object:   object,
 			property: n.Property,
 		}, nil
+	case *semantic.CallExpression:
+		args := make(map[string]Evaluator, len(n.Arguments.Properties))
+		for _, p := range n.Arguments.Properties {
+			node, err := compile(p.Value, types)
+			if err != nil {
+				return nil, err
+			}
+			args[p.Key.Name] = node
+		}
+		if fn, ok := n.Callee.(*semantic.FunctionExpression); ok {
+			return compileFunctionCall(fn, args)
+		}
+		name, ok := calleeName(n.Callee)
+		if !ok {
+			return nil, fmt.Errorf("unsupported callee of type %T", n.Callee)
+		}
+		return compileBuiltinCall(name, args)
+	case *semantic.NullLiteral:
+		return &nullEvaluator{}, nil
 	case *semantic.BooleanLiteral:
 		return &booleanEvaluator{
 			t: n.Type(),
@@ -122,21 +195,38 @@ func compile(n semantic.Node) (Evaluator, error) {
 			t:    n.Type(),
 			time: Time(n.Value.UnixNano()),
 		}, nil
+	case *semantic.DurationLiteral:
+		if n.Months != 0 {
+			return nil, errors.New("calendar durations are not supported in row functions")
+		}
+		return &durationEvaluator{
+			t:        n.Type(),
+			duration: Duration(n.Value),
+		}, nil
 	case *semantic.UnaryExpression:
-		node, err := compile(n.Argument)
+		if n.Operator == ast.ExistsOperator {
+			return compileExists(n, types)
+		}
+		node, err := compile(n.Argument, types)
 		if err != nil {
 			return nil, err
 		}
 		return &unaryEvaluator{
-			t:    n.Type(),
+			t:    node.Type(),
 			node: node,
 		}, nil
+	case *semantic.ConditionalExpression:
+		return compileConditional(n, types)
 	case *semantic.LogicalExpression:
-		l, err := compile(n.Left)
+		l, err := compile(n.Left, types)
 		if err != nil {
 			return nil, err
 		}
-		r, err := compile(n.Right)
+		if b, ok := l.(*booleanEvaluator); ok && b.b == (n.Operator == ast.OrOperator) {
+			// The right operand is not evaluated, it may use properties that do not exist.
+			return b, nil
+		}
+		r, err := compile(n.Right, types)
 		if err != nil {
 			return nil, err
 		}
@@ -147,12 +237,15 @@ func compile(n semantic.Node) (Evaluator, error) {
 			right:    r,
 		}, nil
 	case *semantic.BinaryExpression:
-		l, err := compile(n.Left)
+		if isNull(n.Left) || isNull(n.Right) {
+			return compileNullComparison(n, types)
+		}
+		l, err := compile(n.Left, types)
 		if err != nil {
 			return nil, err
 		}
 		lt := l.Type()
-		r, err := compile(n.Right)
+		r, err := compile(n.Right, types)
 		if err != nil {
 			return nil, err
 		}
@@ -167,7 +260,7 @@ func compile(n semantic.Node) (Evaluator, error) {
 			return nil, fmt.Errorf("unsupported binary expression %v %v %v", sig.Left, sig.Operator, sig.Right)
 		}
 		return &binaryEvaluator{
-			t:     n.Type(),
+			t:     f.ResultKind,
 			left:  l,
 			right: r,
 			f:     f.Func,
@@ -177,6 +270,211 @@ func compile(n semantic.Node) (Evaluator, error) {
 	}
 }
 
+// compileExists compiles an exists check of the property of an object.
+// A property the type of the object does not have never exists, so the check is a constant
+// and the expressions it guards are not compiled.
+func compileExists(n *semantic.UnaryExpression, types map[string]semantic.Type) (Evaluator, error) {
+	member, ok := n.Argument.(*semantic.MemberExpression)
+	if !ok {
+		return nil, fmt.Errorf("operand to exists must be a member expression, got %T", n.Argument)
+	}
+	object, err := compile(member.Object, types)
+	if err != nil {
+		return nil, err
+	}
+	if ot := object.Type(); ot.Kind() == semantic.Object && ot.PropertyType(member.Property) == nil {
+		return &booleanEvaluator{
+			t: semantic.Bool,
+			b: false,
+		}, nil
+	}
+	return &existsEvaluator{
+		object:   object,
+		property: member.Property,
+	}, nil
+}
+
+func isNull(e semantic.Expression) bool {
+	_, ok := e.(*semantic.NullLiteral)
+	return ok
+}
+
+// compileNullComparison compiles the comparison of an expression with null.
+// Comparing a property with null checks whether it exists, see compileExists.
+func compileNullComparison(n *semantic.BinaryExpression, types map[string]semantic.Type) (Evaluator, error) {
+	if n.Operator != ast.EqualOperator && n.Operator != ast.NotEqualOperator {
+		return nil, fmt.Errorf("unsupported binary expression with null operand %v", n.Operator)
+	}
+	equal := n.Operator == ast.EqualOperator
+	operand := n.Left
+	if isNull(operand) {
+		operand = n.Right
+	}
+	if member, ok := operand.(*semantic.MemberExpression); ok {
+		exists, err := compileExists(&semantic.UnaryExpression{
+			Operator: ast.ExistsOperator,
+			Argument: member,
+		}, types)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := exists.(*booleanEvaluator); ok {
+			return &booleanEvaluator{
+				t: semantic.Bool,
+				b: b.b != equal,
+			}, nil
+		}
+	}
+	node, err := compile(operand, types)
+	if err != nil {
+		return nil, err
+	}
+	return &nullCheckEvaluator{
+		operand: node,
+		equal:   equal,
+	}, nil
+}
+
+// compileConditional compiles a conditional expression, whose branches must have the same type.
+// A branch whose type is only known at runtime, such as a tag of a row, or a null branch
+// takes the type of the other branch.
+func compileConditional(n *semantic.ConditionalExpression, types map[string]semantic.Type) (Evaluator, error) {
+	test, err := compile(n.Test, types)
+	if err != nil {
+		return nil, err
+	}
+	if test.Type() != semantic.Bool {
+		return nil, fmt.Errorf("test of conditional expression is not a boolean, got %v", test.Type())
+	}
+	if b, ok := test.(*booleanEvaluator); ok {
+		// Only the branch that is taken is compiled, the other may use properties that do not exist.
+		if b.b {
+			return compile(n.Consequent, types)
+		}
+		return compile(n.Alternate, types)
+	}
+	consequent, err := compile(n.Consequent, types)
+	if err != nil {
+		return nil, err
+	}
+	alternate, err := compile(n.Alternate, types)
+	if err != nil {
+		return nil, err
+	}
+	t := consequent.Type()
+	switch at := alternate.Type(); {
+	case t == at:
+	case t == semantic.Invalid || t == semantic.Nil:
+		t = at
+	case at == semantic.Invalid || at == semantic.Nil:
+	default:
+		return nil, fmt.Errorf("branches of conditional expression have different types %v and %v", t, at)
+	}
+	return &conditionalEvaluator{
+		t:          t,
+		test:       test,
+		consequent: consequent,
+		alternate:  alternate,
+	}, nil
+}
+
+// compileFunctionCall compiles the body of an arrow function that is called with the arguments.
+// The function is compiled for the types of the arguments, and is evaluated in the scope of its parameters.
+func compileFunctionCall(fn *semantic.FunctionExpression, args map[string]Evaluator) (Evaluator, error) {
+	types := make(map[string]semantic.Type, len(fn.Params))
+	for _, p := range fn.Params {
+		name := p.Key.Name
+		if _, ok := args[name]; !ok {
+			if p.Default == nil {
+				return nil, fmt.Errorf("missing required argument %q", name)
+			}
+			node, err := compile(p.Default, nil)
+			if err != nil {
+				return nil, err
+			}
+			args[name] = node
+		}
+		types[name] = args[name].Type()
+	}
+	for name := range args {
+		if _, ok := types[name]; !ok {
+			return nil, fmt.Errorf("unknown argument %q", name)
+		}
+	}
+	body, err := compile(fn.Body, types)
+	if err != nil {
+		return nil, err
+	}
+	return &callEvaluator{
+		t:    body.Type(),
+		args: args,
+		f: func(scope Scope) Value {
+			return eval(body, scope)
+		},
+	}, nil
+}
+
+// compileBuiltinCall compiles the call of the builtin function with the name.
+func compileBuiltinCall(name string, args map[string]Evaluator) (Evaluator, error) {
+	b, ok := builtins[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+	params := make([]string, 0, len(b.Signature.Params))
+	for param := range b.Signature.Params {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+	for _, param := range params {
+		t := b.Signature.Params[param]
+		arg, ok := args[param]
+		if !ok {
+			if _, ok := b.Defaults[param]; ok {
+				continue
+			}
+			return nil, fmt.Errorf("missing required argument %q of %s", param, name)
+		}
+		if t != semantic.Invalid && arg.Type() != t {
+			return nil, fmt.Errorf("invalid argument %q of %s: got %v want %v", param, name, arg.Type(), t)
+		}
+	}
+	for param := range args {
+		if _, ok := b.Signature.Params[param]; !ok {
+			return nil, fmt.Errorf("unknown argument %q of %s", param, name)
+		}
+	}
+	return &callEvaluator{
+		t:    b.Signature.ReturnType,
+		args: args,
+		f: func(args Scope) Value {
+			for param, v := range b.Defaults {
+				if _, ok := args[param]; !ok {
+					args[param] = v
+				}
+			}
+			v, err := b.Call(args)
+			if err != nil {
+				panic(callError{err: fmt.Errorf("%s: %v", name, err)})
+			}
+			return v
+		},
+	}, nil
+}
+
+// calleeName returns the name of the function that is called,
+// qualified by the name of its package for the members of a package, such as strings.toLower.
+func calleeName(callee semantic.Expression) (string, bool) {
+	switch callee := callee.(type) {
+	case *semantic.IdentifierExpression:
+		return callee.Name, true
+	case *semantic.MemberExpression:
+		if pkg, ok := callee.Object.(*semantic.IdentifierExpression); ok {
+			return pkg.Name + "." + callee.Property, true
+		}
+	}
+	return "", false
+}
+
 // CompilationCache caches compilation results based on the types of the input parameters.
 type CompilationCache struct {
 	fn   *semantic.FunctionExpression
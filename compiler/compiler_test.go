@@ -2,11 +2,14 @@ package compiler_test
 
 import (
 	"reflect"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/compiler"
+	"github.com/influxdata/ifql/parser"
 	"github.com/influxdata/ifql/semantic"
 	"github.com/influxdata/ifql/semantic/semantictest"
 )
@@ -34,8 +37,12 @@ func ValueEqual(x, y compiler.Value) bool {
 		return x.Str() == y.Str()
 	case semantic.Time:
 		return x.Time() == y.Time()
+	case semantic.Duration:
+		return x.Duration() == y.Duration()
 	case semantic.Object:
 		return cmp.Equal(x.Object(), y.Object(), CmpOptions...)
+	case semantic.Nil:
+		return true
 	default:
 		return false
 	}
@@ -160,6 +167,316 @@ func TestCompile(t *testing.T) {
 			want:    compiler.NewInt(4),
 			wantErr: false,
 		},
+		{
+			name: "call builtin",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.CallExpression{
+					Callee: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "strings"},
+						Property: "toLower",
+					},
+					Arguments: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{Key: &semantic.Identifier{Name: "v"}, Value: &semantic.IdentifierExpression{Name: "r"}},
+						},
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.String,
+			},
+			scope: map[string]compiler.Value{
+				"r": compiler.NewString("CPU"),
+			},
+			want: compiler.NewString("cpu"),
+		},
+		{
+			name: "call conversion",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BinaryExpression{
+					Operator: ast.AdditionOperator,
+					Left: &semantic.CallExpression{
+						Callee: &semantic.IdentifierExpression{Name: "int"},
+						Arguments: &semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								{Key: &semantic.Identifier{Name: "v"}, Value: &semantic.IdentifierExpression{Name: "r"}},
+							},
+						},
+					},
+					Right: &semantic.IntegerLiteral{Value: 1},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Float,
+			},
+			scope: map[string]compiler.Value{
+				"r": compiler.NewFloat(2.9),
+			},
+			want: compiler.NewInt(3),
+		},
+		{
+			name: "index array",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.MemberExpression{
+					Object: &semantic.CallExpression{
+						Callee: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "strings"},
+							Property: "split",
+						},
+						Arguments: &semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								{Key: &semantic.Identifier{Name: "v"}, Value: &semantic.IdentifierExpression{Name: "r"}},
+								{Key: &semantic.Identifier{Name: "sep"}, Value: &semantic.StringLiteral{Value: "."}},
+							},
+						},
+					},
+					Property: "1",
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.String,
+			},
+			scope: map[string]compiler.Value{
+				"r": compiler.NewString("server01.us-west"),
+			},
+			want: compiler.NewString("us-west"),
+		},
+		{
+			name: "call arrow function",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.CallExpression{
+					Callee: &semantic.FunctionExpression{
+						Params: []*semantic.FunctionParam{
+							{Key: &semantic.Identifier{Name: "x"}},
+							{Key: &semantic.Identifier{Name: "y"}, Default: &semantic.FloatLiteral{Value: 2}},
+						},
+						Body: &semantic.CallExpression{
+							Callee: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "math"},
+								Property: "pow",
+							},
+							Arguments: &semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{Key: &semantic.Identifier{Name: "x"}, Value: &semantic.IdentifierExpression{Name: "x"}},
+									{Key: &semantic.Identifier{Name: "y"}, Value: &semantic.IdentifierExpression{Name: "y"}},
+								},
+							},
+						},
+					},
+					Arguments: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{Key: &semantic.Identifier{Name: "x"}, Value: &semantic.IdentifierExpression{Name: "r"}},
+						},
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Float,
+			},
+			scope: map[string]compiler.Value{
+				"r": compiler.NewFloat(3),
+			},
+			want: compiler.NewFloat(9),
+		},
+		{
+			name: "conditional",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.ConditionalExpression{
+					Test: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanOperator,
+						Left:     &semantic.IdentifierExpression{Name: "r"},
+						Right:    &semantic.FloatLiteral{Value: 90},
+					},
+					Consequent: &semantic.StringLiteral{Value: "crit"},
+					Alternate:  &semantic.StringLiteral{Value: "ok"},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Float,
+			},
+			scope: map[string]compiler.Value{
+				"r": compiler.NewFloat(95),
+			},
+			want: compiler.NewString("crit"),
+		},
+		{
+			name: "regular expression equality",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BinaryExpression{
+					Operator: ast.EqualOperator,
+					Left:     &semantic.IdentifierExpression{Name: "r"},
+					Right:    &semantic.RegexpLiteral{Value: regexp.MustCompile(`^server0[12]$`)},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.String,
+			},
+			scope: map[string]compiler.Value{
+				"r": compiler.NewString("server02"),
+			},
+			want: compiler.NewBool(true),
+		},
+		{
+			name: "exists",
+			fn:   existsFn("host"),
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Float,
+					"host":   semantic.String,
+				}),
+			},
+			scope: map[string]compiler.Value{
+				"r": object(map[string]compiler.Value{
+					"_value": compiler.NewFloat(1),
+					"host":   compiler.NewString("a"),
+				}),
+			},
+			want: compiler.NewString("a"),
+		},
+		{
+			name: "exists missing property",
+			fn:   existsFn("host"),
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Float,
+				}),
+			},
+			scope: map[string]compiler.Value{
+				"r": object(map[string]compiler.Value{
+					"_value": compiler.NewFloat(1),
+				}),
+			},
+			want: compiler.NewString("none"),
+		},
+		{
+			name: "null comparison",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BinaryExpression{
+					Operator: ast.EqualOperator,
+					Left: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "host",
+					},
+					Right: &semantic.NullLiteral{},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Float,
+					"host":   semantic.String,
+				}),
+			},
+			scope: map[string]compiler.Value{
+				"r": withNull(object(map[string]compiler.Value{
+					"_value": compiler.NewFloat(1),
+				}), "host", semantic.String),
+			},
+			want: compiler.NewBool(true),
+		},
+		{
+			name: "null arithmetic",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BinaryExpression{
+					Operator: ast.AdditionOperator,
+					Left: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_value",
+					},
+					Right: &semantic.FloatLiteral{Value: 1},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Float,
+				}),
+			},
+			scope: map[string]compiler.Value{
+				"r": withNull(object(nil), "_value", semantic.Float),
+			},
+			want: compiler.Null,
+		},
+		{
+			name: "null or true",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.LogicalExpression{
+					Operator: ast.OrOperator,
+					Left: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanOperator,
+						Left: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_value",
+						},
+						Right: &semantic.FloatLiteral{Value: 1},
+					},
+					Right: &semantic.BooleanLiteral{Value: true},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Float,
+				}),
+			},
+			scope: map[string]compiler.Value{
+				"r": withNull(object(nil), "_value", semantic.Float),
+			},
+			want: compiler.NewBool(true),
+		},
+		{
+			name: "null and true",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.LogicalExpression{
+					Operator: ast.AndOperator,
+					Left: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanOperator,
+						Left: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_value",
+						},
+						Right: &semantic.FloatLiteral{Value: 1},
+					},
+					Right: &semantic.BooleanLiteral{Value: true},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Float,
+				}),
+			},
+			scope: map[string]compiler.Value{
+				"r": withNull(object(nil), "_value", semantic.Float),
+			},
+			want: compiler.Null,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -181,3 +498,268 @@ func TestCompile(t *testing.T) {
 		})
 	}
 }
+
+// existsFn returns the function (r) => if exists r.property then r.property else "none".
+func existsFn(property string) *semantic.FunctionExpression {
+	member := &semantic.MemberExpression{
+		Object:   &semantic.IdentifierExpression{Name: "r"},
+		Property: property,
+	}
+	return &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{
+			{Key: &semantic.Identifier{Name: "r"}},
+		},
+		Body: &semantic.ConditionalExpression{
+			Test: &semantic.UnaryExpression{
+				Operator: ast.ExistsOperator,
+				Argument: member,
+			},
+			Consequent: member,
+			Alternate:  &semantic.StringLiteral{Value: "none"},
+		},
+	}
+}
+
+func object(values map[string]compiler.Value) *compiler.Object {
+	o := compiler.NewObject()
+	for k, v := range values {
+		o.Set(k, v)
+	}
+	return o
+}
+
+// withNull adds the property name of type typ to o without a value.
+func withNull(o *compiler.Object, name string, typ semantic.Type) *compiler.Object {
+	o.SetPropertyType(name, typ)
+	return o
+}
+
+func TestCompile_ConditionalErrors(t *testing.T) {
+	fn := &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{
+			{Key: &semantic.Identifier{Name: "r"}},
+		},
+		Body: &semantic.ConditionalExpression{
+			Test:       &semantic.IdentifierExpression{Name: "r"},
+			Consequent: &semantic.StringLiteral{Value: "a"},
+			Alternate:  &semantic.IntegerLiteral{Value: 1},
+		},
+	}
+	_, err := compiler.Compile(fn, map[string]semantic.Type{"r": semantic.Bool})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got, want := err.Error(), "branches of conditional expression have different types string and int"; got != want {
+		t.Errorf("unexpected error: got %q want %q", got, want)
+	}
+}
+
+func TestCompile_CallErrors(t *testing.T) {
+	call := func(callee semantic.Expression, args ...*semantic.Property) *semantic.FunctionExpression {
+		return &semantic.FunctionExpression{
+			Params: []*semantic.FunctionParam{
+				{Key: &semantic.Identifier{Name: "r"}},
+			},
+			Body: &semantic.CallExpression{
+				Callee:    callee,
+				Arguments: &semantic.ObjectExpression{Properties: args},
+			},
+		}
+	}
+	r := &semantic.Property{Key: &semantic.Identifier{Name: "v"}, Value: &semantic.IdentifierExpression{Name: "r"}}
+	testCases := []struct {
+		name string
+		fn   *semantic.FunctionExpression
+		want string
+	}{
+		{
+			name: "unknown function",
+			fn:   call(&semantic.IdentifierExpression{Name: "nope"}, r),
+			want: `unknown function "nope"`,
+		},
+		{
+			name: "missing argument",
+			fn:   call(&semantic.MemberExpression{Object: &semantic.IdentifierExpression{Name: "strings"}, Property: "hasPrefix"}, &semantic.Property{Key: &semantic.Identifier{Name: "v"}, Value: &semantic.StringLiteral{Value: "cpu"}}),
+			want: `missing required argument "prefix" of strings.hasPrefix`,
+		},
+		{
+			name: "invalid argument",
+			fn:   call(&semantic.MemberExpression{Object: &semantic.IdentifierExpression{Name: "math"}, Property: "abs"}, &semantic.Property{Key: &semantic.Identifier{Name: "x"}, Value: &semantic.IdentifierExpression{Name: "r"}}),
+			want: `invalid argument "x" of math.abs: got int want float`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := compiler.Compile(tc.fn, map[string]semantic.Type{"r": semantic.Int})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := err.Error(); got != tc.want {
+				t.Errorf("unexpected error: got %q want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompile_EvalCallErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		fn   string
+		want string
+	}{
+		{
+			name: "conversion",
+			fn:   `(r) => int(v: r)`,
+			want: `int: cannot convert string "a" to int`,
+		},
+		{
+			name: "nested conversion",
+			fn:   `(r) => r != "" and int(v: r) > 0`,
+			want: `int: cannot convert string "a" to int`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := parser.NewAST(tc.fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prog, err := semantic.New(program, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fn := prog.Body[0].(*semantic.ExpressionStatement).Expression.(*semantic.FunctionExpression)
+			f, err := compiler.Compile(fn, map[string]semantic.Type{"r": semantic.String})
+			if err != nil {
+				t.Fatal(err)
+			}
+			scope := map[string]compiler.Value{"r": compiler.NewString("a")}
+			// The error of the call is returned, it does not panic.
+			if _, err := f.Eval(scope); err == nil {
+				t.Error("expected an error from Eval")
+			} else if got := err.Error(); got != tc.want {
+				t.Errorf("unexpected error from Eval: got %q want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompile_TimeFunctions(t *testing.T) {
+	// The clocks of New York move forward from 2:00 to 3:00 on 2018-03-11.
+	beforeDST := mustParseTime("2018-03-11T06:30:00Z")
+	afterDST := mustParseTime("2018-03-11T07:30:00Z")
+	testCases := []struct {
+		name string
+		fn   string
+		r    compiler.Time
+		want compiler.Value
+	}{
+		{
+			name: "hour",
+			fn:   `(r) => hour(t: r)`,
+			r:    beforeDST,
+			want: compiler.NewInt(6),
+		},
+		{
+			name: "hour in location",
+			fn:   `(r) => hour(t: r, location: "America/New_York")`,
+			r:    beforeDST,
+			want: compiler.NewInt(1),
+		},
+		{
+			name: "hour in location after daylight saving time",
+			fn:   `(r) => hour(t: r, location: "America/New_York")`,
+			r:    afterDST,
+			want: compiler.NewInt(3),
+		},
+		{
+			name: "weekday",
+			fn:   `(r) => weekday(t: r)`,
+			r:    beforeDST,
+			want: compiler.NewInt(0),
+		},
+		{
+			name: "month",
+			fn:   `(r) => month(t: r)`,
+			r:    beforeDST,
+			want: compiler.NewInt(3),
+		},
+		{
+			name: "business hours",
+			fn:   `(r) => weekday(t: r, location: "America/New_York") > 0 and hour(t: r, location: "America/New_York") >= 9`,
+			r:    afterDST,
+			want: compiler.NewBool(false),
+		},
+		{
+			name: "truncate",
+			fn:   `(r) => truncate(t: r, unit: 1h)`,
+			r:    afterDST,
+			want: compiler.NewTime(mustParseTime("2018-03-11T07:00:00Z")),
+		},
+		{
+			name: "truncate in location",
+			fn:   `(r) => truncate(t: r, unit: 24h, location: "America/New_York")`,
+			r:    afterDST,
+			want: compiler.NewTime(mustParseTime("2018-03-11T05:00:00Z")),
+		},
+		{
+			name: "add duration",
+			fn:   `(r) => r + 1h`,
+			r:    beforeDST,
+			want: compiler.NewTime(afterDST),
+		},
+		{
+			name: "subtract duration",
+			fn:   `(r) => r - 1h`,
+			r:    afterDST,
+			want: compiler.NewTime(beforeDST),
+		},
+		{
+			name: "subtract times",
+			fn:   `(r) => r - 2018-03-11T00:00:00Z`,
+			r:    afterDST,
+			want: compiler.NewDuration(compiler.Duration(7*time.Hour + 30*time.Minute)),
+		},
+		{
+			name: "compare times",
+			fn:   `(r) => r > 2018-03-11T07:00:00Z`,
+			r:    afterDST,
+			want: compiler.NewBool(true),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := parser.NewAST(tc.fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prog, err := semantic.New(program, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fn := prog.Body[0].(*semantic.ExpressionStatement).Expression.(*semantic.FunctionExpression)
+			f, err := compiler.Compile(fn, map[string]semantic.Type{"r": semantic.Time})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := f.Eval(map[string]compiler.Value{"r": compiler.NewTime(tc.r)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.want, got, CmpOptions...) {
+				t.Errorf("unexpected value -want/+got\n%s", cmp.Diff(tc.want, got, CmpOptions...))
+			}
+		})
+	}
+}
+
+func mustParseTime(s string) compiler.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return compiler.Time(t.UnixNano())
+}
@@ -1,6 +1,7 @@
 package compiler
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 
@@ -17,7 +18,9 @@ type Evaluator interface {
 	EvalString(scope Scope) string
 	EvalRegexp(scope Scope) *regexp.Regexp
 	EvalTime(scope Scope) Time
+	EvalDuration(scope Scope) Duration
 	EvalObject(scope Scope) *Object
+	EvalArray(scope Scope) *Array
 }
 
 type Func interface {
@@ -30,11 +33,15 @@ type Func interface {
 	EvalString(scope Scope) (string, error)
 	EvalRegexp(scope Scope) (*regexp.Regexp, error)
 	EvalTime(scope Scope) (Time, error)
+	EvalDuration(scope Scope) (Duration, error)
 	EvalObject(scope Scope) (*Object, error)
+	EvalArray(scope Scope) (*Array, error)
 }
 
 type Time int64
 
+type Duration int64
+
 type compiledFn struct {
 	root    Evaluator
 	inTypes map[string]semantic.Type
@@ -54,10 +61,16 @@ func (c compiledFn) Type() semantic.Type {
 	return c.root.Type()
 }
 
-func (c compiledFn) Eval(scope Scope) (Value, error) {
+func (c compiledFn) Eval(scope Scope) (v Value, err error) {
 	if err := c.validate(scope); err != nil {
 		return nil, err
 	}
+	defer func() {
+		if isNullPanic(recover()) {
+			v, err = Null, nil
+		}
+	}()
+	defer catchCall(&err)
 	var val interface{}
 	switch c.Type().Kind() {
 	case semantic.Bool:
@@ -74,8 +87,12 @@ func (c compiledFn) Eval(scope Scope) (Value, error) {
 		val = c.root.EvalRegexp(scope)
 	case semantic.Time:
 		val = c.root.EvalTime(scope)
+	case semantic.Duration:
+		val = c.root.EvalDuration(scope)
 	case semantic.Object:
 		val = c.root.EvalObject(scope)
+	case semantic.Array:
+		val = c.root.EvalArray(scope)
 	default:
 		return nil, fmt.Errorf("unsupported kind %s", c.Type().Kind())
 	}
@@ -85,54 +102,86 @@ func (c compiledFn) Eval(scope Scope) (Value, error) {
 	}, nil
 }
 
-func (c compiledFn) EvalBool(scope Scope) (bool, error) {
+func (c compiledFn) EvalBool(scope Scope) (v bool, err error) {
 	if err := c.validate(scope); err != nil {
 		return false, err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalBool(scope), nil
 }
-func (c compiledFn) EvalInt(scope Scope) (int64, error) {
+func (c compiledFn) EvalInt(scope Scope) (v int64, err error) {
 	if err := c.validate(scope); err != nil {
 		return 0, err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalInt(scope), nil
 }
-func (c compiledFn) EvalUInt(scope Scope) (uint64, error) {
+func (c compiledFn) EvalUInt(scope Scope) (v uint64, err error) {
 	if err := c.validate(scope); err != nil {
 		return 0, err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalUInt(scope), nil
 }
-func (c compiledFn) EvalFloat(scope Scope) (float64, error) {
+func (c compiledFn) EvalFloat(scope Scope) (v float64, err error) {
 	if err := c.validate(scope); err != nil {
 		return 0, err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalFloat(scope), nil
 }
-func (c compiledFn) EvalString(scope Scope) (string, error) {
+func (c compiledFn) EvalString(scope Scope) (v string, err error) {
 	if err := c.validate(scope); err != nil {
 		return "", err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalString(scope), nil
 }
-func (c compiledFn) EvalRegexp(scope Scope) (*regexp.Regexp, error) {
+func (c compiledFn) EvalRegexp(scope Scope) (v *regexp.Regexp, err error) {
 	if err := c.validate(scope); err != nil {
 		return nil, err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalRegexp(scope), nil
 }
-func (c compiledFn) EvalTime(scope Scope) (Time, error) {
+func (c compiledFn) EvalTime(scope Scope) (v Time, err error) {
 	if err := c.validate(scope); err != nil {
 		return 0, err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalTime(scope), nil
 }
-func (c compiledFn) EvalObject(scope Scope) (*Object, error) {
+func (c compiledFn) EvalDuration(scope Scope) (v Duration, err error) {
+	if err := c.validate(scope); err != nil {
+		return 0, err
+	}
+	defer catchNull(&err)
+	defer catchCall(&err)
+	return c.root.EvalDuration(scope), nil
+}
+func (c compiledFn) EvalObject(scope Scope) (v *Object, err error) {
 	if err := c.validate(scope); err != nil {
 		return nil, err
 	}
+	defer catchNull(&err)
+	defer catchCall(&err)
 	return c.root.EvalObject(scope), nil
 }
+func (c compiledFn) EvalArray(scope Scope) (v *Array, err error) {
+	if err := c.validate(scope); err != nil {
+		return nil, err
+	}
+	defer catchNull(&err)
+	defer catchCall(&err)
+	return c.root.EvalArray(scope), nil
+}
 
 type Value interface {
 	Type() semantic.Type
@@ -143,7 +192,9 @@ type Value interface {
 	Str() string
 	Regexp() *regexp.Regexp
 	Time() Time
+	Duration() Duration
 	Object() *Object
+	Array() *Array
 }
 
 type value struct {
@@ -175,9 +226,15 @@ func (v value) Regexp() *regexp.Regexp {
 func (v value) Time() Time {
 	return v.Value.(Time)
 }
+func (v value) Duration() Duration {
+	return v.Value.(Duration)
+}
 func (v value) Object() *Object {
 	return v.Value.(*Object)
 }
+func (v value) Array() *Array {
+	return v.Value.(*Array)
+}
 
 func NewBool(v bool) Value {
 	return value{
@@ -221,6 +278,12 @@ func NewTime(v Time) Value {
 		Value: v,
 	}
 }
+func NewDuration(v Duration) Value {
+	return value{
+		typ:   semantic.Duration,
+		Value: v,
+	}
+}
 
 type Scope map[string]Value
 
@@ -251,9 +314,15 @@ func (s Scope) GetRegexp(name string) *regexp.Regexp {
 func (s Scope) GetTime(name string) Time {
 	return s[name].Time()
 }
+func (s Scope) GetDuration(name string) Duration {
+	return s[name].Duration()
+}
 func (s Scope) GetObject(name string) *Object {
 	return s[name].Object()
 }
+func (s Scope) GetArray(name string) *Array {
+	return s[name].Array()
+}
 
 func eval(e Evaluator, scope Scope) Value {
 	switch e.Type().Kind() {
@@ -271,11 +340,84 @@ func eval(e Evaluator, scope Scope) Value {
 		return NewRegexp(e.EvalRegexp(scope))
 	case semantic.Time:
 		return NewTime(e.EvalTime(scope))
+	case semantic.Duration:
+		return NewDuration(e.EvalDuration(scope))
+	case semantic.Object:
+		return e.EvalObject(scope)
+	case semantic.Array:
+		return e.EvalArray(scope)
+	case semantic.Nil:
+		panic(null{})
 	default:
 		return nil
 	}
 }
 
+// Null is the value of an expression that evaluates to null.
+var Null Value = value{typ: semantic.Nil}
+
+// ErrNull is returned when an expression evaluates to null, where a value was expected.
+var ErrNull = errors.New("expression evaluated to null")
+
+// null is raised, as a panic, when a null value is evaluated.
+// Evaluators that can represent null values recover it.
+type null struct{}
+
+func isNullPanic(r interface{}) bool {
+	if r == nil {
+		return false
+	}
+	if _, ok := r.(null); !ok {
+		panic(r)
+	}
+	return true
+}
+
+func catchNull(err *error) {
+	if isNullPanic(recover()) {
+		*err = ErrNull
+	}
+}
+
+// callError is raised, as a panic, when a builtin function returns an error.
+// Compiled functions recover it and return its error.
+type callError struct {
+	err error
+}
+
+// catchCall recovers a callError, and raises any other panic again.
+func catchCall(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if e, ok := r.(callError); ok {
+		*err = e.err
+		return
+	}
+	panic(r)
+}
+
+// evalNullable evaluates e, the null return value is true if e evaluates to null.
+func evalNullable(e Evaluator, scope Scope) (v Value, isNull bool) {
+	defer func() {
+		if isNullPanic(recover()) {
+			v, isNull = nil, true
+		}
+	}()
+	return eval(e, scope), false
+}
+
+// evalBoolNullable evaluates the boolean e, the null return value is true if e evaluates to null.
+func evalBoolNullable(e Evaluator, scope Scope) (b, isNull bool) {
+	defer func() {
+		if isNullPanic(recover()) {
+			b, isNull = false, true
+		}
+	}()
+	return e.EvalBool(scope), false
+}
+
 func checkKind(act, exp semantic.Kind) {
 	if act != exp {
 		panic(unexpectedKind(act, exp))
@@ -342,12 +484,24 @@ func (e *blockEvaluator) EvalTime(scope Scope) Time {
 	e.eval(scope)
 	return e.value.Time()
 }
+
+func (e *blockEvaluator) EvalDuration(scope Scope) Duration {
+	checkKind(e.t.Kind(), semantic.Duration)
+	e.eval(scope)
+	return e.value.Duration()
+}
 func (e *blockEvaluator) EvalObject(scope Scope) *Object {
 	checkKind(e.t.Kind(), semantic.Object)
 	e.eval(scope)
 	return e.value.Object()
 }
 
+func (e *blockEvaluator) EvalArray(scope Scope) *Array {
+	checkKind(e.t.Kind(), semantic.Array)
+	e.eval(scope)
+	return e.value.Array()
+}
+
 type returnEvaluator struct {
 	Evaluator
 }
@@ -400,11 +554,21 @@ func (e *declarationEvaluator) EvalTime(scope Scope) Time {
 	return scope.GetTime(e.id)
 }
 
+func (e *declarationEvaluator) EvalDuration(scope Scope) Duration {
+	e.eval(scope)
+	return scope.GetDuration(e.id)
+}
+
 func (e *declarationEvaluator) EvalObject(scope Scope) *Object {
 	e.eval(scope)
 	return scope.GetObject(e.id)
 }
 
+func (e *declarationEvaluator) EvalArray(scope Scope) *Array {
+	e.eval(scope)
+	return scope.GetArray(e.id)
+}
+
 type mapEvaluator struct {
 	t          semantic.Type
 	properties map[string]Evaluator
@@ -440,15 +604,27 @@ func (e *mapEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 func (e *mapEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
+
+func (e *mapEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
 func (e *mapEvaluator) EvalObject(scope Scope) *Object {
 	obj := NewObject()
 	for k, node := range e.properties {
-		v := eval(node, scope)
+		v, null := evalNullable(node, scope)
+		if null {
+			obj.SetPropertyType(k, node.Type())
+			continue
+		}
 		obj.Set(k, v)
 	}
 	return obj
 }
 
+func (e *mapEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
 type Object struct {
 	values        map[string]Value
 	propertyTypes map[string]semantic.Type
@@ -468,6 +644,12 @@ func (o *Object) Set(name string, v Value) {
 		o.SetPropertyType(name, v.Type())
 	}
 }
+
+// Unset removes the value of the property, while preserving its type.
+// The property of an object without a value is null.
+func (o *Object) Unset(name string) {
+	delete(o.values, name)
+}
 func (o *Object) Get(name string) Value {
 	return o.values[name]
 }
@@ -508,10 +690,80 @@ func (o *Object) Time() Time {
 	panic("map is not a time")
 }
 
+func (o *Object) Duration() Duration {
+	panic("map is not a duration")
+}
+
 func (o *Object) Object() *Object {
 	return o
 }
 
+func (o *Object) Array() *Array {
+	panic("map is not an array")
+}
+
+type Array struct {
+	typ      semantic.Type
+	elements []Value
+}
+
+func NewArray(elementType semantic.Type) *Array {
+	return &Array{
+		typ: semantic.NewArrayType(elementType),
+	}
+}
+
+func (a *Array) Len() int {
+	return len(a.elements)
+}
+func (a *Array) Get(i int) Value {
+	return a.elements[i]
+}
+func (a *Array) Append(v Value) {
+	a.elements = append(a.elements, v)
+}
+func (a *Array) Type() semantic.Type {
+	return a.typ
+}
+func (a *Array) Bool() bool {
+	panic("array is not a boolean")
+}
+
+func (a *Array) Int() int64 {
+	panic("array is not a int")
+}
+
+func (a *Array) UInt() uint64 {
+	panic("array is not a uint")
+}
+
+func (a *Array) Float() float64 {
+	panic("array is not a float")
+}
+
+func (a *Array) Str() string {
+	panic("array is not a string")
+}
+func (a *Array) Regexp() *regexp.Regexp {
+	panic("array is not a regular expression")
+}
+
+func (a *Array) Time() Time {
+	panic("array is not a time")
+}
+
+func (a *Array) Duration() Duration {
+	panic("array is not a duration")
+}
+
+func (a *Array) Object() *Object {
+	panic("array is not a map")
+}
+
+func (a *Array) Array() *Array {
+	return a
+}
+
 type logicalEvaluator struct {
 	t           semantic.Type
 	operator    ast.LogicalOperatorKind
@@ -522,15 +774,32 @@ func (e *logicalEvaluator) Type() semantic.Type {
 	return e.t
 }
 
+// EvalBool evaluates the logical expression, where a null operand is unknown.
+// The expression is only null if its value depends on the unknown operand,
+// for example null or true is true, but null or false is null.
 func (e *logicalEvaluator) EvalBool(scope Scope) bool {
+	var short bool
 	switch e.operator {
 	case ast.AndOperator:
-		return e.left.EvalBool(scope) && e.right.EvalBool(scope)
+		short = false
 	case ast.OrOperator:
-		return e.left.EvalBool(scope) || e.right.EvalBool(scope)
+		short = true
 	default:
 		panic(fmt.Errorf("unknown logical operator %v", e.operator))
 	}
+	l, lNull := evalBoolNullable(e.left, scope)
+	if !lNull && l == short {
+		return short
+	}
+	r, rNull := evalBoolNullable(e.right, scope)
+	switch {
+	case !rNull && r == short:
+		return short
+	case lNull || rNull:
+		panic(null{})
+	default:
+		return r
+	}
 }
 
 func (e *logicalEvaluator) EvalInt(scope Scope) int64 {
@@ -555,10 +824,18 @@ func (e *logicalEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 func (e *logicalEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
+
+func (e *logicalEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
 func (e *logicalEvaluator) EvalObject(scope Scope) *Object {
 	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
+func (e *logicalEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
 type binaryFunc func(scope Scope, left, right Evaluator) Value
 
 type binarySignature struct {
@@ -603,10 +880,18 @@ func (e *binaryEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 func (e *binaryEvaluator) EvalTime(scope Scope) Time {
 	return e.f(scope, e.left, e.right).Time()
 }
+
+func (e *binaryEvaluator) EvalDuration(scope Scope) Duration {
+	return e.f(scope, e.left, e.right).Duration()
+}
 func (e *binaryEvaluator) EvalObject(scope Scope) *Object {
 	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
+func (e *binaryEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
 type unaryEvaluator struct {
 	t    semantic.Type
 	node Evaluator
@@ -645,10 +930,222 @@ func (e *unaryEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 func (e *unaryEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
+
+func (e *unaryEvaluator) EvalDuration(scope Scope) Duration {
+	// There is only one duration unary operator
+	return -e.node.EvalDuration(scope)
+}
 func (e *unaryEvaluator) EvalObject(scope Scope) *Object {
 	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
+func (e *unaryEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
+// nullEvaluator evaluates the null literal.
+type nullEvaluator struct{}
+
+func (e *nullEvaluator) Type() semantic.Type {
+	return semantic.Nil
+}
+
+func (e *nullEvaluator) EvalBool(scope Scope) bool {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalInt(scope Scope) int64 {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalFloat(scope Scope) float64 {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalString(scope Scope) string {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalTime(scope Scope) Time {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalDuration(scope Scope) Duration {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalObject(scope Scope) *Object {
+	panic(null{})
+}
+
+func (e *nullEvaluator) EvalArray(scope Scope) *Array {
+	panic(null{})
+}
+
+// nullCheckEvaluator reports whether its operand is null, or not null if equal is false.
+type nullCheckEvaluator struct {
+	operand Evaluator
+	equal   bool
+}
+
+func (e *nullCheckEvaluator) Type() semantic.Type {
+	return semantic.Bool
+}
+
+func (e *nullCheckEvaluator) EvalBool(scope Scope) bool {
+	_, isNull := evalNullable(e.operand, scope)
+	return isNull == e.equal
+}
+
+func (e *nullCheckEvaluator) EvalInt(scope Scope) int64 {
+	panic(unexpectedKind(semantic.Bool, semantic.Int))
+}
+
+func (e *nullCheckEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(unexpectedKind(semantic.Bool, semantic.UInt))
+}
+
+func (e *nullCheckEvaluator) EvalFloat(scope Scope) float64 {
+	panic(unexpectedKind(semantic.Bool, semantic.Float))
+}
+
+func (e *nullCheckEvaluator) EvalString(scope Scope) string {
+	panic(unexpectedKind(semantic.Bool, semantic.String))
+}
+
+func (e *nullCheckEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(unexpectedKind(semantic.Bool, semantic.Regexp))
+}
+
+func (e *nullCheckEvaluator) EvalTime(scope Scope) Time {
+	panic(unexpectedKind(semantic.Bool, semantic.Time))
+}
+
+func (e *nullCheckEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(semantic.Bool, semantic.Duration))
+}
+
+func (e *nullCheckEvaluator) EvalObject(scope Scope) *Object {
+	panic(unexpectedKind(semantic.Bool, semantic.Object))
+}
+
+func (e *nullCheckEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(semantic.Bool, semantic.Array))
+}
+
+// existsEvaluator reports whether an object has a value for the property.
+type existsEvaluator struct {
+	object   Evaluator
+	property string
+}
+
+func (e *existsEvaluator) Type() semantic.Type {
+	return semantic.Bool
+}
+
+func (e *existsEvaluator) EvalBool(scope Scope) bool {
+	return e.object.EvalObject(scope).Get(e.property) != nil
+}
+
+func (e *existsEvaluator) EvalInt(scope Scope) int64 {
+	panic(unexpectedKind(semantic.Bool, semantic.Int))
+}
+
+func (e *existsEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(unexpectedKind(semantic.Bool, semantic.UInt))
+}
+
+func (e *existsEvaluator) EvalFloat(scope Scope) float64 {
+	panic(unexpectedKind(semantic.Bool, semantic.Float))
+}
+
+func (e *existsEvaluator) EvalString(scope Scope) string {
+	panic(unexpectedKind(semantic.Bool, semantic.String))
+}
+func (e *existsEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(unexpectedKind(semantic.Bool, semantic.Regexp))
+}
+
+func (e *existsEvaluator) EvalTime(scope Scope) Time {
+	panic(unexpectedKind(semantic.Bool, semantic.Time))
+}
+
+func (e *existsEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(semantic.Bool, semantic.Duration))
+}
+func (e *existsEvaluator) EvalObject(scope Scope) *Object {
+	panic(unexpectedKind(semantic.Bool, semantic.Object))
+}
+
+func (e *existsEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(semantic.Bool, semantic.Array))
+}
+
+// conditionalEvaluator evaluates the consequent or the alternate depending on the test.
+type conditionalEvaluator struct {
+	t          semantic.Type
+	test       Evaluator
+	consequent Evaluator
+	alternate  Evaluator
+}
+
+func (e *conditionalEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *conditionalEvaluator) branch(scope Scope) Evaluator {
+	if e.test.EvalBool(scope) {
+		return e.consequent
+	}
+	return e.alternate
+}
+
+func (e *conditionalEvaluator) EvalBool(scope Scope) bool {
+	return e.branch(scope).EvalBool(scope)
+}
+
+func (e *conditionalEvaluator) EvalInt(scope Scope) int64 {
+	return e.branch(scope).EvalInt(scope)
+}
+
+func (e *conditionalEvaluator) EvalUInt(scope Scope) uint64 {
+	return e.branch(scope).EvalUInt(scope)
+}
+
+func (e *conditionalEvaluator) EvalFloat(scope Scope) float64 {
+	return e.branch(scope).EvalFloat(scope)
+}
+
+func (e *conditionalEvaluator) EvalString(scope Scope) string {
+	return e.branch(scope).EvalString(scope)
+}
+func (e *conditionalEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	return e.branch(scope).EvalRegexp(scope)
+}
+
+func (e *conditionalEvaluator) EvalTime(scope Scope) Time {
+	return e.branch(scope).EvalTime(scope)
+}
+
+func (e *conditionalEvaluator) EvalDuration(scope Scope) Duration {
+	return e.branch(scope).EvalDuration(scope)
+}
+func (e *conditionalEvaluator) EvalObject(scope Scope) *Object {
+	return e.branch(scope).EvalObject(scope)
+}
+
+func (e *conditionalEvaluator) EvalArray(scope Scope) *Array {
+	return e.branch(scope).EvalArray(scope)
+}
+
 type integerEvaluator struct {
 	t semantic.Type
 	i int64
@@ -685,10 +1182,18 @@ func (e *integerEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 func (e *integerEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
+
+func (e *integerEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
 func (e *integerEvaluator) EvalObject(scope Scope) *Object {
 	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
+func (e *integerEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
 type stringEvaluator struct {
 	t semantic.Type
 	s string
@@ -724,10 +1229,18 @@ func (e *stringEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 func (e *stringEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
+
+func (e *stringEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
 func (e *stringEvaluator) EvalObject(scope Scope) *Object {
 	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
+func (e *stringEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
 type regexpEvaluator struct {
 	t semantic.Type
 	r *regexp.Regexp
@@ -765,10 +1278,18 @@ func (e *regexpEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
 
+func (e *regexpEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
+
 func (e *regexpEvaluator) EvalObject(scope Scope) *Object {
 	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
+func (e *regexpEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
 type booleanEvaluator struct {
 	t semantic.Type
 	b bool
@@ -805,168 +1326,440 @@ func (e *booleanEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 func (e *booleanEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
+
+func (e *booleanEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
 func (e *booleanEvaluator) EvalObject(scope Scope) *Object {
 	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
+func (e *booleanEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
 type floatEvaluator struct {
 	t semantic.Type
 	f float64
 }
 
-func (e *floatEvaluator) Type() semantic.Type {
+func (e *floatEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *floatEvaluator) EvalBool(scope Scope) bool {
+	panic(unexpectedKind(e.t.Kind(), semantic.Bool))
+}
+
+func (e *floatEvaluator) EvalInt(scope Scope) int64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.Int))
+}
+
+func (e *floatEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.UInt))
+}
+
+func (e *floatEvaluator) EvalFloat(scope Scope) float64 {
+	return e.f
+}
+
+func (e *floatEvaluator) EvalString(scope Scope) string {
+	panic(unexpectedKind(e.t.Kind(), semantic.String))
+}
+
+func (e *floatEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(unexpectedKind(e.t.Kind(), semantic.Regexp))
+}
+
+func (e *floatEvaluator) EvalTime(scope Scope) Time {
+	panic(unexpectedKind(e.t.Kind(), semantic.Time))
+}
+
+func (e *floatEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
+func (e *floatEvaluator) EvalObject(scope Scope) *Object {
+	panic(unexpectedKind(e.t.Kind(), semantic.Object))
+}
+
+func (e *floatEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
+type timeEvaluator struct {
+	t    semantic.Type
+	time Time
+}
+
+func (e *timeEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *timeEvaluator) EvalBool(scope Scope) bool {
+	panic(unexpectedKind(e.t.Kind(), semantic.Bool))
+}
+
+func (e *timeEvaluator) EvalInt(scope Scope) int64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.Int))
+}
+
+func (e *timeEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.UInt))
+}
+
+func (e *timeEvaluator) EvalFloat(scope Scope) float64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.Float))
+}
+
+func (e *timeEvaluator) EvalString(scope Scope) string {
+	panic(unexpectedKind(e.t.Kind(), semantic.String))
+}
+
+func (e *timeEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(unexpectedKind(e.t.Kind(), semantic.Regexp))
+}
+
+func (e *timeEvaluator) EvalTime(scope Scope) Time {
+	return e.time
+}
+
+func (e *timeEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
+}
+func (e *timeEvaluator) EvalObject(scope Scope) *Object {
+	panic(unexpectedKind(e.t.Kind(), semantic.Object))
+}
+
+func (e *timeEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
+type durationEvaluator struct {
+	t        semantic.Type
+	duration Duration
+}
+
+func (e *durationEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *durationEvaluator) EvalBool(scope Scope) bool {
+	panic(unexpectedKind(e.t.Kind(), semantic.Bool))
+}
+
+func (e *durationEvaluator) EvalInt(scope Scope) int64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.Int))
+}
+
+func (e *durationEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.UInt))
+}
+
+func (e *durationEvaluator) EvalFloat(scope Scope) float64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.Float))
+}
+
+func (e *durationEvaluator) EvalString(scope Scope) string {
+	panic(unexpectedKind(e.t.Kind(), semantic.String))
+}
+
+func (e *durationEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(unexpectedKind(e.t.Kind(), semantic.Regexp))
+}
+
+func (e *durationEvaluator) EvalTime(scope Scope) Time {
+	panic(unexpectedKind(e.t.Kind(), semantic.Time))
+}
+
+func (e *durationEvaluator) EvalDuration(scope Scope) Duration {
+	return e.duration
+}
+func (e *durationEvaluator) EvalObject(scope Scope) *Object {
+	panic(unexpectedKind(e.t.Kind(), semantic.Object))
+}
+
+func (e *durationEvaluator) EvalArray(scope Scope) *Array {
+	panic(unexpectedKind(e.t.Kind(), semantic.Array))
+}
+
+type identifierEvaluator struct {
+	t    semantic.Type
+	name string
+}
+
+func (e *identifierEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *identifierEvaluator) EvalBool(scope Scope) bool {
+	return scope.GetBool(e.name)
+}
+
+func (e *identifierEvaluator) EvalInt(scope Scope) int64 {
+	return scope.GetInt(e.name)
+}
+
+func (e *identifierEvaluator) EvalUInt(scope Scope) uint64 {
+	return scope.GetUInt(e.name)
+}
+
+func (e *identifierEvaluator) EvalFloat(scope Scope) float64 {
+	return scope.GetFloat(e.name)
+}
+
+func (e *identifierEvaluator) EvalString(scope Scope) string {
+	return scope.GetString(e.name)
+}
+
+func (e *identifierEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	return scope.GetRegexp(e.name)
+}
+
+func (e *identifierEvaluator) EvalTime(scope Scope) Time {
+	return scope.GetTime(e.name)
+}
+
+func (e *identifierEvaluator) EvalDuration(scope Scope) Duration {
+	return scope.GetDuration(e.name)
+}
+func (e *identifierEvaluator) EvalObject(scope Scope) *Object {
+	return scope.GetObject(e.name)
+}
+
+func (e *identifierEvaluator) EvalArray(scope Scope) *Array {
+	return scope.GetArray(e.name)
+}
+
+type memberEvaluator struct {
+	t        semantic.Type
+	object   Evaluator
+	property string
+}
+
+// value returns the value of the property, it is null if the object has no value for it.
+func (e *memberEvaluator) value(scope Scope) Value {
+	v := e.object.EvalObject(scope).Get(e.property)
+	if v == nil {
+		panic(null{})
+	}
+	return v
+}
+
+func (e *memberEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *memberEvaluator) EvalBool(scope Scope) bool {
+	return e.value(scope).Bool()
+}
+
+func (e *memberEvaluator) EvalInt(scope Scope) int64 {
+	return e.value(scope).Int()
+}
+
+func (e *memberEvaluator) EvalUInt(scope Scope) uint64 {
+	return e.value(scope).UInt()
+}
+
+func (e *memberEvaluator) EvalFloat(scope Scope) float64 {
+	return e.value(scope).Float()
+}
+
+func (e *memberEvaluator) EvalString(scope Scope) string {
+	return e.value(scope).Str()
+}
+func (e *memberEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	return e.value(scope).Regexp()
+}
+
+func (e *memberEvaluator) EvalTime(scope Scope) Time {
+	return e.value(scope).Time()
+}
+
+func (e *memberEvaluator) EvalDuration(scope Scope) Duration {
+	return e.value(scope).Duration()
+}
+func (e *memberEvaluator) EvalObject(scope Scope) *Object {
+	return e.value(scope).Object()
+}
+
+func (e *memberEvaluator) EvalArray(scope Scope) *Array {
+	return e.value(scope).Array()
+}
+
+type arrayEvaluator struct {
+	t        semantic.Type
+	elements []Evaluator
+}
+
+func (e *arrayEvaluator) Type() semantic.Type {
 	return e.t
 }
 
-func (e *floatEvaluator) EvalBool(scope Scope) bool {
+func (e *arrayEvaluator) EvalBool(scope Scope) bool {
 	panic(unexpectedKind(e.t.Kind(), semantic.Bool))
 }
 
-func (e *floatEvaluator) EvalInt(scope Scope) int64 {
+func (e *arrayEvaluator) EvalInt(scope Scope) int64 {
 	panic(unexpectedKind(e.t.Kind(), semantic.Int))
 }
 
-func (e *floatEvaluator) EvalUInt(scope Scope) uint64 {
+func (e *arrayEvaluator) EvalUInt(scope Scope) uint64 {
 	panic(unexpectedKind(e.t.Kind(), semantic.UInt))
 }
 
-func (e *floatEvaluator) EvalFloat(scope Scope) float64 {
-	return e.f
+func (e *arrayEvaluator) EvalFloat(scope Scope) float64 {
+	panic(unexpectedKind(e.t.Kind(), semantic.Float))
 }
 
-func (e *floatEvaluator) EvalString(scope Scope) string {
+func (e *arrayEvaluator) EvalString(scope Scope) string {
 	panic(unexpectedKind(e.t.Kind(), semantic.String))
 }
 
-func (e *floatEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+func (e *arrayEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
 	panic(unexpectedKind(e.t.Kind(), semantic.Regexp))
 }
 
-func (e *floatEvaluator) EvalTime(scope Scope) Time {
+func (e *arrayEvaluator) EvalTime(scope Scope) Time {
 	panic(unexpectedKind(e.t.Kind(), semantic.Time))
 }
-func (e *floatEvaluator) EvalObject(scope Scope) *Object {
-	panic(unexpectedKind(e.t.Kind(), semantic.Object))
-}
 
-type timeEvaluator struct {
-	t    semantic.Type
-	time Time
+func (e *arrayEvaluator) EvalDuration(scope Scope) Duration {
+	panic(unexpectedKind(e.t.Kind(), semantic.Duration))
 }
 
-func (e *timeEvaluator) Type() semantic.Type {
-	return e.t
+func (e *arrayEvaluator) EvalObject(scope Scope) *Object {
+	panic(unexpectedKind(e.t.Kind(), semantic.Object))
 }
 
-func (e *timeEvaluator) EvalBool(scope Scope) bool {
-	panic(unexpectedKind(e.t.Kind(), semantic.Bool))
+func (e *arrayEvaluator) EvalArray(scope Scope) *Array {
+	arr := &Array{
+		typ:      e.t,
+		elements: make([]Value, len(e.elements)),
+	}
+	for i, el := range e.elements {
+		arr.elements[i] = eval(el, scope)
+	}
+	return arr
 }
 
-func (e *timeEvaluator) EvalInt(scope Scope) int64 {
-	panic(unexpectedKind(e.t.Kind(), semantic.Int))
+type indexEvaluator struct {
+	t     semantic.Type
+	array Evaluator
+	index int
 }
 
-func (e *timeEvaluator) EvalUInt(scope Scope) uint64 {
-	panic(unexpectedKind(e.t.Kind(), semantic.UInt))
+func (e *indexEvaluator) Type() semantic.Type {
+	return e.t
 }
 
-func (e *timeEvaluator) EvalFloat(scope Scope) float64 {
-	panic(unexpectedKind(e.t.Kind(), semantic.Float))
+func (e *indexEvaluator) get(scope Scope) Value {
+	arr := e.array.EvalArray(scope)
+	if e.index >= arr.Len() {
+		panic(fmt.Errorf("index %d out of range for array of length %d", e.index, arr.Len()))
+	}
+	return arr.Get(e.index)
 }
 
-func (e *timeEvaluator) EvalString(scope Scope) string {
-	panic(unexpectedKind(e.t.Kind(), semantic.String))
+func (e *indexEvaluator) EvalBool(scope Scope) bool {
+	return e.get(scope).Bool()
 }
 
-func (e *timeEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
-	panic(unexpectedKind(e.t.Kind(), semantic.Regexp))
+func (e *indexEvaluator) EvalInt(scope Scope) int64 {
+	return e.get(scope).Int()
 }
 
-func (e *timeEvaluator) EvalTime(scope Scope) Time {
-	return e.time
-}
-func (e *timeEvaluator) EvalObject(scope Scope) *Object {
-	panic(unexpectedKind(e.t.Kind(), semantic.Object))
+func (e *indexEvaluator) EvalUInt(scope Scope) uint64 {
+	return e.get(scope).UInt()
 }
 
-type identifierEvaluator struct {
-	t    semantic.Type
-	name string
+func (e *indexEvaluator) EvalFloat(scope Scope) float64 {
+	return e.get(scope).Float()
 }
 
-func (e *identifierEvaluator) Type() semantic.Type {
-	return e.t
+func (e *indexEvaluator) EvalString(scope Scope) string {
+	return e.get(scope).Str()
 }
 
-func (e *identifierEvaluator) EvalBool(scope Scope) bool {
-	return scope.GetBool(e.name)
+func (e *indexEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	return e.get(scope).Regexp()
 }
 
-func (e *identifierEvaluator) EvalInt(scope Scope) int64 {
-	return scope.GetInt(e.name)
+func (e *indexEvaluator) EvalTime(scope Scope) Time {
+	return e.get(scope).Time()
 }
 
-func (e *identifierEvaluator) EvalUInt(scope Scope) uint64 {
-	return scope.GetUInt(e.name)
+func (e *indexEvaluator) EvalDuration(scope Scope) Duration {
+	return e.get(scope).Duration()
 }
 
-func (e *identifierEvaluator) EvalFloat(scope Scope) float64 {
-	return scope.GetFloat(e.name)
+func (e *indexEvaluator) EvalObject(scope Scope) *Object {
+	return e.get(scope).Object()
 }
 
-func (e *identifierEvaluator) EvalString(scope Scope) string {
-	return scope.GetString(e.name)
+func (e *indexEvaluator) EvalArray(scope Scope) *Array {
+	return e.get(scope).Array()
 }
 
-func (e *identifierEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
-	return scope.GetRegexp(e.name)
+type callFunc func(args Scope) Value
+
+// callEvaluator calls a function with the values of its arguments by the names of the parameters.
+type callEvaluator struct {
+	t    semantic.Type
+	args map[string]Evaluator
+	f    callFunc
 }
 
-func (e *identifierEvaluator) EvalTime(scope Scope) Time {
-	return scope.GetTime(e.name)
+func (e *callEvaluator) Type() semantic.Type {
+	return e.t
 }
-func (e *identifierEvaluator) EvalObject(scope Scope) *Object {
-	return scope.GetObject(e.name)
+
+func (e *callEvaluator) call(scope Scope) Value {
+	args := make(Scope, len(e.args))
+	for k, a := range e.args {
+		args[k] = eval(a, scope)
+	}
+	return e.f(args)
 }
 
-type memberEvaluator struct {
-	t        semantic.Type
-	object   Evaluator
-	property string
+func (e *callEvaluator) EvalBool(scope Scope) bool {
+	return e.call(scope).Bool()
 }
 
-func (e *memberEvaluator) Type() semantic.Type {
-	return e.t
+func (e *callEvaluator) EvalInt(scope Scope) int64 {
+	return e.call(scope).Int()
 }
 
-func (e *memberEvaluator) EvalBool(scope Scope) bool {
-	return e.object.EvalObject(scope).Get(e.property).Bool()
+func (e *callEvaluator) EvalUInt(scope Scope) uint64 {
+	return e.call(scope).UInt()
 }
 
-func (e *memberEvaluator) EvalInt(scope Scope) int64 {
-	return e.object.EvalObject(scope).Get(e.property).Int()
+func (e *callEvaluator) EvalFloat(scope Scope) float64 {
+	return e.call(scope).Float()
 }
 
-func (e *memberEvaluator) EvalUInt(scope Scope) uint64 {
-	return e.object.EvalObject(scope).Get(e.property).UInt()
+func (e *callEvaluator) EvalString(scope Scope) string {
+	return e.call(scope).Str()
 }
 
-func (e *memberEvaluator) EvalFloat(scope Scope) float64 {
-	return e.object.EvalObject(scope).Get(e.property).Float()
+func (e *callEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	return e.call(scope).Regexp()
 }
 
-func (e *memberEvaluator) EvalString(scope Scope) string {
-	return e.object.EvalObject(scope).Get(e.property).Str()
+func (e *callEvaluator) EvalTime(scope Scope) Time {
+	return e.call(scope).Time()
 }
-func (e *memberEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
-	return e.object.EvalObject(scope).Get(e.property).Regexp()
+
+func (e *callEvaluator) EvalDuration(scope Scope) Duration {
+	return e.call(scope).Duration()
 }
 
-func (e *memberEvaluator) EvalTime(scope Scope) Time {
-	return e.object.EvalObject(scope).Get(e.property).Time()
+func (e *callEvaluator) EvalObject(scope Scope) *Object {
+	return e.call(scope).Object()
 }
-func (e *memberEvaluator) EvalObject(scope Scope) *Object {
-	return e.object.EvalObject(scope).Get(e.property).Object()
+
+func (e *callEvaluator) EvalArray(scope Scope) *Array {
+	return e.call(scope).Array()
 }
 
 // Map of binary functions
@@ -1863,4 +2656,252 @@ var binaryFuncs = map[binarySignature]struct {
 		},
 		ResultKind: semantic.Bool,
 	},
+	// Like in InfluxQL, comparing a string to a regular expression for equality matches it.
+	{Operator: ast.EqualOperator, Left: semantic.String, Right: semantic.Regexp}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalString(scope)
+			r := right.EvalRegexp(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: r.MatchString(l),
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.EqualOperator, Left: semantic.Regexp, Right: semantic.String}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalRegexp(scope)
+			r := right.EvalString(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l.MatchString(r),
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.NotEqualOperator, Left: semantic.String, Right: semantic.Regexp}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalString(scope)
+			r := right.EvalRegexp(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: !r.MatchString(l),
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.NotEqualOperator, Left: semantic.Regexp, Right: semantic.String}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalRegexp(scope)
+			r := right.EvalString(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: !l.MatchString(r),
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+
+	//----------------------------
+	// Time and Duration Operators
+	//----------------------------
+
+	{Operator: ast.AdditionOperator, Left: semantic.Time, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Time,
+				Value: l + Time(r),
+			}
+		},
+		ResultKind: semantic.Time,
+	},
+	{Operator: ast.AdditionOperator, Left: semantic.Duration, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Time,
+				Value: Time(l) + r,
+			}
+		},
+		ResultKind: semantic.Time,
+	},
+	{Operator: ast.AdditionOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Duration,
+				Value: l + r,
+			}
+		},
+		ResultKind: semantic.Duration,
+	},
+	{Operator: ast.SubtractionOperator, Left: semantic.Time, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Time,
+				Value: l - Time(r),
+			}
+		},
+		ResultKind: semantic.Time,
+	},
+	{Operator: ast.SubtractionOperator, Left: semantic.Time, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Duration,
+				Value: Duration(l - r),
+			}
+		},
+		ResultKind: semantic.Duration,
+	},
+	{Operator: ast.SubtractionOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Duration,
+				Value: l - r,
+			}
+		},
+		ResultKind: semantic.Duration,
+	},
+	{Operator: ast.LessThanEqualOperator, Left: semantic.Time, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l <= r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.LessThanOperator, Left: semantic.Time, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l < r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.GreaterThanEqualOperator, Left: semantic.Time, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l >= r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.GreaterThanOperator, Left: semantic.Time, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l > r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.EqualOperator, Left: semantic.Time, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l == r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.NotEqualOperator, Left: semantic.Time, Right: semantic.Time}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalTime(scope)
+			r := right.EvalTime(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l != r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.LessThanEqualOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l <= r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.LessThanOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l < r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.GreaterThanEqualOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l >= r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.GreaterThanOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l > r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.EqualOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l == r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
+	{Operator: ast.NotEqualOperator, Left: semantic.Duration, Right: semantic.Duration}: {
+		Func: func(scope Scope, left, right Evaluator) Value {
+			l := left.EvalDuration(scope)
+			r := right.EvalDuration(scope)
+			return value{
+				typ:   semantic.Bool,
+				Value: l != r,
+			}
+		},
+		ResultKind: semantic.Bool,
+	},
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/influxdata/ifql/interpreter"
 	"github.com/influxdata/ifql/semantic"
@@ -11,6 +12,8 @@ import (
 
 type functionType interface {
 	Params() map[string]semantic.Type
+	PipeArgument() string
+	ReturnType() semantic.Type
 }
 
 // FunctionSuggestion provides information about a function
@@ -18,6 +21,41 @@ type FunctionSuggestion struct {
 	Params map[string]string
 }
 
+// FunctionSignature describes the parameters of a function
+type FunctionSignature struct {
+	Name string
+	// Params are the names of the parameters, sorted, with the piped parameter last.
+	Params []string
+	// Kinds are the kinds of the parameters by their names, empty when the kind is not known.
+	Kinds        map[string]string
+	PipeArgument string
+	ReturnKind   string
+}
+
+// ParamLabel returns the parameter as it is written in the signature.
+func (s FunctionSignature) ParamLabel(name string) string {
+	if name == s.PipeArgument {
+		return name + "=<-"
+	}
+	if k := s.Kinds[name]; k != "" {
+		return name + ":" + k
+	}
+	return name
+}
+
+// String returns the signature in the form name(param:kind, table=<-) kind.
+func (s FunctionSignature) String() string {
+	params := make([]string, len(s.Params))
+	for i, p := range s.Params {
+		params[i] = s.ParamLabel(p)
+	}
+	str := s.Name + "(" + strings.Join(params, ", ") + ")"
+	if s.ReturnKind != "" {
+		str += " " + s.ReturnKind
+	}
+	return str
+}
+
 // Completer provides methods for suggestions in IFQL queries
 type Completer struct {
 	scope        *interpreter.Scope
@@ -36,6 +74,18 @@ func (c Completer) Names() []string {
 	return names
 }
 
+// DeclarationNames returns the names of the declarations, except the private names starting with an underscore
+func (c Completer) DeclarationNames() []string {
+	names := make([]string, 0, len(c.declarations))
+	for name := range c.declarations {
+		if !strings.HasPrefix(name, "_") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Declaration returns a declaration based on the expression name, if one exists
 func (c Completer) Declaration(name string) (semantic.VariableDeclaration, error) {
 	d, ok := c.declarations[name]
@@ -92,6 +142,52 @@ func (c Completer) FunctionSuggestion(name string) (FunctionSuggestion, error) {
 	return s, nil
 }
 
+// FunctionSignature returns the signature of a function
+func (c Completer) FunctionSignature(name string) (FunctionSignature, error) {
+	d, err := c.Declaration(name)
+	if err != nil {
+		return FunctionSignature{Name: name}, err
+	}
+	if !isFunction(d) {
+		return FunctionSignature{Name: name}, fmt.Errorf("name ( %s ) is not a function", name)
+	}
+	return Signature(name, d.InitType())
+}
+
+// Signature returns the signature of a function with the name and the function type
+func Signature(name string, typ semantic.Type) (FunctionSignature, error) {
+	s := FunctionSignature{Name: name}
+
+	funcType, ok := typ.(functionType)
+	if !ok {
+		return s, errors.New("could not cast function type")
+	}
+
+	s.PipeArgument = funcType.PipeArgument()
+	s.Kinds = make(map[string]string)
+	for k, v := range funcType.Params() {
+		if k != s.PipeArgument {
+			s.Params = append(s.Params, k)
+		}
+		if v != nil && v.Kind() != semantic.Invalid {
+			s.Kinds[k] = v.Kind().String()
+		}
+	}
+	sort.Strings(s.Params)
+	if s.PipeArgument != "" {
+		s.Params = append(s.Params, s.PipeArgument)
+	}
+	if rt := funcType.ReturnType(); rt != nil && rt.Kind() != semantic.Invalid {
+		s.ReturnKind = rt.Kind().String()
+	}
+	return s, nil
+}
+
+// Doc returns the documentation of a builtin function, or an empty string if there is none
+func (c Completer) Doc(name string) string {
+	return docs[name]
+}
+
 func isFunction(d semantic.VariableDeclaration) bool {
 	return d.InitType().Kind() == semantic.Function
 }
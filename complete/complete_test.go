@@ -1,6 +1,7 @@
 package complete
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -8,6 +9,7 @@ import (
 	"github.com/influxdata/ifql/interpreter"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/semantic/semantictest"
 )
 
 var scope *interpreter.Scope
@@ -44,8 +46,8 @@ func TestDeclaration(t *testing.T) {
 	declaration, _ := NewCompleter(scope, declarations).Declaration(name)
 	result := declaration.ID()
 
-	if !cmp.Equal(result, expected) {
-		t.Error(cmp.Diff(result, expected), "unexpected declaration for name")
+	if !cmp.Equal(result, expected, semantictest.CmpOptions...) {
+		t.Error(cmp.Diff(result, expected, semantictest.CmpOptions...), "unexpected declaration for name")
 	}
 }
 
@@ -75,9 +77,10 @@ func TestFunctionSuggestion(t *testing.T) {
 
 	expected := FunctionSuggestion{
 		Params: map[string]string{
-			"start": semantic.Time.String(),
-			"stop":  semantic.Time.String(),
-			"table": query.TableObjectType.Kind().String(),
+			"location": semantic.String.String(),
+			"start":    semantic.Time.String(),
+			"stop":     semantic.Time.String(),
+			"table":    query.TableObjectType.Kind().String(),
 		},
 	}
 
@@ -85,3 +88,28 @@ func TestFunctionSuggestion(t *testing.T) {
 		t.Error(cmp.Diff(result, expected), "does not match expected suggestion")
 	}
 }
+
+func TestFunctionSignature(t *testing.T) {
+	result, err := NewCompleter(scope, declarations).FunctionSignature("range")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "range(location:string, start:time, stop:time, table=<-) object"
+	if got := result.String(); got != expected {
+		t.Errorf("unexpected signature: got %q want %q", got, expected)
+	}
+}
+
+func TestDocs(t *testing.T) {
+	c := NewCompleter(scope, declarations)
+	for _, name := range c.FunctionNames() {
+		// Names starting with an underscore are helpers of other builtin functions.
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		if c.Doc(name) == "" {
+			t.Errorf("function %q has no docs", name)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package complete
+
+// docs are short descriptions of the builtin functions, shown by editors next to their signatures.
+var docs = map[string]string{
+	"aggregateWindow":          "Applies an aggregate or selector function to the windows of every duration, with a row per window.",
+	"bool":                     "Converts a value to a boolean.",
+	"bottom":                   "Returns the n rows with the lowest values of the columns.",
+	"count":                    "Counts the number of results.",
+	"cov":                      "Computes the covariance of the values of two tables, joined on the on columns.",
+	"covariance":               "Computes the covariance of the columns of the table.",
+	"cumulativeSum":            "Replaces each value with the sum of the values up to its row.",
+	"day":                      "Returns the day of the month of a time in the location, which defaults to UTC.",
+	"derivative":               "Computes the rate of change per unit of time of the values.",
+	"difference":               "Computes the difference between subsequent values.",
+	"distinct":                 "Returns the unique values of the column.",
+	"doubleEMA":                "Computes the double exponential moving average of the last n values.",
+	"exponentialMovingAverage": "Computes the exponential moving average of the last n values.",
+	"fill":                     "Replaces the null values of a column with a value, the previous value, or by interpolation.",
+	"filter":                   "Filters the results using a function of each record, keeping the records for which it returns true.",
+	"first":                    "Returns the first result of the query.",
+	"float":                    "Converts a value to a float.",
+	"from":                     "Starting point for all queries. Gets data from the specified database.",
+	"fromCSV":                  "Starting point for queries over CSV data, read from a file or a string.",
+	"group":                    "Groups results by a set of tags, or by all but a set of tags.",
+	"highestAverage":           "Returns the n groups with the highest average values.",
+	"highestCurrent":           "Returns the n groups with the highest last values.",
+	"highestMax":               "Returns the n groups with the highest maximum values.",
+	"hour":                     "Returns the hour of a time in the location, which defaults to UTC.",
+	"int":                      "Converts a value to an integer.",
+	"integral":                 "Computes the area under the curve of the values per unit of time.",
+	"join":                     "Joins tables together on time and the list of on keys, merging their records with a function.",
+	"last":                     "Returns the last result of the query.",
+	"limit":                    "Restricts the number of rows returned in the results.",
+	"lowestAverage":            "Returns the n groups with the lowest average values.",
+	"lowestCurrent":            "Returns the n groups with the lowest last values.",
+	"lowestMin":                "Returns the n groups with the lowest minimum values.",
+	"map":                      "Applies a function to each row of the table.",
+	"math":                     "Math functions of floats, which record functions can call.",
+	"max":                      "Returns the max value within the results.",
+	"mean":                     "Returns the mean of the values within the results.",
+	"median":                   "Returns the median of the values within the results.",
+	"min":                      "Returns the min value within the results.",
+	"minute":                   "Returns the minute of a time in the location, which defaults to UTC.",
+	"month":                    "Returns the month of a time in the location, from 1 for January to 12 for December.",
+	"movingAverage":            "Computes the mean of the last n values.",
+	"pearsonr":                 "Computes the Pearson correlation coefficient of the values of two tables, joined on the on columns.",
+	"percentile":               "Returns the value at the percentile p of the values within the results.",
+	"pivot":                    "Turns the rows of each block into columns, with a column per distinct value of the column key.",
+	"range":                    "Filters the results by time boundaries.",
+	"reduce":                   "Aggregates the rows of each block by folding them into an accumulator record with a function.",
+	"relativeStrengthIndex":    "Computes the relative strength index of the changes of the last n values.",
+	"sample":                   "Samples every nth element of the results.",
+	"set":                      "Adds a tag of key and value to the results.",
+	"shift":                    "Shifts the times of the results by a duration.",
+	"skew":                     "Skew of the results.",
+	"sort":                     "Sorts the results by the specified columns.",
+	"spread":                   "Difference between min and max values.",
+	"stateCount":               "Counts the consecutive records for which the function returns true.",
+	"stateDuration":            "Measures the time of the consecutive records for which the function returns true.",
+	"stateTracking":            "Tracks the number and the duration of the consecutive records for which the function returns true.",
+	"stddev":                   "Standard deviation of the results.",
+	"string":                   "Converts a value to a string.",
+	"strings":                  "String functions, which record functions can call.",
+	"sum":                      "Sum of the results.",
+	"time":                     "Converts a value to a time, integers are nanoseconds since the Unix epoch.",
+	"timedMovingAverage":       "Computes the mean of the values in the period before every duration.",
+	"to":                       "Writes the results of a query to a database and passes them through unchanged.",
+	"top":                      "Returns the n rows with the highest values of the columns.",
+	"truncate":                 "Truncates a time to a multiple of the unit in the location, which defaults to UTC.",
+	"weekday":                  "Returns the day of the week of a time in the location, from 0 for Sunday to 6 for Saturday.",
+	"window":                   "Partitions the results by a given time range.",
+	"year":                     "Returns the year of a time in the location, which defaults to UTC.",
+	"yield":                    "Names the results of the query, which are returned by it.",
+}
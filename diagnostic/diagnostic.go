@@ -0,0 +1,236 @@
+// Package diagnostic provides source located errors and warnings about IFQL scripts.
+package diagnostic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/ifql/ast"
+)
+
+// Severity is the seriousness of a diagnostic.
+type Severity int
+
+const (
+	// Error diagnostics prevent a script from being run.
+	Error Severity = iota
+	// Warning diagnostics report suspicious but valid code.
+	Warning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	default:
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+}
+
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "error":
+		*s = Error
+	case "warning":
+		*s = Warning
+	default:
+		return fmt.Errorf("unknown severity %q", string(text))
+	}
+	return nil
+}
+
+// Code identifies the kind of problem a diagnostic reports.
+// Codes are stable so that clients can match on them.
+type Code string
+
+const (
+	// SyntaxError is reported when the source cannot be parsed.
+	SyntaxError Code = "syntax-error"
+	// SemanticError is reported when a parsed program is not a valid IFQL program.
+	SemanticError Code = "semantic-error"
+	// TypeError is reported when expressions are used with inconsistent types.
+	TypeError Code = "type-error"
+	// UndefinedIdentifier is reported when an identifier is used without being declared.
+	UndefinedIdentifier Code = "undefined-identifier"
+	// UnknownColumn is reported when a row is accessed with a column it cannot have.
+	UnknownColumn Code = "unknown-column"
+	// ImportError is reported when a package imported by a program cannot be loaded.
+	ImportError Code = "import-error"
+	// EvaluationError is reported when interpreting a program fails.
+	EvaluationError Code = "evaluation-error"
+)
+
+// Diagnostic is a single problem found in a script.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	Code     Code     `json:"code"`
+	Message  string   `json:"message"`
+	// Loc is the span of source the diagnostic is about.
+	// It is nil when the location is not known.
+	Loc *ast.SourceLocation `json:"location,omitempty"`
+}
+
+// Errorf creates an error diagnostic.
+func Errorf(code Code, loc *ast.SourceLocation, format string, args ...interface{}) *Diagnostic {
+	return &Diagnostic{
+		Severity: Error,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Loc:      location(loc),
+	}
+}
+
+// location strips the source text from loc, it is redundant with the script.
+func location(loc *ast.SourceLocation) *ast.SourceLocation {
+	if loc == nil {
+		return nil
+	}
+	return &ast.SourceLocation{
+		Start: loc.Start,
+		End:   loc.End,
+	}
+}
+
+// Error returns the message prefixed with the line and column where the diagnostic starts.
+func (d *Diagnostic) Error() string {
+	if d.Loc == nil {
+		return d.Message
+	}
+	return fmt.Sprintf("%d:%d: %s", d.Loc.Start.Line, d.Loc.Start.Column, d.Message)
+}
+
+// List is a list of diagnostics ordered by their location.
+type List []*Diagnostic
+
+// Error returns the errors of the list one per line.
+func (l List) Error() string {
+	var b strings.Builder
+	for _, d := range l {
+		if d.Severity != Error {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(d.Error())
+	}
+	return b.String()
+}
+
+// HasErrors reports whether any diagnostic in the list is an error.
+func (l List) HasErrors() bool {
+	for _, d := range l {
+		if d.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns the list as an error if it contains any errors, otherwise nil.
+func (l List) Err() error {
+	if !l.HasErrors() {
+		return nil
+	}
+	return l
+}
+
+// Add appends the diagnostics of err to the list.
+func (l *List) Add(err error, code Code) {
+	*l = append(*l, FromError(err, code)...)
+}
+
+// FromError returns the diagnostics held by err.
+// Any other error becomes a single diagnostic with the given code and an unknown location.
+func FromError(err error, code Code) List {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case List:
+		return e
+	case *Diagnostic:
+		return List{e}
+	default:
+		return List{{
+			Severity: Error,
+			Code:     code,
+			Message:  err.Error(),
+		}}
+	}
+}
+
+// MarshalJSON encodes the list as an array, also when it is empty.
+func (l List) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]*Diagnostic(l))
+}
+
+// Render formats the diagnostics with the source lines they are about,
+// marking the span of each diagnostic with carets.
+//
+// Example:
+//
+//	error[unknown-column] 2:24: unknown column "_valu"
+//	  2 |   |> filter(fn: (r) => r._valu > 0)
+//	    |                        ^^^^^^^
+func (l List) Render(source string) string {
+	lines := strings.Split(source, "\n")
+	var b bytes.Buffer
+	for i, d := range l {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "%v[%s] %s\n", d.Severity, d.Code, d.Error())
+		if d.Loc == nil || d.Loc.Start.Line < 1 || d.Loc.Start.Line > len(lines) {
+			continue
+		}
+		line := strings.TrimRight(lines[d.Loc.Start.Line-1], "\r")
+		number := fmt.Sprint(d.Loc.Start.Line)
+		gutter := strings.Repeat(" ", len(number))
+		fmt.Fprintf(&b, "  %s | %s\n", number, line)
+		fmt.Fprintf(&b, "  %s | %s\n", gutter, marker(line, d.Loc))
+	}
+	return b.String()
+}
+
+// marker returns the carets that underline the part of line covered by loc.
+// Spans over several lines are underlined up to the end of their first line.
+func marker(line string, loc *ast.SourceLocation) string {
+	runes := []rune(line)
+	start := loc.Start.Column - 1
+	if start < 0 {
+		start = 0
+	}
+	if start > len(runes) {
+		start = len(runes)
+	}
+	end := len(runes)
+	if loc.End.Line == loc.Start.Line && loc.End.Column-1 < end {
+		end = loc.End.Column - 1
+	}
+	var b strings.Builder
+	for _, r := range runes[:start] {
+		// Keep tabs so the carets line up with the source.
+		if r == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteByte(' ')
+		}
+	}
+	n := end - start
+	if n < 1 {
+		n = 1
+	}
+	b.WriteString(strings.Repeat("^", n))
+	return b.String()
+}
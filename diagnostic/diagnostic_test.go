@@ -0,0 +1,87 @@
+package diagnostic_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/diagnostic"
+)
+
+func TestList_Render(t *testing.T) {
+	loc := func(line, col, endLine, endCol int) *ast.SourceLocation {
+		return &ast.SourceLocation{
+			Start: ast.Position{Line: line, Column: col},
+			End:   ast.Position{Line: endLine, Column: endCol},
+		}
+	}
+	source := "from(db:\"telegraf\")\n\t|> filter(fn: (r) => r._valu > 0)\n\t|> range(start:-1h\n"
+	testCases := []struct {
+		name  string
+		diags diagnostic.List
+		want  string
+	}{
+		{
+			name: "span",
+			diags: diagnostic.List{
+				diagnostic.Errorf(diagnostic.UnknownColumn, loc(2, 23, 2, 30), "unknown column %q", "_valu"),
+			},
+			want: `error[unknown-column] 2:23: unknown column "_valu"
+  2 | 	|> filter(fn: (r) => r._valu > 0)
+    | 	                     ^^^^^^^
+`,
+		},
+		{
+			name: "several lines",
+			diags: diagnostic.List{
+				diagnostic.Errorf(diagnostic.EvaluationError, loc(1, 1, 3, 20), "failed"),
+				diagnostic.Errorf(diagnostic.SyntaxError, loc(3, 20, 3, 21), "unexpected end of input"),
+			},
+			want: `error[evaluation-error] 1:1: failed
+  1 | from(db:"telegraf")
+    | ^^^^^^^^^^^^^^^^^^^
+
+error[syntax-error] 3:20: unexpected end of input
+  3 | 	|> range(start:-1h
+    | 	                  ^
+`,
+		},
+		{
+			name: "unknown location",
+			diags: diagnostic.List{
+				{Severity: diagnostic.Warning, Code: diagnostic.TypeError, Message: "suspicious"},
+			},
+			want: "warning[type-error] suspicious\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := tc.diags.Render(source)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected rendering -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestList_Err(t *testing.T) {
+	warnings := diagnostic.List{
+		{Severity: diagnostic.Warning, Message: "suspicious"},
+	}
+	if err := warnings.Err(); err != nil {
+		t.Errorf("unexpected error from warnings: %v", err)
+	}
+	errs := append(warnings,
+		diagnostic.Errorf(diagnostic.TypeError, &ast.SourceLocation{Start: ast.Position{Line: 1, Column: 5}}, "first"),
+		diagnostic.Errorf(diagnostic.TypeError, nil, "second"),
+	)
+	err := errs.Err()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got, want := err.Error(), "1:5: first\nsecond"; got != want {
+		t.Errorf("unexpected error message: got %q want %q", got, want)
+	}
+}
@@ -0,0 +1,207 @@
+package format
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/influxdata/ifql/ast"
+)
+
+// comment is a line comment of the source.
+type comment struct {
+	pos ast.Position
+	// text is the comment including the leading slashes, without trailing whitespace.
+	text string
+}
+
+// before reports whether the position a is before the position b.
+func before(a, b ast.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
+
+// comments finds the comments of the source of the program, in order,
+// and the columns where they start by their lines.
+// The parser drops comments, so they are found in the source outside of the string and regular expression literals.
+func comments(src string, prog *ast.Program) ([]comment, map[int]int) {
+	// literals are the column spans of the literals by their lines, literals cannot span several lines.
+	literals := make(map[int][][2]int)
+	walk(prog, func(n ast.Node) {
+		switch n.(type) {
+		case *ast.StringLiteral, *ast.RegexpLiteral:
+			if loc := n.Location(); loc != nil {
+				literals[loc.Start.Line] = append(literals[loc.Start.Line], [2]int{loc.Start.Column, loc.End.Column})
+			}
+		}
+	})
+	inLiteral := func(line, col int) bool {
+		for _, span := range literals[line] {
+			if col >= span[0] && col < span[1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	var list []comment
+	columns := make(map[int]int)
+	for i, text := range strings.Split(src, "\n") {
+		line := i + 1
+		col := 1
+		for j, r := range text {
+			if r == '/' && strings.HasPrefix(text[j:], "//") && !inLiteral(line, col) {
+				list = append(list, comment{
+					pos:  ast.Position{Line: line, Column: col},
+					text: strings.TrimRight(text[j:], " \t\r"),
+				})
+				columns[line] = col
+				break
+			}
+			col++
+		}
+	}
+	return list, columns
+}
+
+// start returns the position of the first token of the location,
+// as the location of some nodes includes the whitespace and comments before them.
+func (p *printer) start(loc *ast.SourceLocation) ast.Position {
+	pos := loc.Start
+	if loc.Source == nil || len(p.commentLines) == 0 {
+		return pos
+	}
+	text := *loc.Source
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		switch {
+		case r == '\n':
+			pos.Line++
+			pos.Column = 1
+		case r == ' ' || r == '\t' || r == '\r':
+			pos.Column++
+		default:
+			if col, ok := p.commentLines[pos.Line]; !ok || col != pos.Column {
+				return pos
+			}
+			// Skip the comment until the end of the line.
+			i := strings.IndexByte(text, '\n')
+			if i < 0 {
+				return pos
+			}
+			text = text[i:]
+			continue
+		}
+		text = text[size:]
+	}
+	return pos
+}
+
+// end returns the position after the last token of the location,
+// as the location of some nodes includes the whitespace and comments after them.
+func (p *printer) end(loc *ast.SourceLocation) ast.Position {
+	if loc.Source == nil || len(p.commentLines) == 0 {
+		return loc.End
+	}
+	lines := strings.Split(*loc.Source, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := loc.Start.Line + i
+		col := 1
+		if i == 0 {
+			col = loc.Start.Column
+		}
+		text := []rune(lines[i])
+		if c, ok := p.commentLines[line]; ok && c >= col && c-col < len(text) {
+			text = text[:c-col]
+		}
+		if trimmed := strings.TrimRight(string(text), " \t\r"); trimmed != "" {
+			return ast.Position{Line: line, Column: col + utf8.RuneCountInString(trimmed)}
+		}
+	}
+	return loc.Start
+}
+
+// walk calls f for the node and all of its descendants.
+func walk(n ast.Node, f func(ast.Node)) {
+	f(n)
+	for _, c := range children(n) {
+		walk(c, f)
+	}
+}
+
+func children(n ast.Node) []ast.Node {
+	var nodes []ast.Node
+	add := func(c ast.Node) {
+		nodes = append(nodes, c)
+	}
+	switch n := n.(type) {
+	case *ast.Program:
+		if n.Package != nil {
+			add(n.Package)
+		}
+		for _, imp := range n.Imports {
+			add(imp)
+		}
+		for _, s := range n.Body {
+			add(s)
+		}
+	case *ast.ImportDeclaration:
+		if n.As != nil {
+			add(n.As)
+		}
+		add(n.Path)
+	case *ast.BlockStatement:
+		for _, s := range n.Body {
+			add(s)
+		}
+	case *ast.ExpressionStatement:
+		add(n.Expression)
+	case *ast.ReturnStatement:
+		add(n.Argument)
+	case *ast.VariableDeclaration:
+		for _, d := range n.Declarations {
+			add(d)
+		}
+	case *ast.VariableDeclarator:
+		add(n.Init)
+	case *ast.ArrayExpression:
+		for _, e := range n.Elements {
+			add(e)
+		}
+	case *ast.ArrowFunctionExpression:
+		for _, param := range n.Params {
+			add(param)
+		}
+		add(n.Body)
+	case *ast.BinaryExpression:
+		add(n.Left)
+		add(n.Right)
+	case *ast.LogicalExpression:
+		add(n.Left)
+		add(n.Right)
+	case *ast.UnaryExpression:
+		add(n.Argument)
+	case *ast.CallExpression:
+		add(n.Callee)
+		for _, arg := range n.Arguments {
+			add(arg)
+		}
+	case *ast.PipeExpression:
+		add(n.Argument)
+		add(n.Call)
+	case *ast.MemberExpression:
+		add(n.Object)
+		add(n.Property)
+	case *ast.ConditionalExpression:
+		add(n.Test)
+		add(n.Consequent)
+		add(n.Alternate)
+	case *ast.ObjectExpression:
+		for _, prop := range n.Properties {
+			add(prop)
+		}
+	case *ast.Property:
+		if n.Value != nil {
+			add(n.Value)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,637 @@
+// Package format prints IFQL syntax trees as source code in a canonical style.
+//
+// The canonical style puts each statement on its own line, keeping single blank lines between statements,
+// and each call of a pipe chain with more than one call on its own line.
+// Lists of arguments and object properties are written on one line,
+// unless they do not fit, contain multiple lines or comments,
+// in which case each property is written on its own line with its value aligned to the others.
+package format
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/parser"
+)
+
+const (
+	// indentation is the indentation of every level of nesting.
+	indentation = "    "
+	// lineWidth is the width in runes after which a list is split over several lines.
+	lineWidth = 100
+)
+
+// Format returns the source of the node in the canonical style.
+// Programs end with a newline, other nodes do not.
+func Format(n ast.Node) string {
+	p := new(printer)
+	p.node(n)
+	return p.buf.String()
+}
+
+// Source formats the IFQL source in the canonical style, keeping its comments.
+func Source(src string) (string, error) {
+	prog, err := parser.NewAST(src)
+	if err != nil {
+		return "", err
+	}
+	p := new(printer)
+	p.comments, p.commentLines = comments(src, prog)
+	p.node(prog)
+	return p.buf.String(), nil
+}
+
+// printer writes nodes to its buffer.
+// The comments of the source are written before the first line-level node that follows them,
+// or after the node on whose last line they are.
+type printer struct {
+	buf strings.Builder
+	// col is the column of the end of the buffer, in runes.
+	col    int
+	indent int
+	// atLineStart reports whether the indentation of the current line has yet to be written.
+	atLineStart bool
+	// flat reports whether lists are written on one line regardless of their width.
+	flat bool
+
+	// comments are the comments of the source that have not been written yet, in order.
+	comments []comment
+	// commentLines are the columns of the comments of the source by their lines, including the written comments.
+	commentLines map[int]int
+	// line is the last source line of the last statement written, zero at the start.
+	line int
+}
+
+func (p *printer) write(s string) {
+	if s == "" {
+		return
+	}
+	if p.atLineStart {
+		p.atLineStart = false
+		p.write(strings.Repeat(indentation, p.indent))
+	}
+	p.buf.WriteString(s)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		p.col = utf8.RuneCountInString(s[i+1:])
+	} else {
+		p.col += utf8.RuneCountInString(s)
+	}
+}
+
+// newline starts a new line, its indentation is written with its first text.
+func (p *printer) newline() {
+	p.buf.WriteByte('\n')
+	p.col = 0
+	p.atLineStart = true
+}
+
+// blankLine writes an empty line, it must be called at the start of a line.
+func (p *printer) blankLine() {
+	p.buf.WriteByte('\n')
+}
+
+// inline returns the node as it is written on its own without comments,
+// in order to decide whether a list containing it fits on a line.
+func (p *printer) inline(n ast.Node) string {
+	q := &printer{flat: true}
+	q.node(n)
+	return q.buf.String()
+}
+
+// fits reports whether the node, written on one line from the current column, fits the line width.
+// Nodes with comments before the end of the location do not fit.
+func (p *printer) fits(n ast.Node, loc *ast.SourceLocation) bool {
+	if p.flat {
+		return true
+	}
+	if p.commentsBefore(loc) {
+		return false
+	}
+	s := p.inline(n)
+	return !strings.Contains(s, "\n") && p.col+utf8.RuneCountInString(s) <= lineWidth
+}
+
+func (p *printer) node(n ast.Node) {
+	switch n := n.(type) {
+	case *ast.Program:
+		p.program(n)
+	case *ast.PackageClause:
+		p.write("package ")
+		p.write(n.Name.Name)
+	case *ast.ImportDeclaration:
+		p.write("import ")
+		if n.As != nil {
+			p.write(n.As.Name)
+			p.write(" ")
+		}
+		p.node(n.Path)
+	case *ast.BlockStatement:
+		p.block(n)
+	case *ast.ExpressionStatement:
+		p.node(n.Expression)
+	case *ast.ReturnStatement:
+		p.write("return ")
+		p.node(n.Argument)
+	case *ast.VariableDeclaration:
+		for i, d := range n.Declarations {
+			if i > 0 {
+				p.newline()
+			}
+			p.node(d)
+		}
+	case *ast.VariableDeclarator:
+		p.write(n.ID.Name)
+		p.write(" = ")
+		p.node(n.Init)
+	case *ast.ArrayExpression:
+		p.array(n)
+	case *ast.ArrowFunctionExpression:
+		p.arrow(n)
+	case *ast.BinaryExpression:
+		prec := precedence(n)
+		p.operand(n.Left, prec, false)
+		p.write(" ")
+		p.write(n.Operator.String())
+		p.write(" ")
+		p.operand(n.Right, prec, true)
+	case *ast.LogicalExpression:
+		prec := precedence(n)
+		p.operand(n.Left, prec, false)
+		p.write(" ")
+		p.write(n.Operator.String())
+		p.write(" ")
+		p.operand(n.Right, prec, true)
+	case *ast.UnaryExpression:
+		p.write(n.Operator.String())
+		if n.Operator == ast.NotOperator || n.Operator == ast.ExistsOperator {
+			p.write(" ")
+		}
+		p.primary(n.Argument, true)
+	case *ast.ConditionalExpression:
+		p.write("if ")
+		p.node(n.Test)
+		p.write(" then ")
+		p.node(n.Consequent)
+		p.write(" else ")
+		p.node(n.Alternate)
+	case *ast.CallExpression:
+		p.call(n)
+	case *ast.PipeExpression:
+		p.pipe(n)
+	case *ast.MemberExpression:
+		p.primary(n.Object, true)
+		if id, ok := n.Property.(*ast.Identifier); ok {
+			p.write(".")
+			p.write(id.Name)
+		} else {
+			p.write("[")
+			p.primary(n.Property, false)
+			p.write("]")
+		}
+	case *ast.ObjectExpression:
+		p.properties(n, "{", "}", n.Location())
+	case *ast.Property:
+		p.write(n.Key.Name)
+		p.write(":")
+		p.node(n.Value)
+	case *ast.Identifier:
+		p.write(n.Name)
+	case *ast.PipeLiteral:
+		p.write("<-")
+	case *ast.StringLiteral:
+		p.write(`"` + strings.Replace(n.Value, `"`, `\"`, -1) + `"`)
+	case *ast.BooleanLiteral:
+		p.write(strconv.FormatBool(n.Value))
+	case *ast.NullLiteral:
+		p.write("null")
+	case *ast.FloatLiteral:
+		p.write(formatFloat(n.Value))
+	case *ast.IntegerLiteral:
+		p.write(strconv.FormatInt(n.Value, 10))
+	case *ast.UnsignedIntegerLiteral:
+		p.write(strconv.FormatUint(n.Value, 10))
+	case *ast.RegexpLiteral:
+		p.write("/" + strings.Replace(n.Value.String(), "/", `\/`, -1) + "/")
+	case *ast.DurationLiteral:
+		p.write(formatDuration(n.Months, n.Value))
+	case *ast.DateTimeLiteral:
+		p.write(n.Value.Format(time.RFC3339Nano))
+	}
+}
+
+func (p *printer) program(prog *ast.Program) {
+	// The package clause and the imports are separated from what follows by a blank line.
+	separate := false
+	if prog.Package != nil {
+		p.lineNode(prog.Package)
+		p.newline()
+		separate = true
+	}
+	if len(prog.Imports) > 0 {
+		if separate {
+			p.blankLine()
+		}
+		for _, imp := range prog.Imports {
+			p.lineNode(imp)
+			p.newline()
+		}
+		separate = true
+	}
+	for i, s := range prog.Body {
+		if separate && i == 0 {
+			p.blankLine()
+		}
+		p.statement(s)
+		p.newline()
+	}
+	// Write the comments at the end of the source.
+	if len(p.comments) > 0 {
+		if p.line > 0 && p.comments[0].pos.Line > p.line+1 {
+			p.blankLine()
+		}
+		for _, c := range p.comments {
+			p.write(c.text)
+			p.newline()
+		}
+		p.comments = nil
+	}
+}
+
+func (p *printer) block(b *ast.BlockStatement) {
+	p.write("{")
+	if len(b.Body) == 0 && !p.commentsBefore(b.Location()) {
+		p.write("}")
+		return
+	}
+	p.indent++
+	line := p.line
+	p.line = 0
+	for _, s := range b.Body {
+		p.newline()
+		p.statement(s)
+	}
+	p.closeList(b.Location())
+	p.line = line
+	p.write("}")
+}
+
+// statement writes a statement with its comments,
+// keeping a blank line before it when there is one in the source.
+func (p *printer) statement(s ast.Statement) {
+	if loc := s.Location(); loc != nil && p.line > 0 {
+		first := p.start(loc).Line
+		if len(p.comments) > 0 && before(p.comments[0].pos, p.start(loc)) {
+			first = p.comments[0].pos.Line
+		}
+		if first > p.line+1 {
+			p.blankLine()
+		}
+	}
+	p.lineNode(s)
+	if loc := s.Location(); loc != nil {
+		p.line = p.end(loc).Line
+	}
+}
+
+// lineNode writes a node that starts a line, with the comments before it and the comment at the end of its last line.
+func (p *printer) lineNode(n ast.Node) {
+	p.leadingComments(n.Location())
+	p.node(n)
+	p.trailingComment(n.Location())
+}
+
+// leadingComments writes the comments before the start of the location, each on its own line.
+func (p *printer) leadingComments(loc *ast.SourceLocation) {
+	if loc == nil {
+		return
+	}
+	for p.commentsBeforeStart(loc) {
+		p.write(p.comments[0].text)
+		p.newline()
+		p.comments = p.comments[1:]
+	}
+}
+
+// trailingComment writes the comment at the end of the last line of the location.
+func (p *printer) trailingComment(loc *ast.SourceLocation) {
+	if loc == nil {
+		return
+	}
+	end := p.end(loc)
+	if len(p.comments) > 0 && p.comments[0].pos.Line == end.Line && !before(p.comments[0].pos, end) {
+		p.write(" ")
+		p.write(p.comments[0].text)
+		p.comments = p.comments[1:]
+	}
+}
+
+// commentsBefore reports whether there are comments left before the end of the location.
+func (p *printer) commentsBefore(loc *ast.SourceLocation) bool {
+	return loc != nil && len(p.comments) > 0 && before(p.comments[0].pos, p.end(loc))
+}
+
+// commentsBeforeStart reports whether there are comments left before the start of the location.
+func (p *printer) commentsBeforeStart(loc *ast.SourceLocation) bool {
+	return loc != nil && len(p.comments) > 0 && before(p.comments[0].pos, p.start(loc))
+}
+
+// closeList writes the comments left before the end of a list that is written over several lines,
+// and starts the line of its closing delimiter.
+func (p *printer) closeList(loc *ast.SourceLocation) {
+	if p.commentsBefore(loc) {
+		p.newline()
+		for p.commentsBefore(loc) {
+			p.write(p.comments[0].text)
+			p.comments = p.comments[1:]
+			if p.commentsBefore(loc) {
+				p.newline()
+			}
+		}
+	}
+	p.indent--
+	p.newline()
+}
+
+func (p *printer) array(a *ast.ArrayExpression) {
+	if len(a.Elements) == 0 && !p.commentsBefore(a.Location()) {
+		p.write("[]")
+		return
+	}
+	if p.fits(a, a.Location()) {
+		p.write("[")
+		for i, e := range a.Elements {
+			if i > 0 {
+				p.write(", ")
+			}
+			p.primary(e, false)
+		}
+		p.write("]")
+		return
+	}
+	// Arrays do not allow a trailing comma.
+	p.write("[")
+	p.indent++
+	for i, e := range a.Elements {
+		p.newline()
+		p.leadingComments(e.Location())
+		p.primary(e, false)
+		if i < len(a.Elements)-1 {
+			p.write(",")
+		}
+		p.trailingComment(e.Location())
+	}
+	p.closeList(a.Location())
+	p.write("]")
+}
+
+func (p *printer) arrow(f *ast.ArrowFunctionExpression) {
+	p.write("(")
+	for i, param := range f.Params {
+		if i > 0 {
+			p.write(", ")
+		}
+		p.write(param.Key.Name)
+		if param.Value != nil {
+			p.write("=")
+			p.primary(param.Value, false)
+		}
+	}
+	p.write(") => ")
+	switch body := f.Body.(type) {
+	case *ast.ObjectExpression:
+		// An object body must be distinguished from a block.
+		p.write("(")
+		p.node(body)
+		p.write(")")
+	default:
+		p.node(body)
+	}
+}
+
+func (p *printer) call(c *ast.CallExpression) {
+	p.primary(c.Callee, true)
+	switch len(c.Arguments) {
+	case 0:
+		p.properties(new(ast.ObjectExpression), "(", ")", c.Location())
+		return
+	case 1:
+		if obj, ok := c.Arguments[0].(*ast.ObjectExpression); ok {
+			p.properties(obj, "(", ")", c.Location())
+			return
+		}
+	}
+	p.write("(")
+	for i, arg := range c.Arguments {
+		if i > 0 {
+			p.write(", ")
+		}
+		p.node(arg)
+	}
+	p.write(")")
+}
+
+// properties writes the properties of an object or of the arguments of a call between the delimiters.
+// The location is the location of the properties including their delimiters.
+func (p *printer) properties(obj *ast.ObjectExpression, open, close string, loc *ast.SourceLocation) {
+	props := obj.Properties
+	if len(props) == 0 && !p.commentsBefore(loc) {
+		p.write(open + close)
+		return
+	}
+	if p.fits(obj, loc) {
+		p.write(open)
+		for i, prop := range obj.Properties {
+			if i > 0 {
+				p.write(", ")
+			}
+			p.node(prop)
+		}
+		p.write(close)
+		return
+	}
+
+	width := 0
+	for _, prop := range props {
+		if n := utf8.RuneCountInString(prop.Key.Name); n > width {
+			width = n
+		}
+	}
+	p.write(open)
+	p.indent++
+	for _, prop := range props {
+		p.newline()
+		p.leadingComments(prop.Location())
+		p.write(prop.Key.Name)
+		p.write(":")
+		p.write(strings.Repeat(" ", width-utf8.RuneCountInString(prop.Key.Name)+1))
+		p.node(prop.Value)
+		p.write(",")
+		p.trailingComment(prop.Location())
+	}
+	p.closeList(loc)
+	p.write(close)
+}
+
+func (p *printer) pipe(e *ast.PipeExpression) {
+	// The parser nests the pipe expressions of a chain in their arguments.
+	var calls []*ast.PipeExpression
+	var head ast.Expression = e
+	for {
+		pe, ok := head.(*ast.PipeExpression)
+		if !ok {
+			break
+		}
+		calls = append(calls, pe)
+		head = pe.Argument
+	}
+	for i, j := 0, len(calls)-1; i < j; i, j = i+1, j-1 {
+		calls[i], calls[j] = calls[j], calls[i]
+	}
+
+	// A single call stays on the line of its argument, even if its arguments are split over several lines for their comments.
+	single := len(calls) == 1 && !p.commentsBeforeStart(calls[0].Call.Location()) && p.fits(e, nil)
+	p.primary(head, true)
+	if single {
+		p.write(" |> ")
+		p.call(calls[0].Call)
+		return
+	}
+	// A comment at the end of a line belongs to the last call on that line,
+	// the comment after the last call is written by the statement.
+	if p.commentsBeforeStart(calls[0].Call.Location()) {
+		p.trailingComment(head.Location())
+	}
+	p.indent++
+	for i, pe := range calls {
+		p.newline()
+		p.leadingComments(pe.Call.Location())
+		p.write("|> ")
+		p.call(pe.Call)
+		if i+1 < len(calls) && p.commentsBeforeStart(calls[i+1].Call.Location()) {
+			p.trailingComment(pe.Call.Location())
+		}
+	}
+	p.indent--
+}
+
+// primary writes an expression where the grammar only allows primary expressions,
+// adding parentheses when the expression is not one.
+// Operands also need parentheses around functions, whose bodies would otherwise include what follows them.
+func (p *printer) primary(e ast.Expression, operand bool) {
+	switch e.(type) {
+	case *ast.BinaryExpression, *ast.LogicalExpression, *ast.UnaryExpression, *ast.ConditionalExpression:
+		p.parens(e)
+	case *ast.ArrowFunctionExpression:
+		if operand {
+			p.parens(e)
+		} else {
+			p.node(e)
+		}
+	default:
+		p.node(e)
+	}
+}
+
+// operand writes an operand of an operator with the precedence.
+// Operators are left associative, so a right operand with the same precedence needs parentheses.
+func (p *printer) operand(e ast.Expression, prec int, right bool) {
+	eprec := precedence(e)
+	if eprec < prec || (right && eprec == prec) {
+		p.parens(e)
+		return
+	}
+	p.node(e)
+}
+
+func (p *printer) parens(e ast.Expression) {
+	p.write("(")
+	p.node(e)
+	p.write(")")
+}
+
+// precedence returns the precedence of the operator of the expression,
+// the expressions that are not operations have the highest precedence.
+func precedence(e ast.Expression) int {
+	switch e := e.(type) {
+	case *ast.ArrowFunctionExpression, *ast.ConditionalExpression:
+		return 0
+	case *ast.LogicalExpression:
+		return 1
+	case *ast.BinaryExpression:
+		switch e.Operator {
+		case ast.EqualOperator, ast.NotEqualOperator, ast.RegexpMatchOperator, ast.NotRegexpMatchOperator:
+			return 2
+		case ast.AdditionOperator, ast.SubtractionOperator:
+			return 4
+		case ast.MultiplicationOperator, ast.DivisionOperator:
+			return 5
+		default:
+			return 3
+		}
+	case *ast.UnaryExpression:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// formatFloat writes floats with a fraction, as the grammar requires, and without exponents.
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.ContainsAny(s, ".NI") {
+		s += ".0"
+	}
+	return s
+}
+
+var durationUnits = []struct {
+	unit string
+	d    time.Duration
+}{
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+	{"us", time.Microsecond},
+	{"ns", time.Nanosecond},
+}
+
+// formatDuration writes durations with integer magnitudes, as the grammar requires.
+// Unlike time.Duration.String it does not write fractions or zero magnitudes, for example 1h30m instead of 1h30m0s.
+// The months are written first, in years and months.
+func formatDuration(months int64, d time.Duration) string {
+	var b strings.Builder
+	if y := months / 12; y != 0 {
+		b.WriteString(strconv.FormatInt(y, 10))
+		b.WriteString("y")
+	}
+	if mo := months % 12; mo != 0 {
+		b.WriteString(strconv.FormatInt(mo, 10))
+		b.WriteString("mo")
+	}
+	if d == 0 {
+		if months == 0 {
+			return "0s"
+		}
+		return b.String()
+	}
+	// The magnitudes are negated to format the minimum duration.
+	if d < 0 {
+		b.WriteString("-")
+	} else {
+		d = -d
+	}
+	for _, u := range durationUnits {
+		if n := d / u.d; n != 0 {
+			b.WriteString(strconv.FormatInt(-int64(n), 10))
+			b.WriteString(u.unit)
+			d -= n * u.d
+		}
+	}
+	return b.String()
+}
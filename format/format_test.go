@@ -0,0 +1,241 @@
+package format_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/format"
+)
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "call",
+			raw:  `from( db: "telegraf" )`,
+			want: `from(db:"telegraf")
+`,
+		},
+		{
+			name: "single pipe",
+			raw:  `from(db:"telegraf")|>count()`,
+			want: `from(db:"telegraf") |> count()
+`,
+		},
+		{
+			name: "pipe chain",
+			raw:  `from(db:"telegraf") |> range(start:-1h) |> filter(fn: (r) => r._measurement == "cpu" AND r["host"] =~ /server\/a/) |> mean()`,
+			want: `from(db:"telegraf")
+    |> range(start:-1h)
+    |> filter(fn:(r) => r._measurement == "cpu" and r["host"] =~ /server\/a/)
+    |> mean()
+`,
+		},
+		{
+			name: "literals",
+			raw:  `x = [1, 2.50, "a \"quoted\" string", true, null, 90m, 1h1ns, 2018-01-01T00:00:00.5Z]`,
+			want: `x = [1, 2.5, "a \"quoted\" string", true, null, 1h30m, 1h1ns, 2018-01-01T00:00:00.5Z]
+`,
+		},
+		{
+			name: "calendar durations",
+			raw:  `x = [14mo, 1y, 1mo1d, 7d, 36h]`,
+			want: `x = [1y2mo, 1y, 1mo1d, 1w, 1d12h]
+`,
+		},
+		{
+			name: "precedence",
+			raw:  `x = (a + b) * c - (d - e) + -(f + 1) + not g and (h or i)`,
+			want: `x = (a + b) * c - (d - e) + -(f + 1) + not g and (h or i)
+`,
+		},
+		{
+			name: "conditional",
+			raw:  `from(db:"telegraf") |> map(fn: (r) => if r._value > 90 then "crit" else if exists r.level then r.level else "ok")`,
+			want: `from(db:"telegraf")
+    |> map(fn:(r) => if r._value > 90 then "crit" else if exists r.level then r.level else "ok")
+`,
+		},
+		{
+			name: "conditional operand",
+			raw:  `x = (if a then 1 else 2) + 1`,
+			want: `x = (if a then 1 else 2) + 1
+`,
+		},
+		{
+			name: "object body",
+			raw:  `from(db:"telegraf") |> map(fn: (r) => ({v:r._value * 2}))`,
+			want: `from(db:"telegraf") |> map(fn:(r) => ({v:r._value * 2}))
+`,
+		},
+		{
+			name: "aligned arguments",
+			raw:  `highestMax = (n, cols=["_value"], by=["_measurement"], table=<-) => _highestOrLowest(table:table, n:n, cols:cols, by:by, reducer: (t=<-) => max(table:t, column:cols[0]), _sortLimit: top)`,
+			want: `highestMax = (n, cols=["_value"], by=["_measurement"], table=<-) => _highestOrLowest(
+    table:      table,
+    n:          n,
+    cols:       cols,
+    by:         by,
+    reducer:    (t=<-) => max(table:t, column:cols[0]),
+    _sortLimit: top,
+)
+`,
+		},
+		{
+			name: "empty array default",
+			raw:  `f = (by=[]) => by`,
+			want: `f = (by=[]) => by
+`,
+		},
+		{
+			name: "block",
+			raw: `f = (r) => {
+return r._value+1}`,
+			want: `f = (r) => {
+    return r._value + 1
+}
+`,
+		},
+		{
+			name: "comments",
+			raw: `// The cpu usage
+cpu = from(db:"telegraf")   // all of it
+	|> filter(fn: (r) => r._measurement == "cpu") // only cpu
+	// the last hour
+	|> range(start:-1h)
+
+
+// The mean "//" usage
+cpu |> mean(
+	// comment in arguments
+)
+// at the end
+`,
+			want: `// The cpu usage
+cpu = from(db:"telegraf") // all of it
+    |> filter(fn:(r) => r._measurement == "cpu") // only cpu
+    // the last hour
+    |> range(start:-1h)
+
+// The mean "//" usage
+cpu |> mean(
+    // comment in arguments
+)
+// at the end
+`,
+		},
+		{
+			name: "trailing comment of a pipe chain",
+			raw:  "from(db:\"a\") |> range(start:-1h) // trailing\n",
+			want: `from(db:"a") |> range(start:-1h) // trailing
+`,
+		},
+		{
+			name: "trailing comment of a long pipe chain",
+			raw:  "from(db:\"a\") |> range(start:-1h) |> filter(fn: (r) => r._measurement == \"cpu\") |> mean() // trailing\n",
+			want: `from(db:"a")
+    |> range(start:-1h)
+    |> filter(fn:(r) => r._measurement == "cpu")
+    |> mean() // trailing
+`,
+		},
+		{
+			name: "comment in arguments",
+			raw: `from(db:"telegraf", // the database
+hosts:["a"])`,
+			want: `from(
+    db:    "telegraf", // the database
+    hosts: ["a"],
+)
+`,
+		},
+		{
+			name: "package and imports",
+			raw: `package mylib
+import "selectors"
+import h "testing/helpers"
+total = (table=<-) => table |> count()`,
+			want: `package mylib
+
+import "selectors"
+import h "testing/helpers"
+
+total = (table=<-) => table |> count()
+`,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := format.Source(tc.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Fatalf("unexpected source:\ngot:\n%s\nwant:\n%s", got, tc.want)
+			}
+			// Formatting is idempotent
+			again, err := format.Source(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again != got {
+				t.Errorf("formatting is not idempotent:\ngot:\n%s\nwant:\n%s", again, got)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		node ast.Node
+		want string
+	}{
+		{
+			name: "negative duration",
+			node: &ast.DurationLiteral{Value: -90 * time.Second},
+			want: `-1m30s`,
+		},
+		{
+			name: "float without fraction",
+			node: &ast.FloatLiteral{Value: 1e21},
+			want: `1000000000000000000000.0`,
+		},
+		{
+			name: "binary operand of unary expression",
+			node: &ast.UnaryExpression{
+				Operator: ast.NotOperator,
+				Argument: &ast.BinaryExpression{
+					Operator: ast.EqualOperator,
+					Left:     &ast.Identifier{Name: "a"},
+					Right:    &ast.IntegerLiteral{Value: 1},
+				},
+			},
+			want: `not (a == 1)`,
+		},
+		{
+			name: "function pipe argument",
+			node: &ast.PipeExpression{
+				Argument: &ast.ArrowFunctionExpression{
+					Params: []*ast.Property{{Key: &ast.Identifier{Name: "r"}}},
+					Body:   &ast.Identifier{Name: "r"},
+				},
+				Call: &ast.CallExpression{Callee: &ast.Identifier{Name: "f"}},
+			},
+			want: `((r) => r) |> f()`,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := format.Format(tc.node); got != tc.want {
+				t.Errorf("unexpected source: got %q want %q", got, tc.want)
+			}
+		})
+	}
+}
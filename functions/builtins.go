@@ -0,0 +1,173 @@
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/ifql/compiler"
+	"github.com/influxdata/ifql/interpreter"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+)
+
+// The builtins of the compiler are declared so that the functions of filter, map and the like can call them.
+// The members of a package, such as strings.toLower, are the properties of an object named after the package.
+func init() {
+	builtins := compiler.Builtins()
+	names := make([]string, 0, len(builtins))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgNames []string
+	pkgs := make(map[string]interpreter.Object)
+	pkgTypes := make(map[string]map[string]semantic.Type)
+	for _, name := range names {
+		f := builtinFunction{
+			name:    name,
+			builtin: builtins[name],
+		}
+		t := semantic.NewFunctionType(f.builtin.Signature)
+		i := strings.IndexByte(name, '.')
+		if i < 0 {
+			query.RegisterBuiltInValue(name, f, t)
+			continue
+		}
+		pkg, member := name[:i], name[i+1:]
+		if _, ok := pkgs[pkg]; !ok {
+			pkgNames = append(pkgNames, pkg)
+			pkgs[pkg] = interpreter.Object{Properties: make(map[string]interpreter.Value)}
+			pkgTypes[pkg] = make(map[string]semantic.Type)
+		}
+		pkgs[pkg].Properties[member] = f
+		pkgTypes[pkg][member] = t
+	}
+	for _, pkg := range pkgNames {
+		query.RegisterBuiltInValue(pkg, pkgs[pkg], semantic.NewObjectType(pkgTypes[pkg]))
+	}
+}
+
+// builtinFunction is a builtin of the compiler that can also be called outside of compiled functions.
+type builtinFunction struct {
+	name    string
+	builtin compiler.Builtin
+}
+
+func (f builtinFunction) Type() semantic.Type {
+	return semantic.Function
+}
+
+func (f builtinFunction) Value() interface{} {
+	return f
+}
+
+func (f builtinFunction) Property(name string) (interpreter.Value, error) {
+	return nil, fmt.Errorf("property %q does not exist", name)
+}
+
+// Resolve fails, builtins are called by their names from the functions that are resolved.
+func (f builtinFunction) Resolve() (*semantic.FunctionExpression, error) {
+	return nil, fmt.Errorf("function %q cannot be resolved", f.name)
+}
+
+func (f builtinFunction) Call(args interpreter.Arguments, d interpreter.Domain) (interpreter.Value, error) {
+	scope := make(compiler.Scope, len(f.builtin.Signature.Params))
+	for name, t := range f.builtin.Signature.Params {
+		if v, ok := f.builtin.Defaults[name]; ok {
+			if _, ok := args.Get(name); !ok {
+				scope[name] = v
+				continue
+			}
+		}
+		arg, err := args.GetRequired(name)
+		if err != nil {
+			return nil, err
+		}
+		if t != semantic.Invalid && arg.Type() != t {
+			return nil, fmt.Errorf("invalid argument %q: got %v want %v", name, arg.Type(), t)
+		}
+		v, err := toCompilerValue(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %q: %v", name, err)
+		}
+		scope[name] = v
+	}
+	v, err := f.builtin.Call(scope)
+	if err != nil {
+		return nil, err
+	}
+	return fromCompilerValue(v)
+}
+
+func toCompilerValue(v interpreter.Value) (compiler.Value, error) {
+	switch t := v.Type(); t.Kind() {
+	case semantic.Bool:
+		return compiler.NewBool(v.Value().(bool)), nil
+	case semantic.Int:
+		return compiler.NewInt(v.Value().(int64)), nil
+	case semantic.UInt:
+		return compiler.NewUInt(v.Value().(uint64)), nil
+	case semantic.Float:
+		return compiler.NewFloat(v.Value().(float64)), nil
+	case semantic.String:
+		return compiler.NewString(v.Value().(string)), nil
+	case semantic.Regexp:
+		return compiler.NewRegexp(v.Value().(*regexp.Regexp)), nil
+	case semantic.Time:
+		return compiler.NewTime(compiler.Time(v.Value().(time.Time).UnixNano())), nil
+	case semantic.Duration:
+		d := v.Value().(interpreter.Duration)
+		if d.IsCalendar() {
+			return nil, fmt.Errorf("calendar duration %v is not supported in row functions", d)
+		}
+		return compiler.NewDuration(compiler.Duration(d.Fixed)), nil
+	case semantic.Array:
+		arr := compiler.NewArray(t.ElementType())
+		for _, el := range v.Value().(interpreter.Array).Elements {
+			cv, err := toCompilerValue(el)
+			if err != nil {
+				return nil, err
+			}
+			arr.Append(cv)
+		}
+		return arr, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %v", t)
+	}
+}
+
+func fromCompilerValue(v compiler.Value) (interpreter.Value, error) {
+	switch t := v.Type(); t.Kind() {
+	case semantic.Bool:
+		return interpreter.NewBoolValue(v.Bool()), nil
+	case semantic.Int:
+		return interpreter.NewIntValue(v.Int()), nil
+	case semantic.UInt:
+		return interpreter.NewUIntValue(v.UInt()), nil
+	case semantic.Float:
+		return interpreter.NewFloatValue(v.Float()), nil
+	case semantic.String:
+		return interpreter.NewStringValue(v.Str()), nil
+	case semantic.Time:
+		return interpreter.NewTimeValue(time.Unix(0, int64(v.Time())).UTC()), nil
+	case semantic.Duration:
+		return interpreter.NewDurationValue(time.Duration(v.Duration())), nil
+	case semantic.Array:
+		arr := interpreter.NewArray(t.ElementType())
+		a := v.Array()
+		for i := 0; i < a.Len(); i++ {
+			el, err := fromCompilerValue(a.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			arr.Elements = append(arr.Elements, el)
+		}
+		return arr, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %v", t)
+	}
+}
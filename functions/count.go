@@ -35,6 +35,11 @@ func (s *CountOpSpec) Kind() query.OperationKind {
 	return CountKind
 }
 
+// DecompileArguments returns the arguments of the count call that creates the spec.
+func (s *CountOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return nil
+}
+
 type CountProcedureSpec struct {
 }
 
@@ -127,6 +132,9 @@ func (a *CountAgg) DoString(vs []string) {
 	a.count += int64(len(vs))
 }
 
+// NonNull makes the count of only null values zero.
+func (a *CountAgg) NonNull() {}
+
 func (a *CountAgg) Type() execute.DataType {
 	return execute.TInt
 }
@@ -31,6 +31,8 @@ func init() {
 
 // covarianceBuiltIn defines a `cov` function with an automatic join.
 var covarianceBuiltIn = `
+package stats
+
 cov = (x,y,on,pearsonr=false) =>
     join(
         tables:{x:x, y:y},
@@ -65,6 +67,15 @@ func (s *CovarianceOpSpec) Kind() query.OperationKind {
 	return CovarianceKind
 }
 
+// DecompileArguments returns the arguments of the covariance call that creates the spec.
+func (s *CovarianceOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.PearsonCorrelation {
+		args = append(args, query.DecompiledArgument{Key: "pearsonr", Value: true})
+	}
+	return args
+}
+
 type CovarianceProcedureSpec struct {
 	PearsonCorrelation bool
 }
@@ -0,0 +1,148 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+)
+
+const CumulativeSumKind = "cumulativeSum"
+
+type CumulativeSumOpSpec struct {
+}
+
+var cumulativeSumSignature = query.DefaultFunctionSignature()
+
+func init() {
+	query.RegisterFunction(CumulativeSumKind, createCumulativeSumOpSpec, cumulativeSumSignature)
+	query.RegisterOpSpec(CumulativeSumKind, newCumulativeSumOp)
+	plan.RegisterProcedureSpec(CumulativeSumKind, newCumulativeSumProcedure, CumulativeSumKind)
+	execute.RegisterTransformation(CumulativeSumKind, createCumulativeSumTransformation)
+}
+
+func createCumulativeSumOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	return new(CumulativeSumOpSpec), nil
+}
+
+func newCumulativeSumOp() query.OperationSpec {
+	return new(CumulativeSumOpSpec)
+}
+
+func (s *CumulativeSumOpSpec) Kind() query.OperationKind {
+	return CumulativeSumKind
+}
+
+// DecompileArguments returns the arguments of the cumulativeSum call that creates the spec.
+func (s *CumulativeSumOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return nil
+}
+
+type CumulativeSumProcedureSpec struct {
+}
+
+func newCumulativeSumProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	if _, ok := qs.(*CumulativeSumOpSpec); !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return new(CumulativeSumProcedureSpec), nil
+}
+
+func (s *CumulativeSumProcedureSpec) Kind() plan.ProcedureKind {
+	return CumulativeSumKind
+}
+func (s *CumulativeSumProcedureSpec) Copy() plan.ProcedureSpec {
+	return new(CumulativeSumProcedureSpec)
+}
+
+func createCumulativeSumTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*CumulativeSumProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewCumulativeSumTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type cumulativeSumTransformation struct {
+	d     execute.Dataset
+	cache execute.BlockBuilderCache
+}
+
+func NewCumulativeSumTransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *CumulativeSumProcedureSpec) *cumulativeSumTransformation {
+	return &cumulativeSumTransformation{
+		d:     d,
+		cache: cache,
+	}
+}
+
+func (t *cumulativeSumTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) error {
+	return t.d.RetractBlock(execute.ToBlockKey(meta))
+}
+
+// Process replaces the values of each numeric value column with the sum of the values up to the row.
+// The sums keep the type of the column, null values are left null and do not change the sum.
+func (t *cumulativeSumTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	builder, new := t.cache.BlockBuilder(b)
+	if new {
+		execute.AddBlockCols(b, builder)
+	}
+
+	cols := b.Cols()
+	sums := make([]cumulativeSum, len(cols))
+	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+		for i := range ts {
+			for j, c := range cols {
+				if c.Common {
+					continue
+				}
+				if rr.IsNull(i, j) {
+					builder.AppendNil(j)
+					continue
+				}
+				if !c.IsValue() {
+					execute.AppendValue(builder, j, execute.ValueForRow(i, j, rr))
+					continue
+				}
+				s := &sums[j]
+				switch c.Type {
+				case execute.TInt:
+					s.i += rr.AtInt(i, j)
+					builder.AppendInt(j, s.i)
+				case execute.TUInt:
+					s.u += rr.AtUInt(i, j)
+					builder.AppendUInt(j, s.u)
+				case execute.TFloat:
+					s.f += rr.AtFloat(i, j)
+					builder.AppendFloat(j, s.f)
+				default:
+					execute.AppendValue(builder, j, execute.ValueForRow(i, j, rr))
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func (t *cumulativeSumTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *cumulativeSumTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *cumulativeSumTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// cumulativeSum is the sum of a column of the type of the column.
+type cumulativeSum struct {
+	i int64
+	u uint64
+	f float64
+}
@@ -0,0 +1,70 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestCumulativeSumOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"cumulativeSum","kind":"cumulativeSum","spec":{}}`)
+	op := &query.Operation{
+		ID:   "cumulativeSum",
+		Spec: &functions.CumulativeSumOpSpec{},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestCumulativeSum_PassThrough(t *testing.T) {
+	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+		s := functions.NewCumulativeSumTransformation(
+			d,
+			c,
+			&functions.CumulativeSumProcedureSpec{},
+		)
+		return s
+	})
+}
+
+func TestCumulativeSum_Process(t *testing.T) {
+	cols := []execute.ColMeta{
+		{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: "i", Type: execute.TInt, Kind: execute.ValueColKind},
+		{Label: "u", Type: execute.TUInt, Kind: execute.ValueColKind},
+		{Label: "f", Type: execute.TFloat, Kind: execute.ValueColKind},
+		{Label: "s", Type: execute.TString, Kind: execute.ValueColKind},
+		{Label: "host", Type: execute.TString, Kind: execute.TagColKind},
+	}
+	data := []execute.Block{&executetest.Block{
+		Bnds:    execute.Bounds{Start: 0, Stop: 10},
+		ColMeta: cols,
+		Data: [][]interface{}{
+			{execute.Time(1), int64(1), uint64(1), 0.5, "a", "x"},
+			{execute.Time(2), int64(-2), uint64(2), nil, "b", "x"},
+			{execute.Time(3), nil, uint64(3), 1.5, nil, "y"},
+			{execute.Time(4), int64(4), uint64(4), 2.0, "d", nil},
+		},
+	}}
+	want := []*executetest.Block{{
+		Bnds:    execute.Bounds{Start: 0, Stop: 10},
+		ColMeta: cols,
+		Data: [][]interface{}{
+			{execute.Time(1), int64(1), uint64(1), 0.5, "a", "x"},
+			{execute.Time(2), int64(-1), uint64(3), nil, "b", "x"},
+			{execute.Time(3), nil, uint64(6), 2.0, nil, "y"},
+			{execute.Time(4), int64(3), uint64(10), 4.0, "d", nil},
+		},
+	}}
+	executetest.ProcessTestHelper(
+		t,
+		data,
+		want,
+		func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+			return functions.NewCumulativeSumTransformation(d, c, &functions.CumulativeSumProcedureSpec{})
+		},
+	)
+}
@@ -43,7 +43,7 @@ func createDerivativeOpSpec(args query.Arguments, a *query.Administration) (quer
 		spec.Unit = unit
 	} else {
 		//Default is 1s
-		spec.Unit = query.Duration(time.Second)
+		spec.Unit = query.Duration{Fixed: time.Second}
 	}
 
 	if nn, ok, err := args.GetBool("nonNegative"); err != nil {
@@ -63,6 +63,18 @@ func (s *DerivativeOpSpec) Kind() query.OperationKind {
 	return DerivativeKind
 }
 
+// DecompileArguments returns the arguments of the derivative call that creates the spec.
+func (s *DerivativeOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Unit != (query.Duration{Fixed: time.Second}) {
+		args = append(args, query.DecompiledArgument{Key: "unit", Value: s.Unit})
+	}
+	if s.NonNegative {
+		args = append(args, query.DecompiledArgument{Key: "nonNegative", Value: true})
+	}
+	return args
+}
+
 type DerivativeProcedureSpec struct {
 	Unit        query.Duration `json:"unit"`
 	NonNegative bool           `json:"non_negative"`
@@ -112,7 +124,7 @@ func NewDerivativeTransformation(d execute.Dataset, cache execute.BlockBuilderCa
 	return &derivativeTransformation{
 		d:           d,
 		cache:       cache,
-		unit:        time.Duration(spec.Unit),
+		unit:        spec.Unit.Fixed,
 		nonNegative: spec.NonNegative,
 	}
 }
@@ -151,6 +163,7 @@ func (t *derivativeTransformation) Process(id execute.DatasetID, b execute.Block
 		}
 	}
 
+	// Null values are skipped, the derivative of a row is null for a column that is null.
 	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
 		for i, t := range ts {
 			include := false
@@ -158,8 +171,11 @@ func (t *derivativeTransformation) Process(id execute.DatasetID, b execute.Block
 				if d == nil {
 					continue
 				}
-				var ok bool
 				j := d.col
+				if rr.IsNull(i, j) {
+					continue
+				}
+				var ok bool
 				switch cols[j].Type {
 				case execute.TInt:
 					ok = d.updateInt(t, rr.AtInt(i, j))
@@ -172,13 +188,14 @@ func (t *derivativeTransformation) Process(id execute.DatasetID, b execute.Block
 			}
 			if include {
 				for j, c := range cols {
-					switch c.Kind {
-					case execute.TimeColKind:
+					switch {
+					case c.Kind == execute.TimeColKind:
 						builder.AppendTime(j, rr.AtTime(i, j))
-					case execute.TagColKind:
+					case rr.IsNull(i, j):
+						builder.AppendNil(j)
+					case c.Kind == execute.TagColKind:
 						builder.AppendString(j, rr.AtString(i, j))
-					case execute.ValueColKind:
-						//TODO(nathanielc): Write null markers when we have support for null values.
+					case c.Kind == execute.ValueColKind:
 						builder.AppendFloat(j, derivatives[j].value())
 					}
 				}
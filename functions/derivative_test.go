@@ -17,7 +17,7 @@ func TestDerivativeOperation_Marshaling(t *testing.T) {
 	op := &query.Operation{
 		ID: "derivative",
 		Spec: &functions.DerivativeOpSpec{
-			Unit:        query.Duration(time.Minute),
+			Unit:        query.Duration{Fixed: time.Minute},
 			NonNegative: true,
 		},
 	}
@@ -45,7 +45,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "float",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -78,7 +78,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "float with units",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: query.Duration(time.Second),
+				Unit: query.Duration{Fixed: time.Second},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -111,7 +111,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "int",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -144,7 +144,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "int with units",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: query.Duration(time.Second),
+				Unit: query.Duration{Fixed: time.Second},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -177,7 +177,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "int non negative",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit:        1,
+				Unit:        query.Duration{Fixed: 1},
 				NonNegative: true,
 			},
 			data: []execute.Block{&executetest.Block{
@@ -212,7 +212,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "uint",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -245,7 +245,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "uint with negative result",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -278,7 +278,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "uint with non negative",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit:        1,
+				Unit:        query.Duration{Fixed: 1},
 				NonNegative: true,
 			},
 			data: []execute.Block{&executetest.Block{
@@ -313,7 +313,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "uint with units",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: query.Duration(time.Second),
+				Unit: query.Duration{Fixed: time.Second},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -346,7 +346,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "non negative one block",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit:        1,
+				Unit:        query.Duration{Fixed: 1},
 				NonNegative: true,
 			},
 			data: []execute.Block{&executetest.Block{
@@ -381,7 +381,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "non negative one block with empty result",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit:        1,
+				Unit:        query.Duration{Fixed: 1},
 				NonNegative: true,
 			},
 			data: []execute.Block{&executetest.Block{
@@ -412,7 +412,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "float with tags",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -447,7 +447,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "float with multiple values",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			data: []execute.Block{&executetest.Block{
 				Bnds: execute.Bounds{
@@ -482,7 +482,7 @@ func TestDerivative_Process(t *testing.T) {
 		{
 			name: "float non negative with multiple values",
 			spec: &functions.DerivativeProcedureSpec{
-				Unit:        1,
+				Unit:        query.Duration{Fixed: 1},
 				NonNegative: true,
 			},
 			data: []execute.Block{&executetest.Block{
@@ -516,6 +516,45 @@ func TestDerivative_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "float with nulls",
+			spec: &functions.DerivativeProcedureSpec{
+				Unit: query.Duration{Fixed: 1},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  5,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, 20.0},
+					{execute.Time(2), nil, 10.0},
+					{execute.Time(3), 4.0, nil},
+					{execute.Time(4), 6.0, 30.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  5,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), nil, -10.0},
+					{execute.Time(3), 1.0, nil},
+					{execute.Time(4), 2.0, 10.0},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
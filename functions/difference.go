@@ -56,6 +56,15 @@ func (s *DifferenceOpSpec) Kind() query.OperationKind {
 	return DifferenceKind
 }
 
+// DecompileArguments returns the arguments of the difference call that creates the spec.
+func (s *DifferenceOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.NonNegative {
+		args = append(args, query.DecompiledArgument{Key: "nonNegative", Value: true})
+	}
+	return args
+}
+
 type DifferenceProcedureSpec struct {
 	NonNegative bool `json:"non_negative"`
 }
@@ -148,6 +157,7 @@ func (t *differenceTransformation) Process(id execute.DatasetID, b execute.Block
 		}
 	}
 
+	// Null values are skipped, the difference of a row is null for a column that is null.
 	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
 		for i := range ts {
 			include := false
@@ -155,8 +165,11 @@ func (t *differenceTransformation) Process(id execute.DatasetID, b execute.Block
 				if d == nil {
 					continue
 				}
-				var ok bool
 				j := d.col
+				if rr.IsNull(i, j) {
+					continue
+				}
+				var ok bool
 				switch cols[j].Type {
 				case execute.TInt:
 					ok = d.updateInt(rr.AtInt(i, j))
@@ -169,13 +182,14 @@ func (t *differenceTransformation) Process(id execute.DatasetID, b execute.Block
 			}
 			if include {
 				for j, c := range builder.Cols() {
-					switch c.Kind {
-					case execute.TimeColKind:
+					switch {
+					case c.Kind == execute.TimeColKind:
 						builder.AppendTime(j, rr.AtTime(i, j))
-					case execute.TagColKind:
+					case rr.IsNull(i, j):
+						builder.AppendNil(j)
+					case c.Kind == execute.TagColKind:
 						builder.AppendString(j, rr.AtString(i, j))
-					case execute.ValueColKind:
-						//TODO(nathanielc): Write null markers when we have support for null values.
+					case c.Kind == execute.ValueColKind:
 						switch c.Type {
 						case execute.TInt:
 							builder.AppendInt(j, differences[j].valueInt())
@@ -52,6 +52,15 @@ func (s *DistinctOpSpec) Kind() query.OperationKind {
 	return DistinctKind
 }
 
+// DecompileArguments returns the arguments of the distinct call that creates the spec.
+func (s *DistinctOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Column != execute.DefaultValueColLabel {
+		args = append(args, query.DecompiledArgument{Key: "column", Value: s.Column})
+	}
+	return args
+}
+
 type DistinctProcedureSpec struct {
 	Column string
 }
@@ -124,6 +133,7 @@ func (t *distinctTransformation) Process(id execute.DatasetID, b execute.Block)
 		floatDistinct  map[float64]bool
 		stringDistinct map[string]bool
 		timeDistinct   map[execute.Time]bool
+		nullDistinct   bool
 	)
 	switch col.Type {
 	case execute.TBool:
@@ -143,50 +153,61 @@ func (t *distinctTransformation) Process(id execute.DatasetID, b execute.Block)
 	cols := builder.Cols()
 	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
 		for i := range ts {
-			// Check distinct
-			switch col.Type {
-			case execute.TBool:
-				v := rr.AtBool(i, colIdx)
-				if boolDistinct[v] {
-					continue
-				}
-				boolDistinct[v] = true
-			case execute.TInt:
-				v := rr.AtInt(i, colIdx)
-				if intDistinct[v] {
-					continue
-				}
-				intDistinct[v] = true
-			case execute.TUInt:
-				v := rr.AtUInt(i, colIdx)
-				if uintDistinct[v] {
-					continue
-				}
-				uintDistinct[v] = true
-			case execute.TFloat:
-				v := rr.AtFloat(i, colIdx)
-				if floatDistinct[v] {
-					continue
-				}
-				floatDistinct[v] = true
-			case execute.TString:
-				v := rr.AtString(i, colIdx)
-				if stringDistinct[v] {
+			// Check distinct, null is distinct from all other values
+			if rr.IsNull(i, colIdx) {
+				if nullDistinct {
 					continue
 				}
-				stringDistinct[v] = true
-			case execute.TTime:
-				v := rr.AtTime(i, colIdx)
-				if timeDistinct[v] {
-					continue
+				nullDistinct = true
+			} else {
+				switch col.Type {
+				case execute.TBool:
+					v := rr.AtBool(i, colIdx)
+					if boolDistinct[v] {
+						continue
+					}
+					boolDistinct[v] = true
+				case execute.TInt:
+					v := rr.AtInt(i, colIdx)
+					if intDistinct[v] {
+						continue
+					}
+					intDistinct[v] = true
+				case execute.TUInt:
+					v := rr.AtUInt(i, colIdx)
+					if uintDistinct[v] {
+						continue
+					}
+					uintDistinct[v] = true
+				case execute.TFloat:
+					v := rr.AtFloat(i, colIdx)
+					if floatDistinct[v] {
+						continue
+					}
+					floatDistinct[v] = true
+				case execute.TString:
+					v := rr.AtString(i, colIdx)
+					if stringDistinct[v] {
+						continue
+					}
+					stringDistinct[v] = true
+				case execute.TTime:
+					v := rr.AtTime(i, colIdx)
+					if timeDistinct[v] {
+						continue
+					}
+					timeDistinct[v] = true
 				}
-				timeDistinct[v] = true
 			}
 
 			for j, c := range cols {
 				if c.Common {
 					continue
 				}
+				if rr.IsNull(i, j) {
+					builder.AppendNil(j)
+					continue
+				}
 				switch c.Type {
 				case execute.TBool:
 					builder.AppendBool(j, rr.AtBool(i, j))
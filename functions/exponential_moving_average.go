@@ -0,0 +1,231 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const ExponentialMovingAverageKind = "exponentialMovingAverage"
+const DoubleEMAKind = "doubleEMA"
+
+type ExponentialMovingAverageOpSpec struct {
+	N int64 `json:"n"`
+}
+
+// DoubleEMAOpSpec is the spec of the double exponential moving average, 2 * EMA - EMA(EMA), which lags less than the EMA.
+type DoubleEMAOpSpec struct {
+	N int64 `json:"n"`
+}
+
+var exponentialMovingAverageSignature = query.DefaultFunctionSignature()
+
+func init() {
+	exponentialMovingAverageSignature.Params["n"] = semantic.Int
+
+	query.RegisterFunction(ExponentialMovingAverageKind, createExponentialMovingAverageOpSpec, exponentialMovingAverageSignature)
+	query.RegisterOpSpec(ExponentialMovingAverageKind, newExponentialMovingAverageOp)
+	plan.RegisterProcedureSpec(ExponentialMovingAverageKind, newExponentialMovingAverageProcedure, ExponentialMovingAverageKind)
+	execute.RegisterTransformation(ExponentialMovingAverageKind, createExponentialMovingAverageTransformation)
+
+	query.RegisterFunction(DoubleEMAKind, createDoubleEMAOpSpec, exponentialMovingAverageSignature)
+	query.RegisterOpSpec(DoubleEMAKind, newDoubleEMAOp)
+	plan.RegisterProcedureSpec(DoubleEMAKind, newDoubleEMAProcedure, DoubleEMAKind)
+	execute.RegisterTransformation(DoubleEMAKind, createDoubleEMATransformation)
+}
+
+func createExponentialMovingAverageOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	n, err := getMovingN(ExponentialMovingAverageKind, args)
+	if err != nil {
+		return nil, err
+	}
+	return &ExponentialMovingAverageOpSpec{
+		N: n,
+	}, nil
+}
+
+func newExponentialMovingAverageOp() query.OperationSpec {
+	return new(ExponentialMovingAverageOpSpec)
+}
+
+func (s *ExponentialMovingAverageOpSpec) Kind() query.OperationKind {
+	return ExponentialMovingAverageKind
+}
+
+// DecompileArguments returns the arguments of the exponentialMovingAverage call that creates the spec.
+func (s *ExponentialMovingAverageOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "n", Value: s.N}}
+}
+
+func createDoubleEMAOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	n, err := getMovingN(DoubleEMAKind, args)
+	if err != nil {
+		return nil, err
+	}
+	return &DoubleEMAOpSpec{
+		N: n,
+	}, nil
+}
+
+func newDoubleEMAOp() query.OperationSpec {
+	return new(DoubleEMAOpSpec)
+}
+
+func (s *DoubleEMAOpSpec) Kind() query.OperationKind {
+	return DoubleEMAKind
+}
+
+// DecompileArguments returns the arguments of the doubleEMA call that creates the spec.
+func (s *DoubleEMAOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "n", Value: s.N}}
+}
+
+type ExponentialMovingAverageProcedureSpec struct {
+	N int64 `json:"n"`
+}
+
+func newExponentialMovingAverageProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ExponentialMovingAverageOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &ExponentialMovingAverageProcedureSpec{
+		N: spec.N,
+	}, nil
+}
+
+func (s *ExponentialMovingAverageProcedureSpec) Kind() plan.ProcedureKind {
+	return ExponentialMovingAverageKind
+}
+func (s *ExponentialMovingAverageProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(ExponentialMovingAverageProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+type DoubleEMAProcedureSpec struct {
+	N int64 `json:"n"`
+}
+
+func newDoubleEMAProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*DoubleEMAOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &DoubleEMAProcedureSpec{
+		N: spec.N,
+	}, nil
+}
+
+func (s *DoubleEMAProcedureSpec) Kind() plan.ProcedureKind {
+	return DoubleEMAKind
+}
+func (s *DoubleEMAProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(DoubleEMAProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createExponentialMovingAverageTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ExponentialMovingAverageProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewExponentialMovingAverageTransformation(d, cache, s)
+	return t, d, nil
+}
+
+func NewExponentialMovingAverageTransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *ExponentialMovingAverageProcedureSpec) execute.Transformation {
+	n := spec.N
+	return newMovingTransformation(d, cache, func() movingFunc {
+		return newExponentialMovingAverage(n)
+	})
+}
+
+func createDoubleEMATransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*DoubleEMAProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewDoubleEMATransformation(d, cache, s)
+	return t, d, nil
+}
+
+func NewDoubleEMATransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *DoubleEMAProcedureSpec) execute.Transformation {
+	n := spec.N
+	return newMovingTransformation(d, cache, func() movingFunc {
+		return &doubleEMA{
+			ema:    newExponentialMovingAverage(n),
+			emaEMA: newExponentialMovingAverage(n),
+		}
+	})
+}
+
+// exponentialMovingAverage weighs each value by alpha = 2 / (n + 1) and the previous average by 1 - alpha.
+// The first average is the mean of the first n values.
+type exponentialMovingAverage struct {
+	n     int64
+	alpha float64
+
+	count int64
+	ema   float64
+}
+
+func newExponentialMovingAverage(n int64) *exponentialMovingAverage {
+	return &exponentialMovingAverage{
+		n:     n,
+		alpha: 2 / float64(n+1),
+	}
+}
+
+func (a *exponentialMovingAverage) update(v float64) bool {
+	if a.count < a.n {
+		a.count++
+		a.ema += v
+		if a.count < a.n {
+			return false
+		}
+		a.ema /= float64(a.n)
+		return true
+	}
+	a.ema = a.alpha*v + (1-a.alpha)*a.ema
+	return true
+}
+
+func (a *exponentialMovingAverage) value() float64 {
+	return a.ema
+}
+
+// doubleEMA is 2 * EMA - EMA(EMA), it has a value from the 2n - 1 value on.
+type doubleEMA struct {
+	ema    *exponentialMovingAverage
+	emaEMA *exponentialMovingAverage
+}
+
+func (a *doubleEMA) update(v float64) bool {
+	if !a.ema.update(v) {
+		return false
+	}
+	return a.emaEMA.update(a.ema.value())
+}
+
+func (a *doubleEMA) value() float64 {
+	return 2*a.ema.value() - a.emaEMA.value()
+}
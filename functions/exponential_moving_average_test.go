@@ -0,0 +1,210 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestExponentialMovingAverage_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "exponential moving average",
+			Raw:  `from(db:"mydb") |> exponentialMovingAverage(n:5)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "exponentialMovingAverage1",
+						Spec: &functions.ExponentialMovingAverageOpSpec{
+							N: 5,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "exponentialMovingAverage1"},
+				},
+			},
+		},
+		{
+			Name: "double exponential moving average",
+			Raw:  `from(db:"mydb") |> doubleEMA(n:5)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "doubleEMA1",
+						Spec: &functions.DoubleEMAOpSpec{
+							N: 5,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "doubleEMA1"},
+				},
+			},
+		},
+		{
+			Name:    "negative n",
+			Raw:     `from(db:"mydb") |> exponentialMovingAverage(n:-1)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestExponentialMovingAverageOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"exponentialMovingAverage","kind":"exponentialMovingAverage","spec":{"n":3}}`)
+	op := &query.Operation{
+		ID: "exponentialMovingAverage",
+		Spec: &functions.ExponentialMovingAverageOpSpec{
+			N: 3,
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestDoubleEMAOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"doubleEMA","kind":"doubleEMA","spec":{"n":3}}`)
+	op := &query.Operation{
+		ID: "doubleEMA",
+		Spec: &functions.DoubleEMAOpSpec{
+			N: 3,
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestExponentialMovingAverage_Process(t *testing.T) {
+	cols := []execute.ColMeta{
+		{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+	}
+	testCases := []struct {
+		name string
+		spec *functions.ExponentialMovingAverageProcedureSpec
+		data []execute.Block
+		want []*executetest.Block
+	}{
+		{
+			name: "float",
+			// n is 3 so alpha is 0.5, and the first average is the mean of the first 3 values.
+			spec: &functions.ExponentialMovingAverageProcedureSpec{N: 3},
+			data: []execute.Block{&executetest.Block{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 3.0},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), nil},
+					{execute.Time(6), 8.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(3), 2.0},
+					{execute.Time(4), 3.0},
+					{execute.Time(6), 5.5},
+				},
+			}},
+		},
+		{
+			name: "int",
+			spec: &functions.ExponentialMovingAverageProcedureSpec{N: 1},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), int64(2)},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					return functions.NewExponentialMovingAverageTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+func TestDoubleEMA_Process(t *testing.T) {
+	cols := []execute.ColMeta{
+		{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+	}
+	data := []execute.Block{&executetest.Block{
+		Bnds:    execute.Bounds{Start: 0, Stop: 10},
+		ColMeta: cols,
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0},
+			{execute.Time(2), 2.0},
+			{execute.Time(3), 3.0},
+			{execute.Time(4), 4.0},
+			{execute.Time(5), 5.0},
+			{execute.Time(6), 6.0},
+			{execute.Time(7), 7.0},
+		},
+	}}
+	// The EMA of a line lags behind it, the double EMA does not.
+	want := []*executetest.Block{{
+		Bnds:    execute.Bounds{Start: 0, Stop: 10},
+		ColMeta: cols,
+		Data: [][]interface{}{
+			{execute.Time(5), 5.0},
+			{execute.Time(6), 6.0},
+			{execute.Time(7), 7.0},
+		},
+	}}
+	executetest.ProcessTestHelper(
+		t,
+		data,
+		want,
+		func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+			return functions.NewDoubleEMATransformation(d, c, &functions.DoubleEMAProcedureSpec{N: 3})
+		},
+	)
+}
@@ -0,0 +1,385 @@
+package functions
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/influxdata/ifql/compiler"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const FillKind = "fill"
+
+// FillMethodLinear interpolates null values linearly in time between the values around them.
+const FillMethodLinear = "linear"
+
+type FillOpSpec struct {
+	Column string `json:"column"`
+	// Value is the bool, int64, uint64, float64 or string that replaces null values.
+	Value interface{} `json:"value"`
+	// UsePrevious replaces null values with the previous value of the column.
+	UsePrevious bool `json:"usePrevious"`
+	// Method is the method that computes the values that replace null values.
+	Method string `json:"method"`
+}
+
+var fillSignature = query.DefaultFunctionSignature()
+
+func init() {
+	fillSignature.Params["column"] = semantic.String
+	// The value can be of any type.
+	fillSignature.Params["value"] = semantic.Invalid
+	fillSignature.Params["usePrevious"] = semantic.Bool
+	fillSignature.Params["method"] = semantic.String
+
+	query.RegisterFunction(FillKind, createFillOpSpec, fillSignature)
+	query.RegisterOpSpec(FillKind, newFillOp)
+	plan.RegisterProcedureSpec(FillKind, newFillProcedure, FillKind)
+	execute.RegisterTransformation(FillKind, createFillTransformation)
+}
+
+func createFillOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &FillOpSpec{
+		Column: execute.DefaultValueColLabel,
+	}
+	if col, ok, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Column = col
+	}
+
+	n := 0
+	if v, ok := args.Get("value"); ok {
+		switch v.Type().Kind() {
+		case semantic.Bool, semantic.Int, semantic.UInt, semantic.Float, semantic.String:
+			spec.Value = v.Value()
+		default:
+			return nil, fmt.Errorf("fill value must be a bool, int, uint, float or string, got %v", v.Type().Kind())
+		}
+		n++
+	}
+	if usePrevious, ok, err := args.GetBool("usePrevious"); err != nil {
+		return nil, err
+	} else if ok && usePrevious {
+		spec.UsePrevious = true
+		n++
+	}
+	if method, ok, err := args.GetString("method"); err != nil {
+		return nil, err
+	} else if ok {
+		if method != FillMethodLinear {
+			return nil, fmt.Errorf("unknown fill method %q", method)
+		}
+		spec.Method = method
+		n++
+	}
+	if n != 1 {
+		return nil, errors.New(`fill requires exactly one of "value", "usePrevious" or "method"`)
+	}
+	return spec, nil
+}
+
+func newFillOp() query.OperationSpec {
+	return new(FillOpSpec)
+}
+
+func (s *FillOpSpec) Kind() query.OperationKind {
+	return FillKind
+}
+
+// UnmarshalJSON decodes the spec, whole numbers are decoded as integers so that an integer value keeps its type.
+func (s *FillOpSpec) UnmarshalJSON(data []byte) error {
+	type fillOpSpec FillOpSpec
+	var spec fillOpSpec
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&spec); err != nil {
+		return err
+	}
+	if n, ok := spec.Value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			spec.Value = i
+		} else if f, err := n.Float64(); err == nil {
+			spec.Value = f
+		} else {
+			return err
+		}
+	}
+	*s = FillOpSpec(spec)
+	return nil
+}
+
+// DecompileArguments returns the arguments of the fill call that creates the spec.
+func (s *FillOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Column != execute.DefaultValueColLabel {
+		args = append(args, query.DecompiledArgument{Key: "column", Value: s.Column})
+	}
+	switch {
+	case s.UsePrevious:
+		args = append(args, query.DecompiledArgument{Key: "usePrevious", Value: true})
+	case s.Method != "":
+		args = append(args, query.DecompiledArgument{Key: "method", Value: s.Method})
+	case s.Value != nil:
+		if u, ok := s.Value.(uint64); ok {
+			// There are no uint literals, the closest is the int value.
+			args = append(args, query.DecompiledArgument{Key: "value", Value: int64(u)})
+		} else {
+			args = append(args, query.DecompiledArgument{Key: "value", Value: s.Value})
+		}
+	}
+	return args
+}
+
+type FillProcedureSpec struct {
+	Column      string
+	Value       interface{}
+	UsePrevious bool
+	Method      string
+}
+
+func newFillProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FillOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &FillProcedureSpec{
+		Column:      spec.Column,
+		Value:       spec.Value,
+		UsePrevious: spec.UsePrevious,
+		Method:      spec.Method,
+	}, nil
+}
+
+func (s *FillProcedureSpec) Kind() plan.ProcedureKind {
+	return FillKind
+}
+func (s *FillProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(FillProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createFillTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*FillProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewFillTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type fillTransformation struct {
+	d     execute.Dataset
+	cache execute.BlockBuilderCache
+
+	column      string
+	value       interface{}
+	usePrevious bool
+	method      string
+}
+
+func NewFillTransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *FillProcedureSpec) *fillTransformation {
+	return &fillTransformation{
+		d:           d,
+		cache:       cache,
+		column:      spec.Column,
+		value:       spec.Value,
+		usePrevious: spec.UsePrevious,
+		method:      spec.Method,
+	}
+}
+
+func (t *fillTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) error {
+	return t.d.RetractBlock(execute.ToBlockKey(meta))
+}
+
+func (t *fillTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	cols := b.Cols()
+	fillIdx := execute.ColIdx(t.column, cols)
+	if fillIdx < 0 {
+		return fmt.Errorf("fill column %q not found", t.column)
+	}
+	fillCol := cols[fillIdx]
+	if fillCol.Common {
+		// A common column has no null values.
+		return fmt.Errorf("fill column %q is common to all rows", t.column)
+	}
+
+	var value compiler.Value
+	switch {
+	case t.method == FillMethodLinear:
+		switch fillCol.Type {
+		case execute.TInt, execute.TUInt, execute.TFloat:
+		default:
+			return fmt.Errorf("linear fill requires a numeric column, column %q is %v", t.column, fillCol.Type)
+		}
+	case !t.usePrevious:
+		var err error
+		value, err = fillValue(t.value, fillCol.Type)
+		if err != nil {
+			return fmt.Errorf("cannot fill column %q: %v", t.column, err)
+		}
+	}
+
+	builder, new := t.cache.BlockBuilder(b)
+	if new {
+		execute.AddBlockCols(b, builder)
+	}
+
+	// The rows of a block can arrive in several calls, so the previous value is kept across them.
+	var prev compiler.Value
+	linear := &linearFill{j: fillIdx, typ: fillCol.Type}
+	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+		for i := range ts {
+			row := builder.NRows()
+			for j, c := range cols {
+				if c.Common {
+					continue
+				}
+				if !rr.IsNull(i, j) {
+					execute.AppendValue(builder, j, execute.ValueForRow(i, j, rr))
+					continue
+				}
+				switch {
+				case j != fillIdx:
+					builder.AppendNil(j)
+				case t.method == FillMethodLinear:
+					builder.AppendNil(j)
+					linear.null(row, ts[i])
+				case t.usePrevious:
+					execute.AppendValue(builder, j, prev)
+				default:
+					execute.AppendValue(builder, j, value)
+				}
+			}
+			if rr.IsNull(i, fillIdx) {
+				continue
+			}
+			switch {
+			case t.method == FillMethodLinear:
+				linear.value(builder, ts[i], numericValue(i, fillIdx, rr))
+			case t.usePrevious:
+				prev = execute.ValueForRow(i, fillIdx, rr)
+			}
+		}
+	})
+	return nil
+}
+
+// fillValue converts the fill value to a value of the column type, an integer fills a float column.
+func fillValue(v interface{}, typ execute.DataType) (compiler.Value, error) {
+	switch v := v.(type) {
+	case bool:
+		if typ == execute.TBool {
+			return compiler.NewBool(v), nil
+		}
+	case int64:
+		switch typ {
+		case execute.TInt:
+			return compiler.NewInt(v), nil
+		case execute.TUInt:
+			if v >= 0 {
+				return compiler.NewUInt(uint64(v)), nil
+			}
+		case execute.TFloat:
+			return compiler.NewFloat(float64(v)), nil
+		}
+	case uint64:
+		if typ == execute.TUInt {
+			return compiler.NewUInt(v), nil
+		}
+	case float64:
+		if typ == execute.TFloat {
+			return compiler.NewFloat(v), nil
+		}
+	case string:
+		if typ == execute.TString {
+			return compiler.NewString(v), nil
+		}
+	}
+	return nil, fmt.Errorf("value %v does not have the column type %v", v, typ)
+}
+
+// linearFill interpolates the null values of the numeric column j by time between the values before and after them.
+// The rows are expected to be sorted by time. Null values before the first or after the last value stay null.
+type linearFill struct {
+	j   int
+	typ execute.DataType
+
+	// ok reports whether a value has been seen, t and v are the time and value of the last one.
+	ok bool
+	t  execute.Time
+	v  float64
+
+	// rows and times are the rows of the null values after the last value.
+	rows  []int
+	times []execute.Time
+}
+
+func (f *linearFill) null(row int, t execute.Time) {
+	if !f.ok {
+		return
+	}
+	f.rows = append(f.rows, row)
+	f.times = append(f.times, t)
+}
+
+func (f *linearFill) value(builder execute.BlockBuilder, t execute.Time, v float64) {
+	for k, row := range f.rows {
+		iv := f.v
+		if t != f.t {
+			iv += (v - f.v) * float64(f.times[k]-f.t) / float64(t-f.t)
+		}
+		switch f.typ {
+		case execute.TInt:
+			builder.SetInt(row, f.j, int64(math.Round(iv)))
+		case execute.TUInt:
+			builder.SetUInt(row, f.j, uint64(math.Round(iv)))
+		case execute.TFloat:
+			builder.SetFloat(row, f.j, iv)
+		}
+	}
+	f.rows = f.rows[:0]
+	f.times = f.times[:0]
+	f.ok = true
+	f.t = t
+	f.v = v
+}
+
+func numericValue(i, j int, rr execute.RowReader) float64 {
+	switch typ := rr.Cols()[j].Type; typ {
+	case execute.TInt:
+		return float64(rr.AtInt(i, j))
+	case execute.TUInt:
+		return float64(rr.AtUInt(i, j))
+	case execute.TFloat:
+		return rr.AtFloat(i, j)
+	default:
+		execute.PanicUnknownType(typ)
+		return 0
+	}
+}
+
+func (t *fillTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *fillTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *fillTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
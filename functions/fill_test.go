@@ -0,0 +1,295 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestFill_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "value",
+			Raw:  `from(db:"mydb") |> fill(value:0.0)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "fill1",
+						Spec: &functions.FillOpSpec{
+							Column: "_value",
+							Value:  0.0,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "fill1"},
+				},
+			},
+		},
+		{
+			Name: "previous value of column",
+			Raw:  `from(db:"mydb") |> fill(column:"host", usePrevious:true)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "fill1",
+						Spec: &functions.FillOpSpec{
+							Column:      "host",
+							UsePrevious: true,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "fill1"},
+				},
+			},
+		},
+		{
+			Name: "linear",
+			Raw:  `from(db:"mydb") |> fill(method:"linear")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "fill1",
+						Spec: &functions.FillOpSpec{
+							Column: "_value",
+							Method: "linear",
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "fill1"},
+				},
+			},
+		},
+		{
+			Name:    "no fill",
+			Raw:     `from(db:"mydb") |> fill()`,
+			WantErr: true,
+		},
+		{
+			Name:    "value and previous value",
+			Raw:     `from(db:"mydb") |> fill(value:0, usePrevious:true)`,
+			WantErr: true,
+		},
+		{
+			Name:    "unknown method",
+			Raw:     `from(db:"mydb") |> fill(method:"cubic")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestFillOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"fill","kind":"fill","spec":{"column":"_value","value":1}}`)
+	op := &query.Operation{
+		ID: "fill",
+		Spec: &functions.FillOpSpec{
+			Column: "_value",
+			Value:  int64(1),
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestFill_Process(t *testing.T) {
+	cols := []execute.ColMeta{
+		{Label: execute.TimeColLabel, Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: execute.DefaultValueColLabel, Type: execute.TFloat, Kind: execute.ValueColKind},
+		{Label: "host", Type: execute.TString, Kind: execute.TagColKind},
+	}
+	data := func() []execute.Block {
+		return []execute.Block{&executetest.Block{
+			Bnds:    execute.Bounds{Start: 0, Stop: 10},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(1), nil, "a"},
+				{execute.Time(2), 2.0, nil},
+				{execute.Time(3), nil, "b"},
+				{execute.Time(4), nil, "b"},
+				{execute.Time(5), 5.0, "b"},
+				{execute.Time(6), nil, nil},
+			},
+		}}
+	}
+	testCases := []struct {
+		name string
+		spec *functions.FillProcedureSpec
+		data []execute.Block
+		want []*executetest.Block
+	}{
+		{
+			name: "value",
+			spec: &functions.FillProcedureSpec{
+				Column: "_value",
+				Value:  0.0,
+			},
+			data: data(),
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), 0.0, "a"},
+					{execute.Time(2), 2.0, nil},
+					{execute.Time(3), 0.0, "b"},
+					{execute.Time(4), 0.0, "b"},
+					{execute.Time(5), 5.0, "b"},
+					{execute.Time(6), 0.0, nil},
+				},
+			}},
+		},
+		{
+			name: "int value of float column",
+			spec: &functions.FillProcedureSpec{
+				Column: "_value",
+				Value:  int64(-1),
+			},
+			data: data(),
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), -1.0, "a"},
+					{execute.Time(2), 2.0, nil},
+					{execute.Time(3), -1.0, "b"},
+					{execute.Time(4), -1.0, "b"},
+					{execute.Time(5), 5.0, "b"},
+					{execute.Time(6), -1.0, nil},
+				},
+			}},
+		},
+		{
+			name: "previous value",
+			spec: &functions.FillProcedureSpec{
+				Column:      "_value",
+				UsePrevious: true,
+			},
+			data: data(),
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), nil, "a"},
+					{execute.Time(2), 2.0, nil},
+					{execute.Time(3), 2.0, "b"},
+					{execute.Time(4), 2.0, "b"},
+					{execute.Time(5), 5.0, "b"},
+					{execute.Time(6), 5.0, nil},
+				},
+			}},
+		},
+		{
+			name: "previous value of tag",
+			spec: &functions.FillProcedureSpec{
+				Column:      "host",
+				UsePrevious: true,
+			},
+			data: data(),
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), nil, "a"},
+					{execute.Time(2), 2.0, "a"},
+					{execute.Time(3), nil, "b"},
+					{execute.Time(4), nil, "b"},
+					{execute.Time(5), 5.0, "b"},
+					{execute.Time(6), nil, "b"},
+				},
+			}},
+		},
+		{
+			name: "linear",
+			spec: &functions.FillProcedureSpec{
+				Column: "_value",
+				Method: functions.FillMethodLinear,
+			},
+			data: data(),
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), nil, "a"},
+					{execute.Time(2), 2.0, nil},
+					{execute.Time(3), 3.0, "b"},
+					{execute.Time(4), 4.0, "b"},
+					{execute.Time(5), 5.0, "b"},
+					{execute.Time(6), nil, nil},
+				},
+			}},
+		},
+		{
+			name: "linear int",
+			spec: &functions.FillProcedureSpec{
+				Column: "_value",
+				Method: functions.FillMethodLinear,
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: execute.TimeColLabel, Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: execute.DefaultValueColLabel, Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), nil},
+					{execute.Time(4), int64(4)},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: execute.TimeColLabel, Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: execute.DefaultValueColLabel, Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), int64(2)},
+					{execute.Time(4), int64(4)},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					return functions.NewFillTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
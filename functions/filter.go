@@ -2,7 +2,6 @@ package functions
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/query"
@@ -21,7 +20,7 @@ var filterSignature = query.DefaultFunctionSignature()
 
 func init() {
 	//TODO(nathanielc): Use complete function signature here, or formalize soft kind validation instead of complete function validation.
-	filterSignature.Params["fn"] = semantic.Function
+	filterSignature.Params["fn"] = execute.RowPredicateType
 
 	query.RegisterFunction(FilterKind, createFilterOpSpec, filterSignature)
 	query.RegisterOpSpec(FilterKind, newFilterOp)
@@ -55,6 +54,11 @@ func (s *FilterOpSpec) Kind() query.OperationKind {
 	return FilterKind
 }
 
+// DecompileArguments returns the arguments of the filter call that creates the spec.
+func (s *FilterOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "fn", Value: s.Fn}}
+}
+
 type FilterProcedureSpec struct {
 	Fn *semantic.FunctionExpression
 }
@@ -95,6 +99,11 @@ func (s *FilterProcedureSpec) PushDownRules() []plan.PushDownRule {
 						return false
 					}
 				}
+				// Only predicates the storage can evaluate are pushed down,
+				// others such as hour(t:r._time) >= 9 are evaluated by the filter.
+				if _, err := execute.ToStoragePredicate(s.Fn); err != nil {
+					return false
+				}
 				return true
 			},
 		},
@@ -234,11 +243,15 @@ func (t *filterTransformation) Process(id execute.DatasetID, b execute.Block) er
 	}
 
 	// Append only matching rows to block
+	var err error
 	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
 		for i := range ts {
-			if pass, err := t.fn.Eval(i, rr); err != nil {
-				log.Printf("failed to evaluate filter expression: %v", err)
-				continue
+			if err != nil {
+				return
+			}
+			var pass bool
+			if pass, err = t.fn.Eval(i, rr); err != nil {
+				return
 			} else if !pass {
 				// No match, skipping
 				continue
@@ -247,6 +260,10 @@ func (t *filterTransformation) Process(id execute.DatasetID, b execute.Block) er
 				if c.Common {
 					continue
 				}
+				if rr.IsNull(i, j) {
+					builder.AppendNil(j)
+					continue
+				}
 				switch c.Type {
 				case execute.TBool:
 					builder.AppendBool(j, rr.AtBool(i, j))
@@ -266,6 +283,9 @@ func (t *filterTransformation) Process(id execute.DatasetID, b execute.Block) er
 			}
 		}
 	})
+	if err != nil {
+		return fmt.Errorf("failed to evaluate filter expression: %v", err)
+	}
 	return nil
 }
 
@@ -172,7 +172,7 @@ func TestFilter_NewQuery(t *testing.T) {
 						|> filter(fn: (r) =>
 							(r["t1"] =="val1")
 							and
-							(r["_field"] == 10)
+							(r["_value"] == 10)
 						)
 						|> range(start:-4h, stop:-2h)
 						|> count()`,
@@ -203,7 +203,7 @@ func TestFilter_NewQuery(t *testing.T) {
 										Operator: ast.EqualOperator,
 										Left: &semantic.MemberExpression{
 											Object:   &semantic.IdentifierExpression{Name: "r"},
-											Property: "_field",
+											Property: "_value",
 										},
 										Right: &semantic.IntegerLiteral{Value: 10},
 									},
@@ -242,7 +242,7 @@ func TestFilter_NewQuery(t *testing.T) {
 						|> filter(fn: (r) =>
 							r["t1"]=="val1"
 							and
-							r["_field"] == 10
+							r["_value"] == 10
 						)
 						|> range(start:-4h, stop:-2h)
 						|> count()`,
@@ -273,7 +273,7 @@ func TestFilter_NewQuery(t *testing.T) {
 										Operator: ast.EqualOperator,
 										Left: &semantic.MemberExpression{
 											Object:   &semantic.IdentifierExpression{Name: "r"},
-											Property: "_field",
+											Property: "_value",
 										},
 										Right: &semantic.IntegerLiteral{Value: 10},
 									},
@@ -312,7 +312,7 @@ func TestFilter_NewQuery(t *testing.T) {
 						|> filter(fn: (r) =>
 							r["t1"]==/val1/
 							and
-							r["_field"] == 10.5
+							r["_value"] == 10.5
 						)
 						|> range(start:-4h, stop:-2h)
 						|> count()`,
@@ -343,7 +343,7 @@ func TestFilter_NewQuery(t *testing.T) {
 										Operator: ast.EqualOperator,
 										Left: &semantic.MemberExpression{
 											Object:   &semantic.IdentifierExpression{Name: "r"},
-											Property: "_field",
+											Property: "_value",
 										},
 										Right: &semantic.FloatLiteral{Value: 10.5},
 									},
@@ -461,6 +461,71 @@ func TestFilter_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "from with field compared to a number",
+			Raw: `from(db:"mydb")
+						|> filter(fn: (r) => r["_field"] == 10)`,
+			WantErr: true,
+		},
+		{
+			Name: "from with typo in column",
+			Raw: `from(db:"mydb")
+						|> filter(fn: (r) => r._valu + 1 > 0)`,
+			WantErr: true,
+		},
+		{
+			Name: "from with columns created by map",
+			Raw: `from(db:"mydb")
+						|> map(fn: (r) => ({_foo: r._value}))
+						|> filter(fn: (r) => r._foo > 1)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "map1",
+						Spec: &functions.MapOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{{
+										Key: &semantic.Identifier{Name: "_foo"},
+										Value: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "r"},
+											Property: "_value",
+										},
+									}},
+								},
+							},
+						},
+					},
+					{
+						ID: "filter2",
+						Spec: &functions.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.GreaterThanOperator,
+									Left: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "_foo",
+									},
+									Right: &semantic.IntegerLiteral{Value: 1},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "map1"},
+					{Parent: "map1", Child: "filter2"},
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -720,6 +785,286 @@ func TestFilter_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "strings.hasPrefix(v: r.host, prefix: \"server\")",
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.CallExpression{
+						Callee: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "strings"},
+							Property: "hasPrefix",
+						},
+						Arguments: &semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								{
+									Key: &semantic.Identifier{Name: "v"},
+									Value: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "host",
+									},
+								},
+								{
+									Key:   &semantic.Identifier{Name: "prefix"},
+									Value: &semantic.StringLiteral{Value: "server"},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "server01"},
+					{execute.Time(2), 6.0, "desktop01"},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "server01"},
+				},
+			}},
+		},
+		{
+			name: "exists r.host and r.host == \"server01\"",
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.LogicalExpression{
+						Operator: ast.AndOperator,
+						Left: &semantic.UnaryExpression{
+							Operator: ast.ExistsOperator,
+							Argument: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "host",
+							},
+						},
+						Right: &semantic.BinaryExpression{
+							Operator: ast.EqualOperator,
+							Left: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "host",
+							},
+							Right: &semantic.StringLiteral{Value: "server01"},
+						},
+					},
+				},
+			},
+			data: []execute.Block{
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  3,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "server01"},
+						{execute.Time(2), 6.0, "desktop01"},
+					},
+				},
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 3,
+						Stop:  5,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 2.0},
+						{execute.Time(4), 7.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  3,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "server01"},
+					},
+				},
+				{
+					Bnds: execute.Bounds{
+						Start: 3,
+						Stop:  5,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+				},
+			},
+		},
+		{
+			name: "r._value != null and r._value > 1.0",
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.LogicalExpression{
+						Operator: ast.AndOperator,
+						Left: &semantic.BinaryExpression{
+							Operator: ast.NotEqualOperator,
+							Left: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_value",
+							},
+							Right: &semantic.NullLiteral{},
+						},
+						Right: &semantic.BinaryExpression{
+							Operator: ast.GreaterThanOperator,
+							Left: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_value",
+							},
+							Right: &semantic.FloatLiteral{Value: 1},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  4,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil, "server01"},
+					{execute.Time(2), 6.0, nil},
+					{execute.Time(3), 0.5, "server02"},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  4,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 6.0, nil},
+				},
+			}},
+		},
+		{
+			name: "hour(t:r._time) >= 9",
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanEqualOperator,
+						Left:     hourCall("UTC"),
+						Right:    &semantic.IntegerLiteral{Value: 9},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  execute.Time(24 * time.Hour),
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(8 * time.Hour), 1.0},
+					{execute.Time(9 * time.Hour), 2.0},
+					{execute.Time(15 * time.Hour), 3.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  execute.Time(24 * time.Hour),
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(9 * time.Hour), 2.0},
+					{execute.Time(15 * time.Hour), 3.0},
+				},
+			}},
+		},
+		{
+			name: "hour in location",
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanEqualOperator,
+						Left:     hourCall("America/New_York"),
+						Right:    &semantic.IntegerLiteral{Value: 9},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  execute.Time(24 * time.Hour),
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(9 * time.Hour), 2.0},
+					{execute.Time(15 * time.Hour), 3.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  execute.Time(24 * time.Hour),
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(15 * time.Hour), 3.0},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -740,6 +1085,51 @@ func TestFilter_Process(t *testing.T) {
 	}
 }
 
+// hourCall returns the call hour(t: r._time, location: location).
+func hourCall(location string) *semantic.CallExpression {
+	return &semantic.CallExpression{
+		Callee: &semantic.IdentifierExpression{Name: "hour"},
+		Arguments: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{
+				{
+					Key: &semantic.Identifier{Name: "t"},
+					Value: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_time",
+					},
+				},
+				{
+					Key:   &semantic.Identifier{Name: "location"},
+					Value: &semantic.StringLiteral{Value: location},
+				},
+			},
+		},
+	}
+}
+
+func TestFilter_PushDown_Match(t *testing.T) {
+	spec := &functions.FilterProcedureSpec{
+		Fn: &semantic.FunctionExpression{
+			Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+			Body: &semantic.BinaryExpression{
+				Operator: ast.GreaterThanEqualOperator,
+				Left:     hourCall("UTC"),
+				Right:    &semantic.IntegerLiteral{Value: 9},
+			},
+		},
+	}
+	rules := spec.PushDownRules()
+
+	// Should not push down a predicate the storage cannot evaluate
+	if rules[0].Match(new(functions.FromProcedureSpec)) {
+		t.Error("unexpected push down of a call into from")
+	}
+	// Should still merge with another filter
+	if !rules[1].Match(spec) {
+		t.Error("expected push down into filter")
+	}
+}
+
 func TestFilter_PushDown(t *testing.T) {
 	spec := &functions.FilterProcedureSpec{
 		Fn: &semantic.FunctionExpression{
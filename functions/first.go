@@ -56,6 +56,18 @@ func (s *FirstOpSpec) Kind() query.OperationKind {
 	return FirstKind
 }
 
+// DecompileArguments returns the arguments of the first call that creates the spec.
+func (s *FirstOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Column != "" {
+		args = append(args, query.DecompiledArgument{Key: "column", Value: s.Column})
+	}
+	if s.UseRowTime {
+		args = append(args, query.DecompiledArgument{Key: "useRowTime", Value: true})
+	}
+	return args
+}
+
 type FirstProcedureSpec struct {
 	Column     string
 	UseRowTime bool
@@ -76,17 +88,44 @@ func (s *FirstProcedureSpec) Kind() plan.ProcedureKind {
 	return FirstKind
 }
 func (s *FirstProcedureSpec) PushDownRules() []plan.PushDownRule {
-	return []plan.PushDownRule{{
+	var rules []plan.PushDownRule
+	if rule, ok := selectorAggregateRule(FirstKind, s.Column, s.UseRowTime); ok {
+		rules = append(rules, rule)
+	}
+	// Without the aggregate the storage layer reads only the first point of each series.
+	return append(rules, plan.PushDownRule{
 		Root:    FromKind,
 		Through: []plan.ProcedureKind{GroupKind, LimitKind, FilterKind},
 		Match: func(spec plan.ProcedureSpec) bool {
 			selectSpec := spec.(*FromProcedureSpec)
 			return !selectSpec.AggregateSet
 		},
-	}}
+		PushDown: s.pushDownLimit,
+	})
 }
 
 func (s *FirstProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Procedure) {
+	selectSpec := root.Spec.(*FromProcedureSpec)
+	if selectSpec.AggregateSet {
+		root = dup()
+		selectSpec = root.Spec.(*FromProcedureSpec)
+		selectSpec.AggregateSet = false
+		selectSpec.AggregateMethod = ""
+		return
+	}
+	if !selectSpec.BoundsSet {
+		// Read all data when no bounds have been set.
+		selectSpec.BoundsSet = true
+		selectSpec.Bounds = plan.BoundsSpec{
+			Start: query.MinTime,
+			Stop:  query.Now,
+		}
+	}
+	selectSpec.AggregateSet = true
+	selectSpec.AggregateMethod = FirstKind
+}
+
+func (s *FirstProcedureSpec) pushDownLimit(root *plan.Procedure, dup func() *plan.Procedure) {
 	selectSpec := root.Spec.(*FromProcedureSpec)
 	if selectSpec.BoundsSet || selectSpec.LimitSet || selectSpec.DescendingSet {
 		root = dup()
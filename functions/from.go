@@ -55,6 +55,15 @@ func (s *FromOpSpec) Kind() query.OperationKind {
 	return FromKind
 }
 
+// DecompileArguments returns the arguments of the from call that creates the spec.
+func (s *FromOpSpec) DecompileArguments() []query.DecompiledArgument {
+	args := []query.DecompiledArgument{{Key: "db", Value: s.Database}}
+	if len(s.Hosts) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "hosts", Value: s.Hosts})
+	}
+	return args
+}
+
 type FromProcedureSpec struct {
 	Database string
 	Hosts    []string
@@ -139,28 +148,57 @@ func (s *FromProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// isDefaultValueCol reports whether the column label refers to the value column produced by the storage source.
+// Selectors on other columns cannot be pushed down to storage.
+func isDefaultValueCol(label string) bool {
+	return label == "" || label == execute.DefaultValueColLabel
+}
+
+// selectorAggregateRule returns the rule that pushes a selector down to storage as the aggregate kind,
+// or false if the selector cannot be computed by storage.
+// Storage aggregates are stamped with the window stop, so the time of the selected row is not kept.
+func selectorAggregateRule(kind, column string, useRowTime bool) (plan.PushDownRule, bool) {
+	if !isDefaultValueCol(column) || useRowTime {
+		return plan.PushDownRule{}, false
+	}
+	return plan.PushDownRule{
+		Root:    FromKind,
+		Through: nil,
+		Match: func(spec plan.ProcedureSpec) bool {
+			selectSpec := spec.(*FromProcedureSpec)
+			return !selectSpec.GroupingSet && !selectSpec.LimitSet
+		},
+		Capability: plan.AggregateCapability(kind),
+	}, true
+}
+
 func createFromSource(prSpec plan.ProcedureSpec, id execute.DatasetID, sr execute.StorageReader, a execute.Administration) execute.Source {
 	spec := prSpec.(*FromProcedureSpec)
+	bounds := a.ResolveBounds(spec.Bounds)
 	var w execute.Window
+	var currentTime execute.Time
 	if spec.WindowSet {
 		w = execute.Window{
-			Every:  execute.Duration(spec.Window.Every),
-			Period: execute.Duration(spec.Window.Period),
-			Round:  execute.Duration(spec.Window.Round),
+			Every:  execute.Duration(spec.Window.Every.Fixed),
+			Period: execute.Duration(spec.Window.Period.Fixed),
+			Round:  execute.Duration(spec.Window.Round.Fixed),
 			Start:  a.ResolveTime(spec.Window.Start),
 		}
+		// Align windows the same way as the window transformation,
+		// the first window is the earliest window that contains the start bound.
+		offset := execute.Time(w.Start - w.Start.Truncate(w.Every))
+		currentTime = bounds.Start.Truncate(w.Every) + offset
+		if bounds.Start >= currentTime {
+			currentTime += execute.Time(w.Every)
+		}
 	} else {
-		duration := execute.Duration(a.ResolveTime(spec.Bounds.Stop)) - execute.Duration(a.ResolveTime(spec.Bounds.Start))
+		duration := execute.Duration(bounds.Stop) - execute.Duration(bounds.Start)
 		w = execute.Window{
 			Every:  duration,
 			Period: duration,
-			Start:  a.ResolveTime(spec.Bounds.Start),
+			Start:  bounds.Start,
 		}
-	}
-	currentTime := w.Start + execute.Time(w.Period)
-	bounds := execute.Bounds{
-		Start: a.ResolveTime(spec.Bounds.Start),
-		Stop:  a.ResolveTime(spec.Bounds.Stop),
+		currentTime = w.Start + execute.Time(w.Period)
 	}
 	return execute.NewStorageSource(
 		id,
@@ -183,5 +221,6 @@ func createFromSource(prSpec plan.ProcedureSpec, id execute.DatasetID, sr execut
 		bounds,
 		w,
 		currentTime,
+		a,
 	)
 }
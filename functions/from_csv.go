@@ -0,0 +1,508 @@
+package functions
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/pkg/errors"
+)
+
+const FromCSVKind = "fromCSV"
+
+type FromCSVOpSpec struct {
+	File string `json:"file"`
+	CSV  string `json:"csv"`
+}
+
+var fromCSVSignature = semantic.FunctionSignature{
+	Params: map[string]semantic.Type{
+		"file": semantic.String,
+		"csv":  semantic.String,
+	},
+	ReturnType: query.TableObjectType,
+}
+
+func init() {
+	query.RegisterFunction(FromCSVKind, createFromCSVOpSpec, fromCSVSignature)
+	query.RegisterOpSpec(FromCSVKind, newFromCSVOp)
+	plan.RegisterProcedureSpec(FromCSVKind, newFromCSVProcedure, FromCSVKind)
+	execute.RegisterSource(FromCSVKind, createFromCSVSource)
+}
+
+// csvFilePath returns the path of the file that fromCSV reads, which must be within the directory csvDir.
+// Relative paths are relative to csvDir, and paths that resolve to a file outside of csvDir are rejected.
+// Reading files is disabled when csvDir is empty.
+func csvFilePath(csvDir, file string) (string, error) {
+	if csvDir == "" {
+		return "", errors.New("reading CSV files is disabled")
+	}
+	dir, err := filepath.EvalSymlinks(csvDir)
+	if err != nil {
+		return "", err
+	}
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	// Symbolic links are resolved, so that they cannot point outside of the directory.
+	path, err = filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	if rel, err := filepath.Rel(dir, path); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("CSV file %q is outside of the CSV directory", file)
+	}
+	return path, nil
+}
+
+func createFromCSVOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	spec := new(FromCSVOpSpec)
+
+	file, fileSet, err := args.GetString("file")
+	if err != nil {
+		return nil, err
+	}
+	csv, csvSet, err := args.GetString("csv")
+	if err != nil {
+		return nil, err
+	}
+	if fileSet == csvSet {
+		return nil, errors.New(`fromCSV requires exactly one of "file" or "csv" to be set`)
+	}
+	spec.File = file
+	spec.CSV = csv
+	return spec, nil
+}
+
+func newFromCSVOp() query.OperationSpec {
+	return new(FromCSVOpSpec)
+}
+
+func (s *FromCSVOpSpec) Kind() query.OperationKind {
+	return FromCSVKind
+}
+
+// DecompileArguments returns the arguments of the fromCSV call that creates the spec.
+func (s *FromCSVOpSpec) DecompileArguments() []query.DecompiledArgument {
+	if s.File != "" {
+		return []query.DecompiledArgument{{Key: "file", Value: s.File}}
+	}
+	return []query.DecompiledArgument{{Key: "csv", Value: s.CSV}}
+}
+
+type FromCSVProcedureSpec struct {
+	File string
+	CSV  string
+
+	BoundsSet bool
+	Bounds    plan.BoundsSpec
+}
+
+func newFromCSVProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromCSVOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &FromCSVProcedureSpec{
+		File: spec.File,
+		CSV:  spec.CSV,
+	}, nil
+}
+
+func (s *FromCSVProcedureSpec) Kind() plan.ProcedureKind {
+	return FromCSVKind
+}
+func (s *FromCSVProcedureSpec) TimeBounds() plan.BoundsSpec {
+	return s.Bounds
+}
+func (s *FromCSVProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(FromCSVProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createFromCSVSource(prSpec plan.ProcedureSpec, id execute.DatasetID, sr execute.StorageReader, a execute.Administration) execute.Source {
+	spec := prSpec.(*FromCSVProcedureSpec)
+	bounds := a.ResolveBounds(spec.Bounds)
+	return NewCSVSource(id, spec.File, spec.CSV, a.CSVDir(), bounds, a.Allocator())
+}
+
+// CSVSource produces blocks from CSV data.
+//
+// The data is either annotated CSV, as produced by execute.CSVResultEncoder,
+// or plain CSV with a header row.
+// Plain CSV must contain a _time column of RFC3339 timestamps,
+// the types of all other columns are inferred from their values.
+// Columns containing only integers, floats or booleans are value columns and all other columns are tag columns.
+//
+// Rows are grouped into blocks by their tag values, rows outside of the bounds are dropped,
+// and every block is given the bounds of the source, the same as blocks read from storage.
+type CSVSource struct {
+	id     execute.DatasetID
+	file   string
+	csv    string
+	dir    string
+	bounds execute.Bounds
+	alloc  *execute.Allocator
+
+	ts []execute.Transformation
+}
+
+// NewCSVSource creates a source that reads the CSV data in file, or the csv text if file is empty.
+// The file must be within the directory dir.
+func NewCSVSource(id execute.DatasetID, file, csv, dir string, bounds execute.Bounds, alloc *execute.Allocator) *CSVSource {
+	return &CSVSource{
+		id:     id,
+		file:   file,
+		csv:    csv,
+		dir:    dir,
+		bounds: bounds,
+		alloc:  alloc,
+	}
+}
+
+func (s *CSVSource) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *CSVSource) Run(ctx context.Context) {
+	err := s.run(ctx)
+	for _, t := range s.ts {
+		t.Finish(s.id, err)
+	}
+}
+
+func (s *CSVSource) run(ctx context.Context) error {
+	var r io.Reader = strings.NewReader(s.csv)
+	if s.file != "" {
+		path, err := csvFilePath(s.dir, s.file)
+		if err != nil {
+			return errors.Wrap(err, "failed to open CSV file")
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return errors.Wrap(err, "failed to open CSV file")
+		}
+		defer f.Close()
+		r = f
+	}
+	tables, err := readCSVTables(contextReader{ctx: ctx, r: r})
+	if err != nil {
+		return errors.Wrap(err, "failed to read CSV data")
+	}
+	blocks, err := s.blocks(tables)
+	if err != nil {
+		return err
+	}
+	for _, b := range blocks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for _, t := range s.ts {
+			if err := t.Process(s.id, b); err != nil {
+				return err
+			}
+			if err := t.UpdateProcessingTime(s.id, execute.Now()); err != nil {
+				return err
+			}
+		}
+	}
+	for _, t := range s.ts {
+		if err := t.UpdateWatermark(s.id, s.bounds.Stop); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// contextReader stops reading once its context is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
+// blocks groups the rows of the tables into blocks.
+func (s *CSVSource) blocks(tables []*csvSourceTable) ([]execute.Block, error) {
+	builders := make(map[string]*execute.ColListBlockBuilder)
+	var keys []string
+	for _, t := range tables {
+		timeIdx := execute.TimeIdx(t.cols)
+		if timeIdx < 0 {
+			return nil, fmt.Errorf("CSV data has no %s column", execute.TimeColLabel)
+		}
+		for _, row := range t.rows {
+			ts, ok := row[timeIdx].(execute.Time)
+			if !ok || !s.bounds.Contains(ts) {
+				// Rows without a time cannot be placed within the bounds.
+				continue
+			}
+			key := t.groupKey(row)
+			builder, ok := builders[key]
+			if !ok {
+				builder = execute.NewColListBlockBuilder(s.alloc)
+				builder.SetBounds(s.bounds)
+				for j, c := range t.cols {
+					if c.Kind == execute.TagColKind {
+						c.Common = true
+						builder.AddCol(c)
+						builder.SetCommonString(j, row[j].(string))
+					} else {
+						c.Common = false
+						builder.AddCol(c)
+					}
+				}
+				builders[key] = builder
+				keys = append(keys, key)
+			}
+			for j, c := range t.cols {
+				if row[j] == nil && c.Kind != execute.TagColKind {
+					builder.AppendNil(j)
+					continue
+				}
+				switch c.Type {
+				case execute.TBool:
+					builder.AppendBool(j, row[j].(bool))
+				case execute.TInt:
+					builder.AppendInt(j, row[j].(int64))
+				case execute.TUInt:
+					builder.AppendUInt(j, row[j].(uint64))
+				case execute.TFloat:
+					builder.AppendFloat(j, row[j].(float64))
+				case execute.TString:
+					if c.Kind != execute.TagColKind {
+						builder.AppendString(j, row[j].(string))
+					}
+				case execute.TTime:
+					builder.AppendTime(j, row[j].(execute.Time))
+				default:
+					execute.PanicUnknownType(c.Type)
+				}
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	blocks := make([]execute.Block, len(keys))
+	for i, key := range keys {
+		builder := builders[key]
+		builder.Sort([]string{execute.TimeColLabel}, false)
+		b, err := builder.Block()
+		if err != nil {
+			return nil, err
+		}
+		blocks[i] = b
+	}
+	return blocks, nil
+}
+
+// csvSourceTable is a set of rows that share the same columns.
+type csvSourceTable struct {
+	cols []execute.ColMeta
+	rows [][]interface{}
+}
+
+// groupKey identifies the block a row belongs to by the columns of the table and the tag values of the row.
+func (t *csvSourceTable) groupKey(row []interface{}) string {
+	var b strings.Builder
+	for j, c := range t.cols {
+		b.WriteString(c.Label)
+		b.WriteByte(':')
+		b.WriteString(c.Type.String())
+		if c.Kind == execute.TagColKind {
+			b.WriteByte('=')
+			b.WriteString(row[j].(string))
+		}
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// readCSVTables reads either annotated or plain CSV data.
+// Annotated CSV is detected by its leading annotation row.
+func readCSVTables(r io.Reader) ([]*csvSourceTable, error) {
+	br := bufio.NewReader(r)
+	first, err := br.Peek(1)
+	if err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if first[0] == '#' {
+		return readAnnotatedCSVTables(br)
+	}
+	reader := csv.NewReader(br)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	t, err := readPlainCSVTable(records)
+	if err != nil {
+		return nil, err
+	}
+	return []*csvSourceTable{t}, nil
+}
+
+// readAnnotatedCSVTables decodes the blocks of all results in the annotated CSV data.
+func readAnnotatedCSVTables(r io.Reader) ([]*csvSourceTable, error) {
+	results, err := execute.NewCSVResultDecoder().Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var tables []*csvSourceTable
+	for _, name := range names {
+		if err := results[name].Blocks().Do(func(b execute.Block) error {
+			t := &csvSourceTable{
+				cols: b.Cols(),
+			}
+			b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+				for i := range ts {
+					row := make([]interface{}, len(t.cols))
+					for j, c := range t.cols {
+						if rr.IsNull(i, j) {
+							continue
+						}
+						switch c.Type {
+						case execute.TBool:
+							row[j] = rr.AtBool(i, j)
+						case execute.TInt:
+							row[j] = rr.AtInt(i, j)
+						case execute.TUInt:
+							row[j] = rr.AtUInt(i, j)
+						case execute.TFloat:
+							row[j] = rr.AtFloat(i, j)
+						case execute.TString:
+							row[j] = rr.AtString(i, j)
+						case execute.TTime:
+							row[j] = rr.AtTime(i, j)
+						default:
+							execute.PanicUnknownType(c.Type)
+						}
+					}
+					t.rows = append(t.rows, row)
+				}
+			})
+			tables = append(tables, t)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return tables, nil
+}
+
+// readPlainCSVTable reads CSV records with a header row, inferring the type of each column.
+func readPlainCSVTable(records [][]string) (*csvSourceTable, error) {
+	if len(records) == 0 {
+		return nil, errors.New("CSV data has no header row")
+	}
+	header := records[0]
+	records = records[1:]
+	for i, record := range records {
+		if len(record) != len(header) {
+			return nil, fmt.Errorf("record %d has %d columns, expected %d", i+2, len(record), len(header))
+		}
+	}
+
+	t := &csvSourceTable{
+		cols: make([]execute.ColMeta, len(header)),
+		rows: make([][]interface{}, len(records)),
+	}
+	for i := range t.rows {
+		t.rows[i] = make([]interface{}, len(header))
+	}
+	for j, label := range header {
+		if label == execute.TimeColLabel {
+			t.cols[j] = execute.TimeCol
+			for i, record := range records {
+				v, err := time.Parse(time.RFC3339Nano, record[j])
+				if err != nil {
+					return nil, errors.Wrapf(err, "record %d", i+2)
+				}
+				t.rows[i][j] = execute.Time(v.UnixNano())
+			}
+			continue
+		}
+
+		typ := inferCSVType(records, j)
+		var kind execute.ColKind = execute.ValueColKind
+		if typ == execute.TString && label != execute.DefaultValueColLabel {
+			kind = execute.TagColKind
+		}
+		t.cols[j] = execute.ColMeta{
+			Label: label,
+			Type:  typ,
+			Kind:  kind,
+		}
+		for i, record := range records {
+			switch typ {
+			case execute.TInt:
+				t.rows[i][j], _ = strconv.ParseInt(record[j], 10, 64)
+			case execute.TFloat:
+				t.rows[i][j], _ = strconv.ParseFloat(record[j], 64)
+			case execute.TBool:
+				t.rows[i][j], _ = strconv.ParseBool(record[j])
+			default:
+				t.rows[i][j] = record[j]
+			}
+		}
+	}
+	return t, nil
+}
+
+// inferCSVType determines the narrowest type that can represent every value of column j.
+func inferCSVType(records [][]string, j int) execute.DataType {
+	isInt, isFloat, isBool := len(records) > 0, len(records) > 0, len(records) > 0
+	for _, record := range records {
+		v := record[j]
+		if isInt {
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				isInt = false
+			}
+		}
+		if isFloat {
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				isFloat = false
+			}
+		}
+		if isBool {
+			isBool = v == "true" || v == "false"
+		}
+	}
+	switch {
+	case isInt:
+		return execute.TInt
+	case isFloat:
+		return execute.TFloat
+	case isBool:
+		return execute.TBool
+	default:
+		return execute.TString
+	}
+}
@@ -0,0 +1,481 @@
+package functions_test
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/control"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestFromCSV_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "no args",
+			Raw:     `fromCSV()`,
+			WantErr: true,
+		},
+		{
+			Name:    "file and csv",
+			Raw:     `fromCSV(file:"data.csv", csv:"a,b")`,
+			WantErr: true,
+		},
+		{
+			Name: "from file",
+			Raw:  `fromCSV(file:"data.csv") |> range(start:-4h, stop:-2h) |> sum()`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "fromCSV0",
+						Spec: &functions.FromCSVOpSpec{
+							File: "data.csv",
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{
+								Relative:   -4 * time.Hour,
+								IsRelative: true,
+							},
+							Stop: query.Time{
+								Relative:   -2 * time.Hour,
+								IsRelative: true,
+							},
+						},
+					},
+					{
+						ID:   "sum2",
+						Spec: &functions.SumOpSpec{},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "fromCSV0", Child: "range1"},
+					{Parent: "range1", Child: "sum2"},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestFromCSVOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"fromCSV","kind":"fromCSV","spec":{"csv":"_time,_value\n"}}`)
+	op := &query.Operation{
+		ID: "fromCSV",
+		Spec: &functions.FromCSVOpSpec{
+			CSV: "_time,_value\n",
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+const fromCSVPlain = `_time,_value,host
+2018-01-01T00:00:00Z,1,a
+2018-01-01T00:00:30Z,2,a
+2018-01-01T00:01:00Z,3,a
+2018-01-01T00:00:10Z,10,b
+2018-01-01T00:00:40Z,20,b
+2018-01-01T00:02:00Z,30,b
+`
+
+const fromCSVAnnotated = `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string
+#kind,,,,time,value,tag
+#common,,,,false,false,true
+#default,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,,,a
+,result,_start,_stop,_time,_value,host
+,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,2018-01-01T00:00:00Z,1,a
+,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,2018-01-01T00:00:30Z,2,a
+,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,2018-01-01T00:01:00Z,3,a
+
+#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string
+#kind,,,,time,value,tag
+#common,,,,false,false,true
+#default,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,,,b
+,result,_start,_stop,_time,_value,host
+,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,2018-01-01T00:00:40Z,20,b
+,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,2018-01-01T00:00:10Z,10,b
+,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,2018-01-01T00:02:00Z,30,b
+`
+
+func TestFromCSV_Execute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fromcsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	plainFile := filepath.Join(dir, "plain.csv")
+	if err := ioutil.WriteFile(plainFile, []byte(fromCSVPlain), 0600); err != nil {
+		t.Fatal(err)
+	}
+	annotatedFile := filepath.Join(dir, "annotated.csv")
+	if err := ioutil.WriteFile(annotatedFile, []byte(fromCSVAnnotated), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	start := execute.Time(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+	minute := execute.Time(time.Minute)
+	cols := []execute.ColMeta{
+		execute.TimeCol,
+		{Label: execute.DefaultValueColLabel, Type: execute.TInt, Kind: execute.ValueColKind},
+		{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+	}
+	testCases := []struct {
+		name string
+		raw  string
+		want []*executetest.Block
+	}{
+		{
+			name: "file range",
+			raw: `fromCSV(file:"` + plainFile + `")
+	|> range(start:2018-01-01T00:00:00Z, stop:2018-01-01T00:01:30Z)`,
+			want: []*executetest.Block{
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 90*execute.Time(time.Second)},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{start, int64(1), "a"},
+						{start + 30*execute.Time(time.Second), int64(2), "a"},
+						{start + minute, int64(3), "a"},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 90*execute.Time(time.Second)},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{start + 10*execute.Time(time.Second), int64(10), "b"},
+						{start + 40*execute.Time(time.Second), int64(20), "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "pivot hosts",
+			raw: `fromCSV(file:"` + plainFile + `")
+	|> range(start:2018-01-01T00:00:00Z, stop:2018-01-01T00:01:00Z)
+	|> pivot(colKey:["host"])`,
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{Start: start, Stop: start + minute},
+					ColMeta: []execute.ColMeta{
+						execute.TimeCol,
+						{Label: "a", Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TInt, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{start, int64(1), nil},
+						{start + 10*execute.Time(time.Second), nil, int64(10)},
+						{start + 30*execute.Time(time.Second), int64(2), nil},
+						{start + 40*execute.Time(time.Second), nil, int64(20)},
+					},
+				},
+			},
+		},
+		{
+			name: "annotated window sum",
+			raw: `fromCSV(file:"` + annotatedFile + `")
+	|> range(start:2018-01-01T00:00:00Z, stop:2018-01-01T00:02:00Z)
+	|> window(every:1m, start:2018-01-01T00:00:00Z)
+	|> sum()`,
+			want: []*executetest.Block{
+				// The result merges the windows of each series into a single block.
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 2*minute},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{start + minute, int64(3), "a"},
+						{start + 2*minute, int64(3), "a"},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 2*minute},
+					ColMeta: cols,
+					Data:    [][]interface{}{{start + minute, int64(30), "b"}},
+				},
+			},
+		},
+		{
+			name: "aggregate windows",
+			raw: `fromCSV(file:"` + plainFile + `")
+	|> range(start:2018-01-01T00:00:00Z, stop:2018-01-01T00:03:00Z)
+	|> aggregateWindow(every:1m, fn:sum)`,
+			want: []*executetest.Block{
+				// A window without any rows has a null value.
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 3*minute},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{start + minute, int64(3), "a"},
+						{start + 2*minute, int64(3), "a"},
+						{start + 3*minute, nil, "a"},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 3*minute},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{start + minute, int64(30), "b"},
+						{start + 2*minute, nil, "b"},
+						{start + 3*minute, int64(30), "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "reduce",
+			raw: `fromCSV(file:"` + plainFile + `")
+	|> range(start:2018-01-01T00:00:00Z, stop:2018-01-01T00:01:30Z)
+	|> reduce(fn: (r, accumulator) => ({count: accumulator.count + 1, sum: accumulator.sum + r._value}), identity: {count: 0, sum: 0})`,
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{Start: start, Stop: start + 90*execute.Time(time.Second)},
+					ColMeta: []execute.ColMeta{
+						execute.TimeCol,
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "count", Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "sum", Type: execute.TInt, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{{start + 90*execute.Time(time.Second), "a", int64(3), int64(6)}},
+				},
+				{
+					Bnds: execute.Bounds{Start: start, Stop: start + 90*execute.Time(time.Second)},
+					ColMeta: []execute.ColMeta{
+						execute.TimeCol,
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "count", Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "sum", Type: execute.TInt, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{{start + 90*execute.Time(time.Second), "b", int64(2), int64(30)}},
+				},
+			},
+		},
+		{
+			name: "fill aggregate windows",
+			raw: `fromCSV(file:"` + plainFile + `")
+	|> range(start:2018-01-01T00:00:00Z, stop:2018-01-01T00:03:00Z)
+	|> aggregateWindow(every:1m, fn:sum)
+	|> fill(value:0)`,
+			want: []*executetest.Block{
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 3*minute},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{start + minute, int64(3), "a"},
+						{start + 2*minute, int64(3), "a"},
+						{start + 3*minute, int64(0), "a"},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: start, Stop: start + 3*minute},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{start + minute, int64(30), "b"},
+						{start + 2*minute, int64(0), "b"},
+						{start + 3*minute, int64(30), "b"},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := control.New(control.Config{
+				ConcurrencyQuota: 1,
+				MemoryBytesQuota: math.MaxInt64,
+				ExecutorConfig:   execute.Config{CSVDir: dir},
+			})
+			q, err := c.QueryWithCompile(context.Background(), tc.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer q.Done()
+
+			results, ok := <-q.Ready
+			if !ok {
+				t.Fatal(q.Err())
+			}
+			var got []*executetest.Block
+			for _, r := range results {
+				if err := r.Blocks().Do(func(b execute.Block) error {
+					blk := executetest.ConvertBlock(b)
+					// The windows of a series are merged in no particular order.
+					timeIdx := execute.TimeIdx(blk.ColMeta)
+					sort.SliceStable(blk.Data, func(i, j int) bool {
+						return blk.Data[i][timeIdx].(execute.Time) < blk.Data[j][timeIdx].(execute.Time)
+					})
+					got = append(got, blk)
+					return nil
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			sort.Sort(executetest.SortedBlocks(got))
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestFromCSV_Dir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fromcsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "plain.csv"), []byte(fromCSVPlain), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "empty.csv"), []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Join(filepath.Dir(dir), filepath.Base(dir)+".csv")
+	if err := ioutil.WriteFile(outside, []byte(fromCSVPlain), 0600); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(outside)
+	if err := os.Symlink(outside, filepath.Join(dir, "link.csv")); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		dir     string
+		file    string
+		wantErr bool
+	}{
+		{name: "relative", dir: dir, file: "plain.csv"},
+		{name: "absolute", dir: dir, file: filepath.Join(dir, "plain.csv")},
+		{name: "parent", dir: dir, file: "../" + filepath.Base(outside), wantErr: true},
+		{name: "outside", dir: dir, file: outside, wantErr: true},
+		{name: "symbolic link", dir: dir, file: "link.csv", wantErr: true},
+		{name: "empty", dir: dir, file: "empty.csv", wantErr: true},
+		{name: "disabled", dir: "", file: filepath.Join(dir, "plain.csv"), wantErr: true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := control.New(control.Config{
+				ConcurrencyQuota: 1,
+				MemoryBytesQuota: math.MaxInt64,
+				ExecutorConfig:   execute.Config{CSVDir: tc.dir},
+			})
+			q, err := c.QueryWithCompile(context.Background(), `fromCSV(file:"`+tc.file+`") |> range(start:2018-01-01T00:00:00Z)`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer q.Done()
+
+			results, ok := <-q.Ready
+			if !ok {
+				t.Fatal(q.Err())
+			}
+			for _, r := range results {
+				err = r.Blocks().Do(func(b execute.Block) error { return nil })
+			}
+			if tc.wantErr && err == nil {
+				t.Fatal("expected error")
+			} else if !tc.wantErr && err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestCSVSource_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	bounds := execute.Bounds{
+		Start: execute.Time(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()),
+		Stop:  execute.Time(time.Date(2018, 1, 1, 1, 0, 0, 0, time.UTC).UnixNano()),
+	}
+	s := functions.NewCSVSource(executetest.RandomDatasetID(), "", fromCSVPlain, "", bounds, executetest.UnlimitedAllocator)
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewBlockBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(execute.DefaultTriggerSpec)
+	s.AddTransformation(functions.NewLimitTransformation(d, c, &functions.LimitProcedureSpec{N: 10}))
+	s.Run(ctx)
+
+	if d.FinishedErr == nil {
+		t.Error("expected error after the query was cancelled")
+	}
+	if blocks := executetest.BlocksFromCache(c); len(blocks) != 0 {
+		t.Errorf("unexpected blocks after the query was cancelled: %v", blocks)
+	}
+}
+
+func TestFromCSV_ExecuteSpec(t *testing.T) {
+	// CSV data cannot be written inline as an IFQL string literal since it spans multiple lines,
+	// but it can be embedded directly in a query spec.
+	spec := &query.Spec{
+		Operations: []*query.Operation{
+			{
+				ID:   "fromCSV",
+				Spec: &functions.FromCSVOpSpec{CSV: fromCSVPlain},
+			},
+			{
+				ID: "range",
+				Spec: &functions.RangeOpSpec{
+					Start: query.Time{Absolute: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)},
+					Stop:  query.Time{Absolute: time.Date(2018, 1, 1, 0, 5, 0, 0, time.UTC)},
+				},
+			},
+			{
+				ID:   "max",
+				Spec: &functions.MaxOpSpec{},
+			},
+		},
+		Edges: []query.Edge{
+			{Parent: "fromCSV", Child: "range"},
+			{Parent: "range", Child: "max"},
+		},
+	}
+	c := control.New(control.Config{
+		ConcurrencyQuota: 1,
+		MemoryBytesQuota: math.MaxInt64,
+	})
+	q, err := c.Query(context.Background(), spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	results, ok := <-q.Ready
+	if !ok {
+		t.Fatal(q.Err())
+	}
+	var got []int64
+	for _, r := range results {
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			for _, row := range executetest.ConvertBlock(b).Data {
+				got = append(got, row[1].(int64))
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+	if want := []int64{3, 30}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected max values -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
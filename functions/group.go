@@ -69,6 +69,21 @@ func (s *GroupOpSpec) Kind() query.OperationKind {
 	return GroupKind
 }
 
+// DecompileArguments returns the arguments of the group call that creates the spec.
+func (s *GroupOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if len(s.By) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "by", Value: s.By})
+	}
+	if len(s.Keep) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "keep", Value: s.Keep})
+	}
+	if len(s.Except) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "except", Value: s.Except})
+	}
+	return args
+}
+
 type GroupProcedureSpec struct {
 	By     []string
 	Except []string
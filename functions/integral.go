@@ -40,7 +40,7 @@ func createIntegralOpSpec(args query.Arguments, a *query.Administration) (query.
 		spec.Unit = unit
 	} else {
 		//Default is 1s
-		spec.Unit = query.Duration(time.Second)
+		spec.Unit = query.Duration{Fixed: time.Second}
 	}
 
 	return spec, nil
@@ -54,6 +54,15 @@ func (s *IntegralOpSpec) Kind() query.OperationKind {
 	return IntegralKind
 }
 
+// DecompileArguments returns the arguments of the integral call that creates the spec.
+func (s *IntegralOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Unit != (query.Duration{Fixed: time.Second}) {
+		args = append(args, query.DecompiledArgument{Key: "unit", Value: s.Unit})
+	}
+	return args
+}
+
 type IntegralProcedureSpec struct {
 	Unit query.Duration `json:"unit"`
 }
@@ -102,7 +111,7 @@ func NewIntegralTransformation(d execute.Dataset, cache execute.BlockBuilderCach
 		d:      d,
 		cache:  cache,
 		bounds: bounds,
-		unit:   time.Duration(spec.Unit),
+		unit:   spec.Unit.Fixed,
 	}
 }
 
@@ -149,6 +158,10 @@ func (t *integralTransformation) Process(id execute.DatasetID, b execute.Block)
 				continue
 			}
 			for i, t := range ts {
+				// Null values are skipped, so that they do not count as zero.
+				if rr.IsNull(i, j) {
+					continue
+				}
 				in.updateFloat(t, rr.AtFloat(i, j))
 			}
 		}
@@ -16,7 +16,7 @@ func TestIntegralOperation_Marshaling(t *testing.T) {
 	op := &query.Operation{
 		ID: "integral",
 		Spec: &functions.IntegralOpSpec{
-			Unit: query.Duration(time.Minute),
+			Unit: query.Duration{Fixed: time.Minute},
 		},
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
@@ -45,7 +45,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float",
 			spec: &functions.IntegralProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			bounds: execute.Bounds{
 				Start: 1,
@@ -82,7 +82,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with units",
 			spec: &functions.IntegralProcedureSpec{
-				Unit: query.Duration(time.Second),
+				Unit: query.Duration{Fixed: time.Second},
 			},
 			bounds: execute.Bounds{
 				Start: execute.Time(1 * time.Second),
@@ -119,7 +119,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with tags",
 			spec: &functions.IntegralProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			bounds: execute.Bounds{
 				Start: 1,
@@ -157,7 +157,7 @@ func TestIntegral_Process(t *testing.T) {
 		{
 			name: "float with multiple values",
 			spec: &functions.IntegralProcedureSpec{
-				Unit: 1,
+				Unit: query.Duration{Fixed: 1},
 			},
 			bounds: execute.Bounds{
 				Start: 1,
@@ -195,6 +195,47 @@ func TestIntegral_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "float with nulls",
+			spec: &functions.IntegralProcedureSpec{
+				Unit: query.Duration{Fixed: 1},
+			},
+			bounds: execute.Bounds{
+				Start: 1,
+				Stop:  5,
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  5,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, 20.0},
+					{execute.Time(2), nil, 10.0},
+					{execute.Time(3), 4.0, nil},
+					{execute.Time(4), 6.0, 30.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  5,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), 11.0, 55.0},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
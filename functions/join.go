@@ -5,6 +5,7 @@ import (
 	"log"
 	"math"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/influxdata/ifql/compiler"
@@ -18,9 +19,21 @@ import (
 const JoinKind = "join"
 const MergeJoinKind = "merge-join"
 
+// Join methods, the inner join is the default.
+const (
+	JoinMethodInner = "inner"
+	JoinMethodLeft  = "left"
+	JoinMethodRight = "right"
+	JoinMethodFull  = "full"
+	JoinMethodAsOf  = "asof"
+)
+
 type JoinOpSpec struct {
 	// On is a list of tags on which to join.
 	On []string `json:"on"`
+	// Except is a list of tags on which not to join, the tables are joined on all of their other tags.
+	// It cannot be used together with On.
+	Except []string `json:"except"`
 	// Fn is a function accepting a single parameter.
 	// The parameter is map if records for each of the parent operations.
 	Fn *semantic.FunctionExpression `json:"fn"`
@@ -29,13 +42,30 @@ type JoinOpSpec struct {
 	// TODO(nathanielc): Change this to a map of parent operation IDs to names.
 	// Then make it possible for the transformation to map operation IDs to parent IDs.
 	TableNames map[query.OperationID]string `json:"table_names"`
+	// Method is one of inner, left, right, full or asof, an empty method is an inner join.
+	Method string `json:"method"`
+	// Tolerance is the largest time difference of the rows matched by an asof join, zero means there is no limit.
+	Tolerance query.Duration `json:"tolerance"`
+	// Left is the name of the left table of a left, right or asof join.
+	Left string `json:"left"`
+	// Many is the name of the table of a many-to-one inner join.
+	// Its rows may match the same row of the other tables and keep their tags that are not joined on.
+	Many string `json:"many"`
+	// Include are the tags of the other tables that are copied to the rows of a many-to-one join.
+	Include []string `json:"include"`
 }
 
 var joinSignature = semantic.FunctionSignature{
 	Params: map[string]semantic.Type{
-		"tables": semantic.Object,
-		"fn":     semantic.Function,
-		"on":     semantic.NewArrayType(semantic.String),
+		"tables":    semantic.Object,
+		"fn":        semantic.Function,
+		"on":        semantic.NewArrayType(semantic.String),
+		"except":    semantic.NewArrayType(semantic.String),
+		"method":    semantic.String,
+		"tolerance": semantic.Duration,
+		"left":      semantic.String,
+		"many":      semantic.String,
+		"include":   semantic.NewArrayType(semantic.String),
 	},
 	ReturnType:   query.TableObjectType,
 	PipeArgument: "tables",
@@ -68,11 +98,57 @@ func createJoinOpSpec(args query.Arguments, a *query.Administration) (query.Oper
 	} else if ok {
 		spec.On = array.AsStrings()
 	}
+	if array, ok, err := args.GetArray("except", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Except = array.AsStrings()
+	}
+
+	if method, ok, err := args.GetString("method"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Method = method
+	}
+	if tolerance, ok, err := args.GetDuration("tolerance"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Tolerance = tolerance
+	}
+	if left, ok, err := args.GetString("left"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Left = left
+	}
+	if many, ok, err := args.GetString("many"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Many = many
+	}
+	if array, ok, err := args.GetArray("include", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Include = array.AsStrings()
+	}
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
 
 	if m, ok, err := args.GetObject("tables"); err != nil {
 		return nil, err
 	} else if ok {
-		for k, t := range m.Properties {
+		names := make([]string, 0, len(m.Properties))
+		for k := range m.Properties {
+			names = append(names, k)
+		}
+		if err := spec.validateTables(names); err != nil {
+			return nil, err
+		}
+		// Add the parents in join order.
+		sort.Slice(names, func(i, j int) bool {
+			return joinsBefore(names[i], names[j], spec.Left)
+		})
+		for _, k := range names {
+			t := m.Properties[k]
 			if t.Type().Kind() != semantic.Object {
 				return nil, fmt.Errorf("value for key %q in tables must be an object: got %v", k, t.Type().Kind())
 			}
@@ -88,6 +164,79 @@ func createJoinOpSpec(args query.Arguments, a *query.Administration) (query.Oper
 	return spec, nil
 }
 
+// validate checks the join method, that a tolerance is only given to an asof join,
+// that only the left, right and asof joins have a left table, that only an inner join has a many table
+// and that on and except are not both given.
+func (s *JoinOpSpec) validate() error {
+	if len(s.On) > 0 && len(s.Except) > 0 {
+		return errors.New("join cannot have both on and except")
+	}
+	if s.Many != "" && s.Method != "" && s.Method != JoinMethodInner {
+		return fmt.Errorf("many is only valid for the %q join method", JoinMethodInner)
+	}
+	if len(s.Include) > 0 && s.Many == "" {
+		return errors.New("include is only valid for a join with a many table")
+	}
+	switch s.Method {
+	case "", JoinMethodInner, JoinMethodLeft, JoinMethodRight, JoinMethodFull:
+		if !s.Tolerance.IsZero() {
+			return fmt.Errorf("tolerance is only valid for the %q join method", JoinMethodAsOf)
+		}
+	case JoinMethodAsOf:
+		if s.Tolerance.Fixed < 0 {
+			return fmt.Errorf("tolerance must not be negative, got %v", s.Tolerance)
+		}
+	default:
+		return fmt.Errorf("unknown join method %q", s.Method)
+	}
+	switch s.Method {
+	case JoinMethodLeft, JoinMethodRight, JoinMethodAsOf:
+		if s.Left == "" {
+			return fmt.Errorf("the %q join method requires the left table", s.Method)
+		}
+	default:
+		if s.Left != "" {
+			return fmt.Errorf("left is only valid for the %q, %q and %q join methods", JoinMethodLeft, JoinMethodRight, JoinMethodAsOf)
+		}
+	}
+	return nil
+}
+
+// validateTables checks that the left and many tables are among the tables and that a right join has exactly two tables.
+func (s *JoinOpSpec) validateTables(names []string) error {
+	if s.Many != "" && !isTableName(s.Many, names) {
+		return fmt.Errorf("many table %q is not one of the tables", s.Many)
+	}
+	if s.Left == "" {
+		return nil
+	}
+	if !isTableName(s.Left, names) {
+		return fmt.Errorf("left table %q is not one of the tables", s.Left)
+	}
+	if s.Method == JoinMethodRight && len(names) != 2 {
+		return fmt.Errorf("the %q join method requires exactly two tables, got %d", JoinMethodRight, len(names))
+	}
+	return nil
+}
+
+func isTableName(name string, names []string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// joinsBefore reports whether the table named a comes before the table named b in the join.
+// The left table comes first, the other tables are ordered by name.
+func joinsBefore(a, b, left string) bool {
+	if a == left || b == left {
+		return a == left && b != left
+	}
+	return a < b
+}
+
 func newJoinOp() query.OperationSpec {
 	return new(JoinOpSpec)
 }
@@ -96,10 +245,47 @@ func (s *JoinOpSpec) Kind() query.OperationKind {
 	return JoinKind
 }
 
+// DecompileArguments returns the arguments of the join call that creates the spec.
+func (s *JoinOpSpec) DecompileArguments() []query.DecompiledArgument {
+	tables := make(map[string]query.OperationID, len(s.TableNames))
+	for id, name := range s.TableNames {
+		tables[name] = id
+	}
+	args := []query.DecompiledArgument{{Key: "tables", Value: tables}}
+	if len(s.On) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "on", Value: s.On})
+	}
+	if len(s.Except) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "except", Value: s.Except})
+	}
+	if s.Method != "" && s.Method != JoinMethodInner {
+		args = append(args, query.DecompiledArgument{Key: "method", Value: s.Method})
+	}
+	if s.Left != "" {
+		args = append(args, query.DecompiledArgument{Key: "left", Value: s.Left})
+	}
+	if !s.Tolerance.IsZero() {
+		args = append(args, query.DecompiledArgument{Key: "tolerance", Value: s.Tolerance})
+	}
+	if s.Many != "" {
+		args = append(args, query.DecompiledArgument{Key: "many", Value: s.Many})
+	}
+	if len(s.Include) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "include", Value: s.Include})
+	}
+	return append(args, query.DecompiledArgument{Key: "fn", Value: s.Fn})
+}
+
 type MergeJoinProcedureSpec struct {
 	On         []string                     `json:"keys"`
+	Except     []string                     `json:"except"`
 	Fn         *semantic.FunctionExpression `json:"f"`
 	TableNames map[plan.ProcedureID]string  `json:"table_names"`
+	Method     string                       `json:"method"`
+	Tolerance  query.Duration               `json:"tolerance"`
+	Left       string                       `json:"left"`
+	Many       string                       `json:"many"`
+	Include    []string                     `json:"include"`
 }
 
 func newMergeJoinProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -116,10 +302,18 @@ func newMergeJoinProcedure(qs query.OperationSpec, pa plan.Administration) (plan
 
 	p := &MergeJoinProcedureSpec{
 		On:         spec.On,
+		Except:     spec.Except,
 		Fn:         spec.Fn,
 		TableNames: tableNames,
+		Method:     spec.Method,
+		Tolerance:  spec.Tolerance,
+		Left:       spec.Left,
+		Many:       spec.Many,
+		Include:    spec.Include,
 	}
 	sort.Strings(p.On)
+	sort.Strings(p.Except)
+	sort.Strings(p.Include)
 	return p, nil
 }
 
@@ -132,8 +326,28 @@ func (s *MergeJoinProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.On = make([]string, len(s.On))
 	copy(ns.On, s.On)
 
+	if s.Except != nil {
+		ns.Except = make([]string, len(s.Except))
+		copy(ns.Except, s.Except)
+	}
+
 	ns.Fn = s.Fn.Copy().(*semantic.FunctionExpression)
 
+	ns.TableNames = make(map[plan.ProcedureID]string, len(s.TableNames))
+	for id, name := range s.TableNames {
+		ns.TableNames[id] = name
+	}
+
+	ns.Method = s.Method
+	ns.Tolerance = s.Tolerance
+	ns.Left = s.Left
+	ns.Many = s.Many
+
+	if s.Include != nil {
+		ns.Include = make([]string, len(s.Include))
+		copy(ns.Include, s.Include)
+	}
+
 	return ns
 }
 
@@ -149,10 +363,8 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 	if !ok {
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
-	parents := a.Parents()
-	if len(parents) != 2 {
-		//TODO(nathanielc): Support n-way joins
-		return nil, nil, errors.New("joins currently must only have two parents")
+	if len(a.Parents()) < 2 {
+		return nil, nil, errors.New("joins must have at least two parents")
 	}
 
 	tableNames := make(map[execute.DatasetID]string, len(s.TableNames))
@@ -160,14 +372,22 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 		id := a.ConvertID(pid)
 		tableNames[id] = name
 	}
-	leftName := tableNames[parents[0]]
-	rightName := tableNames[parents[1]]
+	// Order the parents in join order, the first table is the left table and the last the right table.
+	parents := make([]execute.DatasetID, len(a.Parents()))
+	copy(parents, a.Parents())
+	sort.Slice(parents, func(i, j int) bool {
+		return joinsBefore(tableNames[parents[i]], tableNames[parents[j]], s.Left)
+	})
+	names := make([]string, len(parents))
+	for i, p := range parents {
+		names[i] = tableNames[p]
+	}
 
 	joinFn, err := NewRowJoinFunction(s.Fn, parents, tableNames)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "invalid expression")
 	}
-	cache := NewMergeJoinCache(joinFn, a.Allocator(), leftName, rightName)
+	cache := NewMergeJoinCache(joinFn, a.Allocator(), names, s)
 	d := execute.NewDataset(id, mode, cache)
 	t := NewMergeJoinTransformation(d, cache, s, parents, tableNames)
 	return t, d, nil
@@ -181,12 +401,19 @@ type mergeJoinTransformation struct {
 	d     execute.Dataset
 	cache MergeJoinCache
 
-	leftID, rightID     execute.DatasetID
-	leftName, rightName string
+	// tableIdxs maps the parents to the index of their table in the joined tables.
+	tableIdxs map[execute.DatasetID]int
 
 	parentState map[execute.DatasetID]*mergeJoinParentState
 
 	keys []string
+	// except are the tags that are not joined on, if any, otherwise the tables are joined on the keys.
+	except []string
+
+	// many is the index of the many table of a many-to-one join, or -1.
+	many int
+	// include are the tags that the other tables of a many-to-one join keep.
+	include []string
 }
 
 func NewMergeJoinTransformation(d execute.Dataset, cache MergeJoinCache, spec *MergeJoinProcedureSpec, parents []execute.DatasetID, tableNames map[execute.DatasetID]string) *mergeJoinTransformation {
@@ -194,14 +421,19 @@ func NewMergeJoinTransformation(d execute.Dataset, cache MergeJoinCache, spec *M
 		d:         d,
 		cache:     cache,
 		keys:      spec.On,
-		leftID:    parents[0],
-		rightID:   parents[1],
-		leftName:  tableNames[parents[0]],
-		rightName: tableNames[parents[1]],
+		except:    spec.Except,
+		many:      -1,
+		include:   spec.Include,
+		parents:   parents,
+		tableIdxs: make(map[execute.DatasetID]int, len(parents)),
 	}
 	t.parentState = make(map[execute.DatasetID]*mergeJoinParentState)
-	for _, id := range parents {
+	for i, id := range parents {
+		t.tableIdxs[id] = i
 		t.parentState[id] = new(mergeJoinParentState)
+		if spec.Many != "" && tableNames[id] == spec.Many {
+			t.many = i
+		}
 	}
 	return t
 }
@@ -217,7 +449,7 @@ func (t *mergeJoinTransformation) RetractBlock(id execute.DatasetID, meta execut
 	defer t.mu.Unlock()
 
 	bm := blockMetadata{
-		tags:   meta.Tags().IntersectingSubset(t.keys),
+		tags:   t.joinTags(meta.Tags()),
 		bounds: meta.Bounds(),
 	}
 	return t.d.RetractBlock(execute.ToBlockKey(bm))
@@ -228,20 +460,15 @@ func (t *mergeJoinTransformation) Process(id execute.DatasetID, b execute.Block)
 	defer t.mu.Unlock()
 
 	bm := blockMetadata{
-		tags:   b.Tags().IntersectingSubset(t.keys),
+		tags:   t.joinTags(b.Tags()),
 		bounds: b.Bounds(),
 	}
 	tables := t.cache.Tables(bm)
 
-	var table execute.BlockBuilder
-	switch id {
-	case t.leftID:
-		table = tables.left
-	case t.rightID:
-		table = tables.right
-	}
+	idx := t.tableIdxs[id]
+	table := tables.tables[idx]
 
-	colMap := t.addNewCols(b, table)
+	colMap := t.addNewCols(b, table, t.keepsTags(idx))
 
 	times := b.Times()
 	times.DoTime(func(ts []execute.Time, rr execute.RowReader) {
@@ -252,30 +479,81 @@ func (t *mergeJoinTransformation) Process(id execute.DatasetID, b execute.Block)
 	return nil
 }
 
-// addNewCols adds column to builder that exist on b and are part of the join keys.
-// This method ensures that the left and right tables always have the same columns.
+// joinTags returns the tags of a block that it is joined on.
+func (t *mergeJoinTransformation) joinTags(tags execute.Tags) execute.Tags {
+	if len(t.except) == 0 {
+		return tags.IntersectingSubset(t.keys)
+	}
+	joined := make(execute.Tags, len(tags))
+	for k, v := range tags {
+		if !t.isKey(k) {
+			continue
+		}
+		joined[k] = v
+	}
+	return joined
+}
+
+// isKey reports whether the tag is one of the join keys.
+func (t *mergeJoinTransformation) isKey(label string) bool {
+	return isJoinKey(label, t.keys, t.except)
+}
+
+// isJoinKey reports whether the tag is one of the keys of a join on the keys, or of a join except the tags.
+func isJoinKey(label string, keys, except []string) bool {
+	if len(except) > 0 {
+		for _, k := range except {
+			if label == k {
+				return false
+			}
+		}
+		return true
+	}
+	for _, k := range keys {
+		if label == k {
+			return true
+		}
+	}
+	return false
+}
+
+// keepsTags returns whether the tag that is not a join key is kept by the table with the index,
+// the many table of a many-to-one join keeps all of its tags and the other tables keep the included tags.
+func (t *mergeJoinTransformation) keepsTags(idx int) func(label string) bool {
+	return func(label string) bool {
+		if t.many < 0 {
+			return false
+		}
+		if idx == t.many {
+			return true
+		}
+		for _, l := range t.include {
+			if label == l {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// addNewCols adds column to builder that exist on b and are part of the join keys, or are tags that the table keeps.
+// This method ensures that the joined tables always have the same columns.
 // A colMap is returned mapping cols of builder to cols of b.
-func (t *mergeJoinTransformation) addNewCols(b execute.Block, builder execute.BlockBuilder) []int {
+func (t *mergeJoinTransformation) addNewCols(b execute.Block, builder execute.BlockBuilder, keep func(label string) bool) []int {
 	cols := b.Cols()
 	existing := builder.Cols()
 	colMap := make([]int, len(existing))
 	for j, c := range cols {
-		// Skip common tags or tags that are not one of the join keys.
-		if c.IsTag() {
-			if c.Common {
-				continue
-			}
-			found := false
-			for _, k := range t.keys {
-				if c.Label == k {
-					found = true
-					break
-				}
-			}
-			// Column is not one of the join keys
-			if !found {
+		if c.IsTag() && !t.isKey(c.Label) {
+			if !keep(c.Label) {
+				// Column is not one of the join keys
 				continue
 			}
+			// The kept tags may differ between the blocks that are joined together.
+			c.Common = false
+		} else if c.IsTag() && c.Common {
+			// Common join keys are the tags of the joined block.
+			continue
 		}
 		// Check if column already exists
 		found := false
@@ -351,20 +629,36 @@ type mergeJoinCache struct {
 	data  map[execute.BlockKey]*joinTables
 	alloc *execute.Allocator
 
-	leftName, rightName string
+	names     []string
+	method    string
+	tolerance execute.Duration
+
+	keys, except []string
+	many         string
+	include      []string
 
 	triggerSpec query.TriggerSpec
 
 	joinFn *joinFunc
 }
 
-func NewMergeJoinCache(joinFn *joinFunc, a *execute.Allocator, leftName, rightName string) *mergeJoinCache {
+// NewMergeJoinCache creates a cache of the tables to join, names are the names of the tables in join order.
+func NewMergeJoinCache(joinFn *joinFunc, a *execute.Allocator, names []string, spec *MergeJoinProcedureSpec) *mergeJoinCache {
+	method := spec.Method
+	if method == "" {
+		method = JoinMethodInner
+	}
 	return &mergeJoinCache{
 		data:      make(map[execute.BlockKey]*joinTables),
 		joinFn:    joinFn,
 		alloc:     a,
-		leftName:  leftName,
-		rightName: rightName,
+		names:     names,
+		method:    method,
+		tolerance: execute.Duration(spec.Tolerance.Fixed),
+		keys:      spec.On,
+		except:    spec.Except,
+		many:      spec.Many,
+		include:   spec.Include,
 	}
 }
 
@@ -412,15 +706,21 @@ func (c *mergeJoinCache) Tables(bm execute.BlockMetadata) *joinTables {
 			tags:      bm.Tags(),
 			bounds:    bm.Bounds(),
 			alloc:     c.alloc,
-			left:      execute.NewColListBlockBuilder(c.alloc),
-			right:     execute.NewColListBlockBuilder(c.alloc),
-			leftName:  c.leftName,
-			rightName: c.rightName,
+			tables:    make([]*execute.ColListBlockBuilder, len(c.names)),
+			names:     c.names,
+			method:    c.method,
+			tolerance: c.tolerance,
+			keys:      c.keys,
+			except:    c.except,
+			many:      c.many,
+			include:   c.include,
 			trigger:   execute.NewTriggerFromSpec(c.triggerSpec),
 			joinFn:    c.joinFn,
 		}
-		tables.left.AddCol(execute.TimeCol)
-		tables.right.AddCol(execute.TimeCol)
+		for i := range tables.tables {
+			tables.tables[i] = execute.NewColListBlockBuilder(c.alloc)
+			tables.tables[i].AddCol(execute.TimeCol)
+		}
 		c.data[key] = tables
 	}
 	return tables
@@ -432,8 +732,17 @@ type joinTables struct {
 
 	alloc *execute.Allocator
 
-	left, right         *execute.ColListBlockBuilder
-	leftName, rightName string
+	// tables are the tables to join in join order, names are their names.
+	tables []*execute.ColListBlockBuilder
+	names  []string
+
+	method    string
+	tolerance execute.Duration
+
+	// keys and except are the join keys, many and include describe a many-to-one join.
+	keys, except []string
+	many         string
+	include      []string
 
 	trigger execute.Trigger
 
@@ -447,23 +756,44 @@ func (t *joinTables) Tags() execute.Tags {
 	return t.tags
 }
 func (t *joinTables) Size() int {
-	return t.left.NRows() + t.right.NRows()
+	n := 0
+	for _, table := range t.tables {
+		n += table.NRows()
+	}
+	return n
 }
 
 func (t *joinTables) ClearData() {
-	t.left = execute.NewColListBlockBuilder(t.alloc)
-	t.right = execute.NewColListBlockBuilder(t.alloc)
+	for i := range t.tables {
+		t.tables[i] = execute.NewColListBlockBuilder(t.alloc)
+	}
 }
 
-// Join performs a sort-merge join
+// Join performs a sort-merge join of the tables.
+// Rows of the equality join methods match when they have the same time and tags,
+// rows of the asof join match the latest row of each other table with the same tags that is not later.
 func (t *joinTables) Join() (execute.Block, error) {
+	// A table that has not received any blocks has no columns, give it the columns of the other tables
+	// so the join function can be prepared. The inner join of an empty table is empty.
+	for _, table := range t.tables {
+		if table.NRows() > 0 {
+			continue
+		}
+		for _, other := range t.tables {
+			if other.NRows() > 0 {
+				addMissingCols(table, other.Cols())
+			}
+		}
+	}
+
 	// First prepare the join function
-	left := t.left.RawBlock()
-	right := t.right.RawBlock()
-	err := t.joinFn.Prepare(map[string]*execute.ColListBlock{
-		t.leftName:  left,
-		t.rightName: right,
-	})
+	raws := make([]*execute.ColListBlock, len(t.tables))
+	data := make(map[string]*execute.ColListBlock, len(t.tables))
+	for i, table := range t.tables {
+		raws[i] = table.RawBlock()
+		data[t.names[i]] = raws[i]
+	}
+	err := t.joinFn.Prepare(data)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to prepare join function")
 	}
@@ -475,7 +805,11 @@ func (t *joinTables) Join() (execute.Block, error) {
 	// Add new value columns in sorted order
 	properties := t.joinFn.Type().Properties()
 	keys := make([]string, 0, len(properties))
-	for k := range properties {
+	for k, typ := range properties {
+		if typ.Kind() == semantic.Nil {
+			// A column that is only ever null has no type, it is left out.
+			continue
+		}
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
@@ -490,77 +824,286 @@ func (t *joinTables) Join() (execute.Block, error) {
 	// Add common tags
 	execute.AddTags(t.tags, builder)
 
-	// Add non common tags
-	cols := t.left.Cols()
-	for _, c := range cols {
-		if c.IsTag() && !c.Common {
-			builder.AddCol(c)
+	// Add non common tags of any of the tables, in sorted order.
+	// The tags that are not join keys are the tags kept by a many-to-one join.
+	var tagLabels, keptLabels []string
+	for _, table := range t.tables {
+		for _, c := range table.Cols() {
+			if c.IsTag() && !c.Common && execute.ColIdx(c.Label, builder.Cols()) < 0 {
+				builder.AddCol(c)
+				if isJoinKey(c.Label, t.keys, t.except) {
+					tagLabels = append(tagLabels, c.Label)
+				} else {
+					keptLabels = append(keptLabels, c.Label)
+				}
+			}
 		}
 	}
+	sort.Strings(tagLabels)
+	sort.Strings(keptLabels)
+
+	// Sort the joining tables by time and then by the tags in the same order the join keys are compared.
+	sortOrder := append([]string{execute.TimeColLabel}, tagLabels...)
+	sortOrder = append(sortOrder, keptLabels...)
+	for _, table := range t.tables {
+		table.Sort(sortOrder, false)
+	}
 
-	// Now that all columns have been added, keep a reference.
-	bCols := builder.Cols()
-
-	// Determine sort order for the joining tables
-	sortOrder := make([]string, len(cols))
-	for i, c := range cols {
-		sortOrder[i] = c.Label
-	}
-	t.left.Sort(sortOrder, false)
-	t.right.Sort(sortOrder, false)
-
-	var (
-		leftSet, rightSet subset
-		leftKey, rightKey joinKey
-	)
-
-	rows := map[string]int{
-		t.leftName:  -1,
-		t.rightName: -1,
-	}
-
-	leftSet, leftKey = t.advance(leftSet.Stop, left)
-	rightSet, rightKey = t.advance(rightSet.Stop, right)
-	for !leftSet.Empty() && !rightSet.Empty() {
-		if leftKey.Equal(rightKey) {
-			// Inner join
-			for l := leftSet.Start; l < leftSet.Stop; l++ {
-				for r := rightSet.Start; r < rightSet.Stop; r++ {
-					// Evaluate expression and add to block
-					rows[t.leftName] = l
-					rows[t.rightName] = r
-					m, err := t.joinFn.Eval(rows)
-					if err != nil {
-						return nil, errors.Wrap(err, "failed to evaluate join function")
-					}
-					for j, c := range bCols {
-						switch c.Kind {
-						case execute.TimeColKind:
-							builder.AppendTime(j, leftKey.Time)
-						case execute.TagColKind:
-							if c.Common {
-								continue
-							}
-
-							builder.AppendString(j, leftKey.Tags[c.Label])
-						case execute.ValueColKind:
-							v := m.Get(c.Label)
-							execute.AppendValue(builder, j, v)
-						default:
-							log.Printf("unexpected column %v", c)
-						}
-					}
-				}
+	j := &joiner{
+		joinFn:    t.joinFn,
+		builder:   builder,
+		cols:      builder.Cols(),
+		tagLabels: tagLabels,
+		kept:      t.keptTags(keptLabels, raws),
+		rows:      make(map[string]int, len(t.tables)),
+	}
+	if t.method == JoinMethodAsOf {
+		err = t.joinAsOf(j, raws)
+	} else {
+		err = t.joinEqual(j, raws)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return builder.Block()
+}
+
+// keptTags returns the tables that the kept tags of a many-to-one join are copied from.
+// The included tags are copied from the other tables, the rest from the many table.
+func (t *joinTables) keptTags(labels []string, raws []*execute.ColListBlock) map[string][]tableTag {
+	kept := make(map[string][]tableTag, len(labels))
+	for _, l := range labels {
+		included := false
+		for _, il := range t.include {
+			if l == il {
+				included = true
+				break
+			}
+		}
+		for i, raw := range raws {
+			if (t.names[i] == t.many) == included {
+				continue
+			}
+			if j := execute.ColIdx(l, raw.Cols()); j >= 0 {
+				kept[l] = append(kept[l], tableTag{table: t.names[i], data: raw, j: j})
 			}
-			leftSet, leftKey = t.advance(leftSet.Stop, left)
-			rightSet, rightKey = t.advance(rightSet.Stop, right)
-		} else if leftKey.Less(rightKey) {
-			leftSet, leftKey = t.advance(leftSet.Stop, left)
-		} else {
-			rightSet, rightKey = t.advance(rightSet.Stop, right)
 		}
 	}
-	return builder.Block()
+	return kept
+}
+
+// joinEqual joins the rows of the tables with equal join keys.
+// Tables that have no rows for a key are null in the rows of the outer join methods.
+func (t *joinTables) joinEqual(j *joiner, raws []*execute.ColListBlock) error {
+	sets := make([]subset, len(raws))
+	keys := make([]joinKey, len(raws))
+	for i, raw := range raws {
+		sets[i], keys[i] = t.advance(0, raw)
+	}
+
+	matched := make([]bool, len(raws))
+	for {
+		// Find the least key of the tables that still have rows.
+		min := -1
+		for i := range sets {
+			if !sets[i].Empty() && (min < 0 || keys[i].Less(keys[min], j.tagLabels)) {
+				min = i
+			}
+		}
+		if min < 0 {
+			return nil
+		}
+		key := keys[min]
+		for i := range sets {
+			matched[i] = !sets[i].Empty() && keys[i].Equal(key)
+		}
+		if t.keep(matched) {
+			if err := t.checkOne(sets); err != nil {
+				return err
+			}
+			if err := j.product(key, t.names, sets, matched, 0); err != nil {
+				return err
+			}
+		}
+		for i, raw := range raws {
+			if matched[i] {
+				sets[i], keys[i] = t.advance(sets[i].Stop, raw)
+			}
+		}
+	}
+}
+
+// checkOne checks that the tables of a many-to-one join other than the many table have at most one row for a key.
+func (t *joinTables) checkOne(sets []subset) error {
+	if t.many == "" {
+		return nil
+	}
+	for i, s := range sets {
+		if t.names[i] != t.many && s.Stop-s.Start > 1 {
+			return fmt.Errorf("many-to-one join found several rows of table %q with the same time and join keys", t.names[i])
+		}
+	}
+	return nil
+}
+
+// keep reports whether the rows for a key are part of the join given the tables that have rows for the key.
+func (t *joinTables) keep(matched []bool) bool {
+	switch t.method {
+	case JoinMethodLeft:
+		return matched[0]
+	case JoinMethodRight:
+		return matched[len(matched)-1]
+	case JoinMethodFull:
+		return true
+	default:
+		for _, m := range matched {
+			if !m {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// joinAsOf joins each row of the first table with the latest row of each other table that has the same tags
+// and a time that is not later and within the tolerance. Tables without such a row are null.
+func (t *joinTables) joinAsOf(j *joiner, raws []*execute.ColListBlock) error {
+	// Index the rows of the other tables by their tags, the tables are sorted by time so the rows are too.
+	indexes := make([]map[string][]int, len(raws))
+	for i, raw := range raws[1:] {
+		index := make(map[string][]int)
+		for r := 0; r < raw.NRows(); r++ {
+			k := t.rowKey(r, raw).tagKey(j.tagLabels)
+			index[k] = append(index[k], r)
+		}
+		indexes[i+1] = index
+	}
+
+	left := raws[0]
+	for r := 0; r < left.NRows(); r++ {
+		key := t.rowKey(r, left)
+		k := key.tagKey(j.tagLabels)
+		j.rows[t.names[0]] = r
+		for i := 1; i < len(raws); i++ {
+			j.rows[t.names[i]] = -1
+			raw := raws[i]
+			timeIdx := execute.TimeIdx(raw.Cols())
+			rows := indexes[i][k]
+			// Find the first row that is later, the row before it is the latest row that is not later.
+			n := sort.Search(len(rows), func(x int) bool {
+				return raw.AtTime(rows[x], timeIdx) > key.Time
+			})
+			if n == 0 {
+				continue
+			}
+			match := rows[n-1]
+			if t.tolerance == 0 || key.Time-raw.AtTime(match, timeIdx) <= execute.Time(t.tolerance) {
+				j.rows[t.names[i]] = match
+			}
+		}
+		if err := j.append(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// joiner appends the joined rows to the builder of the result.
+type joiner struct {
+	joinFn  *joinFunc
+	builder execute.BlockBuilder
+	// cols are the columns of the result.
+	cols      []execute.ColMeta
+	tagLabels []string
+	// kept are the tables that the tags that are not join keys are copied from, in order.
+	kept map[string][]tableTag
+
+	// rows are the rows of each table to join, -1 if the table has no row.
+	rows map[string]int
+}
+
+// tableTag is the column of a tag in a table to join.
+type tableTag struct {
+	table string
+	data  *execute.ColListBlock
+	j     int
+}
+
+// product appends the join of every combination of the matched rows of the tables, starting at table i.
+func (j *joiner) product(key joinKey, names []string, sets []subset, matched []bool, i int) error {
+	if i == len(sets) {
+		return j.append(key)
+	}
+	if !matched[i] {
+		j.rows[names[i]] = -1
+		return j.product(key, names, sets, matched, i+1)
+	}
+	for r := sets[i].Start; r < sets[i].Stop; r++ {
+		j.rows[names[i]] = r
+		if err := j.product(key, names, sets, matched, i+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// append evaluates the join function for the current rows and appends the result with the time and tags of key.
+func (j *joiner) append(key joinKey) error {
+	m, err := j.joinFn.Eval(j.rows)
+	if err != nil {
+		return errors.Wrap(err, "failed to evaluate join function")
+	}
+	for c, col := range j.cols {
+		switch col.Kind {
+		case execute.TimeColKind:
+			j.builder.AppendTime(c, key.Time)
+		case execute.TagColKind:
+			if col.Common {
+				continue
+			}
+
+			if v, ok := key.Tags[col.Label]; ok {
+				j.builder.AppendString(c, v)
+			} else if v, ok := j.keptTag(col.Label); ok {
+				j.builder.AppendString(c, v)
+			} else {
+				j.builder.AppendNil(c)
+			}
+		case execute.ValueColKind:
+			v := m.Get(col.Label)
+			execute.AppendValue(j.builder, c, v)
+		default:
+			log.Printf("unexpected column %v", col)
+		}
+	}
+	return nil
+}
+
+// keptTag returns the value of a tag that is not a join key from the first table that has it for the current rows.
+func (j *joiner) keptTag(label string) (string, bool) {
+	for _, tt := range j.kept[label] {
+		if i := j.rows[tt.table]; i >= 0 && !tt.data.IsNull(i, tt.j) {
+			return tt.data.AtString(i, tt.j), true
+		}
+	}
+	return "", false
+}
+
+// addMissingCols adds the columns to the builder that it does not already have.
+func addMissingCols(builder execute.BlockBuilder, cols []execute.ColMeta) {
+	existing := builder.Cols()
+	for _, c := range cols {
+		found := false
+		for _, ec := range existing {
+			if c.Label == ec.Label {
+				found = true
+				break
+			}
+		}
+		if !found {
+			builder.AddCol(c)
+		}
+	}
 }
 
 func (t *joinTables) advance(offset int, table *execute.ColListBlock) (subset, joinKey) {
@@ -568,10 +1111,10 @@ func (t *joinTables) advance(offset int, table *execute.ColListBlock) (subset, j
 		return subset{Start: n, Stop: n}, joinKey{}
 	}
 	start := offset
-	key := rowKey(start, table)
+	key := t.rowKey(start, table)
 	s := subset{Start: start}
 	offset++
-	for offset < table.NRows() && equalRowKeys(start, offset, table) {
+	for offset < table.NRows() && t.equalRowKeys(start, offset, table) {
 		offset++
 	}
 	s.Stop = offset
@@ -587,27 +1130,31 @@ func (s subset) Empty() bool {
 	return s.Start == s.Stop
 }
 
-func rowKey(i int, table *execute.ColListBlock) (k joinKey) {
+// rowKey returns the time and the join keys of row i of the table.
+func (t *joinTables) rowKey(i int, table *execute.ColListBlock) (k joinKey) {
 	k.Tags = make(map[string]string)
 	for j, c := range table.Cols() {
 		switch c.Kind {
 		case execute.TimeColKind:
 			k.Time = table.AtTime(i, j)
 		case execute.TagColKind:
-			k.Tags[c.Label] = table.AtString(i, j)
+			// Null tags are left out of the key.
+			if isJoinKey(c.Label, t.keys, t.except) && !table.IsNull(i, j) {
+				k.Tags[c.Label] = table.AtString(i, j)
+			}
 		}
 	}
 	return
 }
 
-func equalRowKeys(x, y int, table *execute.ColListBlock) bool {
+func (t *joinTables) equalRowKeys(x, y int, table *execute.ColListBlock) bool {
 	for j, c := range table.Cols() {
 		if c.Label == execute.TimeColLabel {
 			if table.AtTime(x, j) != table.AtTime(y, j) {
 				return false
 			}
-		} else if c.IsTag() {
-			if table.AtString(x, j) != table.AtString(y, j) {
+		} else if c.IsTag() && isJoinKey(c.Label, t.keys, t.except) {
+			if table.IsNull(x, j) != table.IsNull(y, j) || table.AtString(x, j) != table.AtString(y, j) {
 				return false
 			}
 		}
@@ -622,8 +1169,11 @@ type joinKey struct {
 
 func (k joinKey) Equal(o joinKey) bool {
 	if k.Time == o.Time {
-		for t := range k.Tags {
-			if k.Tags[t] != o.Tags[t] {
+		if len(k.Tags) != len(o.Tags) {
+			return false
+		}
+		for t, v := range k.Tags {
+			if ov, ok := o.Tags[t]; !ok || v != ov {
 				return false
 			}
 		}
@@ -631,15 +1181,39 @@ func (k joinKey) Equal(o joinKey) bool {
 	}
 	return false
 }
-func (k joinKey) Less(o joinKey) bool {
-	if k.Time == o.Time {
-		for t := range k.Tags {
-			if k.Tags[t] != o.Tags[t] {
-				return k.Tags[t] < o.Tags[t]
-			}
+
+// Less reports whether k sorts before o, comparing the time and then the tags in the order of labels.
+// Null tags sort first.
+func (k joinKey) Less(o joinKey, labels []string) bool {
+	if k.Time != o.Time {
+		return k.Time < o.Time
+	}
+	for _, l := range labels {
+		v, ok := k.Tags[l]
+		ov, ook := o.Tags[l]
+		if ok != ook {
+			return !ok
+		}
+		if v != ov {
+			return v < ov
 		}
 	}
-	return k.Time < o.Time
+	return false
+}
+
+// tagKey returns an encoding of the tags of the key in the order of labels.
+func (k joinKey) tagKey(labels []string) string {
+	var b strings.Builder
+	for _, l := range labels {
+		v, ok := k.Tags[l]
+		if !ok {
+			b.WriteByte(0)
+			continue
+		}
+		b.WriteByte(1)
+		fmt.Fprintf(&b, "%d:%s", len(v), v)
+	}
+	return b.String()
 }
 
 type joinFunc struct {
@@ -686,7 +1260,7 @@ func (f *joinFunc) Prepare(tables map[string]*execute.ColListBlock) error {
 	// Prepare types and recordcols
 	for tbl, b := range tables {
 		cols := b.Cols()
-		f.record.Set(tbl, compiler.NewObject())
+		obj := compiler.NewObject()
 		tblPropertyTypes := make(map[string]semantic.Type, len(f.references[tbl]))
 		for _, r := range f.references[tbl] {
 			found := false
@@ -694,6 +1268,8 @@ func (f *joinFunc) Prepare(tables map[string]*execute.ColListBlock) error {
 				if r == c.Label {
 					f.recordCols[tableCol{table: tbl, col: c.Label}] = j
 					tblPropertyTypes[r] = execute.ConvertToKind(c.Type)
+					// The record has the type of the column even when its value is null.
+					obj.SetPropertyType(r, tblPropertyTypes[r])
 					found = true
 					break
 				}
@@ -702,6 +1278,7 @@ func (f *joinFunc) Prepare(tables map[string]*execute.ColListBlock) error {
 				return fmt.Errorf("function references unknown column %q of table %q", r, tbl)
 			}
 		}
+		f.record.Set(tbl, obj)
 		propertyTypes[tbl] = semantic.NewObjectType(tblPropertyTypes)
 	}
 	// Compile fn for given types
@@ -734,7 +1311,13 @@ func (f *joinFunc) Eval(rows map[string]int) (*compiler.Object, error) {
 		data := f.tableData[tbl]
 		obj := f.record.Get(tbl).(*compiler.Object)
 		for _, r := range references {
-			obj.Set(r, readValue(row, f.recordCols[tableCol{table: tbl, col: r}], data))
+			j := f.recordCols[tableCol{table: tbl, col: r}]
+			if row < 0 || data.IsNull(row, j) {
+				// A null column, or a column of a table without a row, does not exist in the record.
+				obj.Unset(r)
+				continue
+			}
+			obj.Set(r, readValue(row, j, data))
 		}
 		f.record.Set(tbl, obj)
 	}
@@ -745,9 +1328,16 @@ func (f *joinFunc) Eval(rows map[string]int) (*compiler.Object, error) {
 		return nil, err
 	}
 	if f.isWrap {
+		if v.Type() == semantic.Nil {
+			f.wrapObj.Unset(execute.DefaultValueColLabel)
+			return f.wrapObj, nil
+		}
 		f.wrapObj.Set(execute.DefaultValueColLabel, v)
 		return f.wrapObj, nil
 	}
+	if v.Type() == semantic.Nil {
+		return nil, compiler.ErrNull
+	}
 	return v.Object(), nil
 }
 
@@ -200,6 +200,266 @@ join(tables:{a:a,b:b}, on:["host"], fn: (t) => t.a["_value"] + t.b["_value"])`,
 				},
 			},
 		},
+		{
+			Name: "asof join with tolerance",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, on:["host"], method:"asof", left:"a", tolerance:5s, fn: (t) => t.a["_value"] + t.b["_value"])`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "dbA",
+						},
+					},
+					{
+						ID: "from1",
+						Spec: &functions.FromOpSpec{
+							Database: "dbB",
+						},
+					},
+					{
+						ID: "join2",
+						Spec: &functions.JoinOpSpec{
+							On:         []string{"host"},
+							TableNames: map[query.OperationID]string{"from0": "a", "from1": "b"},
+							Method:     "asof",
+							Tolerance:  query.Duration{Fixed: 5 * time.Second},
+							Left:       "a",
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.AdditionOperator,
+									Left: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "t",
+											},
+											Property: "a",
+										},
+										Property: "_value",
+									},
+									Right: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "t",
+											},
+											Property: "b",
+										},
+										Property: "_value",
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "join2"},
+					{Parent: "from1", Child: "join2"},
+				},
+			},
+		},
+		{
+			Name: "unknown join method",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, method:"cross", fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "join except tags",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, except:["_measurement"], fn: (t) => t.a["_value"] + t.b["_value"])`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "dbA",
+						},
+					},
+					{
+						ID: "from1",
+						Spec: &functions.FromOpSpec{
+							Database: "dbB",
+						},
+					},
+					{
+						ID: "join2",
+						Spec: &functions.JoinOpSpec{
+							Except:     []string{"_measurement"},
+							TableNames: map[query.OperationID]string{"from0": "a", "from1": "b"},
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.AdditionOperator,
+									Left: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "t",
+											},
+											Property: "a",
+										},
+										Property: "_value",
+									},
+									Right: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "t",
+											},
+											Property: "b",
+										},
+										Property: "_value",
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "join2"},
+					{Parent: "from1", Child: "join2"},
+				},
+			},
+		},
+		{
+			Name: "join on and except",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, on:["host"], except:["_measurement"], fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "tolerance without asof",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, method:"left", left:"a", tolerance:5s, fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "left join without left table",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, method:"left", fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "left table not in tables",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, method:"left", left:"c", fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "left table with inner join",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, left:"a", fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "many-to-one join",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, on:["host"], many:"a", include:["version"], fn: (t) => t.a["_value"] + t.b["_value"])`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "dbA",
+						},
+					},
+					{
+						ID: "from1",
+						Spec: &functions.FromOpSpec{
+							Database: "dbB",
+						},
+					},
+					{
+						ID: "join2",
+						Spec: &functions.JoinOpSpec{
+							On:         []string{"host"},
+							TableNames: map[query.OperationID]string{"from0": "a", "from1": "b"},
+							Many:       "a",
+							Include:    []string{"version"},
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.AdditionOperator,
+									Left: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "t",
+											},
+											Property: "a",
+										},
+										Property: "_value",
+									},
+									Right: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "t",
+											},
+											Property: "b",
+										},
+										Property: "_value",
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "join2"},
+					{Parent: "from1", Child: "join2"},
+				},
+			},
+		},
+		{
+			Name: "many table not in tables",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, many:"c", fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "many table with left join",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, method:"left", left:"a", many:"a", fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "include without many table",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+join(tables:{a:a,b:b}, include:["version"], fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
+		{
+			Name: "right join of three tables",
+			Raw: `
+a = from(db:"dbA")
+b = from(db:"dbB")
+c = from(db:"dbC")
+join(tables:{a:a,b:b,c:c}, method:"right", left:"a", fn: (t) => t.a["_value"] + t.b["_value"])`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -328,16 +588,39 @@ func TestMergeJoin_Process(t *testing.T) {
 	}
 	parentID0 := plantest.RandomProcedureID()
 	parentID1 := plantest.RandomProcedureID()
+	parentID2 := plantest.RandomProcedureID()
 	tableNames := map[plan.ProcedureID]string{
 		parentID0: "a",
 		parentID1: "b",
 	}
+	threeTableNames := map[plan.ProcedureID]string{
+		parentID0: "a",
+		parentID1: "b",
+		parentID2: "c",
+	}
+	sumThreeFunction := &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+		Body: &semantic.BinaryExpression{
+			Operator: ast.AdditionOperator,
+			Left:     addFunction.Body.(*semantic.BinaryExpression),
+			Right: &semantic.MemberExpression{
+				Object: &semantic.MemberExpression{
+					Object: &semantic.IdentifierExpression{
+						Name: "t",
+					},
+					Property: "c",
+				},
+				Property: "_value",
+			},
+		},
+	}
 	testCases := []struct {
 		skip  bool
 		name  string
 		spec  *functions.MergeJoinProcedureSpec
 		data0 []*executetest.Block // data from parent 0
 		data1 []*executetest.Block // data from parent 1
+		data2 []*executetest.Block // data from parent 2
 		want  []*executetest.Block
 	}{
 		{
@@ -512,6 +795,41 @@ func TestMergeJoin_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "inner with missing table",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         addFunction,
+				TableNames: tableNames,
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+				},
+			},
+		},
 		{
 			name: "inner with multiple matches",
 			spec: &functions.MergeJoinProcedureSpec{
@@ -782,9 +1100,10 @@ func TestMergeJoin_Process(t *testing.T) {
 			},
 		},
 		{
-			name: "simple inner with multiple values",
+			name: "inner except tags",
 			spec: &functions.MergeJoinProcedureSpec{
-				Fn:         passThroughFunc,
+				Except:     []string{"_measurement"},
+				Fn:         addFunction,
 				TableNames: tableNames,
 			},
 			data0: []*executetest.Block{
@@ -796,11 +1115,15 @@ func TestMergeJoin_Process(t *testing.T) {
 					ColMeta: []execute.ColMeta{
 						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
 						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
 					},
 					Data: [][]interface{}{
-						{execute.Time(1), 1.0},
-						{execute.Time(2), 2.0},
-						{execute.Time(3), 3.0},
+						{execute.Time(1), 1.0, "errors", "a", "x"},
+						{execute.Time(1), 1.5, "errors", "a", "y"},
+						{execute.Time(2), 2.0, "errors", "a", "x"},
+						{execute.Time(2), 2.5, "errors", "a", "y"},
 					},
 				},
 			},
@@ -813,11 +1136,15 @@ func TestMergeJoin_Process(t *testing.T) {
 					ColMeta: []execute.ColMeta{
 						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
 						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
 					},
 					Data: [][]interface{}{
-						{execute.Time(1), 10.0},
-						{execute.Time(2), 20.0},
-						{execute.Time(3), 30.0},
+						{execute.Time(1), 10.0, "requests", "a", "x"},
+						{execute.Time(1), 10.1, "requests", "a", "y"},
+						{execute.Time(2), 20.0, "requests", "a", "x"},
+						{execute.Time(2), 20.1, "requests", "a", "y"},
 					},
 				},
 			},
@@ -829,22 +1156,156 @@ func TestMergeJoin_Process(t *testing.T) {
 					},
 					ColMeta: []execute.ColMeta{
 						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
-						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
-						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
 					},
 					Data: [][]interface{}{
-						{execute.Time(1), 1.0, 10.0},
-						{execute.Time(2), 2.0, 20.0},
-						{execute.Time(3), 3.0, 30.0},
+						{execute.Time(1), 11.0, "a", "x"},
+						{execute.Time(1), 11.6, "a", "y"},
+						{execute.Time(2), 22.0, "a", "x"},
+						{execute.Time(2), 22.6, "a", "y"},
 					},
 				},
 			},
 		},
 		{
-			name: "inner with multiple value, tags and extra attributes",
+			name: "many-to-one inner",
 			spec: &functions.MergeJoinProcedureSpec{
-				On:         []string{"t1", "t2"},
-				Fn:         passThroughFunc,
+				On:         []string{"t1"},
+				Many:       "a",
+				Include:    []string{"version"},
+				Fn:         addFunction,
+				TableNames: tableNames,
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+						{Label: "version", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "errors", "a", "x", "old"},
+						{execute.Time(1), 2.0, "errors", "a", "y", "old"},
+						{execute.Time(2), 3.0, "errors", "a", "x", "old"},
+						{execute.Time(2), 4.0, "errors", "a", "y", "old"},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "version", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "build", "a", "v1"},
+						{execute.Time(2), 20.0, "build", "a", "v1"},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+						{Label: "version", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 11.0, "a", "errors", "x", "v1"},
+						{execute.Time(1), 12.0, "a", "errors", "y", "v1"},
+						{execute.Time(2), 23.0, "a", "errors", "x", "v1"},
+						{execute.Time(2), 24.0, "a", "errors", "y", "v1"},
+					},
+				},
+			},
+		},
+		{
+			name: "simple inner with multiple values",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0},
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(3), 3.0, 30.0},
+					},
+				},
+			},
+		},
+		{
+			name: "inner with multiple value, tags and extra attributes",
+			spec: &functions.MergeJoinProcedureSpec{
+				On:         []string{"t1", "t2"},
+				Fn:         passThroughFunc,
 				TableNames: tableNames,
 			},
 			data0: []*executetest.Block{
@@ -878,16 +1339,363 @@ func TestMergeJoin_Process(t *testing.T) {
 					ColMeta: []execute.ColMeta{
 						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
 						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
-						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
-						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "a", "x"},
+						{execute.Time(1), 10.1, "a", "y"},
+						{execute.Time(2), 20.0, "a", "x"},
+						{execute.Time(2), 20.1, "a", "y"},
+						{execute.Time(3), 30.0, "a", "x"},
+						{execute.Time(3), 30.1, "a", "y"},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0, "a", "x"},
+						{execute.Time(1), 1.5, 10.1, "a", "y"},
+						{execute.Time(2), 2.0, 20.0, "a", "x"},
+						{execute.Time(2), 2.5, 20.1, "a", "y"},
+						{execute.Time(3), 3.0, 30.0, "a", "x"},
+						{execute.Time(3), 3.5, 30.1, "a", "y"},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				Method:     "left",
+				Left:       "a",
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0},
+						{execute.Time(2), 2.0, nil},
+						{execute.Time(3), 3.0, 30.0},
+					},
+				},
+			},
+		},
+		{
+			name: "right outer",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				Method:     "right",
+				Left:       "a",
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(3), nil, 30.0},
+					},
+				},
+			},
+		},
+		{
+			name: "right outer with the left table sorting last",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				Method:     "right",
+				Left:       "b",
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, nil},
+						{execute.Time(2), 2.0, 20.0},
+					},
+				},
+			},
+		},
+		{
+			name: "full outer with tags",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				On:         []string{"t1"},
+				Method:     "full",
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(1), 1.5, "b"},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "b"},
+						{execute.Time(2), 20.0, "a"},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, nil, "a"},
+						{execute.Time(1), 1.5, 10.0, "b"},
+						{execute.Time(2), nil, 20.0, "a"},
+					},
+				},
+			},
+		},
+		{
+			name: "full outer with missing table",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, nil},
+						{execute.Time(2), 2.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "asof with tolerance",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				Method:     "asof",
+				Left:       "a",
+				Tolerance:  query.Duration{Fixed: 2},
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5), 1.0},
+						{execute.Time(7), 2.0},
+						{execute.Time(9), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
 					},
 					Data: [][]interface{}{
-						{execute.Time(1), 10.0, "a", "x"},
-						{execute.Time(1), 10.1, "a", "y"},
-						{execute.Time(2), 20.0, "a", "x"},
-						{execute.Time(2), 20.1, "a", "y"},
-						{execute.Time(3), 30.0, "a", "x"},
-						{execute.Time(3), 30.1, "a", "y"},
+						{execute.Time(3), 10.0},
+						{execute.Time(6), 20.0},
+						{execute.Time(8), 30.0},
 					},
 				},
 			},
@@ -901,16 +1709,278 @@ func TestMergeJoin_Process(t *testing.T) {
 						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
 						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
 						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
-						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
-						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
 					},
 					Data: [][]interface{}{
-						{execute.Time(1), 1.0, 10.0, "a", "x"},
-						{execute.Time(1), 1.5, 10.1, "a", "y"},
-						{execute.Time(2), 2.0, 20.0, "a", "x"},
-						{execute.Time(2), 2.5, 20.1, "a", "y"},
-						{execute.Time(3), 3.0, 30.0, "a", "x"},
-						{execute.Time(3), 3.5, 30.1, "a", "y"},
+						{execute.Time(5), 1.0, 10.0},
+						{execute.Time(7), 2.0, 20.0},
+						{execute.Time(9), 3.0, 30.0},
+					},
+				},
+			},
+		},
+		{
+			name: "asof beyond tolerance",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				Method:     "asof",
+				Left:       "a",
+				Tolerance:  query.Duration{Fixed: 2},
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(5), 2.0},
+						{execute.Time(9), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 10.0},
+						{execute.Time(5), 20.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, nil},
+						{execute.Time(5), 2.0, 20.0},
+						{execute.Time(9), 3.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "asof with tags",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         passThroughFunc,
+				TableNames: tableNames,
+				On:         []string{"t1"},
+				Method:     "asof",
+				Left:       "a",
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5), 1.0, "a"},
+						{execute.Time(5), 2.0, "b"},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(4), 10.0, "a"},
+						{execute.Time(6), 20.0, "b"},
+						{execute.Time(1), 5.0, "b"},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "a", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "b", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5), 1.0, 10.0, "a"},
+						{execute.Time(5), 2.0, 5.0, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "three-way inner",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         sumThreeFunction,
+				TableNames: threeTableNames,
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(2), 20.0},
+					},
+				},
+			},
+			data2: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 200.0},
+						{execute.Time(3), 300.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 222.0},
+					},
+				},
+			},
+		},
+		{
+			name: "three-way left",
+			spec: &functions.MergeJoinProcedureSpec{
+				Fn:         sumThreeFunction,
+				TableNames: threeTableNames,
+				Method:     "left",
+				Left:       "a",
+			},
+			data0: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(2), 20.0},
+					},
+				},
+			},
+			data2: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 200.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  10,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil},
+						{execute.Time(2), 222.0},
 					},
 				},
 			},
@@ -922,11 +1992,23 @@ func TestMergeJoin_Process(t *testing.T) {
 			if tc.skip {
 				t.Skip()
 			}
-			parents := []execute.DatasetID{execute.DatasetID(parentID0), execute.DatasetID(parentID1)}
-
 			tableNames := make(map[execute.DatasetID]string, len(tc.spec.TableNames))
+			parents := make([]execute.DatasetID, 0, len(tc.spec.TableNames))
 			for pid, name := range tc.spec.TableNames {
 				tableNames[execute.DatasetID(pid)] = name
+				parents = append(parents, execute.DatasetID(pid))
+			}
+			// The left table is joined first, the other tables in the order of their names.
+			sort.Slice(parents, func(i, j int) bool {
+				ni, nj := tableNames[parents[i]], tableNames[parents[j]]
+				if ni == tc.spec.Left || nj == tc.spec.Left {
+					return ni == tc.spec.Left && nj != tc.spec.Left
+				}
+				return ni < nj
+			})
+			names := make([]string, len(parents))
+			for i, p := range parents {
+				names[i] = tableNames[p]
 			}
 
 			d := executetest.NewDataset(executetest.RandomDatasetID())
@@ -934,23 +2016,27 @@ func TestMergeJoin_Process(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			c := functions.NewMergeJoinCache(joinExpr, executetest.UnlimitedAllocator, tableNames[parents[0]], tableNames[parents[1]])
+			c := functions.NewMergeJoinCache(joinExpr, executetest.UnlimitedAllocator, names, tc.spec)
 			c.SetTriggerSpec(execute.DefaultTriggerSpec)
 			jt := functions.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
 
-			l := len(tc.data0)
-			if len(tc.data1) > l {
-				l = len(tc.data1)
+			data := map[execute.DatasetID][]*executetest.Block{
+				execute.DatasetID(parentID0): tc.data0,
+				execute.DatasetID(parentID1): tc.data1,
+				execute.DatasetID(parentID2): tc.data2,
 			}
-			for i := 0; i < l; i++ {
-				if i < len(tc.data0) {
-					if err := jt.Process(parents[0], tc.data0[i]); err != nil {
-						t.Fatal(err)
-					}
+			l := 0
+			for _, blocks := range data {
+				if len(blocks) > l {
+					l = len(blocks)
 				}
-				if i < len(tc.data1) {
-					if err := jt.Process(parents[1], tc.data1[i]); err != nil {
-						t.Fatal(err)
+			}
+			for i := 0; i < l; i++ {
+				for _, p := range parents {
+					if i < len(data[p]) {
+						if err := jt.Process(p, data[p][i]); err != nil {
+							t.Fatal(err)
+						}
 					}
 				}
 			}
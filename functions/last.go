@@ -55,6 +55,18 @@ func (s *LastOpSpec) Kind() query.OperationKind {
 	return LastKind
 }
 
+// DecompileArguments returns the arguments of the last call that creates the spec.
+func (s *LastOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Column != "" {
+		args = append(args, query.DecompiledArgument{Key: "column", Value: s.Column})
+	}
+	if s.UseRowTime {
+		args = append(args, query.DecompiledArgument{Key: "useRowTime", Value: true})
+	}
+	return args
+}
+
 type LastProcedureSpec struct {
 	Column     string
 	UseRowTime bool
@@ -76,17 +88,44 @@ func (s *LastProcedureSpec) Kind() plan.ProcedureKind {
 }
 
 func (s *LastProcedureSpec) PushDownRules() []plan.PushDownRule {
-	return []plan.PushDownRule{{
+	var rules []plan.PushDownRule
+	if rule, ok := selectorAggregateRule(LastKind, s.Column, s.UseRowTime); ok {
+		rules = append(rules, rule)
+	}
+	// Without the aggregate the storage layer reads only the last point of each series.
+	return append(rules, plan.PushDownRule{
 		Root:    FromKind,
 		Through: []plan.ProcedureKind{GroupKind, LimitKind, FilterKind},
 		Match: func(spec plan.ProcedureSpec) bool {
 			selectSpec := spec.(*FromProcedureSpec)
 			return !selectSpec.AggregateSet
 		},
-	}}
+		PushDown: s.pushDownLimit,
+	})
 }
 
 func (s *LastProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Procedure) {
+	selectSpec := root.Spec.(*FromProcedureSpec)
+	if selectSpec.AggregateSet {
+		root = dup()
+		selectSpec = root.Spec.(*FromProcedureSpec)
+		selectSpec.AggregateSet = false
+		selectSpec.AggregateMethod = ""
+		return
+	}
+	if !selectSpec.BoundsSet {
+		// Read all data when no bounds have been set.
+		selectSpec.BoundsSet = true
+		selectSpec.Bounds = plan.BoundsSpec{
+			Start: query.MinTime,
+			Stop:  query.Now,
+		}
+	}
+	selectSpec.AggregateSet = true
+	selectSpec.AggregateMethod = LastKind
+}
+
+func (s *LastProcedureSpec) pushDownLimit(root *plan.Procedure, dup func() *plan.Procedure) {
 	selectSpec := root.Spec.(*FromProcedureSpec)
 	if selectSpec.BoundsSet || selectSpec.LimitSet || selectSpec.DescendingSet {
 		root = dup()
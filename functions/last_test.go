@@ -2,6 +2,7 @@ package functions_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
@@ -78,16 +79,108 @@ func TestLast_PushDown_Match(t *testing.T) {
 	spec := new(functions.LastProcedureSpec)
 	from := new(functions.FromProcedureSpec)
 
-	// Should not match when an aggregate is set
+	// Should push down the aggregate only when storage has the capability
+	if got, want := spec.PushDownRules()[0].Capability, plan.AggregateCapability(functions.LastKind); got != want {
+		t.Errorf("unexpected capability of the aggregate rule, got: %q want: %q", got, want)
+	}
+
+	// Should not match the aggregate when grouping is set
+	from.GroupingSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false, true})
+
+	// Should not match the aggregate when a limit is set
+	from.GroupingSet = false
+	from.LimitSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false, true})
+
+	// Should not match the limit when an aggregate is set
+	from.LimitSet = false
 	from.AggregateSet = true
-	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{true, false})
 
-	// Should match when no aggregate is set
+	// Should match when no grouping, limit or aggregate is set
 	from.AggregateSet = false
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{true, true})
+
+	// Should only push down the limit when selecting a different column
+	spec.Column = "other"
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{true})
+	if c := spec.PushDownRules()[0].Capability; c != "" {
+		t.Errorf("unexpected capability %q for column %q", c, spec.Column)
+	}
+
+	// Should only push down the limit when the time of the row is used
+	spec.Column = ""
+	spec.UseRowTime = true
 	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{true})
+	if c := spec.PushDownRules()[0].Capability; c != "" {
+		t.Errorf("unexpected capability %q when using the row time", c)
+	}
 }
 
 func TestLast_PushDown(t *testing.T) {
+	spec := new(functions.LastProcedureSpec)
+	root := &plan.Procedure{
+		Spec: new(functions.FromProcedureSpec),
+	}
+	want := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			BoundsSet: true,
+			Bounds: plan.BoundsSpec{
+				Start: query.MinTime,
+				Stop:  query.Now,
+			},
+			AggregateSet:    true,
+			AggregateMethod: functions.LastKind,
+		},
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
+}
+
+func TestLast_PushDown_Bounded(t *testing.T) {
+	spec := new(functions.LastProcedureSpec)
+	bounds := plan.BoundsSpec{
+		Start: query.Time{
+			IsRelative: true,
+			Relative:   -1 * time.Hour,
+		},
+	}
+	root := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			BoundsSet: true,
+			Bounds:    bounds,
+		},
+	}
+	want := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			BoundsSet:       true,
+			Bounds:          bounds,
+			AggregateSet:    true,
+			AggregateMethod: functions.LastKind,
+		},
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
+}
+
+func TestLast_PushDown_Duplicate(t *testing.T) {
+	spec := new(functions.LastProcedureSpec)
+	root := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			AggregateSet:    true,
+			AggregateMethod: functions.LastKind,
+		},
+	}
+	want := &plan.Procedure{
+		// Expect the duplicate has been reset to zero values
+		Spec: new(functions.FromProcedureSpec),
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, true, want)
+}
+
+func TestLast_PushDown_Limit(t *testing.T) {
 	spec := new(functions.LastProcedureSpec)
 	root := &plan.Procedure{
 		Spec: new(functions.FromProcedureSpec),
@@ -106,9 +199,10 @@ func TestLast_PushDown(t *testing.T) {
 		},
 	}
 
-	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
+	plantest.PhysicalPlan_PushDownRule_TestHelper(t, spec, 1, root, false, want)
 }
-func TestLast_PushDown_Duplicate(t *testing.T) {
+
+func TestLast_PushDown_Limit_Duplicate(t *testing.T) {
 	spec := new(functions.LastProcedureSpec)
 	root := &plan.Procedure{
 		Spec: &functions.FromProcedureSpec{
@@ -128,5 +222,5 @@ func TestLast_PushDown_Duplicate(t *testing.T) {
 		Spec: new(functions.FromProcedureSpec),
 	}
 
-	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, true, want)
+	plantest.PhysicalPlan_PushDownRule_TestHelper(t, spec, 1, root, true, want)
 }
@@ -21,7 +21,7 @@ type LimitOpSpec struct {
 var limitSignature = query.DefaultFunctionSignature()
 
 func init() {
-	integralSignature.Params["n"] = semantic.Int
+	limitSignature.Params["n"] = semantic.Int
 
 	query.RegisterFunction(LimitKind, createLimitOpSpec, limitSignature)
 	query.RegisterOpSpec(LimitKind, newLimitOp)
@@ -54,6 +54,11 @@ func (s *LimitOpSpec) Kind() query.OperationKind {
 	return LimitKind
 }
 
+// DecompileArguments returns the arguments of the limit call that creates the spec.
+func (s *LimitOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "n", Value: s.N}}
+}
+
 type LimitProcedureSpec struct {
 	N int64 `json:"n"`
 	//Offset int64 `json:"offset"`
@@ -169,6 +174,10 @@ func (t *limitTransformation) Process(id execute.DatasetID, b execute.Block) err
 				continue
 			}
 			for i := range ts[:l] {
+				if rr.IsNull(i, t.colMap[j]) {
+					builder.AppendNil(j)
+					continue
+				}
 				switch c.Type {
 				case execute.TBool:
 					builder.AppendBool(j, rr.AtBool(i, t.colMap[j]))
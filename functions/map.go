@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/influxdata/ifql/compiler"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/query/plan"
@@ -19,7 +20,8 @@ type MapOpSpec struct {
 var mapSignature = query.DefaultFunctionSignature()
 
 func init() {
-	mapSignature.Params["fn"] = semantic.Function
+	mapSignature.Params["fn"] = execute.RowMapType
+	mapSignature.ColumnsArgument = "fn"
 
 	query.RegisterFunction(MapKind, createMapOpSpec, mapSignature)
 	query.RegisterOpSpec(MapKind, newMapOp)
@@ -53,6 +55,11 @@ func (s *MapOpSpec) Kind() query.OperationKind {
 	return MapKind
 }
 
+// DecompileArguments returns the arguments of the map call that creates the spec.
+func (s *MapOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "fn", Value: s.Fn}}
+}
+
 type MapProcedureSpec struct {
 	Fn *semantic.FunctionExpression
 }
@@ -143,9 +150,17 @@ func (t *mapTransformation) Process(id execute.DatasetID, b execute.Block) error
 	mapType := t.fn.Type()
 	// Add new value columns
 	for k, t := range mapType.Properties() {
+		if t.Kind() == semantic.Nil {
+			// A column that is only ever null has no type, it is left out.
+			continue
+		}
+		typ := execute.ConvertFromKind(t.Kind())
+		if typ == execute.TInvalid {
+			return fmt.Errorf("map column %q has unsupported type %v", k, t)
+		}
 		builder.AddCol(execute.ColMeta{
 			Label: k,
-			Type:  execute.ConvertFromKind(t.Kind()),
+			Type:  typ,
 			Kind:  execute.ValueColKind,
 		})
 	}
@@ -154,10 +169,13 @@ func (t *mapTransformation) Process(id execute.DatasetID, b execute.Block) error
 	// Append modified rows
 	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
 		for i := range ts {
-			m, err := t.fn.Eval(i, rr)
 			if err != nil {
-				log.Printf("failed to evaluate map expression: %v", err)
-				continue
+				return
+			}
+			var m *compiler.Object
+			m, err = t.fn.Eval(i, rr)
+			if err != nil {
+				return
 			}
 			for j, c := range bCols {
 				if c.Common {
@@ -168,6 +186,10 @@ func (t *mapTransformation) Process(id execute.DatasetID, b execute.Block) error
 				case execute.TimeColKind:
 					builder.AppendTime(j, rr.AtTime(i, colMap[j]))
 				case execute.TagColKind:
+					if rr.IsNull(i, colMap[j]) {
+						builder.AppendNil(j)
+						continue
+					}
 					builder.AppendString(j, rr.AtString(i, colMap[j]))
 				case execute.ValueColKind:
 					v := m.Get(c.Label)
@@ -178,6 +200,9 @@ func (t *mapTransformation) Process(id execute.DatasetID, b execute.Block) error
 			}
 		}
 	})
+	if err != nil {
+		return fmt.Errorf("failed to evaluate map expression: %v", err)
+	}
 	return nil
 }
 
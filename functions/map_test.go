@@ -1,11 +1,15 @@
 package functions_test
 
 import (
+	"context"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/control"
 	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/query/execute/executetest"
 	"github.com/influxdata/ifql/query/querytest"
@@ -84,6 +88,16 @@ func TestMap_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:    "duration column",
+			Raw:     `from(db:"mydb") |> map(fn: (r) => ({d: r._time - r._time}))`,
+			WantErr: true,
+		},
+		{
+			Name:    "duration value",
+			Raw:     `from(db:"mydb") |> map(fn: (r) => 1h)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -196,6 +210,54 @@ func TestMap_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: `_value+5 with null`,
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.BinaryExpression{
+						Operator: ast.AdditionOperator,
+						Left: &semantic.MemberExpression{
+							Object: &semantic.IdentifierExpression{
+								Name: "r",
+							},
+							Property: "_value",
+						},
+						Right: &semantic.FloatLiteral{
+							Value: 5,
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 6.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 11.0},
+				},
+			}},
+		},
 		{
 			name: `_value*_value`,
 			spec: &functions.MapProcedureSpec{
@@ -247,6 +309,91 @@ func TestMap_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: `if _value > 5 then "high" else "low"`,
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ConditionalExpression{
+						Test: &semantic.BinaryExpression{
+							Operator: ast.GreaterThanOperator,
+							Left: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_value",
+							},
+							Right: &semantic.FloatLiteral{Value: 5},
+						},
+						Consequent: &semantic.StringLiteral{Value: "high"},
+						Alternate:  &semantic.StringLiteral{Value: "low"},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 6.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TString, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "low"},
+					{execute.Time(2), "high"},
+				},
+			}},
+		},
+		{
+			name: `hour(t: r._time, location: "America/New_York")`,
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body:   hourCall("America/New_York"),
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  execute.Time(24 * time.Hour),
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(9 * time.Hour), 1.0},
+					{execute.Time(15 * time.Hour), 6.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  execute.Time(24 * time.Hour),
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(9 * time.Hour), int64(4)},
+					{execute.Time(15 * time.Hour), int64(10)},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -266,3 +413,81 @@ func TestMap_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestMap_Process_DurationColumn(t *testing.T) {
+	spec := &functions.MapProcedureSpec{
+		Fn: &semantic.FunctionExpression{
+			Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+			Body: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{{
+					Key: &semantic.Identifier{Name: "d"},
+					Value: &semantic.BinaryExpression{
+						Operator: ast.SubtractionOperator,
+						Left: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_time",
+						},
+						Right: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_time",
+						},
+					},
+				}},
+			},
+		},
+	}
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewBlockBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(execute.DefaultTriggerSpec)
+	m, err := functions.NewMapTransformation(d, c, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &executetest.Block{
+		Bnds: execute.Bounds{
+			Start: 1,
+			Stop:  3,
+		},
+		ColMeta: []execute.ColMeta{
+			{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+			{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0},
+			{execute.Time(2), 6.0},
+		},
+	}
+	// A duration cannot be stored in a column, the block fails instead of panicking.
+	if err := m.Process(executetest.RandomDatasetID(), b); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMap_Execute_BuiltinError(t *testing.T) {
+	s := execute.NewMemoryStorageReader()
+	if err := s.WriteLineProtocol("test", "cpu,host=a usage=1 0"); err != nil {
+		t.Fatal(err)
+	}
+	c := control.New(control.Config{
+		ConcurrencyQuota: 1,
+		MemoryBytesQuota: math.MaxInt64,
+		ExecutorConfig:   execute.Config{StorageReader: s},
+	})
+	q, err := c.QueryWithCompile(context.Background(), `from(db:"test") |> range(start:1970-01-01T00:00:00Z) |> map(fn:(r) => int(v:"abc"))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	// The failed conversion fails the query instead of dropping the rows.
+	results, ok := <-q.Ready
+	if !ok {
+		return
+	}
+	for _, r := range results {
+		err = r.Blocks().Do(func(b execute.Block) error { return nil })
+	}
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -56,6 +56,18 @@ func (s *MaxOpSpec) Kind() query.OperationKind {
 	return MaxKind
 }
 
+// DecompileArguments returns the arguments of the max call that creates the spec.
+func (s *MaxOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Column != "" {
+		args = append(args, query.DecompiledArgument{Key: "column", Value: s.Column})
+	}
+	if s.UseRowTime {
+		args = append(args, query.DecompiledArgument{Key: "useRowTime", Value: true})
+	}
+	return args
+}
+
 type MaxProcedureSpec struct {
 	Column     string
 	UseRowTime bool
@@ -82,6 +94,27 @@ func (s *MaxProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+func (s *MaxProcedureSpec) PushDownRules() []plan.PushDownRule {
+	rule, ok := selectorAggregateRule(MaxKind, s.Column, s.UseRowTime)
+	if !ok {
+		return nil
+	}
+	return []plan.PushDownRule{rule}
+}
+
+func (s *MaxProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Procedure) {
+	selectSpec := root.Spec.(*FromProcedureSpec)
+	if selectSpec.AggregateSet {
+		root = dup()
+		selectSpec = root.Spec.(*FromProcedureSpec)
+		selectSpec.AggregateSet = false
+		selectSpec.AggregateMethod = ""
+		return
+	}
+	selectSpec.AggregateSet = true
+	selectSpec.AggregateMethod = MaxKind
+}
+
 type MaxSelector struct {
 	set  bool
 	rows []execute.Row
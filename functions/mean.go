@@ -37,6 +37,11 @@ func (s *MeanOpSpec) Kind() query.OperationKind {
 	return MeanKind
 }
 
+// DecompileArguments returns the arguments of the mean call that creates the spec.
+func (s *MeanOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return nil
+}
+
 type MeanProcedureSpec struct {
 }
 
@@ -51,6 +56,31 @@ func (s *MeanProcedureSpec) Copy() plan.ProcedureSpec {
 	return new(MeanProcedureSpec)
 }
 
+func (s *MeanProcedureSpec) PushDownRules() []plan.PushDownRule {
+	return []plan.PushDownRule{{
+		Root:    FromKind,
+		Through: nil,
+		Match: func(spec plan.ProcedureSpec) bool {
+			selectSpec := spec.(*FromProcedureSpec)
+			return !selectSpec.GroupingSet && !selectSpec.LimitSet
+		},
+		Capability: plan.AggregateCapability(MeanKind),
+	}}
+}
+
+func (s *MeanProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Procedure) {
+	selectSpec := root.Spec.(*FromProcedureSpec)
+	if selectSpec.AggregateSet {
+		root = dup()
+		selectSpec = root.Spec.(*FromProcedureSpec)
+		selectSpec.AggregateSet = false
+		selectSpec.AggregateMethod = ""
+		return
+	}
+	selectSpec.AggregateSet = true
+	selectSpec.AggregateMethod = MeanKind
+}
+
 type MeanAgg struct {
 	count float64
 	sum   float64
@@ -7,6 +7,8 @@ import (
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/query/plan/plantest"
 	"github.com/influxdata/ifql/query/querytest"
 )
 
@@ -63,3 +65,57 @@ func BenchmarkMean(b *testing.B) {
 		10.00081696729983,
 	)
 }
+
+func TestMean_PushDown_Match(t *testing.T) {
+	spec := new(functions.MeanProcedureSpec)
+	from := new(functions.FromProcedureSpec)
+
+	// Should push down only when storage has the capability
+	if got, want := spec.PushDownRules()[0].Capability, plan.AggregateCapability(functions.MeanKind); got != want {
+		t.Errorf("unexpected capability, got: %q want: %q", got, want)
+	}
+
+	// Should not match when grouping is set
+	from.GroupingSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should not match when a limit is set
+	from.GroupingSet = false
+	from.LimitSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should match when no grouping or limit is set
+	from.LimitSet = false
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{true})
+}
+
+func TestMean_PushDown(t *testing.T) {
+	spec := new(functions.MeanProcedureSpec)
+	root := &plan.Procedure{
+		Spec: new(functions.FromProcedureSpec),
+	}
+	want := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			AggregateSet:    true,
+			AggregateMethod: functions.MeanKind,
+		},
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
+}
+
+func TestMean_PushDown_Duplicate(t *testing.T) {
+	spec := new(functions.MeanProcedureSpec)
+	root := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			AggregateSet:    true,
+			AggregateMethod: functions.MeanKind,
+		},
+	}
+	want := &plan.Procedure{
+		// Expect the duplicate has been reset to zero values
+		Spec: new(functions.FromProcedureSpec),
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, true, want)
+}
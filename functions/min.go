@@ -56,6 +56,18 @@ func (s *MinOpSpec) Kind() query.OperationKind {
 	return MinKind
 }
 
+// DecompileArguments returns the arguments of the min call that creates the spec.
+func (s *MinOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Column != "" {
+		args = append(args, query.DecompiledArgument{Key: "column", Value: s.Column})
+	}
+	if s.UseRowTime {
+		args = append(args, query.DecompiledArgument{Key: "useRowTime", Value: true})
+	}
+	return args
+}
+
 type MinProcedureSpec struct {
 	Column     string
 	UseRowTime bool
@@ -82,6 +94,27 @@ func (s *MinProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+func (s *MinProcedureSpec) PushDownRules() []plan.PushDownRule {
+	rule, ok := selectorAggregateRule(MinKind, s.Column, s.UseRowTime)
+	if !ok {
+		return nil
+	}
+	return []plan.PushDownRule{rule}
+}
+
+func (s *MinProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Procedure) {
+	selectSpec := root.Spec.(*FromProcedureSpec)
+	if selectSpec.AggregateSet {
+		root = dup()
+		selectSpec = root.Spec.(*FromProcedureSpec)
+		selectSpec.AggregateSet = false
+		selectSpec.AggregateMethod = ""
+		return
+	}
+	selectSpec.AggregateSet = true
+	selectSpec.AggregateMethod = MinKind
+}
+
 type MinSelector struct {
 	set  bool
 	rows []execute.Row
@@ -7,6 +7,8 @@ import (
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/query/plan/plantest"
 	"github.com/influxdata/ifql/query/querytest"
 )
 
@@ -123,3 +125,70 @@ func TestMin_Process(t *testing.T) {
 func BenchmarkMin(b *testing.B) {
 	executetest.RowSelectorFuncBenchmarkHelper(b, new(functions.MinSelector), NormalBlock)
 }
+
+func TestMin_PushDown_Match(t *testing.T) {
+	spec := new(functions.MinProcedureSpec)
+	from := new(functions.FromProcedureSpec)
+
+	// Should push down only when storage has the capability
+	if got, want := spec.PushDownRules()[0].Capability, plan.AggregateCapability(functions.MinKind); got != want {
+		t.Errorf("unexpected capability, got: %q want: %q", got, want)
+	}
+
+	// Should not match when grouping is set
+	from.GroupingSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should not match when a limit is set
+	from.GroupingSet = false
+	from.LimitSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should match when no grouping or limit is set
+	from.LimitSet = false
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{true})
+
+	// Should not push down when selecting a different column
+	spec.Column = "other"
+	if rules := spec.PushDownRules(); len(rules) != 0 {
+		t.Errorf("unexpected push down rules for column %q", spec.Column)
+	}
+
+	// Should not push down when the time of the row is used
+	spec.Column = ""
+	spec.UseRowTime = true
+	if rules := spec.PushDownRules(); len(rules) != 0 {
+		t.Error("unexpected push down rules when using the row time")
+	}
+}
+
+func TestMin_PushDown(t *testing.T) {
+	spec := new(functions.MinProcedureSpec)
+	root := &plan.Procedure{
+		Spec: new(functions.FromProcedureSpec),
+	}
+	want := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			AggregateSet:    true,
+			AggregateMethod: functions.MinKind,
+		},
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
+}
+
+func TestMin_PushDown_Duplicate(t *testing.T) {
+	spec := new(functions.MinProcedureSpec)
+	root := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			AggregateSet:    true,
+			AggregateMethod: functions.MinKind,
+		},
+	}
+	want := &plan.Procedure{
+		// Expect the duplicate has been reset to zero values
+		Spec: new(functions.FromProcedureSpec),
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, true, want)
+}
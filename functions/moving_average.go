@@ -0,0 +1,234 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const MovingAverageKind = "movingAverage"
+
+type MovingAverageOpSpec struct {
+	N int64 `json:"n"`
+}
+
+var movingAverageSignature = query.DefaultFunctionSignature()
+
+func init() {
+	movingAverageSignature.Params["n"] = semantic.Int
+
+	query.RegisterFunction(MovingAverageKind, createMovingAverageOpSpec, movingAverageSignature)
+	query.RegisterOpSpec(MovingAverageKind, newMovingAverageOp)
+	plan.RegisterProcedureSpec(MovingAverageKind, newMovingAverageProcedure, MovingAverageKind)
+	execute.RegisterTransformation(MovingAverageKind, createMovingAverageTransformation)
+}
+
+func createMovingAverageOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	n, err := getMovingN(MovingAverageKind, args)
+	if err != nil {
+		return nil, err
+	}
+	return &MovingAverageOpSpec{
+		N: n,
+	}, nil
+}
+
+func newMovingAverageOp() query.OperationSpec {
+	return new(MovingAverageOpSpec)
+}
+
+func (s *MovingAverageOpSpec) Kind() query.OperationKind {
+	return MovingAverageKind
+}
+
+// DecompileArguments returns the arguments of the movingAverage call that creates the spec.
+func (s *MovingAverageOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "n", Value: s.N}}
+}
+
+type MovingAverageProcedureSpec struct {
+	N int64 `json:"n"`
+}
+
+func newMovingAverageProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*MovingAverageOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &MovingAverageProcedureSpec{
+		N: spec.N,
+	}, nil
+}
+
+func (s *MovingAverageProcedureSpec) Kind() plan.ProcedureKind {
+	return MovingAverageKind
+}
+func (s *MovingAverageProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(MovingAverageProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createMovingAverageTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*MovingAverageProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewMovingAverageTransformation(d, cache, s)
+	return t, d, nil
+}
+
+func NewMovingAverageTransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *MovingAverageProcedureSpec) execute.Transformation {
+	n := int(spec.N)
+	return newMovingTransformation(d, cache, func() movingFunc {
+		return &movingAverage{
+			values: make([]float64, n),
+		}
+	})
+}
+
+// movingAverage is the mean of the last n values.
+type movingAverage struct {
+	values []float64
+	// i is the index of the oldest value, count is the number of values seen up to n.
+	i     int
+	count int
+	sum   float64
+}
+
+func (a *movingAverage) update(v float64) bool {
+	a.sum += v - a.values[a.i]
+	a.values[a.i] = v
+	a.i = (a.i + 1) % len(a.values)
+	if a.count < len(a.values) {
+		a.count++
+	}
+	return a.count == len(a.values)
+}
+
+func (a *movingAverage) value() float64 {
+	return a.sum / float64(len(a.values))
+}
+
+// getMovingN returns the required number of values n of the moving function name.
+func getMovingN(name string, args query.Arguments) (int64, error) {
+	n, err := args.GetRequiredInt("n")
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("%s requires n to be positive", name)
+	}
+	return n, nil
+}
+
+// movingFunc computes a value over the last values of a column.
+type movingFunc interface {
+	// update adds the next value and reports whether there is a value to compute.
+	update(v float64) bool
+	value() float64
+}
+
+// movingTransformation computes a movingFunc over each numeric value column of a block.
+// A row is written once any of its columns has a value, columns without a value are null.
+// Null values are skipped, and other value columns are passed through unchanged.
+type movingTransformation struct {
+	d     execute.Dataset
+	cache execute.BlockBuilderCache
+
+	newFunc func() movingFunc
+}
+
+func newMovingTransformation(d execute.Dataset, cache execute.BlockBuilderCache, newFunc func() movingFunc) *movingTransformation {
+	return &movingTransformation{
+		d:       d,
+		cache:   cache,
+		newFunc: newFunc,
+	}
+}
+
+func (t *movingTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) error {
+	return t.d.RetractBlock(execute.ToBlockKey(meta))
+}
+
+func (t *movingTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	cols := b.Cols()
+	funcs := make([]movingFunc, len(cols))
+	for j, c := range cols {
+		if c.IsValue() && isNumeric(c.Type) {
+			funcs[j] = t.newFunc()
+		}
+	}
+
+	builder, new := t.cache.BlockBuilder(b)
+	if new {
+		for j, c := range cols {
+			if funcs[j] != nil {
+				c.Type = execute.TFloat
+			}
+			builder.AddCol(c)
+			if c.Common {
+				builder.SetCommonString(j, b.Tags()[c.Label])
+			}
+		}
+	}
+
+	ok := make([]bool, len(cols))
+	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+		for i := range ts {
+			include := false
+			for j, f := range funcs {
+				ok[j] = false
+				if f == nil || rr.IsNull(i, j) {
+					continue
+				}
+				ok[j] = f.update(numericValue(i, j, rr))
+				include = include || ok[j]
+			}
+			if !include {
+				continue
+			}
+			for j, c := range cols {
+				switch {
+				case c.Common:
+				case funcs[j] != nil && ok[j]:
+					builder.AppendFloat(j, funcs[j].value())
+				case funcs[j] != nil || rr.IsNull(i, j):
+					builder.AppendNil(j)
+				default:
+					execute.AppendValue(builder, j, execute.ValueForRow(i, j, rr))
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func (t *movingTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *movingTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *movingTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+func isNumeric(typ execute.DataType) bool {
+	switch typ {
+	case execute.TInt, execute.TUInt, execute.TFloat:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,193 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestMovingAverage_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "moving average",
+			Raw:  `from(db:"mydb") |> movingAverage(n:5)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "movingAverage1",
+						Spec: &functions.MovingAverageOpSpec{
+							N: 5,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "movingAverage1"},
+				},
+			},
+		},
+		{
+			Name:    "missing n",
+			Raw:     `from(db:"mydb") |> movingAverage()`,
+			WantErr: true,
+		},
+		{
+			Name:    "zero n",
+			Raw:     `from(db:"mydb") |> movingAverage(n:0)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestMovingAverageOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"movingAverage","kind":"movingAverage","spec":{"n":3}}`)
+	op := &query.Operation{
+		ID: "movingAverage",
+		Spec: &functions.MovingAverageOpSpec{
+			N: 3,
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestMovingAverage_PassThrough(t *testing.T) {
+	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+		s := functions.NewMovingAverageTransformation(
+			d,
+			c,
+			&functions.MovingAverageProcedureSpec{N: 1},
+		)
+		return s
+	})
+}
+
+func TestMovingAverage_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *functions.MovingAverageProcedureSpec
+		data []execute.Block
+		want []*executetest.Block
+	}{
+		{
+			name: "float",
+			spec: &functions.MovingAverageProcedureSpec{N: 3},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 3.0},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), 8.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(3), 2.0},
+					{execute.Time(4), 3.0},
+					{execute.Time(5), 5.0},
+				},
+			}},
+		},
+		{
+			name: "int with nulls",
+			spec: &functions.MovingAverageProcedureSpec{N: 2},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), nil},
+					{execute.Time(3), int64(2)},
+					{execute.Time(4), nil},
+					{execute.Time(5), int64(6)},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(3), 1.5},
+					{execute.Time(5), 4.0},
+				},
+			}},
+		},
+		{
+			name: "multiple columns",
+			spec: &functions.MovingAverageProcedureSpec{N: 2},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "s", Type: execute.TString, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, nil, "a", "h"},
+					{execute.Time(2), 3.0, 10.0, "b", "h"},
+					{execute.Time(3), 5.0, 20.0, "c", "h"},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "s", Type: execute.TString, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 2.0, nil, "b", "h"},
+					{execute.Time(3), 4.0, 15.0, "c", "h"},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					return functions.NewMovingAverageTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
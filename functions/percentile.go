@@ -37,6 +37,8 @@ func init() {
 }
 
 var percentileBuiltin = `
+package stats
+
 // median returns the 50th percentile.
 // By default an approximate percentile is computed, this can be disabled by passing exact:true.
 // Using the exact method requires that the entire data set can fit in memory.
@@ -91,6 +93,17 @@ func (s *PercentileOpSpec) Kind() query.OperationKind {
 	return PercentileKind
 }
 
+// DecompileArguments returns the arguments of the percentile call that creates the spec.
+func (s *PercentileOpSpec) DecompileArguments() []query.DecompiledArgument {
+	args := []query.DecompiledArgument{{Key: "p", Value: s.Percentile}}
+	if s.Exact {
+		args = append(args, query.DecompiledArgument{Key: "exact", Value: true})
+	} else if s.Compression != 1000 {
+		args = append(args, query.DecompiledArgument{Key: "compression", Value: s.Compression})
+	}
+	return args
+}
+
 type PercentileProcedureSpec struct {
 	Percentile  float64 `json:"percentile"`
 	Compression float64 `json:"compression"`
@@ -0,0 +1,615 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const PivotKind = "pivot"
+
+type PivotOpSpec struct {
+	RowKey   []string `json:"rowKey"`
+	ColKey   []string `json:"colKey"`
+	ValueCol string   `json:"valueCol"`
+}
+
+var pivotSignature = query.DefaultFunctionSignature()
+
+func init() {
+	pivotSignature.Params["rowKey"] = semantic.NewArrayType(semantic.String)
+	pivotSignature.Params["colKey"] = semantic.NewArrayType(semantic.String)
+	pivotSignature.Params["valueCol"] = semantic.String
+
+	query.RegisterFunction(PivotKind, createPivotOpSpec, pivotSignature)
+	query.RegisterOpSpec(PivotKind, newPivotOp)
+	plan.RegisterProcedureSpec(PivotKind, newPivotProcedure, PivotKind)
+	plan.RegisterRewriteRule(FieldPivotRewriteRule{})
+	execute.RegisterTransformation(PivotKind, createPivotTransformation)
+}
+
+func createPivotOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &PivotOpSpec{
+		RowKey:   []string{execute.TimeColLabel},
+		ColKey:   []string{fieldColLabel},
+		ValueCol: execute.DefaultValueColLabel,
+	}
+	if array, ok, err := args.GetArray("rowKey", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.RowKey = array.AsStrings()
+	}
+	if array, ok, err := args.GetArray("colKey", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.ColKey = array.AsStrings()
+	}
+	if col, ok, err := args.GetString("valueCol"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.ValueCol = col
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// validate checks that the row key, column key and value column do not overlap.
+func (s *PivotOpSpec) validate() error {
+	if len(s.ColKey) == 0 {
+		return errors.New("pivot requires at least one colKey column")
+	}
+	hasTime := false
+	for _, r := range s.RowKey {
+		if r == execute.TimeColLabel {
+			hasTime = true
+		}
+		for _, c := range s.ColKey {
+			if r == c {
+				return fmt.Errorf("column %q cannot be part of both rowKey and colKey", r)
+			}
+		}
+		if r == s.ValueCol {
+			return fmt.Errorf("value column %q cannot be part of rowKey", r)
+		}
+	}
+	// Every block has a time column, so the rows must be identified by their time.
+	if !hasTime {
+		return fmt.Errorf("rowKey must contain the %q column", execute.TimeColLabel)
+	}
+	for _, c := range s.ColKey {
+		if c == s.ValueCol {
+			return fmt.Errorf("value column %q cannot be part of colKey", c)
+		}
+	}
+	return nil
+}
+
+func newPivotOp() query.OperationSpec {
+	return new(PivotOpSpec)
+}
+
+func (s *PivotOpSpec) Kind() query.OperationKind {
+	return PivotKind
+}
+
+// DecompileArguments returns the arguments of the pivot call that creates the spec.
+func (s *PivotOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if len(s.RowKey) != 1 || s.RowKey[0] != execute.TimeColLabel {
+		args = append(args, query.DecompiledArgument{Key: "rowKey", Value: s.RowKey})
+	}
+	if len(s.ColKey) != 1 || s.ColKey[0] != fieldColLabel {
+		args = append(args, query.DecompiledArgument{Key: "colKey", Value: s.ColKey})
+	}
+	if s.ValueCol != execute.DefaultValueColLabel {
+		args = append(args, query.DecompiledArgument{Key: "valueCol", Value: s.ValueCol})
+	}
+	return args
+}
+
+type PivotProcedureSpec struct {
+	RowKey   []string
+	ColKey   []string
+	ValueCol string
+}
+
+func newPivotProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*PivotOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &PivotProcedureSpec{
+		RowKey:   spec.RowKey,
+		ColKey:   spec.ColKey,
+		ValueCol: spec.ValueCol,
+	}, nil
+}
+
+func (s *PivotProcedureSpec) Kind() plan.ProcedureKind {
+	return PivotKind
+}
+func (s *PivotProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(PivotProcedureSpec)
+
+	ns.RowKey = make([]string, len(s.RowKey))
+	copy(ns.RowKey, s.RowKey)
+
+	ns.ColKey = make([]string, len(s.ColKey))
+	copy(ns.ColKey, s.ColKey)
+
+	ns.ValueCol = s.ValueCol
+	return ns
+}
+
+// IsFieldPivot reports whether the spec turns the values of each field into a column per field,
+// aligned on time, which is the pivot that FieldPivotRewriteRule recognizes.
+func (s *PivotProcedureSpec) IsFieldPivot() bool {
+	return len(s.RowKey) == 1 && s.RowKey[0] == execute.TimeColLabel &&
+		len(s.ColKey) == 1 && s.ColKey[0] == fieldColLabel &&
+		s.ValueCol == execute.DefaultValueColLabel
+}
+
+// FieldPivotRewriteRule recognizes a field pivot that directly follows a from procedure.
+// Storage returns a block per field of each series, which pivot has to merge again.
+// Instead the from procedure groups the series by all tags except _field,
+// so that the fields of a series are read together and _field is kept as a column.
+// Fields of different types are read as separate blocks with the same tags, which pivot merges.
+type FieldPivotRewriteRule struct {
+}
+
+func (r FieldPivotRewriteRule) Root() plan.ProcedureKind {
+	return FromKind
+}
+
+func (r FieldPivotRewriteRule) Rewrite(pr *plan.Procedure, planner plan.PlanRewriter) error {
+	fromSpec := pr.Spec.(*FromProcedureSpec)
+	if fromSpec.GroupingSet || fromSpec.AggregateSet {
+		return nil
+	}
+	var pivot *plan.Procedure
+	pr.DoChildren(func(child *plan.Procedure) {
+		if spec, ok := child.Spec.(*PivotProcedureSpec); ok && spec.IsFieldPivot() {
+			pivot = child
+		}
+	})
+	if pivot == nil {
+		return nil
+	}
+
+	// Rewrite
+	isoFrom, err := planner.IsolatePath(pr, pivot)
+	if err != nil {
+		return err
+	}
+	fromSpec = isoFrom.Spec.(*FromProcedureSpec)
+	fromSpec.GroupingSet = true
+	fromSpec.GroupExcept = []string{fieldColLabel}
+	fromSpec.GroupKeep = []string{fieldColLabel}
+	return nil
+}
+
+func createPivotTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*PivotProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := NewPivotCache(a.Allocator(), s)
+	d := execute.NewDataset(id, mode, cache)
+	t := NewPivotTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type pivotTransformation struct {
+	mu sync.Mutex
+
+	d     execute.Dataset
+	cache PivotCache
+
+	rowKey   []string
+	colKey   []string
+	valueCol string
+}
+
+func NewPivotTransformation(d execute.Dataset, cache PivotCache, spec *PivotProcedureSpec) *pivotTransformation {
+	return &pivotTransformation{
+		d:        d,
+		cache:    cache,
+		rowKey:   spec.RowKey,
+		colKey:   spec.ColKey,
+		valueCol: spec.ValueCol,
+	}
+}
+
+// blockMetadata returns the metadata of the pivoted block, which is grouped by the common tags of meta,
+// except for those that are part of the row or column keys.
+func (t *pivotTransformation) blockMetadata(meta execute.BlockMetadata) blockMetadata {
+	tags := meta.Tags().Copy()
+	for _, k := range t.rowKey {
+		delete(tags, k)
+	}
+	for _, k := range t.colKey {
+		delete(tags, k)
+	}
+	return blockMetadata{
+		tags:   tags,
+		bounds: meta.Bounds(),
+	}
+}
+
+func (t *pivotTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.d.RetractBlock(execute.ToBlockKey(t.blockMetadata(meta)))
+}
+
+func (t *pivotTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cols := b.Cols()
+	rowIdxs := make([]int, len(t.rowKey))
+	rowCols := make([]execute.ColMeta, len(t.rowKey))
+	for i, label := range t.rowKey {
+		j := execute.ColIdx(label, cols)
+		if j < 0 {
+			return fmt.Errorf("pivot row key column %q not found", label)
+		}
+		rowIdxs[i] = j
+		rowCols[i] = cols[j]
+		// Row key columns vary by row, even if they are tags.
+		rowCols[i].Common = false
+	}
+	colIdxs := make([]int, len(t.colKey))
+	for i, label := range t.colKey {
+		j := execute.ColIdx(label, cols)
+		if j < 0 {
+			return fmt.Errorf("pivot column key column %q not found", label)
+		}
+		if cols[j].Type != execute.TString {
+			return fmt.Errorf("pivot column key column %q must be a string column, found %v", label, cols[j].Type)
+		}
+		colIdxs[i] = j
+	}
+	valueIdx := execute.ColIdx(t.valueCol, cols)
+	if valueIdx < 0 {
+		return fmt.Errorf("pivot value column %q not found", t.valueCol)
+	}
+	valueType := cols[valueIdx].Type
+
+	table := t.cache.Table(t.blockMetadata(b))
+	if err := table.setRowCols(rowCols); err != nil {
+		return err
+	}
+
+	var err error
+	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+		for i := range ts {
+			if err != nil {
+				return
+			}
+			label, ok := pivotLabel(i, rr, colIdxs)
+			if !ok {
+				// A row without a column key has no column to be placed in.
+				continue
+			}
+			var j int
+			j, err = table.valueCol(label, valueType)
+			if err != nil {
+				return
+			}
+			r := table.row(i, rr, rowIdxs)
+			if !rr.IsNull(i, valueIdx) {
+				setValue(table.builder, r, j, i, valueIdx, rr)
+			}
+		}
+	})
+	return err
+}
+
+func (t *pivotTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *pivotTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *pivotTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// pivotLabel returns the label of the column for row i, the values of the column key joined by underscores.
+// The boolean return value is false if any of the values are null.
+func pivotLabel(i int, rr execute.RowReader, colIdxs []int) (string, bool) {
+	if len(colIdxs) == 1 {
+		if rr.IsNull(i, colIdxs[0]) {
+			return "", false
+		}
+		return rr.AtString(i, colIdxs[0]), true
+	}
+	parts := make([]string, len(colIdxs))
+	for k, j := range colIdxs {
+		if rr.IsNull(i, j) {
+			return "", false
+		}
+		parts[k] = rr.AtString(i, j)
+	}
+	return strings.Join(parts, "_"), true
+}
+
+// setValue sets the value of row r in column j of builder to the value at i, k of rr.
+func setValue(builder execute.BlockBuilder, r, j, i, k int, rr execute.RowReader) {
+	switch typ := rr.Cols()[k].Type; typ {
+	case execute.TBool:
+		builder.SetBool(r, j, rr.AtBool(i, k))
+	case execute.TInt:
+		builder.SetInt(r, j, rr.AtInt(i, k))
+	case execute.TUInt:
+		builder.SetUInt(r, j, rr.AtUInt(i, k))
+	case execute.TFloat:
+		builder.SetFloat(r, j, rr.AtFloat(i, k))
+	case execute.TString:
+		builder.SetString(r, j, rr.AtString(i, k))
+	case execute.TTime:
+		builder.SetTime(r, j, rr.AtTime(i, k))
+	default:
+		execute.PanicUnknownType(typ)
+	}
+}
+
+type PivotCache interface {
+	Table(execute.BlockMetadata) *pivotTable
+}
+
+type pivotCache struct {
+	data  map[execute.BlockKey]*pivotTable
+	alloc *execute.Allocator
+
+	rowKey []string
+
+	triggerSpec query.TriggerSpec
+}
+
+func NewPivotCache(a *execute.Allocator, spec *PivotProcedureSpec) *pivotCache {
+	return &pivotCache{
+		data:   make(map[execute.BlockKey]*pivotTable),
+		alloc:  a,
+		rowKey: spec.RowKey,
+	}
+}
+
+func (c *pivotCache) BlockMetadata(key execute.BlockKey) execute.BlockMetadata {
+	return c.data[key]
+}
+
+func (c *pivotCache) Block(key execute.BlockKey) (execute.Block, error) {
+	return c.data[key].Block()
+}
+
+func (c *pivotCache) ForEach(f func(execute.BlockKey)) {
+	for bk := range c.data {
+		f(bk)
+	}
+}
+
+func (c *pivotCache) ForEachWithContext(f func(execute.BlockKey, execute.Trigger, execute.BlockContext)) {
+	for bk, table := range c.data {
+		bc := execute.BlockContext{
+			Bounds: table.bounds,
+			Count:  table.builder.NRows(),
+		}
+		f(bk, table.trigger, bc)
+	}
+}
+
+func (c *pivotCache) DiscardBlock(key execute.BlockKey) {
+	c.data[key].ClearData()
+}
+
+func (c *pivotCache) ExpireBlock(key execute.BlockKey) {
+	delete(c.data, key)
+}
+
+func (c *pivotCache) SetTriggerSpec(spec query.TriggerSpec) {
+	c.triggerSpec = spec
+}
+
+func (c *pivotCache) Table(bm execute.BlockMetadata) *pivotTable {
+	key := execute.ToBlockKey(bm)
+	table := c.data[key]
+	if table == nil {
+		table = &pivotTable{
+			tags:    bm.Tags(),
+			bounds:  bm.Bounds(),
+			alloc:   c.alloc,
+			rowKey:  c.rowKey,
+			builder: execute.NewColListBlockBuilder(c.alloc),
+			rows:    make(map[string]int),
+			cols:    make(map[string]int),
+			trigger: execute.NewTriggerFromSpec(c.triggerSpec),
+		}
+		c.data[key] = table
+	}
+	return table
+}
+
+// pivotTable accumulates the rows of a pivoted block.
+// The builder holds the row key columns followed by a column per label, in the order they are first seen.
+// Rows are kept in the order they are first seen and sorted by the row key when the block is built.
+type pivotTable struct {
+	tags   execute.Tags
+	bounds execute.Bounds
+
+	alloc *execute.Allocator
+
+	rowKey  []string
+	builder *execute.ColListBlockBuilder
+	// rows maps the encoded row key to the index of its row in builder.
+	rows map[string]int
+	// cols maps labels to the index of their column in builder.
+	cols map[string]int
+	buf  []byte
+
+	trigger execute.Trigger
+}
+
+func (t *pivotTable) Bounds() execute.Bounds {
+	return t.bounds
+}
+func (t *pivotTable) Tags() execute.Tags {
+	return t.tags
+}
+
+func (t *pivotTable) ClearData() {
+	t.builder.ClearData()
+	t.rows = make(map[string]int)
+}
+
+// setRowCols adds the row key columns to the builder of a new table,
+// and checks that they have the same types as before otherwise.
+func (t *pivotTable) setRowCols(cols []execute.ColMeta) error {
+	existing := t.builder.Cols()
+	if len(existing) == 0 {
+		for _, c := range cols {
+			t.builder.AddCol(c)
+		}
+		return nil
+	}
+	for j, c := range cols {
+		if existing[j].Type != c.Type {
+			return fmt.Errorf("pivot row key column %q has conflicting types %v and %v", c.Label, existing[j].Type, c.Type)
+		}
+	}
+	return nil
+}
+
+// valueCol returns the index of the column for label, adding it if it does not exist.
+// Each column has the type of the first value it was created for.
+func (t *pivotTable) valueCol(label string, typ execute.DataType) (int, error) {
+	if j, ok := t.cols[label]; ok {
+		if c := t.builder.Cols()[j]; c.Type != typ {
+			return 0, fmt.Errorf("pivot column %q has conflicting types %v and %v", label, c.Type, typ)
+		}
+		return j, nil
+	}
+	for _, k := range t.rowKey {
+		if k == label {
+			return 0, fmt.Errorf("pivot column %q conflicts with the row key column of the same name", label)
+		}
+	}
+	if _, ok := t.tags[label]; ok {
+		return 0, fmt.Errorf("pivot column %q conflicts with the tag of the same name", label)
+	}
+	n := t.builder.NRows()
+	j := t.builder.AddCol(execute.ColMeta{
+		Label: label,
+		Type:  typ,
+		Kind:  execute.ValueColKind,
+	})
+	// Existing rows have no value for the new column.
+	for i := 0; i < n; i++ {
+		t.builder.AppendNil(j)
+	}
+	t.cols[label] = j
+	return j, nil
+}
+
+// row returns the index of the row with the row key of row i of rr, adding it if it does not exist.
+func (t *pivotTable) row(i int, rr execute.RowReader, rowIdxs []int) int {
+	t.buf = appendRowKey(t.buf[0:0], i, rr, rowIdxs)
+	if r, ok := t.rows[string(t.buf)]; ok {
+		return r
+	}
+	r := t.builder.NRows()
+	for j := range t.builder.Cols() {
+		t.builder.AppendNil(j)
+	}
+	for j, k := range rowIdxs {
+		if !rr.IsNull(i, k) {
+			setValue(t.builder, r, j, i, k, rr)
+		}
+	}
+	t.rows[string(t.buf)] = r
+	return r
+}
+
+// appendRowKey appends an encoding of the values of the columns rowIdxs of row i onto buf.
+func appendRowKey(buf []byte, i int, rr execute.RowReader, rowIdxs []int) []byte {
+	cols := rr.Cols()
+	for _, j := range rowIdxs {
+		if rr.IsNull(i, j) {
+			buf = append(buf, 0)
+			continue
+		}
+		buf = append(buf, 1)
+		switch typ := cols[j].Type; typ {
+		case execute.TBool:
+			buf = strconv.AppendBool(buf, rr.AtBool(i, j))
+		case execute.TInt:
+			buf = strconv.AppendInt(buf, rr.AtInt(i, j), 10)
+		case execute.TUInt:
+			buf = strconv.AppendUint(buf, rr.AtUInt(i, j), 10)
+		case execute.TFloat:
+			buf = strconv.AppendFloat(buf, rr.AtFloat(i, j), 'g', -1, 64)
+		case execute.TString:
+			// Prefix the length so that the values of adjacent columns cannot run together.
+			s := rr.AtString(i, j)
+			buf = strconv.AppendInt(buf, int64(len(s)), 10)
+			buf = append(buf, ':')
+			buf = append(buf, s...)
+		case execute.TTime:
+			buf = strconv.AppendInt(buf, int64(rr.AtTime(i, j)), 10)
+		default:
+			execute.PanicUnknownType(typ)
+		}
+		buf = append(buf, ',')
+	}
+	return buf
+}
+
+// Block builds the pivoted block, with the columns for the labels sorted by label and the rows sorted by the row key.
+func (t *pivotTable) Block() (execute.Block, error) {
+	raw := t.builder.RawBlock()
+	cols := raw.Cols()
+
+	builder := execute.NewColListBlockBuilder(t.alloc)
+	builder.SetBounds(t.bounds)
+	colMap := make([]int, 0, len(cols))
+	for j := range t.rowKey {
+		builder.AddCol(cols[j])
+		colMap = append(colMap, j)
+	}
+	labels := make([]string, 0, len(t.cols))
+	for label := range t.cols {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		j := t.cols[label]
+		builder.AddCol(cols[j])
+		colMap = append(colMap, j)
+	}
+
+	for i := 0; i < t.builder.NRows(); i++ {
+		execute.AppendRow(i, raw, builder, colMap)
+	}
+	builder.Sort(t.rowKey, false)
+
+	execute.AddTags(t.tags, builder)
+	return builder.Block()
+}
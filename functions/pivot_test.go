@@ -0,0 +1,364 @@
+package functions_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestPivot_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "defaults",
+			Raw:  `from(db:"mydb") |> pivot()`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "pivot1",
+						Spec: &functions.PivotOpSpec{
+							RowKey:   []string{"_time"},
+							ColKey:   []string{"_field"},
+							ValueCol: "_value",
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "pivot1"},
+				},
+			},
+		},
+		{
+			Name: "all arguments",
+			Raw:  `from(db:"mydb") |> pivot(rowKey:["_time", "host"], colKey:["_measurement", "_field"], valueCol:"v")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "pivot1",
+						Spec: &functions.PivotOpSpec{
+							RowKey:   []string{"_time", "host"},
+							ColKey:   []string{"_measurement", "_field"},
+							ValueCol: "v",
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "pivot1"},
+				},
+			},
+		},
+		{
+			Name:    "row key without time",
+			Raw:     `from(db:"mydb") |> pivot(rowKey:["host"])`,
+			WantErr: true,
+		},
+		{
+			Name:    "overlapping keys",
+			Raw:     `from(db:"mydb") |> pivot(rowKey:["_time", "_field"])`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestPivotOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"pivot","kind":"pivot","spec":{"rowKey":["_time"],"colKey":["_field"],"valueCol":"_value"}}`)
+	op := &query.Operation{
+		ID: "pivot",
+		Spec: &functions.PivotOpSpec{
+			RowKey:   []string{"_time"},
+			ColKey:   []string{"_field"},
+			ValueCol: "_value",
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestPivot_Process(t *testing.T) {
+	fieldPivot := &functions.PivotProcedureSpec{
+		RowKey:   []string{"_time"},
+		ColKey:   []string{"_field"},
+		ValueCol: "_value",
+	}
+	testCases := []struct {
+		name    string
+		spec    *functions.PivotProcedureSpec
+		data    []execute.Block
+		want    []*executetest.Block
+		wantErr bool
+	}{
+		{
+			name: "block per field",
+			spec: fieldPivot,
+			data: []execute.Block{
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  4,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "usage_user", "a"},
+						{execute.Time(2), 2.0, "usage_user", "a"},
+					},
+				},
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  4,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0, "usage_system", "a"},
+						{execute.Time(3), 30.0, "usage_system", "a"},
+					},
+				},
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  4,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TString, Kind: execute.ValueColKind},
+						{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "ok", "status", "a"},
+					},
+				},
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  4,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 5.0, "usage_user", "b"},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  4,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "status", Type: execute.TString, Kind: execute.ValueColKind},
+						{Label: "usage_system", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "usage_user", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "ok", nil, 1.0, "a"},
+						{execute.Time(2), nil, 20.0, 2.0, "a"},
+						{execute.Time(3), nil, 30.0, nil, "a"},
+					},
+				},
+				{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  4,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "usage_user", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 5.0, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "fields in one block",
+			spec: fieldPivot,
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "_field", Type: execute.TString, Kind: execute.TagColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 2.0, "x"},
+					{execute.Time(1), 1.0, "x"},
+					{execute.Time(1), nil, "y"},
+					{execute.Time(2), -2.0, "y"},
+					{execute.Time(2), 3.0, nil},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, nil},
+					{execute.Time(2), 2.0, -2.0},
+				},
+			}},
+		},
+		{
+			name: "multiple keys",
+			spec: &functions.PivotProcedureSpec{
+				RowKey:   []string{"_time", "host"},
+				ColKey:   []string{"_measurement", "_field"},
+				ValueCol: "_value",
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+					{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind},
+					{Label: "_field", Type: execute.TString, Kind: execute.TagColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1), "cpu", "usage", "a"},
+					{execute.Time(1), int64(2), "cpu", "usage", "b"},
+					{execute.Time(1), int64(3), "mem", "usage", "a"},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind},
+					{Label: "cpu_usage", Type: execute.TInt, Kind: execute.ValueColKind},
+					{Label: "mem_usage", Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a", int64(1), int64(3)},
+					{execute.Time(1), "b", int64(2), nil},
+				},
+			}},
+		},
+		{
+			name: "conflicting types",
+			spec: fieldPivot,
+			data: []execute.Block{
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  3,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "x"},
+					},
+				},
+				&executetest.Block{
+					Bnds: execute.Bounds{
+						Start: 1,
+						Stop:  3,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), int64(2), "x"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := functions.NewPivotCache(executetest.UnlimitedAllocator, tc.spec)
+			c.SetTriggerSpec(execute.DefaultTriggerSpec)
+			pt := functions.NewPivotTransformation(d, c, tc.spec)
+
+			parentID := executetest.RandomDatasetID()
+			var err error
+			for _, b := range tc.data {
+				if err = pt.Process(parentID, b); err != nil {
+					break
+				}
+			}
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			got := executetest.BlocksFromCache(c)
+
+			sort.Sort(executetest.SortedBlocks(got))
+			sort.Sort(executetest.SortedBlocks(tc.want))
+
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package functions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/plan"
@@ -11,8 +12,9 @@ import (
 const RangeKind = "range"
 
 type RangeOpSpec struct {
-	Start query.Time `json:"start"`
-	Stop  query.Time `json:"stop"`
+	Start    query.Time `json:"start"`
+	Stop     query.Time `json:"stop"`
+	Location string     `json:"location,omitempty"`
 }
 
 var rangeSignature = query.DefaultFunctionSignature()
@@ -20,6 +22,7 @@ var rangeSignature = query.DefaultFunctionSignature()
 func init() {
 	rangeSignature.Params["start"] = semantic.Time
 	rangeSignature.Params["stop"] = semantic.Time
+	rangeSignature.Params["location"] = semantic.String
 
 	query.RegisterFunction(RangeKind, createRangeOpSpec, rangeSignature)
 	query.RegisterOpSpec(RangeKind, newRangeOp)
@@ -49,6 +52,15 @@ func createRangeOpSpec(args query.Arguments, a *query.Administration) (query.Ope
 		spec.Stop.IsRelative = true
 	}
 
+	if location, ok, err := args.GetString("location"); err != nil {
+		return nil, err
+	} else if ok {
+		if _, err := time.LoadLocation(location); err != nil {
+			return nil, err
+		}
+		spec.Location = location
+	}
+
 	return spec, nil
 }
 
@@ -60,6 +72,18 @@ func (s *RangeOpSpec) Kind() query.OperationKind {
 	return RangeKind
 }
 
+// DecompileArguments returns the arguments of the range call that creates the spec.
+func (s *RangeOpSpec) DecompileArguments() []query.DecompiledArgument {
+	args := []query.DecompiledArgument{{Key: "start", Value: s.Start}}
+	if s.Stop != query.Now {
+		args = append(args, query.DecompiledArgument{Key: "stop", Value: s.Stop})
+	}
+	if s.Location != "" {
+		args = append(args, query.DecompiledArgument{Key: "location", Value: s.Location})
+	}
+	return args
+}
+
 type RangeProcedureSpec struct {
 	Bounds plan.BoundsSpec
 }
@@ -71,8 +95,9 @@ func newRangeProcedure(qs query.OperationSpec, pa plan.Administration) (plan.Pro
 	}
 	return &RangeProcedureSpec{
 		Bounds: plan.BoundsSpec{
-			Start: spec.Start,
-			Stop:  spec.Stop,
+			Start:    spec.Start,
+			Stop:     spec.Stop,
+			Location: spec.Location,
 		},
 	}, nil
 }
@@ -87,26 +112,45 @@ func (s *RangeProcedureSpec) Copy() plan.ProcedureSpec {
 }
 
 func (s *RangeProcedureSpec) PushDownRules() []plan.PushDownRule {
-	return []plan.PushDownRule{{
-		Root:    FromKind,
-		Through: []plan.ProcedureKind{GroupKind, LimitKind, FilterKind},
-	}}
+	return []plan.PushDownRule{
+		{
+			Root:    FromKind,
+			Through: []plan.ProcedureKind{GroupKind, LimitKind, FilterKind},
+		},
+		{
+			// Limits are not pushed into fromCSV, so range cannot be pushed through them.
+			Root:    FromCSVKind,
+			Through: []plan.ProcedureKind{GroupKind, FilterKind},
+		},
+	}
 }
 func (s *RangeProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Procedure) {
-	selectSpec := root.Spec.(*FromProcedureSpec)
-	if selectSpec.BoundsSet {
-		// Example case where this matters
-		//    var data = select(database: "mydb")
-		//    var past = data.range(start:-2d,stop:-1d)
-		//    var current = data.range(start:-1d,stop:now)
-		root = dup()
-		selectSpec = root.Spec.(*FromProcedureSpec)
-		selectSpec.BoundsSet = false
-		selectSpec.Bounds = plan.BoundsSpec{}
-		return
+	switch spec := root.Spec.(type) {
+	case *FromProcedureSpec:
+		if spec.BoundsSet {
+			// Example case where this matters
+			//    var data = select(database: "mydb")
+			//    var past = data.range(start:-2d,stop:-1d)
+			//    var current = data.range(start:-1d,stop:now)
+			root = dup()
+			spec = root.Spec.(*FromProcedureSpec)
+			spec.BoundsSet = false
+			spec.Bounds = plan.BoundsSpec{}
+			return
+		}
+		spec.BoundsSet = true
+		spec.Bounds = s.Bounds
+	case *FromCSVProcedureSpec:
+		if spec.BoundsSet {
+			root = dup()
+			spec = root.Spec.(*FromCSVProcedureSpec)
+			spec.BoundsSet = false
+			spec.Bounds = plan.BoundsSpec{}
+			return
+		}
+		spec.BoundsSet = true
+		spec.Bounds = s.Bounds
 	}
-	selectSpec.BoundsSet = true
-	selectSpec.Bounds = s.Bounds
 }
 
 func (s *RangeProcedureSpec) TimeBounds() plan.BoundsSpec {
@@ -48,6 +48,34 @@ func TestRange_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "from with calendar range in location",
+			Raw:  `from(db:"mydb") |> range(start:-1mo, location:"America/New_York")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{
+								Months:     -1,
+								IsRelative: true,
+							},
+							Stop:     query.Now,
+							Location: "America/New_York",
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "range1"},
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -76,6 +104,29 @@ func TestRangeOperation_Marshaling(t *testing.T) {
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestRange_Bounds_Location(t *testing.T) {
+	now := time.Date(2018, 3, 1, 3, 0, 0, 0, time.UTC)
+	spec := &functions.RangeProcedureSpec{
+		Bounds: plan.BoundsSpec{
+			Start: query.Time{Months: -1, IsRelative: true},
+			Stop:  query.Now,
+		},
+	}
+	if start, _ := spec.TimeBounds().Resolve(now); !start.Equal(time.Date(2018, 2, 1, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected start in UTC: %v", start)
+	}
+
+	// It is still February 28th in New York, so the month before is January.
+	spec.Bounds.Location = "America/New_York"
+	start, stop := spec.TimeBounds().Resolve(now)
+	if want := time.Date(2018, 1, 29, 3, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("unexpected start in New York: got %v want %v", start, want)
+	}
+	if !stop.Equal(now) {
+		t.Errorf("unexpected stop in New York: got %v want %v", stop, now)
+	}
+}
+
 func TestRange_PushDown(t *testing.T) {
 	spec := &functions.RangeProcedureSpec{
 		Bounds: plan.BoundsSpec{
@@ -118,3 +169,29 @@ func TestRange_PushDown_Duplicate(t *testing.T) {
 
 	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, true, want)
 }
+
+func TestRange_PushDown_FromCSV(t *testing.T) {
+	spec := &functions.RangeProcedureSpec{
+		Bounds: plan.BoundsSpec{
+			Start: query.MinTime,
+			Stop:  query.Now,
+		},
+	}
+	root := &plan.Procedure{
+		Spec: &functions.FromCSVProcedureSpec{
+			File: "data.csv",
+		},
+	}
+	want := &plan.Procedure{
+		Spec: &functions.FromCSVProcedureSpec{
+			File:      "data.csv",
+			BoundsSet: true,
+			Bounds: plan.BoundsSpec{
+				Start: query.MinTime,
+				Stop:  query.Now,
+			},
+		},
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
+}
@@ -0,0 +1,255 @@
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/influxdata/ifql/compiler"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const ReduceKind = "reduce"
+
+type ReduceOpSpec struct {
+	Fn *semantic.FunctionExpression `json:"fn"`
+	// Identity is the initial value of the accumulator, an object of literals.
+	Identity *semantic.ObjectExpression `json:"identity"`
+}
+
+var reduceSignature = query.DefaultFunctionSignature()
+
+func init() {
+	reduceSignature.Params["fn"] = execute.RowReduceType
+	reduceSignature.Params["identity"] = semantic.Object
+	reduceSignature.ColumnsArgument = "fn"
+
+	query.RegisterFunction(ReduceKind, createReduceOpSpec, reduceSignature)
+	query.RegisterOpSpec(ReduceKind, newReduceOp)
+	plan.RegisterProcedureSpec(ReduceKind, newReduceProcedure, ReduceKind)
+	execute.RegisterTransformation(ReduceKind, createReduceTransformation)
+}
+
+func createReduceOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	f, err := args.GetRequiredFunction("fn")
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := f.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := args.GetRequiredObject("identity")
+	if err != nil {
+		return nil, err
+	}
+	// The properties are ordered by name, so that the spec does not depend on the order of the object.
+	keys := make([]string, 0, len(obj.Properties))
+	for k := range obj.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	identity := &semantic.ObjectExpression{
+		Properties: make([]*semantic.Property, len(keys)),
+	}
+	for i, k := range keys {
+		v := obj.Properties[k]
+		var l semantic.Expression
+		switch v.Type().Kind() {
+		case semantic.Bool:
+			l = &semantic.BooleanLiteral{Value: v.Value().(bool)}
+		case semantic.Int:
+			l = &semantic.IntegerLiteral{Value: v.Value().(int64)}
+		case semantic.UInt:
+			l = &semantic.UnsignedIntegerLiteral{Value: v.Value().(uint64)}
+		case semantic.Float:
+			l = &semantic.FloatLiteral{Value: v.Value().(float64)}
+		case semantic.String:
+			l = &semantic.StringLiteral{Value: v.Value().(string)}
+		case semantic.Time:
+			l = &semantic.DateTimeLiteral{Value: v.Value().(time.Time)}
+		default:
+			return nil, fmt.Errorf("identity property %q must be a bool, int, uint, float, string or time, got %v", k, v.Type().Kind())
+		}
+		identity.Properties[i] = &semantic.Property{
+			Key:   &semantic.Identifier{Name: k},
+			Value: l,
+		}
+	}
+	return &ReduceOpSpec{
+		Fn:       resolved,
+		Identity: identity,
+	}, nil
+}
+
+func newReduceOp() query.OperationSpec {
+	return new(ReduceOpSpec)
+}
+
+func (s *ReduceOpSpec) Kind() query.OperationKind {
+	return ReduceKind
+}
+
+// DecompileArguments returns the arguments of the reduce call that creates the spec.
+func (s *ReduceOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{
+		{Key: "fn", Value: s.Fn},
+		{Key: "identity", Value: s.Identity},
+	}
+}
+
+type ReduceProcedureSpec struct {
+	Fn       *semantic.FunctionExpression
+	Identity *semantic.ObjectExpression
+}
+
+func newReduceProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ReduceOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &ReduceProcedureSpec{
+		Fn:       spec.Fn,
+		Identity: spec.Identity,
+	}, nil
+}
+
+func (s *ReduceProcedureSpec) Kind() plan.ProcedureKind {
+	return ReduceKind
+}
+func (s *ReduceProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(ReduceProcedureSpec)
+	ns.Fn = s.Fn.Copy().(*semantic.FunctionExpression)
+	ns.Identity = s.Identity.Copy().(*semantic.ObjectExpression)
+	return ns
+}
+
+func createReduceTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ReduceProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t, err := NewReduceTransformation(d, cache, a.Bounds(), s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, d, nil
+}
+
+type reduceTransformation struct {
+	d      execute.Dataset
+	cache  execute.BlockBuilderCache
+	bounds execute.Bounds
+
+	fn       *execute.RowReduceFn
+	identity *compiler.Object
+	// labels are the names of the properties of the accumulator in order, each is a value column of the result.
+	labels []string
+}
+
+func NewReduceTransformation(d execute.Dataset, cache execute.BlockBuilderCache, bounds execute.Bounds, spec *ReduceProcedureSpec) (*reduceTransformation, error) {
+	fn, err := execute.NewRowReduceFn(spec.Fn)
+	if err != nil {
+		return nil, err
+	}
+	// The identity is an object of literals, so it is evaluated once by a function without parameters.
+	identityFn, err := compiler.Compile(&semantic.FunctionExpression{Body: spec.Identity}, nil)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := identityFn.EvalObject(nil)
+	if err != nil {
+		return nil, err
+	}
+	labels := make([]string, 0, len(spec.Identity.Properties))
+	for k, t := range identity.Type().Properties() {
+		if execute.ConvertFromKind(t.Kind()) == execute.TInvalid {
+			return nil, fmt.Errorf("identity property %q has unsupported type %v", k, t)
+		}
+		labels = append(labels, k)
+	}
+	sort.Strings(labels)
+	return &reduceTransformation{
+		d:        d,
+		cache:    cache,
+		bounds:   bounds,
+		fn:       fn,
+		identity: identity,
+		labels:   labels,
+	}, nil
+}
+
+func (t *reduceTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) error {
+	return t.d.RetractBlock(execute.ToBlockKey(meta))
+}
+
+// Process folds the rows of the block into the accumulator, starting from the identity.
+// The result is a row at the stop of the block bounds, with the common tags and a value column for each property of the accumulator.
+func (t *reduceTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	accumulatorType := t.identity.Type()
+	if err := t.fn.Prepare(b.Cols(), accumulatorType); err != nil {
+		return err
+	}
+
+	builder, new := t.cache.BlockBuilder(blockMetadata{
+		bounds: t.bounds,
+		tags:   b.Tags(),
+	})
+	if new {
+		builder.AddCol(execute.TimeCol)
+		execute.AddTags(b.Tags(), builder)
+		properties := accumulatorType.Properties()
+		for _, l := range t.labels {
+			if execute.ColIdx(l, builder.Cols()) >= 0 {
+				return fmt.Errorf("accumulator property %q is already a column of the block", l)
+			}
+			builder.AddCol(execute.ColMeta{
+				Label: l,
+				Type:  execute.ConvertFromKind(properties[l].Kind()),
+				Kind:  execute.ValueColKind,
+			})
+		}
+	}
+
+	var err error
+	accumulator := t.identity
+	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+		for i := range ts {
+			if err != nil {
+				return
+			}
+			accumulator, err = t.fn.Eval(i, rr, accumulator)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to evaluate reduce function: %v", err)
+	}
+
+	cols := builder.Cols()
+	builder.AppendTime(execute.TimeIdx(cols), b.Bounds().Stop)
+	for _, l := range t.labels {
+		execute.AppendValue(builder, execute.ColIdx(l, cols), accumulator.Get(l))
+	}
+	return nil
+}
+
+func (t *reduceTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *reduceTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *reduceTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
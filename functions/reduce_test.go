@@ -0,0 +1,389 @@
+package functions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+	"github.com/influxdata/ifql/semantic"
+)
+
+// reduceParams are the parameters of the reduce functions of the tests.
+var reduceParams = []*semantic.FunctionParam{
+	{Key: &semantic.Identifier{Name: "r"}},
+	{Key: &semantic.Identifier{Name: "accumulator"}},
+}
+
+func member(object, property string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object:   &semantic.IdentifierExpression{Name: object},
+		Property: property,
+	}
+}
+
+func TestReduce_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "sum and count",
+			Raw:  `from(db:"mydb") |> reduce(fn: (r, accumulator) => ({sum: accumulator.sum + r._value, count: accumulator.count + 1}), identity: {sum: 0.0, count: 0})`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "reduce1",
+						Spec: &functions.ReduceOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: reduceParams,
+								Body: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{
+										{
+											Key: &semantic.Identifier{Name: "sum"},
+											Value: &semantic.BinaryExpression{
+												Operator: ast.AdditionOperator,
+												Left:     member("accumulator", "sum"),
+												Right:    member("r", "_value"),
+											},
+										},
+										{
+											Key: &semantic.Identifier{Name: "count"},
+											Value: &semantic.BinaryExpression{
+												Operator: ast.AdditionOperator,
+												Left:     member("accumulator", "count"),
+												Right:    &semantic.IntegerLiteral{Value: 1},
+											},
+										},
+									},
+								},
+							},
+							Identity: &semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{Key: &semantic.Identifier{Name: "count"}, Value: &semantic.IntegerLiteral{Value: 0}},
+									{Key: &semantic.Identifier{Name: "sum"}, Value: &semantic.FloatLiteral{Value: 0}},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "reduce1"},
+				},
+			},
+		},
+		{
+			Name:    "missing identity",
+			Raw:     `from(db:"mydb") |> reduce(fn: (r, accumulator) => ({sum: accumulator.sum + r._value}))`,
+			WantErr: true,
+		},
+		{
+			Name:    "duration identity",
+			Raw:     `from(db:"mydb") |> reduce(fn: (r, accumulator) => accumulator, identity: {d: 1h})`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestReduceOperation_Marshaling(t *testing.T) {
+	data := []byte(`{
+		"id":"reduce",
+		"kind":"reduce",
+		"spec":{
+			"fn":{
+				"type": "ArrowFunctionExpression",
+				"params": [
+					{"type":"FunctionParam","key":{"type":"Identifier","name":"r"}},
+					{"type":"FunctionParam","key":{"type":"Identifier","name":"accumulator"}}
+				],
+				"body":{
+					"type":"IdentifierExpression",
+					"name":"accumulator"
+				}
+			},
+			"identity":{
+				"type":"ObjectExpression",
+				"properties":[
+					{"type":"Property","key":{"type":"Identifier","name":"max"},"value":{"type":"FloatLiteral","value":0}},
+					{"type":"Property","key":{"type":"Identifier","name":"time"},"value":{"type":"DateTimeLiteral","value":"2018-01-01T00:00:00Z"}}
+				]
+			}
+		}
+	}`)
+	op := &query.Operation{
+		ID: "reduce",
+		Spec: &functions.ReduceOpSpec{
+			Fn: &semantic.FunctionExpression{
+				Params: reduceParams,
+				Body:   &semantic.IdentifierExpression{Name: "accumulator"},
+			},
+			Identity: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{
+					{Key: &semantic.Identifier{Name: "max"}, Value: &semantic.FloatLiteral{Value: 0}},
+					{Key: &semantic.Identifier{Name: "time"}, Value: &semantic.DateTimeLiteral{Value: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)}},
+				},
+			},
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestReduce_Process(t *testing.T) {
+	// The sum of the values that exist, and the number of rows.
+	sumCount := &functions.ReduceProcedureSpec{
+		Fn: &semantic.FunctionExpression{
+			Params: reduceParams,
+			Body: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{
+					{
+						Key: &semantic.Identifier{Name: "sum"},
+						Value: &semantic.ConditionalExpression{
+							Test: &semantic.UnaryExpression{
+								Operator: ast.ExistsOperator,
+								Argument: member("r", "_value"),
+							},
+							Consequent: &semantic.BinaryExpression{
+								Operator: ast.AdditionOperator,
+								Left:     member("accumulator", "sum"),
+								Right:    member("r", "_value"),
+							},
+							Alternate: member("accumulator", "sum"),
+						},
+					},
+					{
+						Key: &semantic.Identifier{Name: "count"},
+						Value: &semantic.BinaryExpression{
+							Operator: ast.AdditionOperator,
+							Left:     member("accumulator", "count"),
+							Right:    &semantic.IntegerLiteral{Value: 1},
+						},
+					},
+				},
+			},
+		},
+		Identity: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{
+				{Key: &semantic.Identifier{Name: "count"}, Value: &semantic.IntegerLiteral{Value: 0}},
+				{Key: &semantic.Identifier{Name: "sum"}, Value: &semantic.FloatLiteral{Value: 0}},
+			},
+		},
+	}
+	// The sum of the values, without guarding against null values.
+	sum := &functions.ReduceProcedureSpec{
+		Fn: &semantic.FunctionExpression{
+			Params: reduceParams,
+			Body: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{{
+					Key: &semantic.Identifier{Name: "sum"},
+					Value: &semantic.BinaryExpression{
+						Operator: ast.AdditionOperator,
+						Left:     member("accumulator", "sum"),
+						Right:    member("r", "_value"),
+					},
+				}},
+			},
+		},
+		Identity: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{
+				{Key: &semantic.Identifier{Name: "sum"}, Value: &semantic.FloatLiteral{Value: 0}},
+			},
+		},
+	}
+	// The maximum value and its time.
+	isMax := &semantic.BinaryExpression{
+		Operator: ast.GreaterThanOperator,
+		Left:     member("r", "_value"),
+		Right:    member("accumulator", "max"),
+	}
+	maxTime := &functions.ReduceProcedureSpec{
+		Fn: &semantic.FunctionExpression{
+			Params: reduceParams,
+			Body: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{
+					{
+						Key: &semantic.Identifier{Name: "max"},
+						Value: &semantic.ConditionalExpression{
+							Test:       isMax,
+							Consequent: member("r", "_value"),
+							Alternate:  member("accumulator", "max"),
+						},
+					},
+					{
+						Key: &semantic.Identifier{Name: "time"},
+						Value: &semantic.ConditionalExpression{
+							Test:       isMax,
+							Consequent: member("r", "_time"),
+							Alternate:  member("accumulator", "time"),
+						},
+					},
+				},
+			},
+		},
+		Identity: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{
+				{Key: &semantic.Identifier{Name: "max"}, Value: &semantic.FloatLiteral{Value: -1}},
+				{Key: &semantic.Identifier{Name: "time"}, Value: &semantic.DateTimeLiteral{Value: time.Unix(0, 0).UTC()}},
+			},
+		},
+	}
+	bounds := execute.Bounds{Start: 0, Stop: 10}
+	testCases := []struct {
+		name string
+		spec *functions.ReduceProcedureSpec
+		data []execute.Block
+		want []*executetest.Block
+	}{
+		{
+			name: "sum and count",
+			spec: sumCount,
+			data: []execute.Block{&executetest.Block{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					{Label: "cpu", Type: execute.TString, Kind: execute.TagColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "a", "cpu0"},
+					{execute.Time(2), nil, "a", "cpu1"},
+					{execute.Time(3), 3.5, "a", "cpu0"},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					{Label: "count", Type: execute.TInt, Kind: execute.ValueColKind},
+					{Label: "sum", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), "a", int64(3), 4.5},
+				},
+			}},
+		},
+		{
+			// A null value makes the sum null, and the sum stays null for the rest of the block.
+			name: "unguarded null value",
+			spec: sum,
+			data: []execute.Block{&executetest.Block{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), nil},
+					{execute.Time(3), 3.5},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "sum", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), nil},
+				},
+			}},
+		},
+		{
+			name: "empty block",
+			spec: sumCount,
+			data: []execute.Block{&executetest.Block{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "count", Type: execute.TInt, Kind: execute.ValueColKind},
+					{Label: "sum", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), int64(0), 0.0},
+				},
+			}},
+		},
+		{
+			name: "max and its time",
+			spec: maxTime,
+			data: []execute.Block{
+				&executetest.Block{
+					Bnds: execute.Bounds{Start: 0, Stop: 5},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 2.0},
+						{execute.Time(2), 7.0},
+						{execute.Time(3), 4.0},
+					},
+				},
+				&executetest.Block{
+					Bnds: execute.Bounds{Start: 5, Stop: 10},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(6), 1.0},
+						{execute.Time(8), 3.0},
+					},
+				},
+			},
+			// The blocks of a window have the same tags, so their rows are in the same block.
+			want: []*executetest.Block{{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "max", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "time", Type: execute.TTime, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), 7.0, execute.Time(2)},
+					{execute.Time(10), 3.0, execute.Time(8)},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					tx, err := functions.NewReduceTransformation(d, c, bounds, tc.spec)
+					if err != nil {
+						t.Fatal(err)
+					}
+					return tx
+				},
+			)
+		})
+	}
+}
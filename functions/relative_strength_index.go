@@ -0,0 +1,148 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const RelativeStrengthIndexKind = "relativeStrengthIndex"
+
+type RelativeStrengthIndexOpSpec struct {
+	N int64 `json:"n"`
+}
+
+var relativeStrengthIndexSignature = query.DefaultFunctionSignature()
+
+func init() {
+	relativeStrengthIndexSignature.Params["n"] = semantic.Int
+
+	query.RegisterFunction(RelativeStrengthIndexKind, createRelativeStrengthIndexOpSpec, relativeStrengthIndexSignature)
+	query.RegisterOpSpec(RelativeStrengthIndexKind, newRelativeStrengthIndexOp)
+	plan.RegisterProcedureSpec(RelativeStrengthIndexKind, newRelativeStrengthIndexProcedure, RelativeStrengthIndexKind)
+	execute.RegisterTransformation(RelativeStrengthIndexKind, createRelativeStrengthIndexTransformation)
+}
+
+func createRelativeStrengthIndexOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	n, err := getMovingN(RelativeStrengthIndexKind, args)
+	if err != nil {
+		return nil, err
+	}
+	return &RelativeStrengthIndexOpSpec{
+		N: n,
+	}, nil
+}
+
+func newRelativeStrengthIndexOp() query.OperationSpec {
+	return new(RelativeStrengthIndexOpSpec)
+}
+
+func (s *RelativeStrengthIndexOpSpec) Kind() query.OperationKind {
+	return RelativeStrengthIndexKind
+}
+
+// DecompileArguments returns the arguments of the relativeStrengthIndex call that creates the spec.
+func (s *RelativeStrengthIndexOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "n", Value: s.N}}
+}
+
+type RelativeStrengthIndexProcedureSpec struct {
+	N int64 `json:"n"`
+}
+
+func newRelativeStrengthIndexProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*RelativeStrengthIndexOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &RelativeStrengthIndexProcedureSpec{
+		N: spec.N,
+	}, nil
+}
+
+func (s *RelativeStrengthIndexProcedureSpec) Kind() plan.ProcedureKind {
+	return RelativeStrengthIndexKind
+}
+func (s *RelativeStrengthIndexProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(RelativeStrengthIndexProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createRelativeStrengthIndexTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*RelativeStrengthIndexProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewRelativeStrengthIndexTransformation(d, cache, s)
+	return t, d, nil
+}
+
+func NewRelativeStrengthIndexTransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *RelativeStrengthIndexProcedureSpec) execute.Transformation {
+	n := spec.N
+	return newMovingTransformation(d, cache, func() movingFunc {
+		return &relativeStrengthIndex{n: n}
+	})
+}
+
+// relativeStrengthIndex is 100 - 100 / (1 + average gain / average loss) of the changes between values.
+// The first averages are the means of the first n changes, after that an average is (average * (n - 1) + change) / n.
+type relativeStrengthIndex struct {
+	n int64
+
+	// seen reports whether prev is set.
+	seen  bool
+	prev  float64
+	count int64
+
+	gain float64
+	loss float64
+}
+
+func (r *relativeStrengthIndex) update(v float64) bool {
+	if !r.seen {
+		r.seen = true
+		r.prev = v
+		return false
+	}
+	var gain, loss float64
+	if change := v - r.prev; change > 0 {
+		gain = change
+	} else {
+		loss = -change
+	}
+	r.prev = v
+
+	if r.count < r.n {
+		r.count++
+		r.gain += gain
+		r.loss += loss
+		if r.count < r.n {
+			return false
+		}
+		r.gain /= float64(r.n)
+		r.loss /= float64(r.n)
+		return true
+	}
+	n := float64(r.n)
+	r.gain = (r.gain*(n-1) + gain) / n
+	r.loss = (r.loss*(n-1) + loss) / n
+	return true
+}
+
+func (r *relativeStrengthIndex) value() float64 {
+	if r.loss == 0 {
+		return 100
+	}
+	return 100 - 100/(1+r.gain/r.loss)
+}
@@ -0,0 +1,97 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestRelativeStrengthIndexOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"relativeStrengthIndex","kind":"relativeStrengthIndex","spec":{"n":14}}`)
+	op := &query.Operation{
+		ID: "relativeStrengthIndex",
+		Spec: &functions.RelativeStrengthIndexOpSpec{
+			N: 14,
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestRelativeStrengthIndex_Process(t *testing.T) {
+	cols := []execute.ColMeta{
+		{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+	}
+	testCases := []struct {
+		name string
+		spec *functions.RelativeStrengthIndexProcedureSpec
+		data []execute.Block
+		want []*executetest.Block
+	}{
+		{
+			name: "gains and losses",
+			spec: &functions.RelativeStrengthIndexProcedureSpec{N: 2},
+			data: []execute.Block{&executetest.Block{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+					{execute.Time(3), 1.0},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), 4.0},
+				},
+			}},
+			// The average gain and loss are 0.5 and 0.5, then 1.75 and 0.25, then 0.875 and 0.125.
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(3), 50.0},
+					{execute.Time(4), 87.5},
+					{execute.Time(5), 87.5},
+				},
+			}},
+		},
+		{
+			name: "only gains",
+			spec: &functions.RelativeStrengthIndexProcedureSpec{N: 2},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), int64(2)},
+					{execute.Time(3), int64(3)},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds:    execute.Bounds{Start: 0, Stop: 10},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{execute.Time(3), 100.0},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					return functions.NewRelativeStrengthIndexTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
@@ -74,6 +74,21 @@ func (s *SampleOpSpec) Kind() query.OperationKind {
 	return SampleKind
 }
 
+// DecompileArguments returns the arguments of the sample call that creates the spec.
+func (s *SampleOpSpec) DecompileArguments() []query.DecompiledArgument {
+	args := []query.DecompiledArgument{{Key: "n", Value: s.N}}
+	if s.Pos != -1 {
+		args = append(args, query.DecompiledArgument{Key: "pos", Value: s.Pos})
+	}
+	if s.Column != "" {
+		args = append(args, query.DecompiledArgument{Key: "column", Value: s.Column})
+	}
+	if s.UseRowTime {
+		args = append(args, query.DecompiledArgument{Key: "useRowTime", Value: true})
+	}
+	return args
+}
+
 type SampleProcedureSpec struct {
 	Column     string
 	UseRowTime bool
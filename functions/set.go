@@ -1,7 +1,10 @@
 package functions
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
@@ -14,6 +17,8 @@ const SetKind = "set"
 type SetOpSpec struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
+	// Column is the column whose value in each row is the value of the tag, instead of Value.
+	Column string `json:"column,omitempty"`
 }
 
 var setSignature = query.DefaultFunctionSignature()
@@ -21,6 +26,7 @@ var setSignature = query.DefaultFunctionSignature()
 func init() {
 	setSignature.Params["key"] = semantic.String
 	setSignature.Params["value"] = semantic.String
+	setSignature.Params["column"] = semantic.String
 
 	query.RegisterFunction(SetKind, createSetOpSpec, setSignature)
 	query.RegisterOpSpec(SetKind, newSetOp)
@@ -40,12 +46,22 @@ func createSetOpSpec(args query.Arguments, a *query.Administration) (query.Opera
 	}
 	spec.Key = key
 
-	value, err := args.GetRequiredString("value")
+	value, hasValue, err := args.GetString("value")
 	if err != nil {
 		return nil, err
 	}
 	spec.Value = value
 
+	column, hasColumn, err := args.GetString("column")
+	if err != nil {
+		return nil, err
+	}
+	spec.Column = column
+
+	if hasValue == hasColumn {
+		return nil, errors.New("set requires exactly one of value and column")
+	}
+
 	return spec, nil
 }
 
@@ -57,8 +73,22 @@ func (s *SetOpSpec) Kind() query.OperationKind {
 	return SetKind
 }
 
+// DecompileArguments returns the arguments of the set call that creates the spec.
+func (s *SetOpSpec) DecompileArguments() []query.DecompiledArgument {
+	if s.Column != "" {
+		return []query.DecompiledArgument{
+			{Key: "key", Value: s.Key},
+			{Key: "column", Value: s.Column},
+		}
+	}
+	return []query.DecompiledArgument{
+		{Key: "key", Value: s.Key},
+		{Key: "value", Value: s.Value},
+	}
+}
+
 type SetProcedureSpec struct {
-	Key, Value string
+	Key, Value, Column string
 }
 
 func newSetProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -67,8 +97,9 @@ func newSetProcedure(qs query.OperationSpec, pa plan.Administration) (plan.Proce
 		return nil, fmt.Errorf("invalid spec type %T", qs)
 	}
 	p := &SetProcedureSpec{
-		Key:   s.Key,
-		Value: s.Value,
+		Key:    s.Key,
+		Value:  s.Value,
+		Column: s.Column,
 	}
 	return p, nil
 }
@@ -80,6 +111,7 @@ func (s *SetProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(SetProcedureSpec)
 	ns.Key = s.Key
 	ns.Value = s.Value
+	ns.Column = s.Column
 	return ns
 }
 
@@ -98,7 +130,7 @@ type setTransformation struct {
 	d     execute.Dataset
 	cache execute.BlockBuilderCache
 
-	key, value string
+	key, value, column string
 }
 
 func NewSetTransformation(
@@ -107,10 +139,11 @@ func NewSetTransformation(
 	spec *SetProcedureSpec,
 ) execute.Transformation {
 	return &setTransformation{
-		d:     d,
-		cache: cache,
-		key:   spec.Key,
-		value: spec.Value,
+		d:      d,
+		cache:  cache,
+		key:    spec.Key,
+		value:  spec.Value,
+		column: spec.Column,
 	}
 }
 
@@ -122,7 +155,18 @@ func (t *setTransformation) RetractBlock(id execute.DatasetID, meta execute.Bloc
 func (t *setTransformation) Process(id execute.DatasetID, b execute.Block) error {
 	tags := b.Tags()
 	isCommon := false
-	if v, ok := tags[t.key]; ok {
+	colIdx := -1
+	if t.column != "" {
+		// The tag has the value of the column in each row, so it is not common to the block.
+		colIdx = execute.ColIdx(t.column, b.Cols())
+		if colIdx < 0 {
+			return fmt.Errorf("block does not have the column %q", t.column)
+		}
+		if _, ok := tags[t.key]; ok {
+			tags = tags.Copy()
+			delete(tags, t.key)
+		}
+	} else if v, ok := tags[t.key]; ok {
 		isCommon = true
 		if v != t.value {
 			tags = tags.Copy()
@@ -140,6 +184,7 @@ func (t *setTransformation) Process(id execute.DatasetID, b execute.Block) error
 		for j, c := range cols {
 			if c.Label == t.key {
 				found = true
+				c.Common = isCommon
 			}
 			builder.AddCol(c)
 			if c.IsTag() && c.Common {
@@ -171,6 +216,10 @@ func (t *setTransformation) Process(id execute.DatasetID, b execute.Block) error
 				continue
 			}
 			for i := range ts {
+				if j != setIdx && rr.IsNull(i, j) {
+					builder.AppendNil(j)
+					continue
+				}
 				switch c.Type {
 				case execute.TBool:
 					builder.AppendBool(j, rr.AtBool(i, j))
@@ -183,7 +232,9 @@ func (t *setTransformation) Process(id execute.DatasetID, b execute.Block) error
 				case execute.TString:
 					// Set new value
 					var v string
-					if j == setIdx {
+					if j == setIdx && colIdx >= 0 {
+						v = columnString(rr, i, colIdx, b.Cols()[colIdx].Type)
+					} else if j == setIdx {
 						v = t.value
 					} else {
 						v = rr.AtString(i, j)
@@ -200,6 +251,30 @@ func (t *setTransformation) Process(id execute.DatasetID, b execute.Block) error
 	return nil
 }
 
+// columnString formats the value of the column in row i as a tag value.
+func columnString(rr execute.RowReader, i, j int, typ execute.DataType) string {
+	if rr.IsNull(i, j) {
+		return ""
+	}
+	switch typ {
+	case execute.TBool:
+		return strconv.FormatBool(rr.AtBool(i, j))
+	case execute.TInt:
+		return strconv.FormatInt(rr.AtInt(i, j), 10)
+	case execute.TUInt:
+		return strconv.FormatUint(rr.AtUInt(i, j), 10)
+	case execute.TFloat:
+		return strconv.FormatFloat(rr.AtFloat(i, j), 'f', -1, 64)
+	case execute.TString:
+		return rr.AtString(i, j)
+	case execute.TTime:
+		return rr.AtTime(i, j).Time().Format(time.RFC3339Nano)
+	default:
+		execute.PanicUnknownType(typ)
+		return ""
+	}
+}
+
 func (t *setTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
@@ -23,6 +23,52 @@ func TestSetOperation_Marshaling(t *testing.T) {
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestSet_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from column",
+			Raw:  `from(db:"mydb") |> set(key:"t1", column:"_value")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "set1",
+						Spec: &functions.SetOpSpec{
+							Key:    "t1",
+							Column: "_value",
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "set1"},
+				},
+			},
+		},
+		{
+			Name:    "value and column",
+			Raw:     `from(db:"mydb") |> set(key:"t1", value:"a", column:"_value")`,
+			WantErr: true,
+		},
+		{
+			Name:    "no value",
+			Raw:     `from(db:"mydb") |> set(key:"t1")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestSet_Process(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -271,6 +317,43 @@ func TestSet_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "from column",
+			spec: &functions.SetProcedureSpec{
+				Key:    "t1",
+				Column: "_value",
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "alice"},
+					{execute.Time(2), 1.5, "alice"},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 1,
+					Stop:  3,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "t1", Type: execute.TString, Kind: execute.TagColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "2"},
+					{execute.Time(2), 1.5, "1.5"},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
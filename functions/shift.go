@@ -50,6 +50,11 @@ func (s *ShiftOpSpec) Kind() query.OperationKind {
 	return ShiftKind
 }
 
+// DecompileArguments returns the arguments of the shift call that creates the spec.
+func (s *ShiftOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{{Key: "shift", Value: s.Shift}}
+}
+
 type ShiftProcedureSpec struct {
 	Shift query.Duration
 }
@@ -91,7 +96,7 @@ func NewShiftTransformation(d execute.Dataset, cache execute.BlockBuilderCache,
 	return &shiftTransformation{
 		d:     d,
 		cache: cache,
-		shift: execute.Duration(spec.Shift),
+		shift: execute.Duration(spec.Shift.Fixed),
 	}
 }
 
@@ -128,6 +133,10 @@ func (t *shiftTransformation) Process(id execute.DatasetID, b execute.Block) err
 				continue
 			}
 			for i := range ts {
+				if rr.IsNull(i, j) {
+					builder.AppendNil(j)
+					continue
+				}
 				switch c.Type {
 				case execute.TBool:
 					builder.AppendBool(j, rr.AtBool(i, j))
@@ -16,7 +16,7 @@ func TestShiftOperation_Marshaling(t *testing.T) {
 	op := &query.Operation{
 		ID: "shift",
 		Spec: &functions.ShiftOpSpec{
-			Shift: query.Duration(1 * time.Hour),
+			Shift: query.Duration{Fixed: 1 * time.Hour},
 		},
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
@@ -37,7 +37,7 @@ func TestShift_Process(t *testing.T) {
 		{
 			name: "one block",
 			spec: &functions.ShiftProcedureSpec{
-				Shift: query.Duration(1),
+				Shift: query.Duration{Fixed: 1},
 			},
 			data: []execute.Block{
 				&executetest.Block{
@@ -63,7 +63,7 @@ func TestShift_Process(t *testing.T) {
 		{
 			name: "multiple blocks",
 			spec: &functions.ShiftProcedureSpec{
-				Shift: query.Duration(2),
+				Shift: query.Duration{Fixed: 2},
 			},
 			data: []execute.Block{
 				&executetest.Block{
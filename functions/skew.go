@@ -37,6 +37,11 @@ func (s *SkewOpSpec) Kind() query.OperationKind {
 	return SkewKind
 }
 
+// DecompileArguments returns the arguments of the skew call that creates the spec.
+func (s *SkewOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return nil
+}
+
 type SkewProcedureSpec struct {
 }
 
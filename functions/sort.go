@@ -60,6 +60,18 @@ func (s *SortOpSpec) Kind() query.OperationKind {
 	return SortKind
 }
 
+// DecompileArguments returns the arguments of the sort call that creates the spec.
+func (s *SortOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if len(s.Cols) != 1 || s.Cols[0] != execute.DefaultValueColLabel {
+		args = append(args, query.DecompiledArgument{Key: "cols", Value: s.Cols})
+	}
+	if s.Desc {
+		args = append(args, query.DecompiledArgument{Key: "desc", Value: true})
+	}
+	return args
+}
+
 type SortProcedureSpec struct {
 	Cols []string
 	Desc bool
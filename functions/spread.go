@@ -40,6 +40,11 @@ func (s *SpreadOpSpec) Kind() query.OperationKind {
 	return SpreadKind
 }
 
+// DecompileArguments returns the arguments of the spread call that creates the spec.
+func (s *SpreadOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return nil
+}
+
 func newSpreadProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
 	_, ok := qs.(*SpreadOpSpec)
 	if !ok {
@@ -24,7 +24,7 @@ type StateTrackingOpSpec struct {
 var stateTrackingSignature = query.DefaultFunctionSignature()
 
 func init() {
-	stateTrackingSignature.Params["fn"] = semantic.Function
+	stateTrackingSignature.Params["fn"] = execute.RowPredicateType
 	stateTrackingSignature.Params["countLabel"] = semantic.String
 	stateTrackingSignature.Params["durationLabel"] = semantic.String
 	stateTrackingSignature.Params["durationUnit"] = semantic.Duration
@@ -37,6 +37,8 @@ func init() {
 }
 
 var stateTrackingBuiltin = `
+package states
+
 // stateCount computes the number of consecutive records in a given state.
 // The state is defined via the function fn. For each consecutive point for
 // which the expression evaluates as true, the state count will be incremented
@@ -85,7 +87,7 @@ func createStateTrackingOpSpec(args query.Arguments, a *query.Administration) (q
 
 	spec := &StateTrackingOpSpec{
 		Fn:           resolved,
-		DurationUnit: query.Duration(time.Second),
+		DurationUnit: query.Duration{Fixed: time.Second},
 	}
 
 	if label, ok, err := args.GetString("countLabel"); err != nil {
@@ -104,7 +106,7 @@ func createStateTrackingOpSpec(args query.Arguments, a *query.Administration) (q
 		spec.DurationUnit = unit
 	}
 
-	if spec.DurationLabel != "" && spec.DurationUnit <= 0 {
+	if spec.DurationLabel != "" && spec.DurationUnit.Fixed <= 0 {
 		return nil, errors.New("state tracking duration unit must be greater than zero")
 	}
 	return spec, nil
@@ -118,6 +120,21 @@ func (s *StateTrackingOpSpec) Kind() query.OperationKind {
 	return StateTrackingKind
 }
 
+// DecompileArguments returns the arguments of the stateTracking call that creates the spec.
+func (s *StateTrackingOpSpec) DecompileArguments() []query.DecompiledArgument {
+	args := []query.DecompiledArgument{{Key: "fn", Value: s.Fn}}
+	if s.CountLabel != "" {
+		args = append(args, query.DecompiledArgument{Key: "countLabel", Value: s.CountLabel})
+	}
+	if s.DurationLabel != "" {
+		args = append(args, query.DecompiledArgument{Key: "durationLabel", Value: s.DurationLabel})
+	}
+	if s.DurationUnit != (query.Duration{Fixed: time.Second}) {
+		args = append(args, query.DecompiledArgument{Key: "durationUnit", Value: s.DurationUnit})
+	}
+	return args
+}
+
 type StateTrackingProcedureSpec struct {
 	Fn *semantic.FunctionExpression
 	CountLabel,
@@ -190,7 +207,7 @@ func NewStateTrackingTransformation(d execute.Dataset, cache execute.BlockBuilde
 		fn:            fn,
 		countLabel:    spec.CountLabel,
 		durationLabel: spec.DurationLabel,
-		durationUnit:  int64(spec.DurationUnit),
+		durationUnit:  int64(spec.DurationUnit.Fixed),
 	}, nil
 }
 
@@ -20,7 +20,7 @@ func TestStateTrackingOperation_Marshaling(t *testing.T) {
 		Spec: &functions.StateTrackingOpSpec{
 			CountLabel:    "c",
 			DurationLabel: "d",
-			DurationUnit:  query.Duration(time.Minute),
+			DurationUnit:  query.Duration{Fixed: time.Minute},
 		},
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
@@ -49,7 +49,7 @@ func TestStateTracking_Process(t *testing.T) {
 			spec: &functions.StateTrackingProcedureSpec{
 				CountLabel:    "count",
 				DurationLabel: "duration",
-				DurationUnit:  1,
+				DurationUnit:  query.Duration{Fixed: 1},
 				Fn:            gt5,
 			},
 			data: []execute.Block{&executetest.Block{
@@ -95,7 +95,7 @@ func TestStateTracking_Process(t *testing.T) {
 			name: "only duration",
 			spec: &functions.StateTrackingProcedureSpec{
 				DurationLabel: "duration",
-				DurationUnit:  1,
+				DurationUnit:  query.Duration{Fixed: 1},
 				Fn:            gt5,
 			},
 			data: []execute.Block{&executetest.Block{
@@ -1,21 +1,33 @@
 package functions
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
 )
 
 const StddevKind = "stddev"
 
+// The modes of stddev, the sample standard deviation divides the squared deviations by n - 1
+// and the population standard deviation divides them by n.
+const (
+	SampleStddevMode     = "sample"
+	PopulationStddevMode = "population"
+)
+
 type StddevOpSpec struct {
+	Mode string `json:"mode,omitempty"`
 }
 
 var stddevSignature = query.DefaultFunctionSignature()
 
 func init() {
+	stddevSignature.Params["mode"] = semantic.String
+
 	query.RegisterFunction(StddevKind, createStddevOpSpec, stddevSignature)
 	query.RegisterOpSpec(StddevKind, newStddevOp)
 	plan.RegisterProcedureSpec(StddevKind, newStddevProcedure, StddevKind)
@@ -26,7 +38,16 @@ func createStddevOpSpec(args query.Arguments, a *query.Administration) (query.Op
 		return nil, err
 	}
 
-	return new(StddevOpSpec), nil
+	spec := new(StddevOpSpec)
+	if mode, ok, err := args.GetString("mode"); err != nil {
+		return nil, err
+	} else if ok {
+		if mode != SampleStddevMode && mode != PopulationStddevMode {
+			return nil, fmt.Errorf("mode must be %q or %q, got %q", SampleStddevMode, PopulationStddevMode, mode)
+		}
+		spec.Mode = mode
+	}
+	return spec, nil
 }
 
 func newStddevOp() query.OperationSpec {
@@ -37,26 +58,50 @@ func (s *StddevOpSpec) Kind() query.OperationKind {
 	return StddevKind
 }
 
+// DecompileArguments returns the arguments of the stddev call that creates the spec.
+func (s *StddevOpSpec) DecompileArguments() []query.DecompiledArgument {
+	if s.Mode == "" {
+		return nil
+	}
+	return []query.DecompiledArgument{{Key: "mode", Value: s.Mode}}
+}
+
 type StddevProcedureSpec struct {
+	Mode string
 }
 
-func newStddevProcedure(query.OperationSpec, plan.Administration) (plan.ProcedureSpec, error) {
-	return new(StddevProcedureSpec), nil
+func newStddevProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*StddevOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &StddevProcedureSpec{
+		Mode: spec.Mode,
+	}, nil
 }
 
 func (s *StddevProcedureSpec) Kind() plan.ProcedureKind {
 	return StddevKind
 }
 func (s *StddevProcedureSpec) Copy() plan.ProcedureSpec {
-	return new(StddevProcedureSpec)
+	return &StddevProcedureSpec{
+		Mode: s.Mode,
+	}
 }
 
 type StddevAgg struct {
+	// Mode is the mode of the standard deviation, the sample standard deviation when it is empty.
+	Mode string
+
 	n, m2, mean float64
 }
 
 func createStddevTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
-	t, d := execute.NewAggregateTransformationAndDataset(id, mode, a.Bounds(), new(StddevAgg), a.Allocator())
+	s, ok := spec.(*StddevProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	t, d := execute.NewAggregateTransformationAndDataset(id, mode, a.Bounds(), &StddevAgg{Mode: s.Mode}, a.Allocator())
 	return t, d, nil
 }
 
@@ -123,6 +168,12 @@ func (a *StddevAgg) Type() execute.DataType {
 	return execute.TFloat
 }
 func (a *StddevAgg) ValueFloat() float64 {
+	if a.Mode == PopulationStddevMode {
+		if a.n < 1 {
+			return math.NaN()
+		}
+		return math.Sqrt(a.m2 / a.n)
+	}
 	if a.n < 2 {
 		return math.NaN()
 	}
@@ -20,9 +20,50 @@ func TestStddevOperation_Marshaling(t *testing.T) {
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestStddev_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "population",
+			Raw:  `from(db:"mydb") |> stddev(mode:"population")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "stddev1",
+						Spec: &functions.StddevOpSpec{
+							Mode: functions.PopulationStddevMode,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "stddev1"},
+				},
+			},
+		},
+		{
+			Name:    "unknown mode",
+			Raw:     `from(db:"mydb") |> stddev(mode:"biased")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestStddev_Process(t *testing.T) {
 	testCases := []struct {
 		name string
+		mode string
 		data []float64
 		want float64
 	}{
@@ -41,13 +82,25 @@ func TestStddev_Process(t *testing.T) {
 			data: []float64{1},
 			want: math.NaN(),
 		},
+		{
+			name: "population",
+			mode: functions.PopulationStddevMode,
+			data: []float64{1, 2, 3, 4},
+			want: math.Sqrt(1.25),
+		},
+		{
+			name: "population of one value",
+			mode: functions.PopulationStddevMode,
+			data: []float64{1},
+			want: 0.0,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			executetest.AggFuncTestHelper(
 				t,
-				new(functions.StddevAgg),
+				&functions.StddevAgg{Mode: tc.mode},
 				tc.data,
 				tc.want,
 			)
@@ -36,6 +36,11 @@ func (s *SumOpSpec) Kind() query.OperationKind {
 	return SumKind
 }
 
+// DecompileArguments returns the arguments of the sum call that creates the spec.
+func (s *SumOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return nil
+}
+
 type SumProcedureSpec struct {
 }
 
@@ -0,0 +1,257 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const TimedMovingAverageKind = "timedMovingAverage"
+
+type TimedMovingAverageOpSpec struct {
+	Every  query.Duration `json:"every"`
+	Period query.Duration `json:"period"`
+}
+
+var timedMovingAverageSignature = query.DefaultFunctionSignature()
+
+func init() {
+	timedMovingAverageSignature.Params["every"] = semantic.Duration
+	timedMovingAverageSignature.Params["period"] = semantic.Duration
+
+	query.RegisterFunction(TimedMovingAverageKind, createTimedMovingAverageOpSpec, timedMovingAverageSignature)
+	query.RegisterOpSpec(TimedMovingAverageKind, newTimedMovingAverageOp)
+	plan.RegisterProcedureSpec(TimedMovingAverageKind, newTimedMovingAverageProcedure, TimedMovingAverageKind)
+	execute.RegisterTransformation(TimedMovingAverageKind, createTimedMovingAverageTransformation)
+}
+
+func createTimedMovingAverageOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	every, err := args.GetRequiredDuration("every")
+	if err != nil {
+		return nil, err
+	}
+	period, err := args.GetRequiredDuration("period")
+	if err != nil {
+		return nil, err
+	}
+	if every.Fixed <= 0 || period.Fixed <= 0 {
+		return nil, errors.New("timedMovingAverage requires every and period to be positive")
+	}
+
+	return &TimedMovingAverageOpSpec{
+		Every:  every,
+		Period: period,
+	}, nil
+}
+
+func newTimedMovingAverageOp() query.OperationSpec {
+	return new(TimedMovingAverageOpSpec)
+}
+
+func (s *TimedMovingAverageOpSpec) Kind() query.OperationKind {
+	return TimedMovingAverageKind
+}
+
+// DecompileArguments returns the arguments of the timedMovingAverage call that creates the spec.
+func (s *TimedMovingAverageOpSpec) DecompileArguments() []query.DecompiledArgument {
+	return []query.DecompiledArgument{
+		{Key: "every", Value: s.Every},
+		{Key: "period", Value: s.Period},
+	}
+}
+
+type TimedMovingAverageProcedureSpec struct {
+	Every  query.Duration `json:"every"`
+	Period query.Duration `json:"period"`
+}
+
+func newTimedMovingAverageProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*TimedMovingAverageOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &TimedMovingAverageProcedureSpec{
+		Every:  spec.Every,
+		Period: spec.Period,
+	}, nil
+}
+
+func (s *TimedMovingAverageProcedureSpec) Kind() plan.ProcedureKind {
+	return TimedMovingAverageKind
+}
+func (s *TimedMovingAverageProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(TimedMovingAverageProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createTimedMovingAverageTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*TimedMovingAverageProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewTimedMovingAverageTransformation(d, cache, a.Bounds(), s)
+	return t, d, nil
+}
+
+type timedMovingAverageTransformation struct {
+	d     execute.Dataset
+	cache execute.BlockBuilderCache
+
+	bounds execute.Bounds
+	every  execute.Duration
+	period execute.Duration
+}
+
+func NewTimedMovingAverageTransformation(d execute.Dataset, cache execute.BlockBuilderCache, bounds execute.Bounds, spec *TimedMovingAverageProcedureSpec) *timedMovingAverageTransformation {
+	return &timedMovingAverageTransformation{
+		d:      d,
+		cache:  cache,
+		bounds: bounds,
+		every:  execute.Duration(spec.Every.Fixed),
+		period: execute.Duration(spec.Period.Fixed),
+	}
+}
+
+func (t *timedMovingAverageTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) error {
+	return t.d.RetractBlock(execute.ToBlockKey(meta))
+}
+
+// timedPoint is a value of a column at a time.
+type timedPoint struct {
+	t execute.Time
+	v float64
+}
+
+// Process writes a row every duration, aligned to the Unix epoch, within the bounds of the query.
+// The value of each numeric value column is the mean of its values in the period before the time of the row,
+// rows without any values are left out. The columns of the result are the time, the common tags and the numeric value columns.
+func (t *timedMovingAverageTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	cols := b.Cols()
+	builder, new := t.cache.BlockBuilder(b)
+	if new {
+		for _, c := range cols {
+			switch {
+			case c.Kind == execute.TimeColKind:
+				builder.AddCol(c)
+			case c.Common:
+				k := builder.AddCol(c)
+				builder.SetCommonString(k, b.Tags()[c.Label])
+			case c.IsValue() && isNumeric(c.Type):
+				c.Type = execute.TFloat
+				builder.AddCol(c)
+			}
+		}
+	}
+	timeIdx := execute.TimeIdx(builder.Cols())
+
+	// The values of the numeric value columns, and their columns in the builder.
+	var (
+		valueIdxs []int
+		points    [][]timedPoint
+	)
+	for j, c := range cols {
+		if c.IsValue() && isNumeric(c.Type) {
+			valueIdxs = append(valueIdxs, j)
+			points = append(points, nil)
+		}
+	}
+	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+		for k, j := range valueIdxs {
+			for i := range ts {
+				if !rr.IsNull(i, j) {
+					points[k] = append(points[k], timedPoint{t: ts[i], v: numericValue(i, j, rr)})
+				}
+			}
+		}
+	})
+	builderIdxs := make([]int, len(valueIdxs))
+	for k, j := range valueIdxs {
+		builderIdxs[k] = execute.ColIdx(cols[j].Label, builder.Cols())
+		ps := points[k]
+		sort.Slice(ps, func(i, j int) bool { return ps[i].t < ps[j].t })
+	}
+
+	// lo and hi are the indexes of the first point of the period and the first point after it.
+	lo := make([]int, len(valueIdxs))
+	hi := make([]int, len(valueIdxs))
+	sums := make([]float64, len(valueIdxs))
+	first, ok := nextTimedPoint(points, lo)
+	if !ok {
+		return nil
+	}
+	if first < t.bounds.Start {
+		first = t.bounds.Start
+	}
+	for stop := first.Truncate(t.every) + execute.Time(t.every); stop <= t.bounds.Stop; stop += execute.Time(t.every) {
+		start := stop - execute.Time(t.period)
+		include := false
+		for k, ps := range points {
+			for ; hi[k] < len(ps) && ps[hi[k]].t < stop; hi[k]++ {
+				sums[k] += ps[hi[k]].v
+			}
+			for ; lo[k] < hi[k] && ps[lo[k]].t < start; lo[k]++ {
+				sums[k] -= ps[lo[k]].v
+			}
+			if lo[k] == hi[k] {
+				// Reset the sum of an empty period, so that rounding errors do not accumulate.
+				sums[k] = 0
+			}
+			include = include || hi[k] > lo[k]
+		}
+		if !include {
+			// Skip the periods before the next value.
+			next, ok := nextTimedPoint(points, hi)
+			if !ok {
+				break
+			}
+			stop = next.Truncate(t.every)
+			continue
+		}
+		builder.AppendTime(timeIdx, stop)
+		for k := range points {
+			if n := hi[k] - lo[k]; n > 0 {
+				builder.AppendFloat(builderIdxs[k], sums[k]/float64(n))
+			} else {
+				builder.AppendNil(builderIdxs[k])
+			}
+		}
+	}
+	return nil
+}
+
+// nextTimedPoint returns the earliest time of the points at the indexes next.
+func nextTimedPoint(points [][]timedPoint, next []int) (execute.Time, bool) {
+	var (
+		min execute.Time
+		ok  bool
+	)
+	for k, ps := range points {
+		if next[k] < len(ps) && (!ok || ps[next[k]].t < min) {
+			min, ok = ps[next[k]].t, true
+		}
+	}
+	return min, ok
+}
+
+func (t *timedMovingAverageTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *timedMovingAverageTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *timedMovingAverageTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
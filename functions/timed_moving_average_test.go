@@ -0,0 +1,193 @@
+package functions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestTimedMovingAverage_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "timed moving average",
+			Raw:  `from(db:"mydb") |> timedMovingAverage(every:1m, period:5m)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "timedMovingAverage1",
+						Spec: &functions.TimedMovingAverageOpSpec{
+							Every:  query.Duration{Fixed: time.Minute},
+							Period: query.Duration{Fixed: 5 * time.Minute},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "timedMovingAverage1"},
+				},
+			},
+		},
+		{
+			Name:    "missing period",
+			Raw:     `from(db:"mydb") |> timedMovingAverage(every:1m)`,
+			WantErr: true,
+		},
+		{
+			Name:    "calendar duration",
+			Raw:     `from(db:"mydb") |> timedMovingAverage(every:1mo, period:1y)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestTimedMovingAverageOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"timedMovingAverage","kind":"timedMovingAverage","spec":{"every":"1m","period":"5m"}}`)
+	op := &query.Operation{
+		ID: "timedMovingAverage",
+		Spec: &functions.TimedMovingAverageOpSpec{
+			Every:  query.Duration{Fixed: time.Minute},
+			Period: query.Duration{Fixed: 5 * time.Minute},
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestTimedMovingAverage_Process(t *testing.T) {
+	bounds := execute.Bounds{Start: 0, Stop: 10}
+	testCases := []struct {
+		name string
+		spec *functions.TimedMovingAverageProcedureSpec
+		data []execute.Block
+		want []*executetest.Block
+	}{
+		{
+			name: "overlapping periods",
+			spec: &functions.TimedMovingAverageProcedureSpec{
+				Every:  query.Duration{Fixed: 2},
+				Period: query.Duration{Fixed: 4},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), int64(2)},
+					{execute.Time(3), int64(3)},
+					{execute.Time(7), int64(7)},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 1.0},
+					{execute.Time(4), 2.0},
+					{execute.Time(6), 2.5},
+					{execute.Time(8), 7.0},
+					{execute.Time(10), 7.0},
+				},
+			}},
+		},
+		{
+			name: "gap",
+			spec: &functions.TimedMovingAverageProcedureSpec{
+				Every:  query.Duration{Fixed: 1},
+				Period: query.Duration{Fixed: 1},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(8), 8.0},
+					{execute.Time(1), 1.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 1.0},
+					{execute.Time(9), 8.0},
+				},
+			}},
+		},
+		{
+			name: "multiple columns",
+			spec: &functions.TimedMovingAverageProcedureSpec{
+				Every:  query.Duration{Fixed: 5},
+				Period: query.Duration{Fixed: 5},
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "s", Type: execute.TString, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					{Label: "cpu", Type: execute.TString, Kind: execute.TagColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, nil, "a", "h", "cpu0"},
+					{execute.Time(2), 3.0, nil, "b", "h", "cpu1"},
+					{execute.Time(6), nil, 6.0, "c", "h", "cpu0"},
+				},
+			}},
+			want: []*executetest.Block{{
+				Bnds: bounds,
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), 2.0, nil, "h"},
+					{execute.Time(10), nil, 6.0, "h"},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					return functions.NewTimedMovingAverageTransformation(d, c, bounds, tc.spec)
+				},
+			)
+		})
+	}
+}
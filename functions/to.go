@@ -0,0 +1,379 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/influxdata/ifql/compiler"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/influxdb/models"
+)
+
+const ToKind = "to"
+
+const (
+	measurementColLabel = "_measurement"
+	fieldColLabel       = "_field"
+)
+
+type ToOpSpec struct {
+	Database    string                       `json:"db"`
+	Measurement string                       `json:"measurement"`
+	TagColumns  []string                     `json:"tagColumns"`
+	FieldFn     *semantic.FunctionExpression `json:"fieldFn"`
+}
+
+var toSignature = query.DefaultFunctionSignature()
+
+func init() {
+	toSignature.Params["db"] = semantic.String
+	toSignature.Params["measurement"] = semantic.String
+	toSignature.Params["tagColumns"] = semantic.NewArrayType(semantic.String)
+	toSignature.Params["fieldFn"] = execute.RowMapType
+
+	query.RegisterFunction(ToKind, createToOpSpec, toSignature)
+	query.RegisterOpSpec(ToKind, newToOp)
+	plan.RegisterProcedureSpec(ToKind, newToProcedure, ToKind)
+	execute.RegisterTransformation(ToKind, createToTransformation)
+}
+
+func createToOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	db, err := args.GetRequiredString("db")
+	if err != nil {
+		return nil, err
+	}
+	spec := &ToOpSpec{
+		Database: db,
+	}
+
+	if m, ok, err := args.GetString("measurement"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Measurement = m
+	}
+
+	if array, ok, err := args.GetArray("tagColumns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TagColumns = array.AsStrings()
+	}
+
+	if f, ok, err := args.GetFunction("fieldFn"); err != nil {
+		return nil, err
+	} else if ok {
+		fn, err := f.Resolve()
+		if err != nil {
+			return nil, err
+		}
+		spec.FieldFn = fn
+	}
+	return spec, nil
+}
+
+func newToOp() query.OperationSpec {
+	return new(ToOpSpec)
+}
+
+func (s *ToOpSpec) Kind() query.OperationKind {
+	return ToKind
+}
+
+// DecompileArguments returns the arguments of the to call that creates the spec.
+func (s *ToOpSpec) DecompileArguments() []query.DecompiledArgument {
+	args := []query.DecompiledArgument{{Key: "db", Value: s.Database}}
+	if s.Measurement != "" {
+		args = append(args, query.DecompiledArgument{Key: "measurement", Value: s.Measurement})
+	}
+	if len(s.TagColumns) > 0 {
+		args = append(args, query.DecompiledArgument{Key: "tagColumns", Value: s.TagColumns})
+	}
+	if s.FieldFn != nil {
+		args = append(args, query.DecompiledArgument{Key: "fieldFn", Value: s.FieldFn})
+	}
+	return args
+}
+
+type ToProcedureSpec struct {
+	Database    string
+	Measurement string
+	TagColumns  []string
+	FieldFn     *semantic.FunctionExpression
+}
+
+func newToProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &ToProcedureSpec{
+		Database:    spec.Database,
+		Measurement: spec.Measurement,
+		TagColumns:  spec.TagColumns,
+		FieldFn:     spec.FieldFn,
+	}, nil
+}
+
+func (s *ToProcedureSpec) Kind() plan.ProcedureKind {
+	return ToKind
+}
+func (s *ToProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(ToProcedureSpec)
+	*ns = *s
+
+	if s.TagColumns != nil {
+		ns.TagColumns = make([]string, len(s.TagColumns))
+		copy(ns.TagColumns, s.TagColumns)
+	}
+	if s.FieldFn != nil {
+		ns.FieldFn = s.FieldFn.Copy().(*semantic.FunctionExpression)
+	}
+	return ns
+}
+
+func createToTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ToProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	w := a.PointsWriter()
+	if w == nil {
+		return nil, nil, errors.New("to requires a points writer, none has been configured")
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t, err := NewToTransformation(a.Context(), d, cache, s, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, d, nil
+}
+
+// toTransformation writes each row of its blocks as a point and passes the blocks through unchanged.
+//
+// By default the measurement is read from the _measurement column,
+// every tag column other than _measurement and _field becomes a tag,
+// and the _value column is written as the field named by the _field column.
+// Blocks without a _field column write each value column as a field of the same name.
+type toTransformation struct {
+	d     execute.Dataset
+	cache execute.BlockBuilderCache
+	w     execute.PointsWriter
+	spec  *ToProcedureSpec
+	// ctx is the context of the query, writes are given up once it is done.
+	ctx context.Context
+
+	fieldFn *execute.RowMapFn
+}
+
+func NewToTransformation(ctx context.Context, d execute.Dataset, cache execute.BlockBuilderCache, spec *ToProcedureSpec, w execute.PointsWriter) (*toTransformation, error) {
+	t := &toTransformation{
+		ctx:   ctx,
+		d:     d,
+		cache: cache,
+		w:     w,
+		spec:  spec,
+	}
+	if spec.FieldFn != nil {
+		fn, err := execute.NewRowMapFn(spec.FieldFn)
+		if err != nil {
+			return nil, err
+		}
+		t.fieldFn = fn
+	}
+	return t, nil
+}
+
+func (t *toTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) error {
+	return t.d.RetractBlock(execute.ToBlockKey(meta))
+}
+
+func (t *toTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	cols := b.Cols()
+	timeIdx := execute.TimeIdx(cols)
+	if timeIdx < 0 {
+		return fmt.Errorf("no column %q exists", execute.TimeColLabel)
+	}
+	measurementIdx := execute.ColIdx(measurementColLabel, cols)
+	if t.spec.Measurement == "" && measurementIdx < 0 {
+		return fmt.Errorf("no column %q exists and no measurement was specified", measurementColLabel)
+	}
+
+	var tagIdxs []int
+	if t.spec.TagColumns != nil {
+		tagIdxs = make([]int, len(t.spec.TagColumns))
+		for i, label := range t.spec.TagColumns {
+			j := execute.ColIdx(label, cols)
+			if j < 0 {
+				return fmt.Errorf("no tag column %q exists", label)
+			}
+			if cols[j].Type != execute.TString {
+				return fmt.Errorf("tag column %q must be of type string, got %v", label, cols[j].Type)
+			}
+			tagIdxs[i] = j
+		}
+	} else {
+		for j, c := range cols {
+			if c.IsTag() && c.Label != measurementColLabel && c.Label != fieldColLabel {
+				tagIdxs = append(tagIdxs, j)
+			}
+		}
+	}
+
+	fieldIdx := execute.ColIdx(fieldColLabel, cols)
+	valueIdx := execute.ValueIdx(cols)
+	var valueIdxs []int
+	if t.fieldFn != nil {
+		if err := t.fieldFn.Prepare(cols); err != nil {
+			return err
+		}
+	} else if fieldIdx < 0 || valueIdx < 0 {
+		for j, c := range cols {
+			if c.IsValue() {
+				valueIdxs = append(valueIdxs, j)
+			}
+		}
+	}
+
+	builder, new := t.cache.BlockBuilder(b)
+	if !new {
+		return fmt.Errorf("received duplicate block bounds: %v tags: %v", b.Bounds(), b.Tags())
+	}
+	execute.AddBlockCols(b, builder)
+	colMap := make([]int, len(cols))
+	for j := range cols {
+		colMap[j] = j
+	}
+
+	var points []models.Point
+	var err error
+	b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+		for i := range ts {
+			execute.AppendRow(i, rr, builder, colMap)
+			if err != nil {
+				continue
+			}
+
+			measurement := t.spec.Measurement
+			if measurement == "" {
+				// A row without a measurement is not written.
+				if rr.IsNull(i, measurementIdx) {
+					continue
+				}
+				measurement = rr.AtString(i, measurementIdx)
+			}
+			tags := make(map[string]string, len(tagIdxs))
+			for _, j := range tagIdxs {
+				if rr.IsNull(i, j) {
+					continue
+				}
+				tags[cols[j].Label] = rr.AtString(i, j)
+			}
+
+			fields := make(map[string]interface{})
+			switch {
+			case t.fieldFn != nil:
+				m, fnErr := t.fieldFn.Eval(i, rr)
+				if fnErr != nil {
+					err = fnErr
+					continue
+				}
+				for k := range m.Type().Properties() {
+					// Null properties are not written.
+					if v := m.Get(k); v != nil && v.Type() != semantic.Nil {
+						fv, fvErr := compilerFieldValue(k, v)
+						if fvErr != nil {
+							err = fvErr
+							break
+						}
+						fields[k] = fv
+					}
+				}
+			case valueIdxs != nil:
+				for _, j := range valueIdxs {
+					if !rr.IsNull(i, j) {
+						fields[cols[j].Label] = rowFieldValue(i, j, rr)
+					}
+				}
+			default:
+				if !rr.IsNull(i, fieldIdx) && !rr.IsNull(i, valueIdx) {
+					fields[rr.AtString(i, fieldIdx)] = rowFieldValue(i, valueIdx, rr)
+				}
+			}
+			if err != nil || len(fields) == 0 || rr.IsNull(i, timeIdx) {
+				continue
+			}
+
+			p, pErr := models.NewPoint(measurement, models.NewTags(tags), fields, time.Unix(0, int64(rr.AtTime(i, timeIdx))))
+			if pErr != nil {
+				err = pErr
+				continue
+			}
+			points = append(points, p)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return t.w.WritePoints(t.ctx, t.spec.Database, points)
+}
+
+func (t *toTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *toTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *toTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// rowFieldValue returns the value at i, j as a field value, times are written as integer nanoseconds.
+func rowFieldValue(i, j int, rr execute.RowReader) interface{} {
+	switch typ := rr.Cols()[j].Type; typ {
+	case execute.TBool:
+		return rr.AtBool(i, j)
+	case execute.TInt:
+		return rr.AtInt(i, j)
+	case execute.TUInt:
+		return rr.AtUInt(i, j)
+	case execute.TFloat:
+		return rr.AtFloat(i, j)
+	case execute.TString:
+		return rr.AtString(i, j)
+	case execute.TTime:
+		return int64(rr.AtTime(i, j))
+	default:
+		execute.PanicUnknownType(typ)
+		return nil
+	}
+}
+
+// compilerFieldValue returns v as the value of the field, times are written as integer nanoseconds.
+func compilerFieldValue(field string, v compiler.Value) (interface{}, error) {
+	switch k := v.Type().Kind(); k {
+	case semantic.Bool:
+		return v.Bool(), nil
+	case semantic.Int:
+		return v.Int(), nil
+	case semantic.UInt:
+		return v.UInt(), nil
+	case semantic.Float:
+		return v.Float(), nil
+	case semantic.String:
+		return v.Str(), nil
+	case semantic.Time:
+		return int64(v.Time()), nil
+	default:
+		return nil, fmt.Errorf("field %q has unsupported type %v", field, v.Type())
+	}
+}
@@ -0,0 +1,304 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/querytest"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/influxdb/models"
+)
+
+func TestTo_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "missing db",
+			Raw:     `from(db:"mydb") |> to(measurement:"cpu")`,
+			WantErr: true,
+		},
+		{
+			Name: "to with options",
+			Raw:  `from(db:"mydb") |> to(db:"rollups", measurement:"cpu_1h", tagColumns:["host"], fieldFn: (r) => ({max: r._value}))`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "to1",
+						Spec: &functions.ToOpSpec{
+							Database:    "rollups",
+							Measurement: "cpu_1h",
+							TagColumns:  []string{"host"},
+							FieldFn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{{
+										Key: &semantic.Identifier{Name: "max"},
+										Value: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "r"},
+											Property: "_value",
+										},
+									}},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "to1"},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestToOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"to","kind":"to","spec":{"db":"rollups","measurement":"cpu_1h","tagColumns":["host"]}}`)
+	op := &query.Operation{
+		ID: "to",
+		Spec: &functions.ToOpSpec{
+			Database:    "rollups",
+			Measurement: "cpu_1h",
+			TagColumns:  []string{"host"},
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+// testPointsWriter records the points written to it as line protocol.
+type testPointsWriter struct {
+	lines []string
+}
+
+func (w *testPointsWriter) WritePoints(ctx context.Context, db string, points []models.Point) error {
+	for _, p := range points {
+		w.lines = append(w.lines, db+" "+p.String())
+	}
+	return nil
+}
+
+func TestTo_Process(t *testing.T) {
+	storageCols := []execute.ColMeta{
+		{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+		{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		{Label: "region", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+	}
+	storageBlock := &executetest.Block{
+		Bnds:    execute.Bounds{Start: 1, Stop: 3},
+		ColMeta: storageCols,
+		Data: [][]interface{}{
+			{execute.Time(1), 1.5, "usage", "cpu", "a", "west"},
+			{execute.Time(2), 2.5, "usage", "cpu", "a", "west"},
+		},
+	}
+	testCases := []struct {
+		name  string
+		spec  *functions.ToProcedureSpec
+		data  []execute.Block
+		lines []string
+	}{
+		{
+			name: "defaults",
+			spec: &functions.ToProcedureSpec{
+				Database: "rollups",
+			},
+			data: []execute.Block{storageBlock},
+			lines: []string{
+				"rollups cpu,host=a,region=west usage=1.5 1",
+				"rollups cpu,host=a,region=west usage=2.5 2",
+			},
+		},
+		{
+			name: "measurement and tag columns",
+			spec: &functions.ToProcedureSpec{
+				Database:    "rollups",
+				Measurement: "cpu_1h",
+				TagColumns:  []string{"host"},
+			},
+			data: []execute.Block{storageBlock},
+			lines: []string{
+				"rollups cpu_1h,host=a usage=1.5 1",
+				"rollups cpu_1h,host=a usage=2.5 2",
+			},
+		},
+		{
+			name: "field fn",
+			spec: &functions.ToProcedureSpec{
+				Database: "rollups",
+				FieldFn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{
+								Key: &semantic.Identifier{Name: "max"},
+								Value: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "_value",
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "double"},
+								Value: &semantic.BinaryExpression{
+									Operator: ast.MultiplicationOperator,
+									Left: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "_value",
+									},
+									Right: &semantic.FloatLiteral{Value: 2},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{storageBlock},
+			lines: []string{
+				"rollups cpu,host=a,region=west double=3,max=1.5 1",
+				"rollups cpu,host=a,region=west double=5,max=2.5 2",
+			},
+		},
+		{
+			name: "value columns",
+			spec: &functions.ToProcedureSpec{
+				Database:    "rollups",
+				Measurement: "stats",
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 1, Stop: 3},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "count", Type: execute.TInt, Kind: execute.ValueColKind},
+					{Label: "ok", Type: execute.TBool, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(3), true, "a"},
+					{execute.Time(2), int64(4), false, "b"},
+				},
+			}},
+			lines: []string{
+				"rollups stats,host=a count=3i,ok=true 1",
+				"rollups stats,host=b count=4i,ok=false 2",
+			},
+		},
+		{
+			name: "null measurement",
+			spec: &functions.ToProcedureSpec{
+				Database: "rollups",
+			},
+			data: []execute.Block{&executetest.Block{
+				Bnds: execute.Bounds{Start: 1, Stop: 3},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.5, "usage", nil},
+					{execute.Time(2), 2.5, "usage", "cpu"},
+				},
+			}},
+			lines: []string{
+				"rollups cpu usage=2.5 2",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := new(testPointsWriter)
+			want := make([]*executetest.Block, len(tc.data))
+			for i, b := range tc.data {
+				want[i] = executetest.ConvertBlock(b)
+			}
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					tx, err := functions.NewToTransformation(context.Background(), d, c, tc.spec, w)
+					if err != nil {
+						t.Fatal(err)
+					}
+					return tx
+				},
+			)
+			if !cmp.Equal(tc.lines, w.lines) {
+				t.Errorf("unexpected points -want/+got\n%s", cmp.Diff(tc.lines, w.lines))
+			}
+		})
+	}
+}
+
+func TestTo_Process_DurationField(t *testing.T) {
+	spec := &functions.ToProcedureSpec{
+		Database:    "rollups",
+		Measurement: "cpu",
+		FieldFn: &semantic.FunctionExpression{
+			Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+			Body: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{{
+					Key: &semantic.Identifier{Name: "d"},
+					Value: &semantic.BinaryExpression{
+						Operator: ast.SubtractionOperator,
+						Left: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_time",
+						},
+						Right: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_time",
+						},
+					},
+				}},
+			},
+		},
+	}
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewBlockBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(execute.DefaultTriggerSpec)
+	w := new(testPointsWriter)
+	tx, err := functions.NewToTransformation(context.Background(), d, c, spec, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &executetest.Block{
+		Bnds: execute.Bounds{Start: 1, Stop: 3},
+		ColMeta: []execute.ColMeta{
+			{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+			{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0},
+			{execute.Time(2), 6.0},
+		},
+	}
+	// A duration cannot be written as a field, the block fails instead of panicking.
+	if err := tx.Process(executetest.RandomDatasetID(), b); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(w.lines) != 0 {
+		t.Errorf("unexpected points %v", w.lines)
+	}
+}
@@ -11,6 +11,8 @@ func init() {
 }
 
 var topBottomBuiltIn = `
+package selectors
+
 // _sortLimit is a helper function, which sorts and limits a table.
 _sortLimit = (n, desc, cols=["_value"], table=<-) =>
 	table
@@ -2,6 +2,7 @@ package functions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
@@ -13,11 +14,13 @@ import (
 const WindowKind = "window"
 
 type WindowOpSpec struct {
-	Every      query.Duration    `json:"every"`
-	Period     query.Duration    `json:"period"`
-	Start      query.Time        `json:"start"`
-	Round      query.Duration    `json:"round"`
-	Triggering query.TriggerSpec `json:"triggering"`
+	Every       query.Duration    `json:"every"`
+	Period      query.Duration    `json:"period"`
+	Start       query.Time        `json:"start"`
+	Round       query.Duration    `json:"round"`
+	Location    string            `json:"location,omitempty"`
+	CreateEmpty bool              `json:"createEmpty"`
+	Triggering  query.TriggerSpec `json:"triggering"`
 }
 
 var windowSignature = query.DefaultFunctionSignature()
@@ -27,32 +30,44 @@ func init() {
 	windowSignature.Params["period"] = semantic.Duration
 	windowSignature.Params["round"] = semantic.Duration
 	windowSignature.Params["start"] = semantic.Time
+	windowSignature.Params["location"] = semantic.String
+	windowSignature.Params["createEmpty"] = semantic.Bool
 
 	query.RegisterFunction(WindowKind, createWindowOpSpec, windowSignature)
+	query.RegisterBuiltIn("window", windowBuiltIn)
 	query.RegisterOpSpec(WindowKind, newWindowOp)
 	plan.RegisterProcedureSpec(WindowKind, newWindowProcedure, WindowKind)
 	execute.RegisterTransformation(WindowKind, createWindowTransformation)
 }
 
+var windowBuiltIn = `
+// aggregateWindow applies the aggregate or selector fn to the windows of every duration, aligned to the Unix epoch.
+// The result has a row for every window within the bounds of the query, at the stop time of the window.
+// The value of a window without any rows is null, unless createEmpty is false and the window is left out.
+aggregateWindow = (every, fn, createEmpty=true, table=<-) =>
+	fn(table: table |> window(every:every, start:1970-01-01T00:00:00Z, createEmpty:createEmpty))
+		|> sort(cols:["_time"])
+`
+
 func createWindowOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
 	if err := a.AddParentFromArgs(args); err != nil {
 		return nil, err
 	}
 
 	spec := new(WindowOpSpec)
-	every, everySet, err := args.GetDuration("every")
+	every, everySet, err := args.GetCalendarDuration("every")
 	if err != nil {
 		return nil, err
 	}
 	if everySet {
-		spec.Every = query.Duration(every)
+		spec.Every = every
 	}
-	period, periodSet, err := args.GetDuration("period")
+	period, periodSet, err := args.GetCalendarDuration("period")
 	if err != nil {
 		return nil, err
 	}
 	if periodSet {
-		spec.Every = period
+		spec.Period = period
 	}
 	if round, ok, err := args.GetDuration("round"); err != nil {
 		return nil, err
@@ -64,6 +79,19 @@ func createWindowOpSpec(args query.Arguments, a *query.Administration) (query.Op
 	} else if ok {
 		spec.Start = start
 	}
+	if location, ok, err := args.GetString("location"); err != nil {
+		return nil, err
+	} else if ok {
+		if _, err := time.LoadLocation(location); err != nil {
+			return nil, err
+		}
+		spec.Location = location
+	}
+	if createEmpty, ok, err := args.GetBool("createEmpty"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.CreateEmpty = createEmpty
+	}
 
 	if !everySet && !periodSet {
 		return nil, errors.New(`window function requires at least one of "every" or "period" to be set`)
@@ -86,9 +114,31 @@ func (s *WindowOpSpec) Kind() query.OperationKind {
 	return WindowKind
 }
 
+// DecompileArguments returns the arguments of the window call that creates the spec.
+func (s *WindowOpSpec) DecompileArguments() []query.DecompiledArgument {
+	args := []query.DecompiledArgument{{Key: "every", Value: s.Every}}
+	if s.Period != s.Every {
+		args = append(args, query.DecompiledArgument{Key: "period", Value: s.Period})
+	}
+	if !s.Start.IsZero() {
+		args = append(args, query.DecompiledArgument{Key: "start", Value: s.Start})
+	}
+	if !s.Round.IsZero() {
+		args = append(args, query.DecompiledArgument{Key: "round", Value: s.Round})
+	}
+	if s.Location != "" {
+		args = append(args, query.DecompiledArgument{Key: "location", Value: s.Location})
+	}
+	if s.CreateEmpty {
+		args = append(args, query.DecompiledArgument{Key: "createEmpty", Value: true})
+	}
+	return args
+}
+
 type WindowProcedureSpec struct {
-	Window     plan.WindowSpec
-	Triggering query.TriggerSpec
+	Window      plan.WindowSpec
+	CreateEmpty bool
+	Triggering  query.TriggerSpec
 }
 
 func newWindowProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -98,12 +148,14 @@ func newWindowProcedure(qs query.OperationSpec, pa plan.Administration) (plan.Pr
 	}
 	p := &WindowProcedureSpec{
 		Window: plan.WindowSpec{
-			Every:  s.Every,
-			Period: s.Period,
-			Round:  s.Round,
-			Start:  s.Start,
+			Every:    s.Every,
+			Period:   s.Period,
+			Round:    s.Round,
+			Start:    s.Start,
+			Location: s.Location,
 		},
-		Triggering: s.Triggering,
+		CreateEmpty: s.CreateEmpty,
+		Triggering:  s.Triggering,
 	}
 	if p.Triggering == nil {
 		p.Triggering = query.DefaultTrigger
@@ -117,6 +169,7 @@ func (s *WindowProcedureSpec) Kind() plan.ProcedureKind {
 func (s *WindowProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(WindowProcedureSpec)
 	ns.Window = s.Window
+	ns.CreateEmpty = s.CreateEmpty
 	ns.Triggering = s.Triggering
 	return ns
 }
@@ -125,6 +178,30 @@ func (s *WindowProcedureSpec) TriggerSpec() query.TriggerSpec {
 	return s.Triggering
 }
 
+func (s *WindowProcedureSpec) PushDownRules() []plan.PushDownRule {
+	if s.Triggering != query.DefaultTrigger {
+		// The storage source cannot honor custom triggers.
+		return nil
+	}
+	return []plan.PushDownRule{{
+		Root:    FromKind,
+		Through: nil,
+		Match: func(spec plan.ProcedureSpec) bool {
+			selectSpec := spec.(*FromProcedureSpec)
+			// Windows must be applied before any aggregate or limit,
+			// and windowing an already windowed source is left to the transformation.
+			// The storage only supports fixed windows in UTC, and does not create empty windows.
+			return !s.Window.IsCalendar() && !s.CreateEmpty && !selectSpec.WindowSet && !selectSpec.AggregateSet && !selectSpec.LimitSet
+		},
+	}}
+}
+
+func (s *WindowProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Procedure) {
+	selectSpec := root.Spec.(*FromProcedureSpec)
+	selectSpec.WindowSet = true
+	selectSpec.Window = s.Window
+}
+
 func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*WindowProcedureSpec)
 	if !ok {
@@ -132,12 +209,30 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 	}
 	cache := execute.NewBlockBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
-	t := NewFixedWindowTransformation(d, cache, a.Bounds(), execute.Window{
-		Every:  execute.Duration(s.Window.Every),
-		Period: execute.Duration(s.Window.Period),
-		Round:  execute.Duration(s.Window.Round),
-		Start:  a.ResolveTime(s.Window.Start),
-	})
+	w := execute.Window{
+		Every:        execute.Duration(s.Window.Every.Fixed),
+		Period:       execute.Duration(s.Window.Period.Fixed),
+		Round:        execute.Duration(s.Window.Round.Fixed),
+		Start:        a.ResolveTime(s.Window.Start),
+		EveryMonths:  s.Window.Every.Months,
+		PeriodMonths: s.Window.Period.Months,
+	}
+	if s.Window.Location != "" {
+		loc, err := time.LoadLocation(s.Window.Location)
+		if err != nil {
+			return nil, nil, err
+		}
+		w.Location = loc
+	}
+	if w.IsCalendar() && s.Window.Start.IsZero() {
+		// Calendar windows are aligned to the Unix epoch on the wall clock of their location.
+		loc := w.Location
+		if loc == nil {
+			loc = time.UTC
+		}
+		w.Start = execute.Time(time.Date(1970, time.January, 1, 0, 0, 0, 0, loc).UnixNano())
+	}
+	t := NewFixedWindowTransformation(d, cache, a.Bounds(), w, s.CreateEmpty)
 	return t, d, nil
 }
 
@@ -148,6 +243,15 @@ type fixedWindowTransformation struct {
 	bounds execute.Bounds
 
 	offset execute.Duration
+
+	// loc and origin are the location and wall clock start of calendar windows.
+	loc    *time.Location
+	origin time.Time
+
+	// createEmpty creates the windows of each tag set that have no rows,
+	// seen records the tag sets whose empty windows were created.
+	createEmpty bool
+	seen        map[execute.TagsKey]bool
 }
 
 func NewFixedWindowTransformation(
@@ -155,15 +259,26 @@ func NewFixedWindowTransformation(
 	cache execute.BlockBuilderCache,
 	bounds execute.Bounds,
 	w execute.Window,
+	createEmpty bool,
 ) execute.Transformation {
-	offset := execute.Duration(w.Start - w.Start.Truncate(w.Every))
-	return &fixedWindowTransformation{
-		d:      d,
-		cache:  cache,
-		w:      w,
-		bounds: bounds,
-		offset: offset,
+	t := &fixedWindowTransformation{
+		d:           d,
+		cache:       cache,
+		w:           w,
+		bounds:      bounds,
+		createEmpty: createEmpty,
+		seen:        make(map[execute.TagsKey]bool),
+	}
+	if w.IsCalendar() {
+		t.loc = w.Location
+		if t.loc == nil {
+			t.loc = time.UTC
+		}
+		t.origin = wallClock(w.Start, t.loc)
+		return t
 	}
+	t.offset = execute.Duration(w.Start - w.Start.Truncate(w.Every))
+	return t
 }
 
 func (t *fixedWindowTransformation) RetractBlock(id execute.DatasetID, meta execute.BlockMetadata) (err error) {
@@ -183,6 +298,20 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, b execute.Bloc
 	cols := b.Cols()
 	valueIdx := execute.ValueIdx(cols)
 	valueCol := cols[valueIdx]
+	if tagKey := b.Tags().Key(); t.createEmpty && !t.seen[tagKey] {
+		t.seen[tagKey] = true
+		for _, bnds := range t.allWindowBounds() {
+			builder, new := t.cache.BlockBuilder(blockMetadata{
+				tags:   b.Tags(),
+				bounds: bnds,
+			})
+			if new {
+				builder.AddCol(execute.TimeCol)
+				builder.AddCol(valueCol)
+				execute.AddTags(b.Tags(), builder)
+			}
+		}
+	}
 	times := b.Times()
 	times.DoTime(func(ts []execute.Time, rr execute.RowReader) {
 		for i, time := range ts {
@@ -207,6 +336,9 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, b execute.Bloc
 }
 
 func (t *fixedWindowTransformation) getWindowBounds(now execute.Time) []execute.Bounds {
+	if t.w.IsCalendar() {
+		return t.getCalendarWindowBounds(now)
+	}
 	stop := now.Truncate(t.w.Every) + execute.Time(t.offset)
 	if now >= stop {
 		stop += execute.Time(t.w.Every)
@@ -243,6 +375,133 @@ func (t *fixedWindowTransformation) getWindowBounds(now execute.Time) []execute.
 	return bounds
 }
 
+// getCalendarWindowBounds returns the bounds of the windows containing now,
+// where window boundaries are computed on the wall clock of the window location.
+// Boundaries are k multiples of every from the origin, so a daily window spans 23 or 25 hours
+// across daylight saving time transitions and a monthly window spans a calendar month.
+func (t *fixedWindowTransformation) getCalendarWindowBounds(now execute.Time) []execute.Bounds {
+	wallNow := wallClock(now, t.loc)
+	k, ok := t.nextBoundary(wallNow)
+	if !ok {
+		return nil
+	}
+
+	var bounds []execute.Bounds
+	for ; ; k++ {
+		stop := t.boundary(k)
+		start := stop.AddDate(0, -int(t.w.PeriodMonths), 0).Add(-time.Duration(t.w.Period))
+		if wallNow.Before(start) {
+			break
+		}
+		bnds := execute.Bounds{
+			Start: fromWallClock(start, t.loc),
+			Stop:  fromWallClock(stop, t.loc),
+		}
+
+		// Check global bounds
+		if bnds.Stop > t.bounds.Stop {
+			bnds.Stop = t.bounds.Stop
+		}
+		if bnds.Start < t.bounds.Start {
+			bnds.Start = t.bounds.Start
+		}
+
+		// Check bounds again since we just clamped them.
+		if bnds.Contains(now) {
+			bounds = append(bounds, bnds)
+		}
+	}
+	return bounds
+}
+
+// nextBoundary returns the index of the first window boundary after the wall clock time,
+// the boolean return value is false if the windows have no length.
+func (t *fixedWindowTransformation) nextBoundary(wall time.Time) (int64, bool) {
+	// Estimate the index of the first boundary after wall, and then correct it.
+	step := float64(t.w.EveryMonths)*float64(averageMonth) + float64(t.w.Every)
+	if step <= 0 {
+		return 0, false
+	}
+	k := int64(float64(wall.Sub(t.origin)) / step)
+	for !t.boundary(k).After(wall) {
+		k++
+	}
+	for t.boundary(k - 1).After(wall) {
+		k--
+	}
+	return k, true
+}
+
+// allWindowBounds returns the bounds of every window that overlaps the bounds of the transformation,
+// clamped to those bounds.
+func (t *fixedWindowTransformation) allWindowBounds() []execute.Bounds {
+	var bounds []execute.Bounds
+	add := func(bnds execute.Bounds) {
+		if bnds.Stop > t.bounds.Stop {
+			bnds.Stop = t.bounds.Stop
+		}
+		if bnds.Start < t.bounds.Start {
+			bnds.Start = t.bounds.Start
+		}
+		if bnds.Start < bnds.Stop {
+			bounds = append(bounds, bnds)
+		}
+	}
+	if t.w.IsCalendar() {
+		k, ok := t.nextBoundary(wallClock(t.bounds.Start, t.loc))
+		if !ok {
+			return nil
+		}
+		for ; ; k++ {
+			stop := t.boundary(k)
+			start := stop.AddDate(0, -int(t.w.PeriodMonths), 0).Add(-time.Duration(t.w.Period))
+			bnds := execute.Bounds{
+				Start: fromWallClock(start, t.loc),
+				Stop:  fromWallClock(stop, t.loc),
+			}
+			if bnds.Start >= t.bounds.Stop {
+				return bounds
+			}
+			add(bnds)
+		}
+	}
+	if t.w.Every <= 0 {
+		return nil
+	}
+	stop := t.bounds.Start.Truncate(t.w.Every) + execute.Time(t.offset)
+	if t.bounds.Start >= stop {
+		stop += execute.Time(t.w.Every)
+	}
+	for start := stop - execute.Time(t.w.Period); start < t.bounds.Stop; start += execute.Time(t.w.Every) {
+		add(execute.Bounds{
+			Start: start,
+			Stop:  stop,
+		})
+		stop += execute.Time(t.w.Every)
+	}
+	return bounds
+}
+
+// boundary returns the wall clock time of the kth window boundary from the origin.
+func (t *fixedWindowTransformation) boundary(k int64) time.Time {
+	return t.origin.AddDate(0, int(k*t.w.EveryMonths), 0).Add(time.Duration(k) * time.Duration(t.w.Every))
+}
+
+// averageMonth is the average length of a month in the Gregorian calendar.
+const averageMonth = 2629746 * time.Second
+
+// wallClock returns the wall clock reading of t in the location as a UTC time,
+// so calendar arithmetic on it is not affected by daylight saving time.
+func wallClock(t execute.Time, loc *time.Location) time.Time {
+	lt := time.Unix(0, int64(t)).In(loc)
+	return time.Date(lt.Year(), lt.Month(), lt.Day(), lt.Hour(), lt.Minute(), lt.Second(), lt.Nanosecond(), time.UTC)
+}
+
+// fromWallClock returns the time of the wall clock reading in the location.
+func fromWallClock(wall time.Time, loc *time.Location) execute.Time {
+	return execute.Time(time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc).UnixNano())
+}
+
 func (t *fixedWindowTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
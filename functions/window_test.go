@@ -11,6 +11,8 @@ import (
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/query/plan/plantest"
 	"github.com/influxdata/ifql/query/querytest"
 )
 
@@ -34,8 +36,8 @@ func TestWindow_NewQuery(t *testing.T) {
 								Relative:   -4 * time.Hour,
 								IsRelative: true,
 							},
-							Every:  query.Duration(time.Hour),
-							Period: query.Duration(time.Hour),
+							Every:  query.Duration{Fixed: time.Hour},
+							Period: query.Duration{Fixed: time.Hour},
 						},
 					},
 				},
@@ -44,6 +46,114 @@ func TestWindow_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "from with calendar window",
+			Raw:  `from(db:"mydb") |> window(every:1mo, period:1y, location:"America/New_York")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &functions.WindowOpSpec{
+							Every:    query.Duration{Months: 1},
+							Period:   query.Duration{Months: 12},
+							Location: "America/New_York",
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+			},
+		},
+		{
+			Name: "from with empty windows",
+			Raw:  `from(db:"mydb") |> window(every:1m, createEmpty:true)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &functions.WindowOpSpec{
+							Every:       query.Duration{Fixed: time.Minute},
+							Period:      query.Duration{Fixed: time.Minute},
+							CreateEmpty: true,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+			},
+		},
+		{
+			Name: "from with overlapping windows",
+			Raw:  `from(db:"mydb") |> window(every:1m, period:1h)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &functions.WindowOpSpec{
+							Every:  query.Duration{Fixed: time.Minute},
+							Period: query.Duration{Fixed: time.Hour},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+			},
+		},
+		{
+			Name: "from with only a period",
+			Raw:  `from(db:"mydb") |> window(period:1h)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &functions.WindowOpSpec{
+							Every:  query.Duration{Fixed: time.Hour},
+							Period: query.Duration{Fixed: time.Hour},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+			},
+		},
+		{
+			Name:    "window with unknown location",
+			Raw:     `from(db:"mydb") |> window(every:1d, location:"Nowhere/Special")`,
+			WantErr: true,
+		},
+		{
+			Name:    "window with calendar round",
+			Raw:     `from(db:"mydb") |> window(every:1d, round:1mo)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -54,25 +164,103 @@ func TestWindow_NewQuery(t *testing.T) {
 	}
 }
 
+func TestWindowOperation_Marshaling_Calendar(t *testing.T) {
+	data := []byte(`{"id":"window","kind":"window","spec":{"every":"1mo","period":"1mo1d","start":"-1mo","round":"0s","location":"Europe/Paris"}}`)
+	op := &query.Operation{
+		ID: "window",
+		Spec: &functions.WindowOpSpec{
+			Every:  query.Duration{Months: 1},
+			Period: query.Duration{Months: 1, Fixed: 24 * time.Hour},
+			Start: query.Time{
+				Months:     -1,
+				IsRelative: true,
+			},
+			Location: "Europe/Paris",
+		},
+	}
+
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
 func TestWindowOperation_Marshaling(t *testing.T) {
 	//TODO: Test marshalling of triggerspec
 	data := []byte(`{"id":"window","kind":"window","spec":{"every":"1m","period":"1h","start":"-4h","round":"1s"}}`)
 	op := &query.Operation{
 		ID: "window",
 		Spec: &functions.WindowOpSpec{
-			Every:  query.Duration(time.Minute),
-			Period: query.Duration(time.Hour),
+			Every:  query.Duration{Fixed: time.Minute},
+			Period: query.Duration{Fixed: time.Hour},
 			Start: query.Time{
 				Relative:   -4 * time.Hour,
 				IsRelative: true,
 			},
-			Round: query.Duration(time.Second),
+			Round: query.Duration{Fixed: time.Second},
 		},
 	}
 
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
 
+func TestWindow_PushDown_Match(t *testing.T) {
+	spec := &functions.WindowProcedureSpec{
+		Triggering: query.DefaultTrigger,
+	}
+	from := new(functions.FromProcedureSpec)
+
+	// Should not match when an aggregate is set
+	from.AggregateSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should not match when a limit is set
+	from.AggregateSet = false
+	from.LimitSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should not match when a window is already set
+	from.LimitSet = false
+	from.WindowSet = true
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should match when no aggregate, limit or window is set
+	from.WindowSet = false
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{true})
+
+	// Should not match calendar windows
+	spec.Window.Every = query.Duration{Months: 1}
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+	spec.Window.Every = query.Duration{Fixed: time.Hour}
+	spec.Window.Location = "America/New_York"
+	plantest.PhysicalPlan_PushDown_Match_TestHelper(t, spec, from, []bool{false})
+
+	// Should not push down custom triggers
+	spec.Triggering = query.AfterProcessingTimeTriggerSpec{Duration: query.Duration{Fixed: time.Minute}}
+	if rules := spec.PushDownRules(); len(rules) != 0 {
+		t.Error("unexpected push down rules for custom trigger")
+	}
+}
+
+func TestWindow_PushDown(t *testing.T) {
+	window := plan.WindowSpec{
+		Every:  query.Duration{Fixed: time.Minute},
+		Period: query.Duration{Fixed: time.Minute},
+	}
+	spec := &functions.WindowProcedureSpec{
+		Window:     window,
+		Triggering: query.DefaultTrigger,
+	}
+	root := &plan.Procedure{
+		Spec: new(functions.FromProcedureSpec),
+	}
+	want := &plan.Procedure{
+		Spec: &functions.FromProcedureSpec{
+			WindowSet: true,
+			Window:    window,
+		},
+	}
+
+	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, false, want)
+}
+
 func TestFixedWindow_PassThrough(t *testing.T) {
 	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
 		fw := functions.NewFixedWindowTransformation(
@@ -83,6 +271,7 @@ func TestFixedWindow_PassThrough(t *testing.T) {
 				Every:  execute.Duration(time.Minute),
 				Period: execute.Duration(time.Minute),
 			},
+			false,
 		)
 		return fw
 	})
@@ -593,6 +782,7 @@ func TestFixedWindow_Process(t *testing.T) {
 					Period: tc.period,
 					Start:  start,
 				},
+				false,
 			)
 
 			block0 := &executetest.Block{
@@ -643,3 +833,241 @@ func TestFixedWindow_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestFixedWindow_Process_Calendar(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := func(year int, month time.Month, day, hour int) execute.Time {
+		return execute.Time(time.Date(year, month, day, hour, 0, 0, 0, newYork).UnixNano())
+	}
+	colMeta := []execute.ColMeta{
+		{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+	}
+	testCases := []struct {
+		name   string
+		window execute.Window
+		data   [][]interface{}
+		want   []*executetest.Block
+	}{
+		{
+			name: "monthly",
+			window: execute.Window{
+				EveryMonths:  1,
+				PeriodMonths: 1,
+				Start:        local(1970, time.January, 1, 0),
+				Location:     newYork,
+			},
+			data: [][]interface{}{
+				{local(2018, time.February, 28, 23), 1.0},
+				{local(2018, time.March, 1, 1), 2.0},
+				{local(2018, time.March, 31, 23), 3.0},
+				{local(2018, time.April, 1, 0), 4.0},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.February, 1, 0), Stop: local(2018, time.March, 1, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.February, 28, 23), 1.0},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.March, 1, 0), Stop: local(2018, time.April, 1, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.March, 1, 1), 2.0},
+						{local(2018, time.March, 31, 23), 3.0},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.April, 1, 0), Stop: local(2018, time.May, 1, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.April, 1, 0), 4.0},
+					},
+				},
+			},
+		},
+		{
+			// Daylight saving time starts on 2018-03-11, so that day is 23 hours long.
+			name: "daily across daylight saving time",
+			window: execute.Window{
+				Every:    execute.Duration(24 * time.Hour),
+				Period:   execute.Duration(24 * time.Hour),
+				Start:    local(1970, time.January, 1, 0),
+				Location: newYork,
+			},
+			data: [][]interface{}{
+				{local(2018, time.March, 10, 23), 1.0},
+				{local(2018, time.March, 11, 0), 2.0},
+				{local(2018, time.March, 11, 23), 3.0},
+				{local(2018, time.March, 12, 0), 4.0},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.March, 10, 0), Stop: local(2018, time.March, 11, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.March, 10, 23), 1.0},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.March, 11, 0), Stop: local(2018, time.March, 12, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.March, 11, 0), 2.0},
+						{local(2018, time.March, 11, 23), 3.0},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.March, 12, 0), Stop: local(2018, time.March, 13, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.March, 12, 0), 4.0},
+					},
+				},
+			},
+		},
+		{
+			name: "quarterly over a year",
+			window: execute.Window{
+				EveryMonths:  3,
+				PeriodMonths: 12,
+				Start:        local(1970, time.January, 1, 0),
+				Location:     newYork,
+			},
+			data: [][]interface{}{
+				{local(2018, time.May, 1, 0), 1.0},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.January, 1, 0), Stop: local(2018, time.July, 1, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.May, 1, 0), 1.0},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.January, 1, 0), Stop: local(2018, time.October, 1, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.May, 1, 0), 1.0},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.January, 1, 0), Stop: local(2018, time.December, 31, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.May, 1, 0), 1.0},
+					},
+				},
+				{
+					Bnds:    execute.Bounds{Start: local(2018, time.April, 1, 0), Stop: local(2018, time.December, 31, 0)},
+					ColMeta: colMeta,
+					Data: [][]interface{}{
+						{local(2018, time.May, 1, 0), 1.0},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// The global bounds clamp the windows of the quarterly case.
+			bounds := execute.Bounds{
+				Start: local(2018, time.January, 1, 0),
+				Stop:  local(2018, time.December, 31, 0),
+			}
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := execute.NewBlockBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(execute.DefaultTriggerSpec)
+
+			fw := functions.NewFixedWindowTransformation(d, c, bounds, tc.window, false)
+
+			block0 := &executetest.Block{
+				Bnds:    bounds,
+				ColMeta: colMeta,
+				Data:    tc.data,
+			}
+			parentID := executetest.RandomDatasetID()
+			if err := fw.Process(parentID, block0); err != nil {
+				t.Fatal(err)
+			}
+
+			got := executetest.BlocksFromCache(c)
+
+			sort.Sort(executetest.SortedBlocks(got))
+			want := tc.want
+			sort.Sort(executetest.SortedBlocks(want))
+
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestFixedWindow_Process_CreateEmpty(t *testing.T) {
+	colMeta := []execute.ColMeta{
+		{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+		{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+	}
+	bounds := execute.Bounds{Start: 0, Stop: 10}
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewBlockBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(execute.DefaultTriggerSpec)
+
+	fw := functions.NewFixedWindowTransformation(d, c, bounds, execute.Window{Every: 3, Period: 3}, true)
+
+	block0 := &executetest.Block{
+		Bnds:    bounds,
+		ColMeta: colMeta,
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0},
+			{execute.Time(7), 7.0},
+		},
+	}
+	parentID := executetest.RandomDatasetID()
+	if err := fw.Process(parentID, block0); err != nil {
+		t.Fatal(err)
+	}
+
+	// The windows without rows are created, and the last window is clamped to the bounds.
+	want := []*executetest.Block{
+		{
+			Bnds:    execute.Bounds{Start: 0, Stop: 3},
+			ColMeta: colMeta,
+			Data: [][]interface{}{
+				{execute.Time(1), 1.0},
+			},
+		},
+		{
+			Bnds:    execute.Bounds{Start: 3, Stop: 6},
+			ColMeta: colMeta,
+		},
+		{
+			Bnds:    execute.Bounds{Start: 6, Stop: 9},
+			ColMeta: colMeta,
+			Data: [][]interface{}{
+				{execute.Time(7), 7.0},
+			},
+		},
+		{
+			Bnds:    execute.Bounds{Start: 9, Stop: 10},
+			ColMeta: colMeta,
+		},
+	}
+	got := executetest.BlocksFromCache(c)
+
+	sort.Sort(executetest.SortedBlocks(got))
+	sort.Sort(executetest.SortedBlocks(want))
+
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
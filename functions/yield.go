@@ -56,6 +56,15 @@ func (s *YieldOpSpec) Kind() query.OperationKind {
 	return YieldKind
 }
 
+// DecompileArguments returns the arguments of the yield call that creates the spec.
+func (s *YieldOpSpec) DecompileArguments() []query.DecompiledArgument {
+	var args []query.DecompiledArgument
+	if s.Name != "_result" {
+		args = append(args, query.DecompiledArgument{Key: "name", Value: s.Name})
+	}
+	return args
+}
+
 type YieldProcedureSpec struct {
 	Name string `json:"name"`
 }
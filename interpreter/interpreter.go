@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/diagnostic"
 	"github.com/influxdata/ifql/semantic"
 	"github.com/pkg/errors"
 )
 
+// Eval evaluates the statements of the program in the scope.
+// An error is returned as a *diagnostic.Diagnostic located at the innermost expression that failed.
 func Eval(program *semantic.Program, scope *Scope, d Domain) error {
 	itrp := interpreter{
 		d: d,
@@ -35,6 +38,13 @@ func (itrp interpreter) eval(program *semantic.Program, scope *Scope) error {
 }
 
 func (itrp interpreter) doStatement(stmt semantic.Statement, scope *Scope) error {
+	if err := itrp.statement(stmt, scope); err != nil {
+		return evalError(stmt, err)
+	}
+	return nil
+}
+
+func (itrp interpreter) statement(stmt semantic.Statement, scope *Scope) error {
 	scope.SetReturn(value{t: semantic.Invalid})
 	switch s := stmt.(type) {
 	case *semantic.NativeVariableDeclaration:
@@ -75,6 +85,27 @@ func (itrp interpreter) doStatement(stmt semantic.Statement, scope *Scope) error
 	return nil
 }
 
+// evalError locates err at the node n, unless err is already located.
+func evalError(n semantic.Node, err error) error {
+	if d, ok := err.(*diagnostic.Diagnostic); ok && d.Loc != nil {
+		return d
+	}
+	return diagnostic.Errorf(diagnostic.EvaluationError, n.Location(), "%v", err)
+}
+
+// wrapEvalError is like evalError but prefixes the message of err with msg.
+func wrapEvalError(n semantic.Node, err error, msg string) error {
+	if d, ok := err.(*diagnostic.Diagnostic); ok && d.Loc != nil {
+		return &diagnostic.Diagnostic{
+			Severity: d.Severity,
+			Code:     d.Code,
+			Message:  msg + ": " + d.Message,
+			Loc:      d.Loc,
+		}
+	}
+	return diagnostic.Errorf(diagnostic.EvaluationError, n.Location(), "%s: %v", msg, err)
+}
+
 func (itrp interpreter) doVariableDeclaration(declaration *semantic.NativeVariableDeclaration, scope *Scope) error {
 	value, err := itrp.doExpression(declaration.Init, scope)
 	if err != nil {
@@ -85,6 +116,14 @@ func (itrp interpreter) doVariableDeclaration(declaration *semantic.NativeVariab
 }
 
 func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (Value, error) {
+	v, err := itrp.expression(expr, scope)
+	if err != nil {
+		return nil, evalError(expr, err)
+	}
+	return v, nil
+}
+
+func (itrp interpreter) expression(expr semantic.Expression, scope *Scope) (Value, error) {
 	switch e := expr.(type) {
 	case semantic.Literal:
 		return itrp.doLiteral(e)
@@ -100,7 +139,7 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (Va
 		v, err := itrp.doCall(e, scope)
 		if err != nil {
 			// Determine function name
-			return nil, errors.Wrapf(err, "error calling function %q", functionName(e))
+			return nil, wrapEvalError(e, err, fmt.Sprintf("error calling function %q", functionName(e)))
 		}
 		return v, nil
 	case *semantic.MemberExpression:
@@ -112,6 +151,9 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (Va
 	case *semantic.ObjectExpression:
 		return itrp.doObject(e, scope)
 	case *semantic.UnaryExpression:
+		if e.Operator == ast.ExistsOperator {
+			return itrp.doExists(e, scope)
+		}
 		v, err := itrp.doExpression(e.Argument, scope)
 		if err != nil {
 			return nil, err
@@ -129,7 +171,7 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (Va
 			case semantic.Float:
 				return NewFloatValue(-v.Value().(float64)), nil
 			case semantic.Duration:
-				return NewDurationValue(-v.Value().(time.Duration)), nil
+				return NewCalendarDurationValue(v.Value().(Duration).Neg()), nil
 			default:
 				return nil, fmt.Errorf("operand to unary expression is not a number value, got %v", v.Type())
 			}
@@ -148,6 +190,15 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (Va
 			return nil, err
 		}
 
+		if l.Type() == semantic.Nil || r.Type() == semantic.Nil {
+			// Only null is equal to null.
+			switch e.Operator {
+			case ast.EqualOperator:
+				return NewBoolValue(l.Type() == r.Type()), nil
+			case ast.NotEqualOperator:
+				return NewBoolValue(l.Type() != r.Type()), nil
+			}
+		}
 		bf, ok := binaryFuncLookup[binaryFuncSignature{
 			operator: e.Operator,
 			left:     l.Type(),
@@ -157,6 +208,19 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (Va
 			return nil, fmt.Errorf("unsupported binary operation: %v %v %v", l.Type(), e.Operator, r.Type())
 		}
 		return bf(l, r), nil
+	case *semantic.ConditionalExpression:
+		t, err := itrp.doExpression(e.Test, scope)
+		if err != nil {
+			return nil, err
+		}
+		if t.Type() != semantic.Bool {
+			return nil, fmt.Errorf("test of conditional expression is not a boolean value, got %v", t.Type())
+		}
+		// Only the branch that is taken is evaluated.
+		if t.Value().(bool) {
+			return itrp.doExpression(e.Consequent, scope)
+		}
+		return itrp.doExpression(e.Alternate, scope)
 	case *semantic.LogicalExpression:
 		l, err := itrp.doExpression(e.Left, scope)
 		if err != nil {
@@ -205,6 +269,20 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (Va
 	}
 }
 
+// doExists reports whether the object has the property of the member expression.
+func (itrp interpreter) doExists(e *semantic.UnaryExpression, scope *Scope) (Value, error) {
+	member, ok := e.Argument.(*semantic.MemberExpression)
+	if !ok {
+		return nil, fmt.Errorf("operand to exists must be a member expression, got %T", e.Argument)
+	}
+	obj, err := itrp.doExpression(member.Object, scope)
+	if err != nil {
+		return nil, err
+	}
+	_, err = obj.Property(member.Property)
+	return NewBoolValue(err == nil), nil
+}
+
 func (itrp interpreter) doArray(a *semantic.ArrayExpression, scope *Scope) (Value, error) {
 	array := Array{
 		Elements: make([]Value, len(a.Elements)),
@@ -252,10 +330,10 @@ func (itrp interpreter) doLiteral(lit semantic.Literal) (Value, error) {
 			v: l.Value,
 		}, nil
 	case *semantic.DurationLiteral:
-		return value{
-			t: semantic.Duration,
-			v: l.Value,
-		}, nil
+		return NewCalendarDurationValue(Duration{
+			Months: l.Months,
+			Fixed:  l.Value,
+		}), nil
 	case *semantic.FloatLiteral:
 		return value{
 			t: semantic.Float,
@@ -286,6 +364,8 @@ func (itrp interpreter) doLiteral(lit semantic.Literal) (Value, error) {
 			t: semantic.Bool,
 			v: l.Value,
 		}, nil
+	case *semantic.NullLiteral:
+		return NewNullValue(), nil
 	// semantic.TODO(nathanielc): Support lists and objects
 	default:
 		return nil, fmt.Errorf("unknown literal type %T", lit)
@@ -482,6 +562,13 @@ func NewStringValue(v string) Value {
 		v: v,
 	}
 }
+
+// NewNullValue returns the value of null, which represents the absence of a value.
+func NewNullValue() Value {
+	return value{
+		t: semantic.Nil,
+	}
+}
 func NewTimeValue(v time.Time) Value {
 	return value{
 		t: semantic.Time,
@@ -489,12 +576,60 @@ func NewTimeValue(v time.Time) Value {
 	}
 }
 func NewDurationValue(v time.Duration) Value {
+	return NewCalendarDurationValue(Duration{Fixed: v})
+}
+func NewCalendarDurationValue(v Duration) Value {
 	return value{
 		t: semantic.Duration,
 		v: v,
 	}
 }
 
+// Duration is the value of a duration.
+// A duration is a number of calendar months followed by a fixed length of time,
+// the length of a month depends on the time it is added to.
+type Duration struct {
+	Months int64
+	Fixed  time.Duration
+}
+
+// IsCalendar reports whether the length of the duration depends on the calendar.
+func (d Duration) IsCalendar() bool {
+	return d.Months != 0
+}
+
+// AddTo returns the time t plus the duration, months are added in the location of t.
+func (d Duration) AddTo(t time.Time) time.Time {
+	if d.Months != 0 {
+		t = t.AddDate(0, int(d.Months), 0)
+	}
+	return t.Add(d.Fixed)
+}
+
+// Neg returns the negated duration.
+func (d Duration) Neg() Duration {
+	return Duration{Months: -d.Months, Fixed: -d.Fixed}
+}
+
+// averageMonth is the average length of a month in the Gregorian calendar.
+const averageMonth = 2629746 * time.Second
+
+// approximate returns the length of the duration using the average length of a month.
+func (d Duration) approximate() float64 {
+	return float64(d.Months)*float64(averageMonth) + float64(d.Fixed)
+}
+
+func (d Duration) String() string {
+	if d.Months == 0 {
+		return d.Fixed.String()
+	}
+	s := strconv.FormatInt(d.Months, 10) + "mo"
+	if d.Fixed != 0 {
+		s += d.Fixed.String()
+	}
+	return s
+}
+
 // Function represents a callable type
 type Function interface {
 	Call(args Arguments, d Domain) (Value, error)
@@ -508,6 +643,11 @@ type arrowFunc struct {
 	call  func(Arguments, Domain) (Value, error)
 
 	itrp interpreter
+
+	// resolving are the functions being resolved, the functions that call them cannot be resolved.
+	resolving map[*semantic.FunctionExpression]bool
+	// locals are the names declared within the function being resolved, which are not resolved.
+	locals map[string]bool
 }
 
 func (f arrowFunc) Call(args Arguments, d Domain) (Value, error) {
@@ -551,6 +691,16 @@ func (f arrowFunc) Call(args Arguments, d Domain) (Value, error) {
 
 // Resolve rewrites the function resolving any identifiers not listed in the function params.
 func (f arrowFunc) Resolve() (*semantic.FunctionExpression, error) {
+	if f.resolving == nil {
+		f.resolving = make(map[*semantic.FunctionExpression]bool)
+	}
+	if f.resolving[f.e] {
+		return nil, errors.New("cannot resolve a recursive function")
+	}
+	f.resolving[f.e] = true
+	defer delete(f.resolving, f.e)
+	f.locals = make(map[string]bool)
+
 	n := f.e.Copy()
 	node, err := f.resolveIdentifiers(n)
 	if err != nil {
@@ -568,6 +718,10 @@ func (f arrowFunc) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 				return n, nil
 			}
 		}
+		if f.locals[n.Name] {
+			// Identifier is declared within the function do not resolve
+			return n, nil
+		}
 		v, ok := f.scope.Lookup(n.Name)
 		if !ok {
 			return nil, fmt.Errorf("name %q does not exist in scope", n.Name)
@@ -599,13 +753,22 @@ func (f arrowFunc) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			return nil, err
 		}
 		n.Init = node.(semantic.Expression)
+		f.locals[n.Identifier.Name] = true
 	case *semantic.CallExpression:
+		callee, err := f.resolveCallee(n.Callee)
+		if err != nil {
+			return nil, err
+		}
+		n.Callee = callee
 		node, err := f.resolveIdentifiers(n.Arguments)
 		if err != nil {
 			return nil, err
 		}
 		n.Arguments = node.(*semantic.ObjectExpression)
 	case *semantic.FunctionExpression:
+		for _, p := range n.Params {
+			f.locals[p.Key.Name] = true
+		}
 		node, err := f.resolveIdentifiers(n.Body)
 		if err != nil {
 			return nil, err
@@ -629,6 +792,12 @@ func (f arrowFunc) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			return nil, err
 		}
 		n.Argument = node.(semantic.Expression)
+	case *semantic.MemberExpression:
+		node, err := f.resolveIdentifiers(n.Object)
+		if err != nil {
+			return nil, err
+		}
+		n.Object = node.(semantic.Expression)
 	case *semantic.LogicalExpression:
 		node, err := f.resolveIdentifiers(n.Left)
 		if err != nil {
@@ -684,8 +853,45 @@ func (f arrowFunc) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 	return n, nil
 }
 
+// resolveCallee resolves the arrow functions called by the function to their function expressions.
+// Other callees are left as they are, so that native functions, such as the builtins of the compiler, are called by their names.
+func (f arrowFunc) resolveCallee(callee semantic.Expression) (semantic.Expression, error) {
+	var v Value
+	switch callee := callee.(type) {
+	case *semantic.IdentifierExpression:
+		for _, p := range f.e.Params {
+			if callee.Name == p.Key.Name {
+				return callee, nil
+			}
+		}
+		if f.locals[callee.Name] {
+			return callee, nil
+		}
+		v, _ = f.scope.Lookup(callee.Name)
+	case *semantic.MemberExpression:
+		object, ok := callee.Object.(*semantic.IdentifierExpression)
+		if !ok {
+			return callee, nil
+		}
+		obj, ok := f.scope.Lookup(object.Name)
+		if !ok || obj.Type().Kind() != semantic.Object {
+			return callee, nil
+		}
+		v, _ = obj.Property(callee.Property)
+	}
+	if v == nil || v.Type() != semantic.Function {
+		return callee, nil
+	}
+	fn, ok := v.Value().(arrowFunc)
+	if !ok {
+		return callee, nil
+	}
+	fn.resolving = f.resolving
+	return fn.Resolve()
+}
+
 func resolveValue(v Value) (semantic.Node, error) {
-	switch t := v.Type(); t {
+	switch t := v.Type(); t.Kind() {
 	case semantic.String:
 		return &semantic.StringLiteral{
 			Value: v.Value().(string),
@@ -715,9 +921,13 @@ func resolveValue(v Value) (semantic.Node, error) {
 			Value: v.Value().(*regexp.Regexp),
 		}, nil
 	case semantic.Duration:
+		d := v.Value().(Duration)
 		return &semantic.DurationLiteral{
-			Value: v.Value().(time.Duration),
+			Value:  d.Fixed,
+			Months: d.Months,
 		}, nil
+	case semantic.Nil:
+		return &semantic.NullLiteral{}, nil
 	case semantic.Function:
 		return v.Value().(Function).Resolve()
 	case semantic.Array:
@@ -1438,4 +1648,99 @@ var binaryFuncLookup = map[binaryFuncSignature]binaryFunc{
 		r := rv.Value().(string)
 		return NewBoolValue(!l.MatchString(r))
 	},
+
+	//----------------------------
+	// Time and Duration Operators
+	//----------------------------
+	{operator: ast.AdditionOperator, left: semantic.Time, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(Duration)
+		return NewTimeValue(r.AddTo(l))
+	},
+	{operator: ast.AdditionOperator, left: semantic.Duration, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(time.Time)
+		return NewTimeValue(l.AddTo(r))
+	},
+	{operator: ast.AdditionOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewCalendarDurationValue(Duration{Months: l.Months + r.Months, Fixed: l.Fixed + r.Fixed})
+	},
+	{operator: ast.SubtractionOperator, left: semantic.Time, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(Duration)
+		return NewTimeValue(r.Neg().AddTo(l))
+	},
+	{operator: ast.SubtractionOperator, left: semantic.Time, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(time.Time)
+		return NewDurationValue(l.Sub(r))
+	},
+	{operator: ast.SubtractionOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewCalendarDurationValue(Duration{Months: l.Months - r.Months, Fixed: l.Fixed - r.Fixed})
+	},
+	{operator: ast.LessThanEqualOperator, left: semantic.Time, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(time.Time)
+		return NewBoolValue(!l.After(r))
+	},
+	{operator: ast.LessThanOperator, left: semantic.Time, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(time.Time)
+		return NewBoolValue(l.Before(r))
+	},
+	{operator: ast.GreaterThanEqualOperator, left: semantic.Time, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(time.Time)
+		return NewBoolValue(!l.Before(r))
+	},
+	{operator: ast.GreaterThanOperator, left: semantic.Time, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(time.Time)
+		return NewBoolValue(l.After(r))
+	},
+	{operator: ast.EqualOperator, left: semantic.Time, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(time.Time)
+		return NewBoolValue(l.Equal(r))
+	},
+	{operator: ast.NotEqualOperator, left: semantic.Time, right: semantic.Time}: func(lv, rv Value) Value {
+		l := lv.Value().(time.Time)
+		r := rv.Value().(time.Time)
+		return NewBoolValue(!l.Equal(r))
+	},
+	// Durations with calendar months are ordered using the average length of a month.
+	{operator: ast.LessThanEqualOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewBoolValue(l.approximate() <= r.approximate())
+	},
+	{operator: ast.LessThanOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewBoolValue(l.approximate() < r.approximate())
+	},
+	{operator: ast.GreaterThanEqualOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewBoolValue(l.approximate() >= r.approximate())
+	},
+	{operator: ast.GreaterThanOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewBoolValue(l.approximate() > r.approximate())
+	},
+	{operator: ast.EqualOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewBoolValue(l == r)
+	},
+	{operator: ast.NotEqualOperator, left: semantic.Duration, right: semantic.Duration}: func(lv, rv Value) Value {
+		l := lv.Value().(Duration)
+		r := rv.Value().(Duration)
+		return NewBoolValue(l != r)
+	},
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/diagnostic"
 	"github.com/influxdata/ifql/interpreter"
 	"github.com/influxdata/ifql/parser"
 	"github.com/influxdata/ifql/semantic"
@@ -210,6 +211,49 @@ func TestEval(t *testing.T) {
 			"abba" !~ /^a.*a$/ and fail()
 			`,
 		},
+		{
+			name: "conditional expression",
+			query: `
+			level = (v) => if v > 90.0 then "crit" else if v > 50.0 then "warn" else "ok"
+			level(v:95.0) == "crit" or fail()
+			level(v:60.0) == "warn" or fail()
+			level(v:six()) == "ok" or fail()
+			`,
+		},
+		{
+			name: "conditional expression evaluates one branch",
+			query: `
+			x = if six() == 6.0 then 1 else fail()
+			x == 1 or fail()
+			`,
+		},
+		{
+			name: "exists",
+			query: `
+			m = {a: 1}
+			exists m.a or fail()
+			exists m.b and fail()
+			`,
+		},
+		{
+			name: "calendar duration arithmetic",
+			query: `
+			2018-01-15T00:00:00Z + 1mo == 2018-02-15T00:00:00Z or fail()
+			2018-03-15T12:00:00Z - 1y1d == 2017-03-14T12:00:00Z or fail()
+			-1mo - 1w == -(1mo + 7d) or fail()
+			1mo > 30d and 1mo < 32d or fail()
+			`,
+		},
+		{
+			name: "null",
+			query: `
+			n = null
+			n == null or fail()
+			1 != null or fail()
+			o = {a:1}
+			(if exists o.b then o.b else null) == null or fail()
+			`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -234,6 +278,26 @@ func TestEval(t *testing.T) {
 	}
 
 }
+func TestEval_ErrorLocation(t *testing.T) {
+	program, err := parser.NewAST("six = six()\nx = six + fail()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	graph, err := semantic.New(program, testDeclarations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = interpreter.Eval(graph, testScope.Nest(), nil)
+	d, ok := err.(*diagnostic.Diagnostic)
+	if !ok {
+		t.Fatalf("expected a diagnostic, got %T: %v", err, err)
+	}
+	want := ast.Position{Line: 2, Column: 11}
+	if d.Loc == nil || d.Loc.Start != want {
+		t.Errorf("unexpected error location: got %v want %v", d.Loc, want)
+	}
+}
+
 func TestFunction_Resolve(t *testing.T) {
 	var got *semantic.FunctionExpression
 	scope := interpreter.NewScope()
@@ -282,6 +346,87 @@ func TestFunction_Resolve(t *testing.T) {
 	}
 }
 
+func TestFunction_ResolveCalls(t *testing.T) {
+	var got *semantic.FunctionExpression
+	scope := interpreter.NewScope()
+	scope.Set("native", function{
+		name: "native",
+		call: func(args interpreter.Arguments, d interpreter.Domain) (interpreter.Value, error) {
+			return nil, errors.New("not called")
+		},
+	})
+	scope.Set("resolver", function{
+		name: "resolver",
+		call: func(args interpreter.Arguments, d interpreter.Domain) (interpreter.Value, error) {
+			f, err := args.GetRequiredFunction("f")
+			if err != nil {
+				return nil, err
+			}
+			got, err = f.Resolve()
+			if err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	})
+
+	// Arrow functions are resolved to their function expressions, native functions are called by their names.
+	program, err := parser.NewAST(`
+	x = 42
+	add = (a, b) => a + b
+	resolver(f: (r) => add(a: r, b: x) + native(x: r))
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graph, err := semantic.New(program, testDeclarations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := interpreter.Eval(graph, scope, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+		Body: &semantic.BinaryExpression{
+			Operator: ast.AdditionOperator,
+			Left: &semantic.CallExpression{
+				Callee: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{
+						{Key: &semantic.Identifier{Name: "a"}},
+						{Key: &semantic.Identifier{Name: "b"}},
+					},
+					Body: &semantic.BinaryExpression{
+						Operator: ast.AdditionOperator,
+						Left:     &semantic.IdentifierExpression{Name: "a"},
+						Right:    &semantic.IdentifierExpression{Name: "b"},
+					},
+				},
+				Arguments: &semantic.ObjectExpression{
+					Properties: []*semantic.Property{
+						{Key: &semantic.Identifier{Name: "a"}, Value: &semantic.IdentifierExpression{Name: "r"}},
+						{Key: &semantic.Identifier{Name: "b"}, Value: &semantic.IntegerLiteral{Value: 42}},
+					},
+				},
+			},
+			Right: &semantic.CallExpression{
+				Callee: &semantic.IdentifierExpression{Name: "native"},
+				Arguments: &semantic.ObjectExpression{
+					Properties: []*semantic.Property{
+						{Key: &semantic.Identifier{Name: "x"}, Value: &semantic.IdentifierExpression{Name: "r"}},
+					},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(want, got, semantictest.CmpOptions...) {
+		t.Errorf("unexpected resoved function: -want/+got\n%s", cmp.Diff(want, got, semantictest.CmpOptions...))
+	}
+}
+
 type function struct {
 	name string
 	call func(args interpreter.Arguments, d interpreter.Domain) (interpreter.Value, error)
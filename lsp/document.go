@@ -0,0 +1,434 @@
+package lsp
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/complete"
+	"github.com/influxdata/ifql/diagnostic"
+	"github.com/influxdata/ifql/parser"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+)
+
+// document is an open IFQL document and the result of analyzing it.
+type document struct {
+	uri   string
+	text  string
+	lines []string
+
+	// program is the semantic program of the statements that could be analyzed.
+	program *semantic.Program
+	// declarations are the builtin, imported and top level declarations of the document.
+	declarations semantic.DeclarationScope
+	completer    complete.Completer
+	diags        diagnostic.List
+}
+
+// newDocument analyzes the text the same way as query.Compile, without evaluating it.
+// All of the problems that are found are kept as diagnostics.
+func newDocument(uri, text string, loader *query.Loader) *document {
+	d := &document{
+		uri:   uri,
+		text:  text,
+		lines: strings.Split(text, "\n"),
+	}
+	d.analyze(loader)
+	d.completer = complete.NewCompleter(nil, d.declarations)
+	return d
+}
+
+func (d *document) analyze(loader *query.Loader) {
+	scope, declarations := query.BuiltIns()
+	d.declarations = declarations.Copy()
+	defer func() {
+		// A document that is being edited must not stop the server.
+		if r := recover(); r != nil {
+			d.diags = append(d.diags, diagnostic.Errorf(diagnostic.SemanticError, nil, "failed to analyze document: %v", r))
+		}
+	}()
+
+	astProg, err := parser.NewAST(d.text)
+	d.diags.Add(err, diagnostic.SyntaxError)
+	if astProg == nil {
+		return
+	}
+	if err := loader.Import(astProg, scope, declarations); err != nil {
+		d.diags.Add(err, diagnostic.ImportError)
+		return
+	}
+	d.declarations = declarations.Copy()
+	d.program, err = semantic.New(astProg, d.declarations)
+	d.diags.Add(err, diagnostic.SemanticError)
+	if len(d.diags) > 0 {
+		// Types are only checked in complete programs, other statements would use the missing declarations.
+		return
+	}
+	d.diags.Add(semantic.Infer(d.program, declarations), diagnostic.TypeError)
+}
+
+// Diagnostics returns the diagnostics of the document in the protocol form.
+func (d *document) Diagnostics() []Diagnostic {
+	diags := make([]Diagnostic, len(d.diags))
+	for i, diag := range d.diags {
+		diags[i] = Diagnostic{
+			Severity: SeverityError,
+			Code:     string(diag.Code),
+			Source:   "ifql",
+			Message:  diag.Message,
+		}
+		if diag.Severity == diagnostic.Warning {
+			diags[i].Severity = SeverityWarning
+		}
+		if diag.Loc != nil {
+			diags[i].Range = d.rangeOf(diag.Loc)
+		}
+	}
+	return diags
+}
+
+// position converts a protocol position to a position of the AST.
+func (d *document) position(p Position) ast.Position {
+	pos := ast.Position{Line: p.Line + 1, Column: 1}
+	if p.Line < 0 || p.Line >= len(d.lines) {
+		return pos
+	}
+	units := 0
+	for _, r := range d.lines[p.Line] {
+		if units >= p.Character {
+			break
+		}
+		units += len(utf16.Encode([]rune{r}))
+		pos.Column++
+	}
+	return pos
+}
+
+// protocolPosition converts a position of the AST to a protocol position.
+func (d *document) protocolPosition(pos ast.Position) Position {
+	p := Position{Line: pos.Line - 1}
+	if p.Line < 0 || p.Line >= len(d.lines) {
+		return p
+	}
+	col := 1
+	for _, r := range d.lines[p.Line] {
+		if col >= pos.Column {
+			break
+		}
+		p.Character += len(utf16.Encode([]rune{r}))
+		col++
+	}
+	return p
+}
+
+func (d *document) rangeOf(loc *ast.SourceLocation) Range {
+	return Range{
+		Start: d.protocolPosition(loc.Start),
+		End:   d.protocolPosition(loc.End),
+	}
+}
+
+// offset returns the byte offset of the position in the text.
+func (d *document) offset(pos ast.Position) int {
+	offset := 0
+	for i := 0; i < pos.Line-1 && i < len(d.lines); i++ {
+		offset += len(d.lines[i]) + 1
+	}
+	if pos.Line < 1 || pos.Line > len(d.lines) {
+		return offset
+	}
+	line := d.lines[pos.Line-1]
+	for col := 1; col < pos.Column && len(line) > 0; col++ {
+		_, size := utf8.DecodeRuneInString(line)
+		line = line[size:]
+		offset += size
+	}
+	return offset
+}
+
+// commentsBefore returns the text of the line comments directly above the line,
+// which document the declaration on it.
+func (d *document) commentsBefore(line int) string {
+	var comments []string
+	for i := line - 2; i >= 0 && i < len(d.lines); i-- {
+		text := strings.TrimSpace(d.lines[i])
+		if !strings.HasPrefix(text, "//") {
+			break
+		}
+		comments = append([]string{strings.TrimSpace(strings.TrimPrefix(text, "//"))}, comments...)
+	}
+	return strings.Join(comments, "\n")
+}
+
+// binding is a declaration of a name in the document.
+type binding struct {
+	id *semantic.Identifier
+	// decl is the declaration of a variable, nil for parameters.
+	decl *semantic.NativeVariableDeclaration
+}
+
+// reference is the name at a position of the document.
+type reference struct {
+	name string
+	loc  *ast.SourceLocation
+	// binding is the declaration the name refers to, nil if it is not declared in the document.
+	binding *binding
+}
+
+// referenceAt returns the identifier at the position, or nil if there is none.
+func (d *document) referenceAt(pos ast.Position) *reference {
+	if d.program == nil {
+		return nil
+	}
+	r := &resolver{
+		scope: &lexicalScope{names: make(map[string]*binding)},
+		pos:   pos,
+		found: new(*reference),
+	}
+	semantic.Walk(r, d.program)
+	return *r.found
+}
+
+// lexicalScope holds the names declared by the statements and functions that enclose a node.
+type lexicalScope struct {
+	parent *lexicalScope
+	names  map[string]*binding
+}
+
+func (s *lexicalScope) lookup(name string) *binding {
+	for ; s != nil; s = s.parent {
+		if b, ok := s.names[name]; ok {
+			return b
+		}
+	}
+	return nil
+}
+
+// resolver finds the identifier at a position and the declaration it refers to.
+// Declarations are visited in order, so a name refers to the last declaration before its use.
+type resolver struct {
+	scope *lexicalScope
+	pos   ast.Position
+	found **reference
+}
+
+func (r *resolver) Visit(n semantic.Node) semantic.Visitor {
+	switch n := n.(type) {
+	case *semantic.NativeVariableDeclaration:
+		b := &binding{id: n.Identifier, decl: n}
+		r.scope.names[n.Identifier.Name] = b
+		r.find(n.Identifier.Name, n.Identifier.Location(), b)
+	case *semantic.FunctionExpression:
+		s := &lexicalScope{parent: r.scope, names: make(map[string]*binding)}
+		for _, p := range n.Params {
+			b := &binding{id: p.Key}
+			s.names[p.Key.Name] = b
+			r.find(p.Key.Name, p.Key.Location(), b)
+		}
+		return &resolver{scope: s, pos: r.pos, found: r.found}
+	case *semantic.BlockStatement:
+		s := &lexicalScope{parent: r.scope, names: make(map[string]*binding)}
+		return &resolver{scope: s, pos: r.pos, found: r.found}
+	case *semantic.IdentifierExpression:
+		r.find(n.Name, n.Location(), r.scope.lookup(n.Name))
+	}
+	return r
+}
+
+func (r *resolver) Done() {}
+
+// find records the name if its location contains the position.
+func (r *resolver) find(name string, loc *ast.SourceLocation, b *binding) {
+	if loc == nil || before(r.pos, loc.Start) || before(loc.End, r.pos) {
+		return
+	}
+	*r.found = &reference{name: name, loc: loc, binding: b}
+}
+
+// before reports whether the position a is before the position b.
+func before(a, b ast.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
+
+// typeOf returns the type of the value of the expression.
+// Unlike the Type method of a call, it is the return type of the function that is called.
+func typeOf(e semantic.Expression) semantic.Type {
+	if call, ok := e.(*semantic.CallExpression); ok {
+		if t := typeOf(call.Callee); t != nil && t.Kind() == semantic.Function {
+			return t.ReturnType()
+		}
+		return semantic.Invalid
+	}
+	return e.Type()
+}
+
+// describe returns the name with its signature, if it is a function, or with the kind of its type.
+func describe(name string, typ semantic.Type) string {
+	if typ == nil || typ.Kind() == semantic.Invalid {
+		return name
+	}
+	if typ.Kind() == semantic.Function {
+		if s, err := complete.Signature(name, typ); err == nil {
+			return s.String()
+		}
+	}
+	return fmt.Sprintf("%s: %v", name, typ.Kind())
+}
+
+// typeOfName returns the type of the declaration of a name that is not declared in the document,
+// or of a member of a package, such as helpers.firstN.
+func (d *document) typeOfName(name string) semantic.Type {
+	parts := strings.Split(name, ".")
+	decl, err := d.completer.Declaration(parts[0])
+	if err != nil {
+		return nil
+	}
+	typ := declarationType(decl)
+	for _, p := range parts[1:] {
+		if typ == nil || typ.Kind() != semantic.Object {
+			return nil
+		}
+		typ = typ.Properties()[p]
+	}
+	return typ
+}
+
+// callContext is the call whose arguments enclose a position.
+type callContext struct {
+	// callee is the name of the function, members of packages are joined with dots.
+	callee string
+	// key is the key of the argument at the position, or the part of it that precedes the position.
+	key string
+	// inValue reports whether the position is in the value of the argument, after its key.
+	inValue bool
+	// used are the keys of the arguments before the position.
+	used []string
+}
+
+// callAt finds the innermost call whose parentheses enclose the offset.
+// The text is scanned rather than parsed, as it is usually incomplete while arguments are typed.
+func callAt(text string, offset int) (callContext, bool) {
+	type open struct {
+		bracket  byte
+		pos      int
+		argStart int
+		used     []string
+	}
+	var stack []*open
+	inString, inComment := false, false
+	for i := 0; i < offset && i < len(text); i++ {
+		c := text[i]
+		switch {
+		case inComment:
+			inComment = c != '\n'
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '/' && i+1 < len(text) && text[i+1] == '/':
+			inComment = true
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, &open{bracket: c, pos: i, argStart: i + 1})
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case c == ',':
+			if len(stack) > 0 {
+				top := stack[len(stack)-1]
+				if key, _, ok := argumentKey(text[top.argStart:i]); ok {
+					top.used = append(top.used, key)
+				}
+				top.argStart = i + 1
+			}
+		}
+	}
+	if inString || inComment || len(stack) == 0 || stack[len(stack)-1].bracket != '(' {
+		return callContext{}, false
+	}
+	top := stack[len(stack)-1]
+	callee := calleeBefore(text[:top.pos])
+	if callee == "" {
+		return callContext{}, false
+	}
+	key, inValue, _ := argumentKey(text[top.argStart:offset])
+	return callContext{
+		callee:  callee,
+		key:     key,
+		inValue: inValue,
+		used:    top.used,
+	}, true
+}
+
+// argumentKey returns the key of the argument text, and whether the text continues after the key.
+func argumentKey(arg string) (string, bool, bool) {
+	if i := strings.IndexByte(arg, ':'); i >= 0 {
+		return strings.TrimSpace(arg[:i]), true, true
+	}
+	key := strings.TrimSpace(arg)
+	return key, false, isIdentifier(key)
+}
+
+// calleeBefore returns the dotted identifier at the end of the text, ignoring trailing whitespace.
+func calleeBefore(text string) string {
+	text = strings.TrimRight(text, " \t\r\n")
+	i := len(text)
+	for i > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:i])
+		if r != '.' && !isIdentifierRune(r) {
+			break
+		}
+		i -= size
+	}
+	callee := strings.Trim(text[i:], ".")
+	if callee == "" || !isIdentifierStart(callee) {
+		return ""
+	}
+	return callee
+}
+
+// prefixBefore returns the identifier that ends at the offset,
+// and the dotted name of the object it is a member of, if any.
+func prefixBefore(text string, offset int) (prefix, object string) {
+	i := offset
+	for i > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:i])
+		if !isIdentifierRune(r) {
+			break
+		}
+		i -= size
+	}
+	prefix = text[i:offset]
+	if i > 0 && text[i-1] == '.' {
+		object = calleeBefore(text[:i-1])
+	}
+	return prefix, object
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r >= utf8.RuneSelf
+}
+
+func isIdentifierStart(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return isIdentifierRune(r) && (r < '0' || r > '9')
+}
+
+func isIdentifier(s string) bool {
+	if s == "" || !isIdentifierStart(s) {
+		return false
+	}
+	for _, r := range s {
+		if !isIdentifierRune(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,377 @@
+/*
+Package lsp implements a Language Server Protocol server for IFQL.
+
+The server speaks JSON-RPC over a pair of streams, usually the standard input and output of the ifql lsp command.
+Open documents are analyzed like query.Compile does, without evaluating them,
+and the server provides:
+
+	diagnostics of syntax, semantic, import and type errors,
+	completion of names, package members and argument keys,
+	hover with the signatures and documentation of functions,
+	go to definition of the variables and parameters declared in a document,
+	signature help for the arguments of calls.
+
+The functions must be registered and query.FinalizeRegistration called before the server is run.
+*/
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/ifql/complete"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+)
+
+// Server is a language server, handling one client.
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	// loader imports the packages of the documents.
+	loader *query.Loader
+	// documents are the open documents by their URIs.
+	documents map[string]*document
+	shutdown  bool
+}
+
+// NewServer creates a server that reads messages from in and writes messages to out.
+// The packages that documents import are loaded with the loader.
+func NewServer(in io.Reader, out io.Writer, loader *query.Loader) *Server {
+	return &Server{
+		in:        bufio.NewReader(in),
+		out:       out,
+		loader:    loader,
+		documents: make(map[string]*document),
+	}
+}
+
+// Run handles messages until the client exits or the input ends.
+func (s *Server) Run() error {
+	for {
+		content, err := readMessage(s.in)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var m message
+		if err := json.Unmarshal(content, &m); err != nil {
+			if err := s.reply(nil, nil, &responseError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if m.Method == "exit" {
+			return nil
+		}
+		result, err := s.handle(&m)
+		if m.ID == nil {
+			// Notifications have no response.
+			continue
+		}
+		if err := s.reply(m.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) reply(id *json.RawMessage, result interface{}, err error) error {
+	r := response{
+		JSONRPC: "2.0",
+		ID:      id,
+	}
+	if err != nil {
+		rerr, ok := err.(*responseError)
+		if !ok {
+			rerr = &responseError{Code: codeInternalError, Message: err.Error()}
+		}
+		r.Error = rerr
+	} else {
+		data, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		r.Result = data
+	}
+	return writeMessage(s.out, r)
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	return writeMessage(s.out, notification{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	})
+}
+
+// handle returns the result of a request, nil results are sent as null.
+func (s *Server) handle(m *message) (interface{}, error) {
+	if s.shutdown {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "the server is shut down"}
+	}
+	switch m.Method {
+	case "initialize":
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync: FullSync,
+				CompletionProvider: &CompletionOptions{
+					TriggerCharacters: []string{".", "(", ","},
+				},
+				HoverProvider:      true,
+				DefinitionProvider: true,
+				SignatureHelpProvider: &SignatureHelpOptions{
+					TriggerCharacters: []string{"(", ","},
+				},
+			},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := unmarshalParams(m, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.update(params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := unmarshalParams(m, &params); err != nil {
+			return nil, err
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			return nil, s.update(params.TextDocument.URI, params.ContentChanges[n-1].Text)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := unmarshalParams(m, &params); err != nil {
+			return nil, err
+		}
+		delete(s.documents, params.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+	case "textDocument/completion":
+		return s.positionRequest(m, (*document).Completion)
+	case "textDocument/hover":
+		return s.positionRequest(m, (*document).Hover)
+	case "textDocument/definition":
+		return s.positionRequest(m, (*document).Definition)
+	case "textDocument/signatureHelp":
+		return s.positionRequest(m, (*document).SignatureHelp)
+	default:
+		if m.ID == nil {
+			// Unknown notifications, such as initialized, are ignored.
+			return nil, nil
+		}
+		return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + m.Method}
+	}
+}
+
+func unmarshalParams(m *message, params interface{}) error {
+	if err := json.Unmarshal(m.Params, params); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+// update analyzes the new text of the document and publishes its diagnostics.
+func (s *Server) update(uri, text string) error {
+	d := newDocument(uri, text, s.loader)
+	s.documents[uri] = d
+	return s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: d.Diagnostics(),
+	})
+}
+
+func (s *Server) positionRequest(m *message, f func(*document, Position) interface{}) (interface{}, error) {
+	var params TextDocumentPositionParams
+	if err := unmarshalParams(m, &params); err != nil {
+		return nil, err
+	}
+	d, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil, &responseError{Code: codeInvalidParams, Message: "unknown document " + params.TextDocument.URI}
+	}
+	return f(d, params.Position), nil
+}
+
+// Completion returns the argument keys of the call at the position, when a key is being typed,
+// the members of the package before a dot, or else the declared names.
+func (d *document) Completion(p Position) interface{} {
+	list := CompletionList{Items: []CompletionItem{}}
+	offset := d.offset(d.position(p))
+	prefix, object := prefixBefore(d.text, offset)
+
+	if object != "" {
+		typ := d.typeOfName(object)
+		if typ == nil || typ.Kind() != semantic.Object {
+			return list
+		}
+		props := typ.Properties()
+		for _, name := range sortedKeys(props) {
+			if strings.HasPrefix(name, prefix) {
+				list.Items = append(list.Items, d.completionItem(object+"."+name, name, props[name], ""))
+			}
+		}
+		return list
+	}
+
+	if call, ok := callAt(d.text, offset); ok && !call.inValue {
+		if sig, err := d.signature(call.callee); err == nil {
+			used := make(map[string]bool, len(call.used))
+			for _, k := range call.used {
+				used[k] = true
+			}
+			for _, param := range sig.Params {
+				if used[param] || param == sig.PipeArgument || !strings.HasPrefix(param, prefix) {
+					continue
+				}
+				list.Items = append(list.Items, CompletionItem{
+					Label:      param,
+					Kind:       FieldCompletion,
+					Detail:     sig.ParamLabel(param),
+					InsertText: param + ":",
+				})
+			}
+			return list
+		}
+	}
+
+	// The names of the declarations of the document and of the builtins.
+	for _, name := range d.completer.DeclarationNames() {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		var typ semantic.Type
+		if decl, err := d.completer.Declaration(name); err == nil {
+			typ = declarationType(decl)
+		}
+		list.Items = append(list.Items, d.completionItem(name, name, typ, d.completer.Doc(name)))
+	}
+	return list
+}
+
+func (d *document) completionItem(name, label string, typ semantic.Type, doc string) CompletionItem {
+	item := CompletionItem{
+		Label:         label,
+		Kind:          VariableCompletion,
+		Detail:        describe(name, typ),
+		Documentation: doc,
+	}
+	if typ != nil {
+		switch typ.Kind() {
+		case semantic.Function:
+			item.Kind = FunctionCompletion
+		case semantic.Object:
+			item.Kind = ModuleCompletion
+		}
+	}
+	return item
+}
+
+// Hover returns the signature or type of the name at the position, and its documentation.
+func (d *document) Hover(p Position) interface{} {
+	ref := d.referenceAt(d.position(p))
+	if ref == nil {
+		return nil
+	}
+	var label, doc string
+	switch {
+	case ref.binding != nil && ref.binding.decl != nil:
+		label = describe(ref.name, typeOf(ref.binding.decl.Init))
+		doc = d.commentsBefore(ref.binding.id.Location().Start.Line)
+	case ref.binding != nil:
+		label = "(parameter) " + ref.name
+	default:
+		label = describe(ref.name, d.typeOfName(ref.name))
+		doc = d.completer.Doc(ref.name)
+	}
+	value := "```ifql\n" + label + "\n```"
+	if doc != "" {
+		value += "\n\n" + doc
+	}
+	r := d.rangeOf(ref.loc)
+	return Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: value},
+		Range:    &r,
+	}
+}
+
+// Definition returns the location of the declaration of the variable or parameter at the position.
+// Builtins are not declared in the document and have no definition.
+func (d *document) Definition(p Position) interface{} {
+	ref := d.referenceAt(d.position(p))
+	if ref == nil || ref.binding == nil || ref.binding.id.Location() == nil {
+		return nil
+	}
+	return Location{
+		URI:   d.uri,
+		Range: d.rangeOf(ref.binding.id.Location()),
+	}
+}
+
+// SignatureHelp returns the signature of the call whose arguments enclose the position,
+// with the argument that is being typed as the active parameter.
+func (d *document) SignatureHelp(p Position) interface{} {
+	call, ok := callAt(d.text, d.offset(d.position(p)))
+	if !ok {
+		return nil
+	}
+	sig, err := d.signature(call.callee)
+	if err != nil {
+		return nil
+	}
+	info := SignatureInformation{
+		Label:         sig.String(),
+		Documentation: d.completer.Doc(call.callee),
+		Parameters:    make([]ParameterInformation, len(sig.Params)),
+	}
+	active := len(sig.Params)
+	for i, param := range sig.Params {
+		info.Parameters[i] = ParameterInformation{Label: sig.ParamLabel(param)}
+		if active == len(sig.Params) && call.key != "" &&
+			(param == call.key || (!call.inValue && strings.HasPrefix(param, call.key))) {
+			active = i
+		}
+	}
+	return SignatureHelp{
+		Signatures:      []SignatureInformation{info},
+		ActiveParameter: active,
+	}
+}
+
+// signature returns the signature of the function with the name, which may be a member of a package.
+func (d *document) signature(name string) (complete.FunctionSignature, error) {
+	typ := d.typeOfName(name)
+	if typ == nil || typ.Kind() != semantic.Function {
+		return complete.FunctionSignature{Name: name}, fmt.Errorf("%q is not a function", name)
+	}
+	return complete.Signature(name, typ)
+}
+
+// declarationType returns the type of the value of the declaration.
+func declarationType(decl semantic.VariableDeclaration) semantic.Type {
+	if nd, ok := decl.(*semantic.NativeVariableDeclaration); ok {
+		return typeOf(nd.Init)
+	}
+	return decl.InitType()
+}
+
+func sortedKeys(m map[string]semantic.Type) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,281 @@
+package lsp_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/lsp"
+	"github.com/influxdata/ifql/query"
+)
+
+func init() {
+	query.FinalizeRegistration()
+}
+
+const uri = "file:///cpu.ifql"
+
+// session writes the messages to a server and returns its responses by their IDs, and its notifications in order.
+func session(t *testing.T, messages ...map[string]interface{}) (map[int]json.RawMessage, map[int]json.RawMessage, []json.RawMessage) {
+	t.Helper()
+	var in bytes.Buffer
+	for _, m := range messages {
+		m["jsonrpc"] = "2.0"
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(data), data)
+	}
+	var out bytes.Buffer
+	if err := lsp.NewServer(&in, &out, query.NewLoader()).Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	results := make(map[int]json.RawMessage)
+	errors := make(map[int]json.RawMessage)
+	var notifications []json.RawMessage
+	r := bufio.NewReader(&out)
+	for {
+		header, err := r.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "Content-Length:")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.ReadString('\n'); err != nil {
+			t.Fatal(err)
+		}
+		content := make([]byte, length)
+		if _, err := io.ReadFull(r, content); err != nil {
+			t.Fatal(err)
+		}
+		var m struct {
+			ID     *int            `json:"id"`
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := json.Unmarshal(content, &m); err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case m.ID == nil:
+			notifications = append(notifications, m.Params)
+		case m.Error != nil:
+			errors[*m.ID] = m.Error
+		default:
+			results[*m.ID] = m.Result
+		}
+	}
+	return results, errors, notifications
+}
+
+func request(id int, method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"id": id, "method": method, "params": params}
+}
+
+func notification(method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"method": method, "params": params}
+}
+
+func open(text string) map[string]interface{} {
+	return notification("textDocument/didOpen", lsp.DidOpenTextDocumentParams{
+		TextDocument: lsp.TextDocumentItem{URI: uri, LanguageID: "ifql", Version: 1, Text: text},
+	})
+}
+
+func at(line, character int) lsp.TextDocumentPositionParams {
+	return lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Position:     lsp.Position{Line: line, Character: character},
+	}
+}
+
+func decode(t *testing.T, data json.RawMessage, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("failed to decode %s: %v", data, err)
+	}
+}
+
+func TestServer_Document(t *testing.T) {
+	results, errs, notifications := session(t,
+		request(1, "initialize", map[string]interface{}{}),
+		notification("initialized", map[string]interface{}{}),
+		open(`// cpu is the cpu usage.
+cpu = from(db:"telegraf")
+    |> range(start:-1h)
+cpu |> filter(fn: (r) => r._value > 0)
+`),
+		request(2, "textDocument/hover", at(2, 9)),
+		request(3, "textDocument/hover", at(3, 1)),
+		request(4, "textDocument/definition", at(3, 0)),
+		request(5, "textDocument/definition", at(3, 25)),
+		request(6, "textDocument/definition", at(2, 9)),
+		request(7, "textDocument/unknown", at(0, 0)),
+		request(8, "shutdown", nil),
+		notification("exit", nil),
+	)
+
+	var initialized lsp.InitializeResult
+	decode(t, results[1], &initialized)
+	if !initialized.Capabilities.HoverProvider || initialized.Capabilities.TextDocumentSync != lsp.FullSync {
+		t.Errorf("unexpected capabilities %s", results[1])
+	}
+
+	if len(notifications) != 1 {
+		t.Fatalf("expected the diagnostics of the document, got %d notifications", len(notifications))
+	}
+	var published lsp.PublishDiagnosticsParams
+	decode(t, notifications[0], &published)
+	if len(published.Diagnostics) != 0 {
+		t.Errorf("unexpected diagnostics %v", published.Diagnostics)
+	}
+
+	hovers := map[int]string{
+		2: "```ifql\nrange(location:string, start:time, stop:time, table=<-) object\n```\n\nFilters the results by time boundaries.",
+		3: "```ifql\ncpu: object\n```\n\ncpu is the cpu usage.",
+	}
+	for id, want := range hovers {
+		var hover lsp.Hover
+		decode(t, results[id], &hover)
+		if hover.Contents.Value != want {
+			t.Errorf("unexpected hover %d: got %q want %q", id, hover.Contents.Value, want)
+		}
+	}
+
+	definitions := map[int]*lsp.Location{
+		4: {URI: uri, Range: lsp.Range{Start: lsp.Position{Line: 1, Character: 0}, End: lsp.Position{Line: 1, Character: 3}}},
+		5: {URI: uri, Range: lsp.Range{Start: lsp.Position{Line: 3, Character: 19}, End: lsp.Position{Line: 3, Character: 20}}},
+		// Builtins are not declared in the document.
+		6: nil,
+	}
+	for id, want := range definitions {
+		var got *lsp.Location
+		decode(t, results[id], &got)
+		if !cmp.Equal(got, want) {
+			t.Errorf("unexpected definition %d: %s", id, cmp.Diff(got, want))
+		}
+	}
+
+	var rerr struct {
+		Code int `json:"code"`
+	}
+	decode(t, errs[7], &rerr)
+	if rerr.Code != -32601 {
+		t.Errorf("unexpected error code %d for unknown method", rerr.Code)
+	}
+	if string(results[8]) != "null" {
+		t.Errorf("unexpected shutdown result %s", results[8])
+	}
+}
+
+func TestServer_Arguments(t *testing.T) {
+	results, _, notifications := session(t,
+		open(`from(db:"telegraf") |> range(st`),
+		request(1, "textDocument/completion", at(0, 31)),
+		request(2, "textDocument/signatureHelp", at(0, 31)),
+		request(3, "textDocument/completion", at(0, 5)),
+	)
+
+	var published lsp.PublishDiagnosticsParams
+	decode(t, notifications[0], &published)
+	if len(published.Diagnostics) == 0 || published.Diagnostics[0].Code != "syntax-error" {
+		t.Errorf("expected a syntax error, got %v", published.Diagnostics)
+	}
+
+	var keys lsp.CompletionList
+	decode(t, results[1], &keys)
+	var labels []string
+	for _, item := range keys.Items {
+		labels = append(labels, item.InsertText)
+	}
+	if want := []string{"start:", "stop:"}; !cmp.Equal(labels, want) {
+		t.Errorf("unexpected argument completion: %s", cmp.Diff(labels, want))
+	}
+
+	var help lsp.SignatureHelp
+	decode(t, results[2], &help)
+	want := lsp.SignatureHelp{
+		Signatures: []lsp.SignatureInformation{{
+			Label:         "range(location:string, start:time, stop:time, table=<-) object",
+			Documentation: "Filters the results by time boundaries.",
+			Parameters: []lsp.ParameterInformation{
+				{Label: "location:string"},
+				{Label: "start:time"},
+				{Label: "stop:time"},
+				{Label: "table=<-"},
+			},
+		}},
+		ActiveParameter: 1,
+	}
+	if !cmp.Equal(help, want) {
+		t.Errorf("unexpected signature help: %s", cmp.Diff(help, want))
+	}
+
+	// The keys of from are completed in its empty arguments.
+	var fromKeys lsp.CompletionList
+	decode(t, results[3], &fromKeys)
+	labels = labels[:0]
+	for _, item := range fromKeys.Items {
+		labels = append(labels, item.Label)
+	}
+	if want := []string{"db"}; !cmp.Equal(labels, want) {
+		t.Errorf("unexpected argument completion: %s", cmp.Diff(labels, want))
+	}
+}
+
+func TestServer_Names(t *testing.T) {
+	results, _, _ := session(t,
+		open("x = 1\nco"),
+		request(1, "textDocument/completion", at(1, 2)),
+		request(2, "textDocument/completion", at(0, 0)),
+	)
+
+	var names lsp.CompletionList
+	decode(t, results[1], &names)
+	found := false
+	for _, item := range names.Items {
+		if !strings.HasPrefix(item.Label, "co") {
+			t.Errorf("unexpected completion %q for prefix co", item.Label)
+		}
+		if item.Label == "count" {
+			found = true
+			if item.Kind != lsp.FunctionCompletion || item.Documentation != "Counts the number of results." {
+				t.Errorf("unexpected completion of count %+v", item)
+			}
+		}
+	}
+	if !found {
+		t.Error("expected count to be completed")
+	}
+
+	// Variables declared by the document are completed.
+	var all lsp.CompletionList
+	decode(t, results[2], &all)
+	found = false
+	for _, item := range all.Items {
+		if item.Label == "x" {
+			found = true
+			if item.Kind != lsp.VariableCompletion || item.Detail != "x: int" {
+				t.Errorf("unexpected completion of x %+v", item)
+			}
+		}
+	}
+	if !found {
+		t.Error("expected x to be completed")
+	}
+}
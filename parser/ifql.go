@@ -28,29 +28,29 @@ var g = &grammar{
 					pos: position{line: 9, col: 5, offset: 112},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -58,7 +58,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -76,29 +76,29 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -106,7 +106,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -116,9 +116,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 483, col: 5, offset: 8851},
+							pos: position{line: 524, col: 5, offset: 9796},
 							expr: &anyMatcher{
-								line: 483, col: 6, offset: 8852,
+								line: 524, col: 6, offset: 9797,
 							},
 						},
 					},
@@ -131,65 +131,505 @@ var g = &grammar{
 			expr: &actionExpr{
 				pos: position{line: 14, col: 5, offset: 185},
 				run: (*parser).callonProgram1,
-				expr: &labeledExpr{
-					pos:   position{line: 14, col: 5, offset: 185},
-					label: "body",
-					expr: &ruleRefExpr{
-						pos:  position{line: 14, col: 10, offset: 190},
-						name: "SourceElements",
+				expr: &seqExpr{
+					pos: position{line: 14, col: 5, offset: 185},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 14, col: 5, offset: 185},
+							label: "pkg",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 14, col: 9, offset: 189},
+								expr: &seqExpr{
+									pos: position{line: 14, col: 10, offset: 190},
+									exprs: []interface{}{
+										&actionExpr{
+											pos: position{line: 19, col: 5, offset: 345},
+											run: (*parser).callonProgram6,
+											expr: &seqExpr{
+												pos: position{line: 19, col: 5, offset: 345},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 19, col: 5, offset: 345},
+														val:        "package",
+														ignoreCase: false,
+													},
+													&oneOrMoreExpr{
+														pos: position{line: 19, col: 15, offset: 355},
+														expr: &charClassMatcher{
+															pos:        position{line: 19, col: 15, offset: 355},
+															val:        "[ \\t]",
+															chars:      []rune{' ', '\t'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+													&labeledExpr{
+														pos:   position{line: 19, col: 22, offset: 362},
+														label: "name",
+														expr: &actionExpr{
+															pos: position{line: 501, col: 5, offset: 9583},
+															run: (*parser).callonProgram12,
+															expr: &seqExpr{
+																pos: position{line: 501, col: 5, offset: 9583},
+																exprs: []interface{}{
+																	&charClassMatcher{
+																		pos:        position{line: 501, col: 5, offset: 9583},
+																		val:        "[_\\pL]",
+																		chars:      []rune{'_'},
+																		classes:    []*unicode.RangeTable{rangeTable("L")},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&zeroOrMoreExpr{
+																		pos: position{line: 501, col: 11, offset: 9589},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 501, col: 11, offset: 9589},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 509, col: 5, offset: 9673},
+											expr: &choiceExpr{
+												pos: position{line: 509, col: 7, offset: 9675},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 515, col: 5, offset: 9736},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&seqExpr{
+														pos: position{line: 512, col: 5, offset: 9710},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 512, col: 5, offset: 9710},
+																val:        "//",
+																ignoreCase: false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 512, col: 10, offset: 9715},
+																expr: &charClassMatcher{
+																	pos:        position{line: 512, col: 10, offset: 9715},
+																	val:        "[^\\r\\n]",
+																	chars:      []rune{'\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 521, col: 5, offset: 9782},
+																val:        "\n",
+																ignoreCase: false,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 14, col: 29, offset: 209},
+							label: "imports",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 14, col: 37, offset: 217},
+								expr: &seqExpr{
+									pos: position{line: 14, col: 38, offset: 218},
+									exprs: []interface{}{
+										&actionExpr{
+											pos: position{line: 24, col: 5, offset: 453},
+											run: (*parser).callonProgram28,
+											expr: &seqExpr{
+												pos: position{line: 24, col: 5, offset: 453},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 24, col: 5, offset: 453},
+														val:        "import",
+														ignoreCase: false,
+													},
+													&oneOrMoreExpr{
+														pos: position{line: 24, col: 14, offset: 462},
+														expr: &charClassMatcher{
+															pos:        position{line: 24, col: 14, offset: 462},
+															val:        "[ \\t]",
+															chars:      []rune{' ', '\t'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+													&labeledExpr{
+														pos:   position{line: 24, col: 21, offset: 469},
+														label: "as",
+														expr: &zeroOrOneExpr{
+															pos: position{line: 24, col: 24, offset: 472},
+															expr: &seqExpr{
+																pos: position{line: 24, col: 25, offset: 473},
+																exprs: []interface{}{
+																	&actionExpr{
+																		pos: position{line: 501, col: 5, offset: 9583},
+																		run: (*parser).callonProgram36,
+																		expr: &seqExpr{
+																			pos: position{line: 501, col: 5, offset: 9583},
+																			exprs: []interface{}{
+																				&charClassMatcher{
+																					pos:        position{line: 501, col: 5, offset: 9583},
+																					val:        "[_\\pL]",
+																					chars:      []rune{'_'},
+																					classes:    []*unicode.RangeTable{rangeTable("L")},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&zeroOrMoreExpr{
+																					pos: position{line: 501, col: 11, offset: 9589},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 501, col: 11, offset: 9589},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&oneOrMoreExpr{
+																		pos: position{line: 24, col: 36, offset: 484},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 24, col: 36, offset: 484},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+													&labeledExpr{
+														pos:   position{line: 24, col: 45, offset: 493},
+														label: "path",
+														expr: &choiceExpr{
+															pos: position{line: 414, col: 5, offset: 7979},
+															alternatives: []interface{}{
+																&actionExpr{
+																	pos: position{line: 414, col: 5, offset: 7979},
+																	run: (*parser).callonProgram45,
+																	expr: &seqExpr{
+																		pos: position{line: 414, col: 7, offset: 7981},
+																		exprs: []interface{}{
+																			&litMatcher{
+																				pos:        position{line: 414, col: 7, offset: 7981},
+																				val:        "\"",
+																				ignoreCase: false,
+																			},
+																			&zeroOrMoreExpr{
+																				pos: position{line: 414, col: 11, offset: 7985},
+																				expr: &choiceExpr{
+																					pos: position{line: 422, col: 5, offset: 8194},
+																					alternatives: []interface{}{
+																						&seqExpr{
+																							pos: position{line: 422, col: 5, offset: 8194},
+																							exprs: []interface{}{
+																								&notExpr{
+																									pos: position{line: 422, col: 5, offset: 8194},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 422, col: 8, offset: 8197},
+																										val:        "[\"\\\\\\n]",
+																										chars:      []rune{'"', '\\', '\n'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&anyMatcher{
+																									line: 507, col: 5, offset: 9664,
+																								},
+																							},
+																						},
+																						&seqExpr{
+																							pos: position{line: 423, col: 5, offset: 8231},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 423, col: 5, offset: 8231},
+																									val:        "\\",
+																									ignoreCase: false,
+																								},
+																								&choiceExpr{
+																									pos: position{line: 426, col: 5, offset: 8279},
+																									alternatives: []interface{}{
+																										&litMatcher{
+																											pos:        position{line: 426, col: 5, offset: 8279},
+																											val:        "\"",
+																											ignoreCase: false,
+																										},
+																										&actionExpr{
+																											pos: position{line: 427, col: 5, offset: 8287},
+																											run: (*parser).callonProgram58,
+																											expr: &choiceExpr{
+																												pos: position{line: 427, col: 7, offset: 8289},
+																												alternatives: []interface{}{
+																													&anyMatcher{
+																														line: 507, col: 5, offset: 9664,
+																													},
+																													&litMatcher{
+																														pos:        position{line: 521, col: 5, offset: 9782},
+																														val:        "\n",
+																														ignoreCase: false,
+																													},
+																													&notExpr{
+																														pos: position{line: 524, col: 5, offset: 9796},
+																														expr: &anyMatcher{
+																															line: 524, col: 6, offset: 9797,
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&litMatcher{
+																				pos:        position{line: 414, col: 29, offset: 8003},
+																				val:        "\"",
+																				ignoreCase: false,
+																			},
+																		},
+																	},
+																},
+																&actionExpr{
+																	pos: position{line: 417, col: 5, offset: 8063},
+																	run: (*parser).callonProgram65,
+																	expr: &seqExpr{
+																		pos: position{line: 417, col: 7, offset: 8065},
+																		exprs: []interface{}{
+																			&litMatcher{
+																				pos:        position{line: 417, col: 7, offset: 8065},
+																				val:        "\"",
+																				ignoreCase: false,
+																			},
+																			&zeroOrMoreExpr{
+																				pos: position{line: 417, col: 11, offset: 8069},
+																				expr: &choiceExpr{
+																					pos: position{line: 422, col: 5, offset: 8194},
+																					alternatives: []interface{}{
+																						&seqExpr{
+																							pos: position{line: 422, col: 5, offset: 8194},
+																							exprs: []interface{}{
+																								&notExpr{
+																									pos: position{line: 422, col: 5, offset: 8194},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 422, col: 8, offset: 8197},
+																										val:        "[\"\\\\\\n]",
+																										chars:      []rune{'"', '\\', '\n'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&anyMatcher{
+																									line: 507, col: 5, offset: 9664,
+																								},
+																							},
+																						},
+																						&seqExpr{
+																							pos: position{line: 423, col: 5, offset: 8231},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 423, col: 5, offset: 8231},
+																									val:        "\\",
+																									ignoreCase: false,
+																								},
+																								&choiceExpr{
+																									pos: position{line: 426, col: 5, offset: 8279},
+																									alternatives: []interface{}{
+																										&litMatcher{
+																											pos:        position{line: 426, col: 5, offset: 8279},
+																											val:        "\"",
+																											ignoreCase: false,
+																										},
+																										&actionExpr{
+																											pos: position{line: 427, col: 5, offset: 8287},
+																											run: (*parser).callonProgram78,
+																											expr: &choiceExpr{
+																												pos: position{line: 427, col: 7, offset: 8289},
+																												alternatives: []interface{}{
+																													&anyMatcher{
+																														line: 507, col: 5, offset: 9664,
+																													},
+																													&litMatcher{
+																														pos:        position{line: 521, col: 5, offset: 9782},
+																														val:        "\n",
+																														ignoreCase: false,
+																													},
+																													&notExpr{
+																														pos: position{line: 524, col: 5, offset: 9796},
+																														expr: &anyMatcher{
+																															line: 524, col: 6, offset: 9797,
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&choiceExpr{
+																				pos: position{line: 417, col: 31, offset: 8089},
+																				alternatives: []interface{}{
+																					&litMatcher{
+																						pos:        position{line: 521, col: 5, offset: 9782},
+																						val:        "\n",
+																						ignoreCase: false,
+																					},
+																					&notExpr{
+																						pos: position{line: 524, col: 5, offset: 9796},
+																						expr: &anyMatcher{
+																							line: 524, col: 6, offset: 9797,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 509, col: 5, offset: 9673},
+											expr: &choiceExpr{
+												pos: position{line: 509, col: 7, offset: 9675},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 515, col: 5, offset: 9736},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&seqExpr{
+														pos: position{line: 512, col: 5, offset: 9710},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 512, col: 5, offset: 9710},
+																val:        "//",
+																ignoreCase: false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 512, col: 10, offset: 9715},
+																expr: &charClassMatcher{
+																	pos:        position{line: 512, col: 10, offset: 9715},
+																	val:        "[^\\r\\n]",
+																	chars:      []rune{'\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 521, col: 5, offset: 9782},
+																val:        "\n",
+																ignoreCase: false,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 14, col: 61, offset: 241},
+							label: "body",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 14, col: 66, offset: 246},
+								expr: &ruleRefExpr{
+									pos:  position{line: 14, col: 66, offset: 246},
+									name: "SourceElements",
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "SourceElements",
-			pos:  position{line: 18, col: 1, offset: 256},
+			pos:  position{line: 28, col: 1, offset: 570},
 			expr: &actionExpr{
-				pos: position{line: 19, col: 5, offset: 275},
+				pos: position{line: 29, col: 5, offset: 589},
 				run: (*parser).callonSourceElements1,
 				expr: &seqExpr{
-					pos: position{line: 19, col: 5, offset: 275},
+					pos: position{line: 29, col: 5, offset: 589},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 19, col: 5, offset: 275},
+							pos:   position{line: 29, col: 5, offset: 589},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 19, col: 10, offset: 280},
+								pos:  position{line: 29, col: 10, offset: 594},
 								name: "SourceElement",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 19, col: 24, offset: 294},
+							pos:   position{line: 29, col: 24, offset: 608},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 19, col: 29, offset: 299},
+								pos: position{line: 29, col: 29, offset: 613},
 								expr: &seqExpr{
-									pos: position{line: 19, col: 30, offset: 300},
+									pos: position{line: 29, col: 30, offset: 614},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -197,7 +637,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -207,33 +647,33 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 19, col: 33, offset: 303},
+											pos:  position{line: 29, col: 33, offset: 617},
 											name: "SourceElement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -241,7 +681,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -260,32 +700,32 @@ var g = &grammar{
 		},
 		{
 			name: "SourceElement",
-			pos:  position{line: 23, col: 1, offset: 365},
+			pos:  position{line: 33, col: 1, offset: 679},
 			expr: &ruleRefExpr{
-				pos:  position{line: 24, col: 5, offset: 383},
+				pos:  position{line: 34, col: 5, offset: 697},
 				name: "Statement",
 			},
 		},
 		{
 			name: "Statement",
-			pos:  position{line: 26, col: 1, offset: 394},
+			pos:  position{line: 36, col: 1, offset: 708},
 			expr: &choiceExpr{
-				pos: position{line: 27, col: 5, offset: 408},
+				pos: position{line: 37, col: 5, offset: 722},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 5, offset: 408},
+						pos:  position{line: 37, col: 5, offset: 722},
 						name: "VariableStatement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 5, offset: 430},
+						pos:  position{line: 38, col: 5, offset: 744},
 						name: "ReturnStatement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 29, col: 5, offset: 450},
+						pos:  position{line: 39, col: 5, offset: 764},
 						name: "ExpressionStatement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 30, col: 5, offset: 474},
+						pos:  position{line: 40, col: 5, offset: 788},
 						name: "BlockStatement",
 					},
 				},
@@ -293,15 +733,15 @@ var g = &grammar{
 		},
 		{
 			name: "VariableStatement",
-			pos:  position{line: 33, col: 1, offset: 491},
+			pos:  position{line: 43, col: 1, offset: 805},
 			expr: &actionExpr{
-				pos: position{line: 34, col: 5, offset: 513},
+				pos: position{line: 44, col: 5, offset: 827},
 				run: (*parser).callonVariableStatement1,
 				expr: &labeledExpr{
-					pos:   position{line: 34, col: 5, offset: 513},
+					pos:   position{line: 44, col: 5, offset: 827},
 					label: "declaration",
 					expr: &ruleRefExpr{
-						pos:  position{line: 34, col: 17, offset: 525},
+						pos:  position{line: 44, col: 17, offset: 839},
 						name: "VariableDeclaration",
 					},
 				},
@@ -309,42 +749,42 @@ var g = &grammar{
 		},
 		{
 			name: "ReturnStatement",
-			pos:  position{line: 38, col: 1, offset: 604},
+			pos:  position{line: 48, col: 1, offset: 918},
 			expr: &actionExpr{
-				pos: position{line: 39, col: 5, offset: 624},
+				pos: position{line: 49, col: 5, offset: 938},
 				run: (*parser).callonReturnStatement1,
 				expr: &seqExpr{
-					pos: position{line: 39, col: 5, offset: 624},
+					pos: position{line: 49, col: 5, offset: 938},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 39, col: 5, offset: 624},
+							pos:        position{line: 49, col: 5, offset: 938},
 							val:        "return",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -352,7 +792,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -362,10 +802,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 17, offset: 636},
+							pos:   position{line: 49, col: 17, offset: 950},
 							label: "argument",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 26, offset: 645},
+								pos:  position{line: 49, col: 26, offset: 959},
 								name: "Expr",
 							},
 						},
@@ -375,15 +815,15 @@ var g = &grammar{
 		},
 		{
 			name: "ExpressionStatement",
-			pos:  position{line: 43, col: 1, offset: 708},
+			pos:  position{line: 53, col: 1, offset: 1022},
 			expr: &actionExpr{
-				pos: position{line: 44, col: 5, offset: 732},
+				pos: position{line: 54, col: 5, offset: 1046},
 				run: (*parser).callonExpressionStatement1,
 				expr: &labeledExpr{
-					pos:   position{line: 44, col: 5, offset: 732},
+					pos:   position{line: 54, col: 5, offset: 1046},
 					label: "expr",
 					expr: &ruleRefExpr{
-						pos:  position{line: 44, col: 10, offset: 737},
+						pos:  position{line: 54, col: 10, offset: 1051},
 						name: "Expr",
 					},
 				},
@@ -391,42 +831,42 @@ var g = &grammar{
 		},
 		{
 			name: "BlockStatement",
-			pos:  position{line: 48, col: 1, offset: 796},
+			pos:  position{line: 58, col: 1, offset: 1110},
 			expr: &actionExpr{
-				pos: position{line: 49, col: 5, offset: 815},
+				pos: position{line: 59, col: 5, offset: 1129},
 				run: (*parser).callonBlockStatement1,
 				expr: &seqExpr{
-					pos: position{line: 49, col: 5, offset: 815},
+					pos: position{line: 59, col: 5, offset: 1129},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 49, col: 5, offset: 815},
+							pos:        position{line: 59, col: 5, offset: 1129},
 							val:        "{",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -434,7 +874,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -444,37 +884,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 49, col: 12, offset: 822},
+							pos:   position{line: 59, col: 12, offset: 1136},
 							label: "body",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 49, col: 17, offset: 827},
+								pos: position{line: 59, col: 17, offset: 1141},
 								expr: &seqExpr{
-									pos: position{line: 49, col: 19, offset: 829},
+									pos: position{line: 59, col: 19, offset: 1143},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -482,7 +922,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -492,33 +932,33 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 49, col: 22, offset: 832},
+											pos:  position{line: 59, col: 22, offset: 1146},
 											name: "Statement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -526,7 +966,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -540,29 +980,29 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -570,7 +1010,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -580,7 +1020,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 49, col: 41, offset: 851},
+							pos:        position{line: 59, col: 41, offset: 1165},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -590,24 +1030,24 @@ var g = &grammar{
 		},
 		{
 			name: "VariableDeclaration",
-			pos:  position{line: 53, col: 1, offset: 908},
+			pos:  position{line: 63, col: 1, offset: 1222},
 			expr: &actionExpr{
-				pos: position{line: 54, col: 5, offset: 932},
+				pos: position{line: 64, col: 5, offset: 1246},
 				run: (*parser).callonVariableDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 54, col: 5, offset: 932},
+					pos: position{line: 64, col: 5, offset: 1246},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 54, col: 5, offset: 932},
+							pos:   position{line: 64, col: 5, offset: 1246},
 							label: "id",
 							expr: &actionExpr{
-								pos: position{line: 460, col: 5, offset: 8638},
+								pos: position{line: 501, col: 5, offset: 9583},
 								run: (*parser).callonVariableDeclaration4,
 								expr: &seqExpr{
-									pos: position{line: 460, col: 5, offset: 8638},
+									pos: position{line: 501, col: 5, offset: 9583},
 									exprs: []interface{}{
 										&charClassMatcher{
-											pos:        position{line: 460, col: 5, offset: 8638},
+											pos:        position{line: 501, col: 5, offset: 9583},
 											val:        "[_\\pL]",
 											chars:      []rune{'_'},
 											classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -615,9 +1055,9 @@ var g = &grammar{
 											inverted:   false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 460, col: 11, offset: 8644},
+											pos: position{line: 501, col: 11, offset: 9589},
 											expr: &charClassMatcher{
-												pos:        position{line: 460, col: 11, offset: 8644},
+												pos:        position{line: 501, col: 11, offset: 9589},
 												val:        "[_0-9\\pL]",
 												chars:      []rune{'_'},
 												ranges:     []rune{'0', '9'},
@@ -631,29 +1071,29 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -661,7 +1101,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -671,34 +1111,34 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 54, col: 22, offset: 949},
+							pos:        position{line: 64, col: 22, offset: 1263},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -706,7 +1146,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -716,10 +1156,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 54, col: 29, offset: 956},
+							pos:   position{line: 64, col: 29, offset: 1270},
 							label: "init",
 							expr: &ruleRefExpr{
-								pos:  position{line: 54, col: 34, offset: 961},
+								pos:  position{line: 64, col: 34, offset: 1275},
 								name: "Expr",
 							},
 						},
@@ -729,24 +1169,24 @@ var g = &grammar{
 		},
 		{
 			name: "MemberExpressions",
-			pos:  position{line: 59, col: 1, offset: 1022},
+			pos:  position{line: 69, col: 1, offset: 1336},
 			expr: &actionExpr{
-				pos: position{line: 60, col: 5, offset: 1044},
+				pos: position{line: 70, col: 5, offset: 1358},
 				run: (*parser).callonMemberExpressions1,
 				expr: &seqExpr{
-					pos: position{line: 60, col: 5, offset: 1044},
+					pos: position{line: 70, col: 5, offset: 1358},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 60, col: 5, offset: 1044},
+							pos:   position{line: 70, col: 5, offset: 1358},
 							label: "head",
 							expr: &actionExpr{
-								pos: position{line: 460, col: 5, offset: 8638},
+								pos: position{line: 501, col: 5, offset: 9583},
 								run: (*parser).callonMemberExpressions4,
 								expr: &seqExpr{
-									pos: position{line: 460, col: 5, offset: 8638},
+									pos: position{line: 501, col: 5, offset: 9583},
 									exprs: []interface{}{
 										&charClassMatcher{
-											pos:        position{line: 460, col: 5, offset: 8638},
+											pos:        position{line: 501, col: 5, offset: 9583},
 											val:        "[_\\pL]",
 											chars:      []rune{'_'},
 											classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -754,9 +1194,9 @@ var g = &grammar{
 											inverted:   false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 460, col: 11, offset: 8644},
+											pos: position{line: 501, col: 11, offset: 9589},
 											expr: &charClassMatcher{
-												pos:        position{line: 460, col: 11, offset: 8644},
+												pos:        position{line: 501, col: 11, offset: 9589},
 												val:        "[_0-9\\pL]",
 												chars:      []rune{'_'},
 												ranges:     []rune{'0', '9'},
@@ -770,40 +1210,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 61, col: 5, offset: 1091},
+							pos:   position{line: 71, col: 5, offset: 1405},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 61, col: 10, offset: 1096},
+								pos: position{line: 71, col: 10, offset: 1410},
 								expr: &actionExpr{
-									pos: position{line: 62, col: 10, offset: 1107},
+									pos: position{line: 72, col: 10, offset: 1421},
 									run: (*parser).callonMemberExpressions11,
 									expr: &seqExpr{
-										pos: position{line: 62, col: 10, offset: 1107},
+										pos: position{line: 72, col: 10, offset: 1421},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 468, col: 5, offset: 8728},
+												pos: position{line: 509, col: 5, offset: 9673},
 												expr: &choiceExpr{
-													pos: position{line: 468, col: 7, offset: 8730},
+													pos: position{line: 509, col: 7, offset: 9675},
 													alternatives: []interface{}{
 														&charClassMatcher{
-															pos:        position{line: 474, col: 5, offset: 8791},
+															pos:        position{line: 515, col: 5, offset: 9736},
 															val:        "[ \\t\\r\\n]",
 															chars:      []rune{' ', '\t', '\r', '\n'},
 															ignoreCase: false,
 															inverted:   false,
 														},
 														&seqExpr{
-															pos: position{line: 471, col: 5, offset: 8765},
+															pos: position{line: 512, col: 5, offset: 9710},
 															exprs: []interface{}{
 																&litMatcher{
-																	pos:        position{line: 471, col: 5, offset: 8765},
+																	pos:        position{line: 512, col: 5, offset: 9710},
 																	val:        "//",
 																	ignoreCase: false,
 																},
 																&zeroOrMoreExpr{
-																	pos: position{line: 471, col: 10, offset: 8770},
+																	pos: position{line: 512, col: 10, offset: 9715},
 																	expr: &charClassMatcher{
-																		pos:        position{line: 471, col: 10, offset: 8770},
+																		pos:        position{line: 512, col: 10, offset: 9715},
 																		val:        "[^\\r\\n]",
 																		chars:      []rune{'\r', '\n'},
 																		ignoreCase: false,
@@ -811,7 +1251,7 @@ var g = &grammar{
 																	},
 																},
 																&litMatcher{
-																	pos:        position{line: 480, col: 5, offset: 8837},
+																	pos:        position{line: 521, col: 5, offset: 9782},
 																	val:        "\n",
 																	ignoreCase: false,
 																},
@@ -821,10 +1261,10 @@ var g = &grammar{
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 62, col: 13, offset: 1110},
+												pos:   position{line: 72, col: 13, offset: 1424},
 												label: "property",
 												expr: &ruleRefExpr{
-													pos:  position{line: 62, col: 22, offset: 1119},
+													pos:  position{line: 72, col: 22, offset: 1433},
 													name: "MemberExpressionProperty",
 												},
 											},
@@ -839,45 +1279,45 @@ var g = &grammar{
 		},
 		{
 			name: "MemberExpressionProperty",
-			pos:  position{line: 70, col: 1, offset: 1259},
+			pos:  position{line: 80, col: 1, offset: 1573},
 			expr: &choiceExpr{
-				pos: position{line: 71, col: 5, offset: 1288},
+				pos: position{line: 81, col: 5, offset: 1602},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 71, col: 5, offset: 1288},
+						pos: position{line: 81, col: 5, offset: 1602},
 						run: (*parser).callonMemberExpressionProperty2,
 						expr: &seqExpr{
-							pos: position{line: 71, col: 5, offset: 1288},
+							pos: position{line: 81, col: 5, offset: 1602},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 71, col: 5, offset: 1288},
+									pos:        position{line: 81, col: 5, offset: 1602},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -885,7 +1325,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -895,16 +1335,16 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 71, col: 12, offset: 1295},
+									pos:   position{line: 81, col: 12, offset: 1609},
 									label: "property",
 									expr: &actionExpr{
-										pos: position{line: 460, col: 5, offset: 8638},
+										pos: position{line: 501, col: 5, offset: 9583},
 										run: (*parser).callonMemberExpressionProperty14,
 										expr: &seqExpr{
-											pos: position{line: 460, col: 5, offset: 8638},
+											pos: position{line: 501, col: 5, offset: 9583},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 460, col: 5, offset: 8638},
+													pos:        position{line: 501, col: 5, offset: 9583},
 													val:        "[_\\pL]",
 													chars:      []rune{'_'},
 													classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -912,9 +1352,9 @@ var g = &grammar{
 													inverted:   false,
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 460, col: 11, offset: 8644},
+													pos: position{line: 501, col: 11, offset: 9589},
 													expr: &charClassMatcher{
-														pos:        position{line: 460, col: 11, offset: 8644},
+														pos:        position{line: 501, col: 11, offset: 9589},
 														val:        "[_0-9\\pL]",
 														chars:      []rune{'_'},
 														ranges:     []rune{'0', '9'},
@@ -931,40 +1371,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 74, col: 7, offset: 1356},
+						pos: position{line: 84, col: 7, offset: 1670},
 						run: (*parser).callonMemberExpressionProperty19,
 						expr: &seqExpr{
-							pos: position{line: 74, col: 7, offset: 1356},
+							pos: position{line: 84, col: 7, offset: 1670},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 74, col: 7, offset: 1356},
+									pos:        position{line: 84, col: 7, offset: 1670},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -972,7 +1412,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -982,37 +1422,37 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 74, col: 14, offset: 1363},
+									pos:   position{line: 84, col: 14, offset: 1677},
 									label: "property",
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 23, offset: 1372},
+										pos:  position{line: 84, col: 23, offset: 1686},
 										name: "Primary",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1020,7 +1460,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1030,34 +1470,34 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 74, col: 34, offset: 1383},
+									pos:        position{line: 84, col: 34, offset: 1697},
 									val:        "]",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1065,7 +1505,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1082,54 +1522,54 @@ var g = &grammar{
 		},
 		{
 			name: "CallExpression",
-			pos:  position{line: 78, col: 1, offset: 1426},
+			pos:  position{line: 88, col: 1, offset: 1740},
 			expr: &actionExpr{
-				pos: position{line: 79, col: 5, offset: 1445},
+				pos: position{line: 89, col: 5, offset: 1759},
 				run: (*parser).callonCallExpression1,
 				expr: &seqExpr{
-					pos: position{line: 79, col: 5, offset: 1445},
+					pos: position{line: 89, col: 5, offset: 1759},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 79, col: 5, offset: 1445},
+							pos:   position{line: 89, col: 5, offset: 1759},
 							label: "head",
 							expr: &actionExpr{
-								pos: position{line: 80, col: 7, offset: 1458},
+								pos: position{line: 90, col: 7, offset: 1772},
 								run: (*parser).callonCallExpression4,
 								expr: &seqExpr{
-									pos: position{line: 80, col: 7, offset: 1458},
+									pos: position{line: 90, col: 7, offset: 1772},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 80, col: 7, offset: 1458},
+											pos:   position{line: 90, col: 7, offset: 1772},
 											label: "callee",
 											expr: &ruleRefExpr{
-												pos:  position{line: 80, col: 14, offset: 1465},
+												pos:  position{line: 90, col: 14, offset: 1779},
 												name: "MemberExpressions",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -1137,7 +1577,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -1147,10 +1587,10 @@ var g = &grammar{
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 80, col: 35, offset: 1486},
+											pos:   position{line: 90, col: 35, offset: 1800},
 											label: "args",
 											expr: &ruleRefExpr{
-												pos:  position{line: 80, col: 40, offset: 1491},
+												pos:  position{line: 90, col: 40, offset: 1805},
 												name: "Arguments",
 											},
 										},
@@ -1159,43 +1599,43 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 84, col: 5, offset: 1574},
+							pos:   position{line: 94, col: 5, offset: 1888},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 84, col: 10, offset: 1579},
+								pos: position{line: 94, col: 10, offset: 1893},
 								expr: &choiceExpr{
-									pos: position{line: 85, col: 9, offset: 1589},
+									pos: position{line: 95, col: 9, offset: 1903},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 85, col: 9, offset: 1589},
+											pos: position{line: 95, col: 9, offset: 1903},
 											run: (*parser).callonCallExpression21,
 											expr: &seqExpr{
-												pos: position{line: 85, col: 9, offset: 1589},
+												pos: position{line: 95, col: 9, offset: 1903},
 												exprs: []interface{}{
 													&zeroOrMoreExpr{
-														pos: position{line: 468, col: 5, offset: 8728},
+														pos: position{line: 509, col: 5, offset: 9673},
 														expr: &choiceExpr{
-															pos: position{line: 468, col: 7, offset: 8730},
+															pos: position{line: 509, col: 7, offset: 9675},
 															alternatives: []interface{}{
 																&charClassMatcher{
-																	pos:        position{line: 474, col: 5, offset: 8791},
+																	pos:        position{line: 515, col: 5, offset: 9736},
 																	val:        "[ \\t\\r\\n]",
 																	chars:      []rune{' ', '\t', '\r', '\n'},
 																	ignoreCase: false,
 																	inverted:   false,
 																},
 																&seqExpr{
-																	pos: position{line: 471, col: 5, offset: 8765},
+																	pos: position{line: 512, col: 5, offset: 9710},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 471, col: 5, offset: 8765},
+																			pos:        position{line: 512, col: 5, offset: 9710},
 																			val:        "//",
 																			ignoreCase: false,
 																		},
 																		&zeroOrMoreExpr{
-																			pos: position{line: 471, col: 10, offset: 8770},
+																			pos: position{line: 512, col: 10, offset: 9715},
 																			expr: &charClassMatcher{
-																				pos:        position{line: 471, col: 10, offset: 8770},
+																				pos:        position{line: 512, col: 10, offset: 9715},
 																				val:        "[^\\r\\n]",
 																				chars:      []rune{'\r', '\n'},
 																				ignoreCase: false,
@@ -1203,7 +1643,7 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 521, col: 5, offset: 9782},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
@@ -1213,10 +1653,10 @@ var g = &grammar{
 														},
 													},
 													&labeledExpr{
-														pos:   position{line: 85, col: 12, offset: 1592},
+														pos:   position{line: 95, col: 12, offset: 1906},
 														label: "args",
 														expr: &ruleRefExpr{
-															pos:  position{line: 85, col: 17, offset: 1597},
+															pos:  position{line: 95, col: 17, offset: 1911},
 															name: "Arguments",
 														},
 													},
@@ -1224,35 +1664,35 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 88, col: 10, offset: 1680},
+											pos: position{line: 98, col: 10, offset: 1994},
 											run: (*parser).callonCallExpression33,
 											expr: &seqExpr{
-												pos: position{line: 88, col: 10, offset: 1680},
+												pos: position{line: 98, col: 10, offset: 1994},
 												exprs: []interface{}{
 													&zeroOrMoreExpr{
-														pos: position{line: 468, col: 5, offset: 8728},
+														pos: position{line: 509, col: 5, offset: 9673},
 														expr: &choiceExpr{
-															pos: position{line: 468, col: 7, offset: 8730},
+															pos: position{line: 509, col: 7, offset: 9675},
 															alternatives: []interface{}{
 																&charClassMatcher{
-																	pos:        position{line: 474, col: 5, offset: 8791},
+																	pos:        position{line: 515, col: 5, offset: 9736},
 																	val:        "[ \\t\\r\\n]",
 																	chars:      []rune{' ', '\t', '\r', '\n'},
 																	ignoreCase: false,
 																	inverted:   false,
 																},
 																&seqExpr{
-																	pos: position{line: 471, col: 5, offset: 8765},
+																	pos: position{line: 512, col: 5, offset: 9710},
 																	exprs: []interface{}{
 																		&litMatcher{
-																			pos:        position{line: 471, col: 5, offset: 8765},
+																			pos:        position{line: 512, col: 5, offset: 9710},
 																			val:        "//",
 																			ignoreCase: false,
 																		},
 																		&zeroOrMoreExpr{
-																			pos: position{line: 471, col: 10, offset: 8770},
+																			pos: position{line: 512, col: 10, offset: 9715},
 																			expr: &charClassMatcher{
-																				pos:        position{line: 471, col: 10, offset: 8770},
+																				pos:        position{line: 512, col: 10, offset: 9715},
 																				val:        "[^\\r\\n]",
 																				chars:      []rune{'\r', '\n'},
 																				ignoreCase: false,
@@ -1260,7 +1700,7 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 521, col: 5, offset: 9782},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
@@ -1270,10 +1710,10 @@ var g = &grammar{
 														},
 													},
 													&labeledExpr{
-														pos:   position{line: 88, col: 13, offset: 1683},
+														pos:   position{line: 98, col: 13, offset: 1997},
 														label: "property",
 														expr: &ruleRefExpr{
-															pos:  position{line: 88, col: 22, offset: 1692},
+															pos:  position{line: 98, col: 22, offset: 2006},
 															name: "MemberExpressionProperty",
 														},
 													},
@@ -1290,45 +1730,45 @@ var g = &grammar{
 		},
 		{
 			name: "PipeExpression",
-			pos:  position{line: 96, col: 1, offset: 1857},
+			pos:  position{line: 106, col: 1, offset: 2171},
 			expr: &actionExpr{
-				pos: position{line: 97, col: 5, offset: 1876},
+				pos: position{line: 107, col: 5, offset: 2190},
 				run: (*parser).callonPipeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 97, col: 5, offset: 1876},
+					pos: position{line: 107, col: 5, offset: 2190},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 97, col: 5, offset: 1876},
+							pos:   position{line: 107, col: 5, offset: 2190},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 97, col: 10, offset: 1881},
+								pos:  position{line: 107, col: 10, offset: 2195},
 								name: "PipeExpressionHead",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -1336,7 +1776,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -1346,37 +1786,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 97, col: 32, offset: 1903},
+							pos:   position{line: 107, col: 32, offset: 2217},
 							label: "tail",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 97, col: 37, offset: 1908},
+								pos: position{line: 107, col: 37, offset: 2222},
 								expr: &seqExpr{
-									pos: position{line: 97, col: 38, offset: 1909},
+									pos: position{line: 107, col: 38, offset: 2223},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -1384,7 +1824,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -1394,33 +1834,33 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 97, col: 41, offset: 1912},
+											pos:  position{line: 107, col: 41, offset: 2226},
 											name: "PipeExpressionPipe",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -1428,7 +1868,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -1447,37 +1887,37 @@ var g = &grammar{
 		},
 		{
 			name: "PipeExpressionHead",
-			pos:  position{line: 101, col: 1, offset: 1995},
+			pos:  position{line: 111, col: 1, offset: 2309},
 			expr: &choiceExpr{
-				pos: position{line: 102, col: 5, offset: 2018},
+				pos: position{line: 112, col: 5, offset: 2332},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 102, col: 5, offset: 2018},
+						pos:  position{line: 112, col: 5, offset: 2332},
 						name: "CallExpression",
 					},
 					&actionExpr{
-						pos: position{line: 378, col: 5, offset: 7123},
+						pos: position{line: 414, col: 5, offset: 7979},
 						run: (*parser).callonPipeExpressionHead3,
 						expr: &seqExpr{
-							pos: position{line: 378, col: 7, offset: 7125},
+							pos: position{line: 414, col: 7, offset: 7981},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 378, col: 7, offset: 7125},
+									pos:        position{line: 414, col: 7, offset: 7981},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 378, col: 11, offset: 7129},
+									pos: position{line: 414, col: 11, offset: 7985},
 									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
+										pos: position{line: 422, col: 5, offset: 8194},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
+												pos: position{line: 422, col: 5, offset: 8194},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
+														pos: position{line: 422, col: 5, offset: 8194},
 														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
+															pos:        position{line: 422, col: 8, offset: 8197},
 															val:        "[\"\\\\\\n]",
 															chars:      []rune{'"', '\\', '\n'},
 															ignoreCase: false,
@@ -1485,44 +1925,44 @@ var g = &grammar{
 														},
 													},
 													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
+														line: 507, col: 5, offset: 9664,
 													},
 												},
 											},
 											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
+												pos: position{line: 423, col: 5, offset: 8231},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
+														pos:        position{line: 423, col: 5, offset: 8231},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
+														pos: position{line: 426, col: 5, offset: 8279},
 														alternatives: []interface{}{
 															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
+																pos:        position{line: 426, col: 5, offset: 8279},
 																val:        "\"",
 																ignoreCase: false,
 															},
 															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
+																pos: position{line: 427, col: 5, offset: 8287},
 																run: (*parser).callonPipeExpressionHead16,
 																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
+																	pos: position{line: 427, col: 7, offset: 8289},
 																	alternatives: []interface{}{
 																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
+																			line: 507, col: 5, offset: 9664,
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 521, col: 5, offset: 9782},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
 																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
+																			pos: position{line: 524, col: 5, offset: 9796},
 																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
+																				line: 524, col: 6, offset: 9797,
 																			},
 																		},
 																	},
@@ -1536,7 +1976,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 378, col: 29, offset: 7147},
+									pos:        position{line: 414, col: 29, offset: 8003},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -1544,28 +1984,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 381, col: 5, offset: 7207},
+						pos: position{line: 417, col: 5, offset: 8063},
 						run: (*parser).callonPipeExpressionHead23,
 						expr: &seqExpr{
-							pos: position{line: 381, col: 7, offset: 7209},
+							pos: position{line: 417, col: 7, offset: 8065},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 381, col: 7, offset: 7209},
+									pos:        position{line: 417, col: 7, offset: 8065},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 381, col: 11, offset: 7213},
+									pos: position{line: 417, col: 11, offset: 8069},
 									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
+										pos: position{line: 422, col: 5, offset: 8194},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
+												pos: position{line: 422, col: 5, offset: 8194},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
+														pos: position{line: 422, col: 5, offset: 8194},
 														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
+															pos:        position{line: 422, col: 8, offset: 8197},
 															val:        "[\"\\\\\\n]",
 															chars:      []rune{'"', '\\', '\n'},
 															ignoreCase: false,
@@ -1573,44 +2013,44 @@ var g = &grammar{
 														},
 													},
 													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
+														line: 507, col: 5, offset: 9664,
 													},
 												},
 											},
 											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
+												pos: position{line: 423, col: 5, offset: 8231},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
+														pos:        position{line: 423, col: 5, offset: 8231},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
+														pos: position{line: 426, col: 5, offset: 8279},
 														alternatives: []interface{}{
 															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
+																pos:        position{line: 426, col: 5, offset: 8279},
 																val:        "\"",
 																ignoreCase: false,
 															},
 															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
+																pos: position{line: 427, col: 5, offset: 8287},
 																run: (*parser).callonPipeExpressionHead36,
 																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
+																	pos: position{line: 427, col: 7, offset: 8289},
 																	alternatives: []interface{}{
 																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
+																			line: 507, col: 5, offset: 9664,
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 521, col: 5, offset: 9782},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
 																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
+																			pos: position{line: 524, col: 5, offset: 9796},
 																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
+																				line: 524, col: 6, offset: 9797,
 																			},
 																		},
 																	},
@@ -1624,17 +2064,17 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 381, col: 31, offset: 7233},
+									pos: position{line: 417, col: 31, offset: 8089},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 480, col: 5, offset: 8837},
+											pos:        position{line: 521, col: 5, offset: 9782},
 											val:        "\n",
 											ignoreCase: false,
 										},
 										&notExpr{
-											pos: position{line: 483, col: 5, offset: 8851},
+											pos: position{line: 524, col: 5, offset: 9796},
 											expr: &anyMatcher{
-												line: 483, col: 6, offset: 8852,
+												line: 524, col: 6, offset: 9797,
 											},
 										},
 									},
@@ -1643,35 +2083,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 426, col: 5, offset: 8041},
+						pos: position{line: 462, col: 5, offset: 8897},
 						run: (*parser).callonPipeExpressionHead46,
 						expr: &seqExpr{
-							pos: position{line: 426, col: 5, offset: 8041},
+							pos: position{line: 462, col: 5, offset: 8897},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1679,7 +2119,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1689,34 +2129,34 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 426, col: 8, offset: 8044},
+									pos:        position{line: 462, col: 8, offset: 8900},
 									val:        "true",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1724,7 +2164,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1737,35 +2177,129 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 429, col: 5, offset: 8115},
+						pos: position{line: 465, col: 5, offset: 8971},
 						run: (*parser).callonPipeExpressionHead65,
 						expr: &seqExpr{
-							pos: position{line: 429, col: 5, offset: 8115},
+							pos: position{line: 465, col: 5, offset: 8971},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 509, col: 5, offset: 9673},
+									expr: &choiceExpr{
+										pos: position{line: 509, col: 7, offset: 9675},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 515, col: 5, offset: 9736},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 512, col: 5, offset: 9710},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 512, col: 5, offset: 9710},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 512, col: 10, offset: 9715},
+														expr: &charClassMatcher{
+															pos:        position{line: 512, col: 10, offset: 9715},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 521, col: 5, offset: 9782},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 465, col: 8, offset: 8974},
+									val:        "false",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 509, col: 5, offset: 9673},
+									expr: &choiceExpr{
+										pos: position{line: 509, col: 7, offset: 9675},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 515, col: 5, offset: 9736},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 512, col: 5, offset: 9710},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 512, col: 5, offset: 9710},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 512, col: 10, offset: 9715},
+														expr: &charClassMatcher{
+															pos:        position{line: 512, col: 10, offset: 9715},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 521, col: 5, offset: 9782},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 470, col: 5, offset: 9060},
+						run: (*parser).callonPipeExpressionHead84,
+						expr: &seqExpr{
+							pos: position{line: 470, col: 5, offset: 9060},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1773,7 +2307,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1783,34 +2317,46 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 429, col: 8, offset: 8118},
-									val:        "false",
+									pos:        position{line: 470, col: 8, offset: 9063},
+									val:        "null",
 									ignoreCase: false,
 								},
+								&notExpr{
+									pos: position{line: 470, col: 15, offset: 9070},
+									expr: &charClassMatcher{
+										pos:        position{line: 470, col: 16, offset: 9071},
+										val:        "[_0-9\\pL]",
+										chars:      []rune{'_'},
+										ranges:     []rune{'0', '9'},
+										classes:    []*unicode.RangeTable{rangeTable("L")},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1818,7 +2364,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1831,40 +2377,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 397, col: 5, offset: 7543},
-						run: (*parser).callonPipeExpressionHead84,
+						pos: position{line: 433, col: 5, offset: 8399},
+						run: (*parser).callonPipeExpressionHead105,
 						expr: &seqExpr{
-							pos: position{line: 397, col: 5, offset: 7543},
+							pos: position{line: 433, col: 5, offset: 8399},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 397, col: 5, offset: 7543},
+									pos:        position{line: 433, col: 5, offset: 8399},
 									val:        "/",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 397, col: 9, offset: 7547},
+									pos:   position{line: 433, col: 9, offset: 8403},
 									label: "pattern",
 									expr: &actionExpr{
-										pos: position{line: 402, col: 5, offset: 7624},
-										run: (*parser).callonPipeExpressionHead88,
+										pos: position{line: 438, col: 5, offset: 8480},
+										run: (*parser).callonPipeExpressionHead109,
 										expr: &labeledExpr{
-											pos:   position{line: 402, col: 5, offset: 7624},
+											pos:   position{line: 438, col: 5, offset: 8480},
 											label: "chars",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 402, col: 11, offset: 7630},
+												pos: position{line: 438, col: 11, offset: 8486},
 												expr: &choiceExpr{
-													pos: position{line: 407, col: 5, offset: 7714},
+													pos: position{line: 443, col: 5, offset: 8570},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 407, col: 5, offset: 7714},
-															run: (*parser).callonPipeExpressionHead92,
+															pos: position{line: 443, col: 5, offset: 8570},
+															run: (*parser).callonPipeExpressionHead113,
 															expr: &seqExpr{
-																pos: position{line: 407, col: 5, offset: 7714},
+																pos: position{line: 443, col: 5, offset: 8570},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 407, col: 5, offset: 7714},
+																		pos: position{line: 443, col: 5, offset: 8570},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 407, col: 6, offset: 7715},
+																			pos:        position{line: 443, col: 6, offset: 8571},
 																			val:        "[\\\\/]",
 																			chars:      []rune{'\\', '/'},
 																			ignoreCase: false,
@@ -1872,18 +2418,18 @@ var g = &grammar{
 																		},
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 407, col: 12, offset: 7721},
+																		pos:   position{line: 443, col: 12, offset: 8577},
 																		label: "re",
 																		expr: &actionExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
-																			run: (*parser).callonPipeExpressionHead97,
+																			pos: position{line: 457, col: 5, offset: 8817},
+																			run: (*parser).callonPipeExpressionHead118,
 																			expr: &seqExpr{
-																				pos: position{line: 421, col: 5, offset: 7961},
+																				pos: position{line: 457, col: 5, offset: 8817},
 																				exprs: []interface{}{
 																					&notExpr{
-																						pos: position{line: 421, col: 5, offset: 7961},
+																						pos: position{line: 457, col: 5, offset: 8817},
 																						expr: &charClassMatcher{
-																							pos:        position{line: 477, col: 5, offset: 8821},
+																							pos:        position{line: 518, col: 5, offset: 9766},
 																							val:        "[\\n\\r]",
 																							chars:      []rune{'\n', '\r'},
 																							ignoreCase: false,
@@ -1891,7 +2437,7 @@ var g = &grammar{
 																						},
 																					},
 																					&anyMatcher{
-																						line: 466, col: 5, offset: 8719,
+																						line: 507, col: 5, offset: 9664,
 																					},
 																				},
 																			},
@@ -1901,35 +2447,35 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 413, col: 5, offset: 7830},
-															run: (*parser).callonPipeExpressionHead102,
+															pos: position{line: 449, col: 5, offset: 8686},
+															run: (*parser).callonPipeExpressionHead123,
 															expr: &litMatcher{
-																pos:        position{line: 413, col: 5, offset: 7830},
+																pos:        position{line: 449, col: 5, offset: 8686},
 																val:        "\\/",
 																ignoreCase: false,
 															},
 														},
 														&actionExpr{
-															pos: position{line: 416, col: 5, offset: 7878},
-															run: (*parser).callonPipeExpressionHead104,
+															pos: position{line: 452, col: 5, offset: 8734},
+															run: (*parser).callonPipeExpressionHead125,
 															expr: &seqExpr{
-																pos: position{line: 416, col: 5, offset: 7878},
+																pos: position{line: 452, col: 5, offset: 8734},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 416, col: 5, offset: 7878},
+																		pos:        position{line: 452, col: 5, offset: 8734},
 																		val:        "\\",
 																		ignoreCase: false,
 																	},
 																	&actionExpr{
-																		pos: position{line: 421, col: 5, offset: 7961},
-																		run: (*parser).callonPipeExpressionHead107,
+																		pos: position{line: 457, col: 5, offset: 8817},
+																		run: (*parser).callonPipeExpressionHead128,
 																		expr: &seqExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
+																			pos: position{line: 457, col: 5, offset: 8817},
 																			exprs: []interface{}{
 																				&notExpr{
-																					pos: position{line: 421, col: 5, offset: 7961},
+																					pos: position{line: 457, col: 5, offset: 8817},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 477, col: 5, offset: 8821},
+																						pos:        position{line: 518, col: 5, offset: 9766},
 																						val:        "[\\n\\r]",
 																						chars:      []rune{'\n', '\r'},
 																						ignoreCase: false,
@@ -1937,7 +2483,7 @@ var g = &grammar{
 																					},
 																				},
 																				&anyMatcher{
-																					line: 466, col: 5, offset: 8719,
+																					line: 507, col: 5, offset: 9664,
 																				},
 																			},
 																		},
@@ -1952,7 +2498,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 397, col: 28, offset: 7566},
+									pos:        position{line: 433, col: 28, offset: 8422},
 									val:        "/",
 									ignoreCase: false,
 								},
@@ -1960,44 +2506,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 453, col: 5, offset: 8452},
-						run: (*parser).callonPipeExpressionHead113,
+						pos: position{line: 494, col: 5, offset: 9397},
+						run: (*parser).callonPipeExpressionHead134,
 						expr: &litMatcher{
-							pos:        position{line: 453, col: 5, offset: 8452},
+							pos:        position{line: 494, col: 5, offset: 9397},
 							val:        "<-",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 373, col: 5, offset: 7036},
-						run: (*parser).callonPipeExpressionHead115,
+						pos: position{line: 409, col: 5, offset: 7892},
+						run: (*parser).callonPipeExpressionHead136,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 373, col: 5, offset: 7036},
+							pos: position{line: 409, col: 5, offset: 7892},
 							expr: &seqExpr{
-								pos: position{line: 370, col: 5, offset: 6993},
+								pos: position{line: 406, col: 5, offset: 7849},
 								exprs: []interface{}{
 									&choiceExpr{
-										pos: position{line: 439, col: 6, offset: 8288},
+										pos: position{line: 480, col: 6, offset: 9233},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 439, col: 6, offset: 8288},
+												pos:        position{line: 480, col: 6, offset: 9233},
 												val:        "0",
 												ignoreCase: false,
 											},
 											&seqExpr{
-												pos: position{line: 439, col: 12, offset: 8294},
+												pos: position{line: 480, col: 12, offset: 9239},
 												exprs: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 447, col: 5, offset: 8412},
+														pos:        position{line: 488, col: 5, offset: 9357},
 														val:        "[1-9]",
 														ranges:     []rune{'1', '9'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 439, col: 25, offset: 8307},
+														pos: position{line: 480, col: 25, offset: 9252},
 														expr: &charClassMatcher{
-															pos:        position{line: 450, col: 5, offset: 8429},
+															pos:        position{line: 491, col: 5, offset: 9374},
 															val:        "[0-9]",
 															ranges:     []rune{'0', '9'},
 															ignoreCase: false,
@@ -2009,37 +2555,42 @@ var g = &grammar{
 										},
 									},
 									&choiceExpr{
-										pos: position{line: 361, col: 9, offset: 6843},
+										pos: position{line: 393, col: 9, offset: 7627},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 342, col: 5, offset: 6676},
+												pos:        position{line: 362, col: 5, offset: 7383},
 												val:        "ns",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 6, offset: 6704},
+												pos:        position{line: 365, col: 6, offset: 7411},
 												val:        "us",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 13, offset: 6711},
+												pos:        position{line: 365, col: 13, offset: 7418},
 												val:        "µs",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 20, offset: 6719},
+												pos:        position{line: 365, col: 20, offset: 7426},
 												val:        "μs",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 348, col: 5, offset: 6748},
+												pos:        position{line: 368, col: 5, offset: 7455},
 												val:        "ms",
 												ignoreCase: false,
 											},
+											&litMatcher{
+												pos:        position{line: 386, col: 5, offset: 7574},
+												val:        "mo",
+												ignoreCase: false,
+											},
 											&charClassMatcher{
-												pos:        position{line: 351, col: 5, offset: 6770},
-												val:        "[smh]",
-												chars:      []rune{'s', 'm', 'h'},
+												pos:        position{line: 371, col: 5, offset: 7477},
+												val:        "[smhdwy]",
+												chars:      []rune{'s', 'm', 'h', 'd', 'w', 'y'},
 												ignoreCase: false,
 												inverted:   false,
 											},
@@ -2050,148 +2601,148 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 337, col: 5, offset: 6588},
-						run: (*parser).callonPipeExpressionHead131,
+						pos: position{line: 357, col: 5, offset: 7295},
+						run: (*parser).callonPipeExpressionHead153,
 						expr: &seqExpr{
-							pos: position{line: 337, col: 5, offset: 6588},
+							pos: position{line: 357, col: 5, offset: 7295},
 							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 331, col: 18, offset: 6503},
+									pos:        position{line: 351, col: 18, offset: 7210},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 331, col: 32, offset: 6517},
+									pos:        position{line: 351, col: 32, offset: 7224},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 337, col: 14, offset: 6597},
+									pos:        position{line: 357, col: 14, offset: 7304},
 									val:        "T",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 328, col: 14, offset: 6433},
+									pos:        position{line: 348, col: 14, offset: 7140},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 328, col: 29, offset: 6448},
+									pos:        position{line: 348, col: 29, offset: 7155},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 328, col: 44, offset: 6463},
+									pos: position{line: 348, col: 44, offset: 7170},
 									expr: &seqExpr{
-										pos: position{line: 319, col: 5, offset: 6303},
+										pos: position{line: 339, col: 5, offset: 7010},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 319, col: 5, offset: 6303},
+												pos:        position{line: 339, col: 5, offset: 7010},
 												val:        ".",
 												ignoreCase: false,
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 319, col: 9, offset: 6307},
+												pos: position{line: 339, col: 9, offset: 7014},
 												expr: &charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
@@ -2202,51 +2753,51 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 325, col: 6, offset: 6386},
+									pos: position{line: 345, col: 6, offset: 7093},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 325, col: 6, offset: 6386},
+											pos:        position{line: 345, col: 6, offset: 7093},
 											val:        "Z",
 											ignoreCase: false,
 										},
 										&seqExpr{
-											pos: position{line: 322, col: 5, offset: 6333},
+											pos: position{line: 342, col: 5, offset: 7040},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 322, col: 6, offset: 6334},
+													pos:        position{line: 342, col: 6, offset: 7041},
 													val:        "[+-]",
 													chars:      []rune{'+', '-'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 322, col: 26, offset: 6354},
+													pos:        position{line: 342, col: 26, offset: 7061},
 													val:        ":",
 													ignoreCase: false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
@@ -2260,33 +2811,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 434, col: 5, offset: 8206},
-						run: (*parser).callonPipeExpressionHead166,
+						pos: position{line: 475, col: 5, offset: 9151},
+						run: (*parser).callonPipeExpressionHead188,
 						expr: &seqExpr{
-							pos: position{line: 434, col: 5, offset: 8206},
+							pos: position{line: 475, col: 5, offset: 9151},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 439, col: 6, offset: 8288},
+									pos: position{line: 480, col: 6, offset: 9233},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 439, col: 6, offset: 8288},
+											pos:        position{line: 480, col: 6, offset: 9233},
 											val:        "0",
 											ignoreCase: false,
 										},
 										&seqExpr{
-											pos: position{line: 439, col: 12, offset: 8294},
+											pos: position{line: 480, col: 12, offset: 9239},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 447, col: 5, offset: 8412},
+													pos:        position{line: 488, col: 5, offset: 9357},
 													val:        "[1-9]",
 													ranges:     []rune{'1', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 439, col: 25, offset: 8307},
+													pos: position{line: 480, col: 25, offset: 9252},
 													expr: &charClassMatcher{
-														pos:        position{line: 450, col: 5, offset: 8429},
+														pos:        position{line: 491, col: 5, offset: 9374},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -2298,14 +2849,14 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 434, col: 13, offset: 8214},
+									pos:        position{line: 475, col: 13, offset: 9159},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 434, col: 17, offset: 8218},
+									pos: position{line: 475, col: 17, offset: 9163},
 									expr: &charClassMatcher{
-										pos:        position{line: 450, col: 5, offset: 8429},
+										pos:        position{line: 491, col: 5, offset: 9374},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -2316,30 +2867,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 442, col: 5, offset: 8335},
-						run: (*parser).callonPipeExpressionHead177,
+						pos: position{line: 483, col: 5, offset: 9280},
+						run: (*parser).callonPipeExpressionHead199,
 						expr: &choiceExpr{
-							pos: position{line: 439, col: 6, offset: 8288},
+							pos: position{line: 480, col: 6, offset: 9233},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 439, col: 6, offset: 8288},
+									pos:        position{line: 480, col: 6, offset: 9233},
 									val:        "0",
 									ignoreCase: false,
 								},
 								&seqExpr{
-									pos: position{line: 439, col: 12, offset: 8294},
+									pos: position{line: 480, col: 12, offset: 9239},
 									exprs: []interface{}{
 										&charClassMatcher{
-											pos:        position{line: 447, col: 5, offset: 8412},
+											pos:        position{line: 488, col: 5, offset: 9357},
 											val:        "[1-9]",
 											ranges:     []rune{'1', '9'},
 											ignoreCase: false,
 											inverted:   false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 439, col: 25, offset: 8307},
+											pos: position{line: 480, col: 25, offset: 9252},
 											expr: &charClassMatcher{
-												pos:        position{line: 450, col: 5, offset: 8429},
+												pos:        position{line: 491, col: 5, offset: 9374},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -2352,21 +2903,21 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 104, col: 5, offset: 2171},
+						pos:  position{line: 114, col: 5, offset: 2485},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 105, col: 5, offset: 2181},
+						pos:  position{line: 115, col: 5, offset: 2495},
 						name: "MemberExpressions",
 					},
 					&actionExpr{
-						pos: position{line: 460, col: 5, offset: 8638},
-						run: (*parser).callonPipeExpressionHead186,
+						pos: position{line: 501, col: 5, offset: 9583},
+						run: (*parser).callonPipeExpressionHead208,
 						expr: &seqExpr{
-							pos: position{line: 460, col: 5, offset: 8638},
+							pos: position{line: 501, col: 5, offset: 9583},
 							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 460, col: 5, offset: 8638},
+									pos:        position{line: 501, col: 5, offset: 9583},
 									val:        "[_\\pL]",
 									chars:      []rune{'_'},
 									classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -2374,9 +2925,9 @@ var g = &grammar{
 									inverted:   false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 460, col: 11, offset: 8644},
+									pos: position{line: 501, col: 11, offset: 9589},
 									expr: &charClassMatcher{
-										pos:        position{line: 460, col: 11, offset: 8644},
+										pos:        position{line: 501, col: 11, offset: 9589},
 										val:        "[_0-9\\pL]",
 										chars:      []rune{'_'},
 										ranges:     []rune{'0', '9'},
@@ -2389,15 +2940,15 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 107, col: 5, offset: 2218},
+						pos:  position{line: 117, col: 5, offset: 2532},
 						name: "ObjectExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 108, col: 5, offset: 2239},
+						pos:  position{line: 118, col: 5, offset: 2553},
 						name: "ArrowFunctionExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 109, col: 5, offset: 2267},
+						pos:  position{line: 119, col: 5, offset: 2581},
 						name: "Parens",
 					},
 				},
@@ -2405,42 +2956,42 @@ var g = &grammar{
 		},
 		{
 			name: "PipeExpressionPipe",
-			pos:  position{line: 111, col: 1, offset: 2275},
+			pos:  position{line: 121, col: 1, offset: 2589},
 			expr: &actionExpr{
-				pos: position{line: 112, col: 5, offset: 2298},
+				pos: position{line: 122, col: 5, offset: 2612},
 				run: (*parser).callonPipeExpressionPipe1,
 				expr: &seqExpr{
-					pos: position{line: 112, col: 5, offset: 2298},
+					pos: position{line: 122, col: 5, offset: 2612},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 112, col: 5, offset: 2298},
+							pos:        position{line: 122, col: 5, offset: 2612},
 							val:        "|>",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2448,7 +2999,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2458,10 +3009,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 112, col: 13, offset: 2306},
+							pos:   position{line: 122, col: 13, offset: 2620},
 							label: "call",
 							expr: &ruleRefExpr{
-								pos:  position{line: 112, col: 18, offset: 2311},
+								pos:  position{line: 122, col: 18, offset: 2625},
 								name: "CallExpression",
 							},
 						},
@@ -2471,42 +3022,42 @@ var g = &grammar{
 		},
 		{
 			name: "Arguments",
-			pos:  position{line: 116, col: 1, offset: 2388},
+			pos:  position{line: 126, col: 1, offset: 2702},
 			expr: &actionExpr{
-				pos: position{line: 117, col: 5, offset: 2402},
+				pos: position{line: 127, col: 5, offset: 2716},
 				run: (*parser).callonArguments1,
 				expr: &seqExpr{
-					pos: position{line: 117, col: 5, offset: 2402},
+					pos: position{line: 127, col: 5, offset: 2716},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 117, col: 5, offset: 2402},
+							pos:        position{line: 127, col: 5, offset: 2716},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2514,7 +3065,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2524,40 +3075,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 117, col: 12, offset: 2409},
+							pos:   position{line: 127, col: 12, offset: 2723},
 							label: "args",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 117, col: 17, offset: 2414},
+								pos: position{line: 127, col: 17, offset: 2728},
 								expr: &ruleRefExpr{
-									pos:  position{line: 117, col: 18, offset: 2415},
+									pos:  position{line: 127, col: 18, offset: 2729},
 									name: "ObjectProperties",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2565,7 +3116,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2575,7 +3126,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 40, offset: 2437},
+							pos:        position{line: 127, col: 40, offset: 2751},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -2585,42 +3136,42 @@ var g = &grammar{
 		},
 		{
 			name: "ArrowFunctionExpression",
-			pos:  position{line: 121, col: 1, offset: 2473},
+			pos:  position{line: 131, col: 1, offset: 2787},
 			expr: &actionExpr{
-				pos: position{line: 122, col: 5, offset: 2501},
+				pos: position{line: 132, col: 5, offset: 2815},
 				run: (*parser).callonArrowFunctionExpression1,
 				expr: &seqExpr{
-					pos: position{line: 122, col: 5, offset: 2501},
+					pos: position{line: 132, col: 5, offset: 2815},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 122, col: 5, offset: 2501},
+							pos:        position{line: 132, col: 5, offset: 2815},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2628,7 +3179,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2638,40 +3189,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 122, col: 12, offset: 2508},
+							pos:   position{line: 132, col: 12, offset: 2822},
 							label: "params",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 122, col: 19, offset: 2515},
+								pos: position{line: 132, col: 19, offset: 2829},
 								expr: &ruleRefExpr{
-									pos:  position{line: 122, col: 19, offset: 2515},
+									pos:  position{line: 132, col: 19, offset: 2829},
 									name: "ArrowFunctionParams",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2679,7 +3230,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2689,34 +3240,34 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 122, col: 43, offset: 2539},
+							pos:        position{line: 132, col: 43, offset: 2853},
 							val:        ")",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2724,7 +3275,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2734,34 +3285,34 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 122, col: 50, offset: 2546},
+							pos:        position{line: 132, col: 50, offset: 2860},
 							val:        "=>",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2769,7 +3320,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2779,37 +3330,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 122, col: 58, offset: 2554},
+							pos:   position{line: 132, col: 58, offset: 2868},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 63, offset: 2559},
+								pos:  position{line: 132, col: 63, offset: 2873},
 								name: "ArrowFunctionBody",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2817,7 +3368,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2832,45 +3383,45 @@ var g = &grammar{
 		},
 		{
 			name: "ArrowFunctionParams",
-			pos:  position{line: 126, col: 1, offset: 2646},
+			pos:  position{line: 136, col: 1, offset: 2960},
 			expr: &actionExpr{
-				pos: position{line: 127, col: 5, offset: 2670},
+				pos: position{line: 137, col: 5, offset: 2984},
 				run: (*parser).callonArrowFunctionParams1,
 				expr: &seqExpr{
-					pos: position{line: 127, col: 5, offset: 2670},
+					pos: position{line: 137, col: 5, offset: 2984},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 127, col: 5, offset: 2670},
+							pos:   position{line: 137, col: 5, offset: 2984},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 11, offset: 2676},
+								pos:  position{line: 137, col: 11, offset: 2990},
 								name: "ArrowFunctionParam",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2878,7 +3429,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2888,20 +3439,20 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 127, col: 33, offset: 2698},
+							pos:   position{line: 137, col: 33, offset: 3012},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 127, col: 38, offset: 2703},
+								pos: position{line: 137, col: 38, offset: 3017},
 								expr: &ruleRefExpr{
-									pos:  position{line: 127, col: 38, offset: 2703},
+									pos:  position{line: 137, col: 38, offset: 3017},
 									name: "ArrowFunctionParamsRest",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 127, col: 63, offset: 2728},
+							pos: position{line: 137, col: 63, offset: 3042},
 							expr: &litMatcher{
-								pos:        position{line: 127, col: 63, offset: 2728},
+								pos:        position{line: 137, col: 63, offset: 3042},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -2912,42 +3463,42 @@ var g = &grammar{
 		},
 		{
 			name: "ArrowFunctionParamsRest",
-			pos:  position{line: 131, col: 1, offset: 2813},
+			pos:  position{line: 141, col: 1, offset: 3127},
 			expr: &actionExpr{
-				pos: position{line: 132, col: 5, offset: 2841},
+				pos: position{line: 142, col: 5, offset: 3155},
 				run: (*parser).callonArrowFunctionParamsRest1,
 				expr: &seqExpr{
-					pos: position{line: 132, col: 5, offset: 2841},
+					pos: position{line: 142, col: 5, offset: 3155},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 132, col: 5, offset: 2841},
+							pos:        position{line: 142, col: 5, offset: 3155},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2955,7 +3506,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2965,37 +3516,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 132, col: 13, offset: 2849},
+							pos:   position{line: 142, col: 13, offset: 3163},
 							label: "arg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 132, col: 17, offset: 2853},
+								pos:  position{line: 142, col: 17, offset: 3167},
 								name: "ArrowFunctionParam",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3003,7 +3554,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3018,27 +3569,27 @@ var g = &grammar{
 		},
 		{
 			name: "ArrowFunctionParam",
-			pos:  position{line: 136, col: 1, offset: 2906},
+			pos:  position{line: 146, col: 1, offset: 3220},
 			expr: &choiceExpr{
-				pos: position{line: 137, col: 5, offset: 2929},
+				pos: position{line: 147, col: 5, offset: 3243},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 137, col: 5, offset: 2929},
+						pos: position{line: 147, col: 5, offset: 3243},
 						run: (*parser).callonArrowFunctionParam2,
 						expr: &seqExpr{
-							pos: position{line: 137, col: 5, offset: 2929},
+							pos: position{line: 147, col: 5, offset: 3243},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 137, col: 5, offset: 2929},
+									pos:   position{line: 147, col: 5, offset: 3243},
 									label: "key",
 									expr: &actionExpr{
-										pos: position{line: 460, col: 5, offset: 8638},
+										pos: position{line: 501, col: 5, offset: 9583},
 										run: (*parser).callonArrowFunctionParam5,
 										expr: &seqExpr{
-											pos: position{line: 460, col: 5, offset: 8638},
+											pos: position{line: 501, col: 5, offset: 9583},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 460, col: 5, offset: 8638},
+													pos:        position{line: 501, col: 5, offset: 9583},
 													val:        "[_\\pL]",
 													chars:      []rune{'_'},
 													classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -3046,9 +3597,9 @@ var g = &grammar{
 													inverted:   false,
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 460, col: 11, offset: 8644},
+													pos: position{line: 501, col: 11, offset: 9589},
 													expr: &charClassMatcher{
-														pos:        position{line: 460, col: 11, offset: 8644},
+														pos:        position{line: 501, col: 11, offset: 9589},
 														val:        "[_0-9\\pL]",
 														chars:      []rune{'_'},
 														ranges:     []rune{'0', '9'},
@@ -3062,29 +3613,29 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -3092,7 +3643,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -3102,34 +3653,34 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 137, col: 23, offset: 2947},
+									pos:        position{line: 147, col: 23, offset: 3261},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -3137,7 +3688,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -3147,37 +3698,37 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 137, col: 30, offset: 2954},
+									pos:   position{line: 147, col: 30, offset: 3268},
 									label: "value",
 									expr: &ruleRefExpr{
-										pos:  position{line: 137, col: 36, offset: 2960},
+										pos:  position{line: 147, col: 36, offset: 3274},
 										name: "Primary",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -3185,7 +3736,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -3198,22 +3749,22 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 140, col: 5, offset: 3033},
+						pos: position{line: 150, col: 5, offset: 3347},
 						run: (*parser).callonArrowFunctionParam37,
 						expr: &seqExpr{
-							pos: position{line: 140, col: 5, offset: 3033},
+							pos: position{line: 150, col: 5, offset: 3347},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 140, col: 5, offset: 3033},
+									pos:   position{line: 150, col: 5, offset: 3347},
 									label: "key",
 									expr: &actionExpr{
-										pos: position{line: 460, col: 5, offset: 8638},
+										pos: position{line: 501, col: 5, offset: 9583},
 										run: (*parser).callonArrowFunctionParam40,
 										expr: &seqExpr{
-											pos: position{line: 460, col: 5, offset: 8638},
+											pos: position{line: 501, col: 5, offset: 9583},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 460, col: 5, offset: 8638},
+													pos:        position{line: 501, col: 5, offset: 9583},
 													val:        "[_\\pL]",
 													chars:      []rune{'_'},
 													classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -3221,9 +3772,9 @@ var g = &grammar{
 													inverted:   false,
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 460, col: 11, offset: 8644},
+													pos: position{line: 501, col: 11, offset: 9589},
 													expr: &charClassMatcher{
-														pos:        position{line: 460, col: 11, offset: 8644},
+														pos:        position{line: 501, col: 11, offset: 9589},
 														val:        "[_0-9\\pL]",
 														chars:      []rune{'_'},
 														ranges:     []rune{'0', '9'},
@@ -3237,29 +3788,29 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -3267,7 +3818,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -3284,30 +3835,30 @@ var g = &grammar{
 		},
 		{
 			name: "ArrowFunctionBody",
-			pos:  position{line: 145, col: 1, offset: 3109},
+			pos:  position{line: 155, col: 1, offset: 3423},
 			expr: &choiceExpr{
-				pos: position{line: 146, col: 5, offset: 3131},
+				pos: position{line: 156, col: 5, offset: 3445},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 146, col: 5, offset: 3131},
+						pos: position{line: 156, col: 5, offset: 3445},
 						run: (*parser).callonArrowFunctionBody2,
 						expr: &labeledExpr{
-							pos:   position{line: 146, col: 5, offset: 3131},
+							pos:   position{line: 156, col: 5, offset: 3445},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 146, col: 10, offset: 3136},
+								pos:  position{line: 156, col: 10, offset: 3450},
 								name: "Expr",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 149, col: 5, offset: 3176},
+						pos: position{line: 159, col: 5, offset: 3490},
 						run: (*parser).callonArrowFunctionBody5,
 						expr: &labeledExpr{
-							pos:   position{line: 149, col: 5, offset: 3176},
+							pos:   position{line: 159, col: 5, offset: 3490},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 149, col: 10, offset: 3181},
+								pos:  position{line: 159, col: 10, offset: 3495},
 								name: "BlockStatement",
 							},
 						},
@@ -3317,42 +3868,42 @@ var g = &grammar{
 		},
 		{
 			name: "ObjectExpression",
-			pos:  position{line: 153, col: 1, offset: 3224},
+			pos:  position{line: 163, col: 1, offset: 3538},
 			expr: &actionExpr{
-				pos: position{line: 154, col: 5, offset: 3245},
+				pos: position{line: 164, col: 5, offset: 3559},
 				run: (*parser).callonObjectExpression1,
 				expr: &seqExpr{
-					pos: position{line: 154, col: 5, offset: 3245},
+					pos: position{line: 164, col: 5, offset: 3559},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 154, col: 5, offset: 3245},
+							pos:        position{line: 164, col: 5, offset: 3559},
 							val:        "{",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3360,7 +3911,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3370,40 +3921,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 154, col: 12, offset: 3252},
+							pos:   position{line: 164, col: 12, offset: 3566},
 							label: "object",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 154, col: 19, offset: 3259},
+								pos: position{line: 164, col: 19, offset: 3573},
 								expr: &ruleRefExpr{
-									pos:  position{line: 154, col: 20, offset: 3260},
+									pos:  position{line: 164, col: 20, offset: 3574},
 									name: "ObjectProperties",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3411,7 +3962,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3421,7 +3972,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 154, col: 42, offset: 3282},
+							pos:        position{line: 164, col: 42, offset: 3596},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -3431,45 +3982,45 @@ var g = &grammar{
 		},
 		{
 			name: "ObjectProperties",
-			pos:  position{line: 158, col: 1, offset: 3316},
+			pos:  position{line: 168, col: 1, offset: 3630},
 			expr: &actionExpr{
-				pos: position{line: 159, col: 5, offset: 3337},
+				pos: position{line: 169, col: 5, offset: 3651},
 				run: (*parser).callonObjectProperties1,
 				expr: &seqExpr{
-					pos: position{line: 159, col: 5, offset: 3337},
+					pos: position{line: 169, col: 5, offset: 3651},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 159, col: 5, offset: 3337},
+							pos:   position{line: 169, col: 5, offset: 3651},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 159, col: 11, offset: 3343},
+								pos:  position{line: 169, col: 11, offset: 3657},
 								name: "Property",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3477,7 +4028,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3487,40 +4038,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 159, col: 23, offset: 3355},
+							pos:   position{line: 169, col: 23, offset: 3669},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 159, col: 28, offset: 3360},
+								pos: position{line: 169, col: 28, offset: 3674},
 								expr: &ruleRefExpr{
-									pos:  position{line: 159, col: 28, offset: 3360},
+									pos:  position{line: 169, col: 28, offset: 3674},
 									name: "PropertiesRest",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3528,7 +4079,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3538,9 +4089,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 159, col: 47, offset: 3379},
+							pos: position{line: 169, col: 47, offset: 3693},
 							expr: &litMatcher{
-								pos:        position{line: 159, col: 47, offset: 3379},
+								pos:        position{line: 169, col: 47, offset: 3693},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -3551,42 +4102,381 @@ var g = &grammar{
 		},
 		{
 			name: "PropertiesRest",
-			pos:  position{line: 163, col: 1, offset: 3445},
+			pos:  position{line: 173, col: 1, offset: 3759},
 			expr: &actionExpr{
-				pos: position{line: 164, col: 5, offset: 3464},
+				pos: position{line: 174, col: 5, offset: 3778},
 				run: (*parser).callonPropertiesRest1,
 				expr: &seqExpr{
-					pos: position{line: 164, col: 5, offset: 3464},
+					pos: position{line: 174, col: 5, offset: 3778},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 174, col: 5, offset: 3778},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 509, col: 5, offset: 9673},
+							expr: &choiceExpr{
+								pos: position{line: 509, col: 7, offset: 9675},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 515, col: 5, offset: 9736},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 512, col: 5, offset: 9710},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 512, col: 5, offset: 9710},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 512, col: 10, offset: 9715},
+												expr: &charClassMatcher{
+													pos:        position{line: 512, col: 10, offset: 9715},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 521, col: 5, offset: 9782},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 174, col: 13, offset: 3786},
+							label: "arg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 174, col: 17, offset: 3790},
+								name: "Property",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Property",
+			pos:  position{line: 178, col: 1, offset: 3830},
+			expr: &actionExpr{
+				pos: position{line: 179, col: 5, offset: 3843},
+				run: (*parser).callonProperty1,
+				expr: &seqExpr{
+					pos: position{line: 179, col: 5, offset: 3843},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 179, col: 5, offset: 3843},
+							label: "key",
+							expr: &actionExpr{
+								pos: position{line: 501, col: 5, offset: 9583},
+								run: (*parser).callonProperty4,
+								expr: &seqExpr{
+									pos: position{line: 501, col: 5, offset: 9583},
+									exprs: []interface{}{
+										&charClassMatcher{
+											pos:        position{line: 501, col: 5, offset: 9583},
+											val:        "[_\\pL]",
+											chars:      []rune{'_'},
+											classes:    []*unicode.RangeTable{rangeTable("L")},
+											ignoreCase: false,
+											inverted:   false,
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 501, col: 11, offset: 9589},
+											expr: &charClassMatcher{
+												pos:        position{line: 501, col: 11, offset: 9589},
+												val:        "[_0-9\\pL]",
+												chars:      []rune{'_'},
+												ranges:     []rune{'0', '9'},
+												classes:    []*unicode.RangeTable{rangeTable("L")},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 509, col: 5, offset: 9673},
+							expr: &choiceExpr{
+								pos: position{line: 509, col: 7, offset: 9675},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 515, col: 5, offset: 9736},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 512, col: 5, offset: 9710},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 512, col: 5, offset: 9710},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 512, col: 10, offset: 9715},
+												expr: &charClassMatcher{
+													pos:        position{line: 512, col: 10, offset: 9715},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 521, col: 5, offset: 9782},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 179, col: 24, offset: 3862},
+							val:        ":",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 509, col: 5, offset: 9673},
+							expr: &choiceExpr{
+								pos: position{line: 509, col: 7, offset: 9675},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 515, col: 5, offset: 9736},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 512, col: 5, offset: 9710},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 512, col: 5, offset: 9710},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 512, col: 10, offset: 9715},
+												expr: &charClassMatcher{
+													pos:        position{line: 512, col: 10, offset: 9715},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 521, col: 5, offset: 9782},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 179, col: 31, offset: 3869},
+							label: "value",
+							expr: &ruleRefExpr{
+								pos:  position{line: 179, col: 37, offset: 3875},
+								name: "Expr",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Expr",
+			pos:  position{line: 190, col: 1, offset: 4125},
+			expr: &choiceExpr{
+				pos: position{line: 191, col: 5, offset: 4134},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 191, col: 5, offset: 4134},
+						name: "ConditionalExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 192, col: 5, offset: 4160},
+						name: "LogicalExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "ConditionalExpression",
+			pos:  position{line: 194, col: 1, offset: 4179},
+			expr: &actionExpr{
+				pos: position{line: 195, col: 5, offset: 4205},
+				run: (*parser).callonConditionalExpression1,
+				expr: &seqExpr{
+					pos: position{line: 195, col: 5, offset: 4205},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 164, col: 5, offset: 3464},
-							val:        ",",
+							pos:        position{line: 195, col: 5, offset: 4205},
+							val:        "if",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 195, col: 10, offset: 4210},
+							expr: &charClassMatcher{
+								pos:        position{line: 195, col: 11, offset: 4211},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 509, col: 5, offset: 9673},
+							expr: &choiceExpr{
+								pos: position{line: 509, col: 7, offset: 9675},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 515, col: 5, offset: 9736},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 512, col: 5, offset: 9710},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 512, col: 5, offset: 9710},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 512, col: 10, offset: 9715},
+												expr: &charClassMatcher{
+													pos:        position{line: 512, col: 10, offset: 9715},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 521, col: 5, offset: 9782},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 195, col: 24, offset: 4224},
+							label: "test",
+							expr: &ruleRefExpr{
+								pos:  position{line: 195, col: 29, offset: 4229},
+								name: "Expr",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 509, col: 5, offset: 9673},
+							expr: &choiceExpr{
+								pos: position{line: 509, col: 7, offset: 9675},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 515, col: 5, offset: 9736},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 512, col: 5, offset: 9710},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 512, col: 5, offset: 9710},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 512, col: 10, offset: 9715},
+												expr: &charClassMatcher{
+													pos:        position{line: 512, col: 10, offset: 9715},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 521, col: 5, offset: 9782},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 195, col: 37, offset: 4237},
+							val:        "then",
 							ignoreCase: false,
 						},
+						&notExpr{
+							pos: position{line: 195, col: 44, offset: 4244},
+							expr: &charClassMatcher{
+								pos:        position{line: 195, col: 45, offset: 4245},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3594,7 +4484,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3604,83 +4494,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 164, col: 13, offset: 3472},
-							label: "arg",
+							pos:   position{line: 195, col: 58, offset: 4258},
+							label: "consequent",
 							expr: &ruleRefExpr{
-								pos:  position{line: 164, col: 17, offset: 3476},
-								name: "Property",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Property",
-			pos:  position{line: 168, col: 1, offset: 3516},
-			expr: &actionExpr{
-				pos: position{line: 169, col: 5, offset: 3529},
-				run: (*parser).callonProperty1,
-				expr: &seqExpr{
-					pos: position{line: 169, col: 5, offset: 3529},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 169, col: 5, offset: 3529},
-							label: "key",
-							expr: &actionExpr{
-								pos: position{line: 460, col: 5, offset: 8638},
-								run: (*parser).callonProperty4,
-								expr: &seqExpr{
-									pos: position{line: 460, col: 5, offset: 8638},
-									exprs: []interface{}{
-										&charClassMatcher{
-											pos:        position{line: 460, col: 5, offset: 8638},
-											val:        "[_\\pL]",
-											chars:      []rune{'_'},
-											classes:    []*unicode.RangeTable{rangeTable("L")},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 460, col: 11, offset: 8644},
-											expr: &charClassMatcher{
-												pos:        position{line: 460, col: 11, offset: 8644},
-												val:        "[_0-9\\pL]",
-												chars:      []rune{'_'},
-												ranges:     []rune{'0', '9'},
-												classes:    []*unicode.RangeTable{rangeTable("L")},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
+								pos:  position{line: 195, col: 69, offset: 4269},
+								name: "Expr",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3688,7 +4532,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3698,34 +4542,46 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 169, col: 24, offset: 3548},
-							val:        ":",
+							pos:        position{line: 195, col: 77, offset: 4277},
+							val:        "else",
 							ignoreCase: false,
 						},
+						&notExpr{
+							pos: position{line: 195, col: 84, offset: 4284},
+							expr: &charClassMatcher{
+								pos:        position{line: 195, col: 85, offset: 4285},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3733,7 +4589,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3743,10 +4599,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 169, col: 31, offset: 3555},
-							label: "value",
+							pos:   position{line: 195, col: 98, offset: 4298},
+							label: "alternate",
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 37, offset: 3561},
+								pos:  position{line: 195, col: 108, offset: 4308},
 								name: "Expr",
 							},
 						},
@@ -3754,63 +4610,55 @@ var g = &grammar{
 				},
 			},
 		},
-		{
-			name: "Expr",
-			pos:  position{line: 180, col: 1, offset: 3811},
-			expr: &ruleRefExpr{
-				pos:  position{line: 181, col: 5, offset: 3820},
-				name: "LogicalExpression",
-			},
-		},
 		{
 			name: "LogicalExpression",
-			pos:  position{line: 188, col: 1, offset: 3921},
+			pos:  position{line: 204, col: 1, offset: 4483},
 			expr: &actionExpr{
-				pos: position{line: 189, col: 5, offset: 3943},
+				pos: position{line: 205, col: 5, offset: 4505},
 				run: (*parser).callonLogicalExpression1,
 				expr: &seqExpr{
-					pos: position{line: 189, col: 5, offset: 3943},
+					pos: position{line: 205, col: 5, offset: 4505},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 189, col: 5, offset: 3943},
+							pos:   position{line: 205, col: 5, offset: 4505},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 189, col: 10, offset: 3948},
+								pos:  position{line: 205, col: 10, offset: 4510},
 								name: "Equality",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 189, col: 19, offset: 3957},
+							pos:   position{line: 205, col: 19, offset: 4519},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 189, col: 24, offset: 3962},
+								pos: position{line: 205, col: 24, offset: 4524},
 								expr: &seqExpr{
-									pos: position{line: 189, col: 26, offset: 3964},
+									pos: position{line: 205, col: 26, offset: 4526},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -3818,7 +4666,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -3828,18 +4676,18 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 184, col: 5, offset: 3860},
+											pos: position{line: 200, col: 5, offset: 4422},
 											run: (*parser).callonLogicalExpression16,
 											expr: &choiceExpr{
-												pos: position{line: 184, col: 6, offset: 3861},
+												pos: position{line: 200, col: 6, offset: 4423},
 												alternatives: []interface{}{
 													&litMatcher{
-														pos:        position{line: 184, col: 6, offset: 3861},
+														pos:        position{line: 200, col: 6, offset: 4423},
 														val:        "or",
 														ignoreCase: true,
 													},
 													&litMatcher{
-														pos:        position{line: 184, col: 14, offset: 3869},
+														pos:        position{line: 200, col: 14, offset: 4431},
 														val:        "and",
 														ignoreCase: true,
 													},
@@ -3847,29 +4695,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -3877,7 +4725,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -3887,7 +4735,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 189, col: 51, offset: 3989},
+											pos:  position{line: 205, col: 51, offset: 4551},
 											name: "Equality",
 										},
 									},
@@ -3900,53 +4748,53 @@ var g = &grammar{
 		},
 		{
 			name: "Equality",
-			pos:  position{line: 198, col: 1, offset: 4157},
+			pos:  position{line: 214, col: 1, offset: 4719},
 			expr: &actionExpr{
-				pos: position{line: 199, col: 5, offset: 4170},
+				pos: position{line: 215, col: 5, offset: 4732},
 				run: (*parser).callonEquality1,
 				expr: &seqExpr{
-					pos: position{line: 199, col: 5, offset: 4170},
+					pos: position{line: 215, col: 5, offset: 4732},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 199, col: 5, offset: 4170},
+							pos:   position{line: 215, col: 5, offset: 4732},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 199, col: 10, offset: 4175},
+								pos:  position{line: 215, col: 10, offset: 4737},
 								name: "Relational",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 199, col: 21, offset: 4186},
+							pos:   position{line: 215, col: 21, offset: 4748},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 199, col: 26, offset: 4191},
+								pos: position{line: 215, col: 26, offset: 4753},
 								expr: &seqExpr{
-									pos: position{line: 199, col: 28, offset: 4193},
+									pos: position{line: 215, col: 28, offset: 4755},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -3954,7 +4802,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -3964,28 +4812,28 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 194, col: 5, offset: 4090},
+											pos: position{line: 210, col: 5, offset: 4652},
 											run: (*parser).callonEquality16,
 											expr: &choiceExpr{
-												pos: position{line: 194, col: 6, offset: 4091},
+												pos: position{line: 210, col: 6, offset: 4653},
 												alternatives: []interface{}{
 													&litMatcher{
-														pos:        position{line: 194, col: 6, offset: 4091},
+														pos:        position{line: 210, col: 6, offset: 4653},
 														val:        "==",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 194, col: 13, offset: 4098},
+														pos:        position{line: 210, col: 13, offset: 4660},
 														val:        "!=",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 194, col: 20, offset: 4105},
+														pos:        position{line: 210, col: 20, offset: 4667},
 														val:        "=~",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 194, col: 27, offset: 4112},
+														pos:        position{line: 210, col: 27, offset: 4674},
 														val:        "!~",
 														ignoreCase: false,
 													},
@@ -3993,29 +4841,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4023,7 +4871,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4033,7 +4881,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 199, col: 52, offset: 4217},
+											pos:  position{line: 215, col: 52, offset: 4779},
 											name: "Relational",
 										},
 									},
@@ -4046,53 +4894,53 @@ var g = &grammar{
 		},
 		{
 			name: "Relational",
-			pos:  position{line: 216, col: 1, offset: 4490},
+			pos:  position{line: 232, col: 1, offset: 5052},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 5, offset: 4505},
+				pos: position{line: 233, col: 5, offset: 5067},
 				run: (*parser).callonRelational1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 5, offset: 4505},
+					pos: position{line: 233, col: 5, offset: 5067},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 217, col: 5, offset: 4505},
+							pos:   position{line: 233, col: 5, offset: 5067},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 10, offset: 4510},
+								pos:  position{line: 233, col: 10, offset: 5072},
 								name: "Additive",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 19, offset: 4519},
+							pos:   position{line: 233, col: 19, offset: 5081},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 217, col: 24, offset: 4524},
+								pos: position{line: 233, col: 24, offset: 5086},
 								expr: &seqExpr{
-									pos: position{line: 217, col: 26, offset: 4526},
+									pos: position{line: 233, col: 26, offset: 5088},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4100,7 +4948,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4110,48 +4958,48 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 204, col: 5, offset: 4321},
+											pos: position{line: 220, col: 5, offset: 4883},
 											run: (*parser).callonRelational16,
 											expr: &choiceExpr{
-												pos: position{line: 204, col: 9, offset: 4325},
+												pos: position{line: 220, col: 9, offset: 4887},
 												alternatives: []interface{}{
 													&litMatcher{
-														pos:        position{line: 204, col: 9, offset: 4325},
+														pos:        position{line: 220, col: 9, offset: 4887},
 														val:        "<=",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 205, col: 9, offset: 4338},
+														pos:        position{line: 221, col: 9, offset: 4900},
 														val:        "<",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 206, col: 9, offset: 4350},
+														pos:        position{line: 222, col: 9, offset: 4912},
 														val:        ">=",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 207, col: 9, offset: 4363},
+														pos:        position{line: 223, col: 9, offset: 4925},
 														val:        ">",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 208, col: 9, offset: 4375},
+														pos:        position{line: 224, col: 9, offset: 4937},
 														val:        "startswith",
 														ignoreCase: true,
 													},
 													&litMatcher{
-														pos:        position{line: 209, col: 9, offset: 4397},
+														pos:        position{line: 225, col: 9, offset: 4959},
 														val:        "in",
 														ignoreCase: true,
 													},
 													&litMatcher{
-														pos:        position{line: 210, col: 9, offset: 4411},
+														pos:        position{line: 226, col: 9, offset: 4973},
 														val:        "not empty",
 														ignoreCase: true,
 													},
 													&litMatcher{
-														pos:        position{line: 211, col: 9, offset: 4432},
+														pos:        position{line: 227, col: 9, offset: 4994},
 														val:        "empty",
 														ignoreCase: true,
 													},
@@ -4159,29 +5007,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4189,7 +5037,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4199,7 +5047,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 217, col: 52, offset: 4552},
+											pos:  position{line: 233, col: 52, offset: 5114},
 											name: "Additive",
 										},
 									},
@@ -4212,53 +5060,53 @@ var g = &grammar{
 		},
 		{
 			name: "Additive",
-			pos:  position{line: 226, col: 1, offset: 4706},
+			pos:  position{line: 242, col: 1, offset: 5268},
 			expr: &actionExpr{
-				pos: position{line: 227, col: 5, offset: 4719},
+				pos: position{line: 243, col: 5, offset: 5281},
 				run: (*parser).callonAdditive1,
 				expr: &seqExpr{
-					pos: position{line: 227, col: 5, offset: 4719},
+					pos: position{line: 243, col: 5, offset: 5281},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 227, col: 5, offset: 4719},
+							pos:   position{line: 243, col: 5, offset: 5281},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 227, col: 10, offset: 4724},
+								pos:  position{line: 243, col: 10, offset: 5286},
 								name: "Multiplicative",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 227, col: 25, offset: 4739},
+							pos:   position{line: 243, col: 25, offset: 5301},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 227, col: 30, offset: 4744},
+								pos: position{line: 243, col: 30, offset: 5306},
 								expr: &seqExpr{
-									pos: position{line: 227, col: 32, offset: 4746},
+									pos: position{line: 243, col: 32, offset: 5308},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4266,7 +5114,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4276,10 +5124,10 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 222, col: 5, offset: 4651},
+											pos: position{line: 238, col: 5, offset: 5213},
 											run: (*parser).callonAdditive16,
 											expr: &charClassMatcher{
-												pos:        position{line: 222, col: 6, offset: 4652},
+												pos:        position{line: 238, col: 6, offset: 5214},
 												val:        "[+-]",
 												chars:      []rune{'+', '-'},
 												ignoreCase: false,
@@ -4287,29 +5135,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4317,7 +5165,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4327,7 +5175,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 227, col: 55, offset: 4769},
+											pos:  position{line: 243, col: 55, offset: 5331},
 											name: "Multiplicative",
 										},
 									},
@@ -4340,53 +5188,53 @@ var g = &grammar{
 		},
 		{
 			name: "Multiplicative",
-			pos:  position{line: 236, col: 1, offset: 4931},
+			pos:  position{line: 252, col: 1, offset: 5493},
 			expr: &actionExpr{
-				pos: position{line: 237, col: 5, offset: 4950},
+				pos: position{line: 253, col: 5, offset: 5512},
 				run: (*parser).callonMultiplicative1,
 				expr: &seqExpr{
-					pos: position{line: 237, col: 5, offset: 4950},
+					pos: position{line: 253, col: 5, offset: 5512},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 237, col: 5, offset: 4950},
+							pos:   position{line: 253, col: 5, offset: 5512},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 237, col: 10, offset: 4955},
+								pos:  position{line: 253, col: 10, offset: 5517},
 								name: "UnaryExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 237, col: 26, offset: 4971},
+							pos:   position{line: 253, col: 26, offset: 5533},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 237, col: 31, offset: 4976},
+								pos: position{line: 253, col: 31, offset: 5538},
 								expr: &seqExpr{
-									pos: position{line: 237, col: 33, offset: 4978},
+									pos: position{line: 253, col: 33, offset: 5540},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4394,7 +5242,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4404,10 +5252,10 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 232, col: 5, offset: 4880},
+											pos: position{line: 248, col: 5, offset: 5442},
 											run: (*parser).callonMultiplicative16,
 											expr: &charClassMatcher{
-												pos:        position{line: 232, col: 6, offset: 4881},
+												pos:        position{line: 248, col: 6, offset: 5443},
 												val:        "[*/]",
 												chars:      []rune{'*', '/'},
 												ignoreCase: false,
@@ -4415,29 +5263,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 509, col: 5, offset: 9673},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 509, col: 7, offset: 9675},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 515, col: 5, offset: 9736},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 512, col: 5, offset: 9710},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 512, col: 5, offset: 9710},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 512, col: 10, offset: 9715},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 512, col: 10, offset: 9715},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4445,7 +5293,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 521, col: 5, offset: 9782},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4455,7 +5303,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 237, col: 62, offset: 5007},
+											pos:  position{line: 253, col: 62, offset: 5569},
 											name: "UnaryExpression",
 										},
 									},
@@ -4468,40 +5316,40 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryExpression",
-			pos:  position{line: 246, col: 1, offset: 5163},
+			pos:  position{line: 262, col: 1, offset: 5758},
 			expr: &choiceExpr{
-				pos: position{line: 247, col: 5, offset: 5183},
+				pos: position{line: 263, col: 5, offset: 5778},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 247, col: 5, offset: 5183},
+						pos: position{line: 263, col: 5, offset: 5778},
 						run: (*parser).callonUnaryExpression2,
 						expr: &seqExpr{
-							pos: position{line: 247, col: 5, offset: 5183},
+							pos: position{line: 263, col: 5, offset: 5778},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4509,7 +5357,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4519,52 +5367,91 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 247, col: 8, offset: 5186},
+									pos:   position{line: 263, col: 8, offset: 5781},
 									label: "op",
 									expr: &actionExpr{
-										pos: position{line: 242, col: 5, offset: 5110},
+										pos: position{line: 258, col: 5, offset: 5672},
 										run: (*parser).callonUnaryExpression13,
 										expr: &choiceExpr{
-											pos: position{line: 242, col: 6, offset: 5111},
+											pos: position{line: 258, col: 6, offset: 5673},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 242, col: 6, offset: 5111},
+													pos:        position{line: 258, col: 6, offset: 5673},
 													val:        "-",
 													ignoreCase: false,
 												},
-												&litMatcher{
-													pos:        position{line: 242, col: 12, offset: 5117},
-													val:        "not",
-													ignoreCase: false,
+												&seqExpr{
+													pos: position{line: 258, col: 12, offset: 5679},
+													exprs: []interface{}{
+														&litMatcher{
+															pos:        position{line: 258, col: 12, offset: 5679},
+															val:        "not",
+															ignoreCase: false,
+														},
+														&notExpr{
+															pos: position{line: 258, col: 18, offset: 5685},
+															expr: &charClassMatcher{
+																pos:        position{line: 258, col: 19, offset: 5686},
+																val:        "[_0-9\\pL]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'0', '9'},
+																classes:    []*unicode.RangeTable{rangeTable("L")},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+												&seqExpr{
+													pos: position{line: 258, col: 31, offset: 5698},
+													exprs: []interface{}{
+														&litMatcher{
+															pos:        position{line: 258, col: 31, offset: 5698},
+															val:        "exists",
+															ignoreCase: false,
+														},
+														&notExpr{
+															pos: position{line: 258, col: 40, offset: 5707},
+															expr: &charClassMatcher{
+																pos:        position{line: 258, col: 41, offset: 5708},
+																val:        "[_0-9\\pL]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'0', '9'},
+																classes:    []*unicode.RangeTable{rangeTable("L")},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
 												},
 											},
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4572,7 +5459,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4582,37 +5469,37 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 247, col: 28, offset: 5206},
+									pos:   position{line: 263, col: 28, offset: 5801},
 									label: "argument",
 									expr: &ruleRefExpr{
-										pos:  position{line: 247, col: 37, offset: 5215},
-										name: "Primary",
+										pos:  position{line: 263, col: 37, offset: 5810},
+										name: "UnaryExpression",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4620,7 +5507,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4633,7 +5520,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 250, col: 5, offset: 5296},
+						pos:  position{line: 266, col: 5, offset: 5899},
 						name: "Primary",
 					},
 				},
@@ -4641,41 +5528,41 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 252, col: 1, offset: 5305},
+			pos:  position{line: 268, col: 1, offset: 5908},
 			expr: &choiceExpr{
-				pos: position{line: 253, col: 5, offset: 5317},
+				pos: position{line: 269, col: 5, offset: 5920},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 253, col: 5, offset: 5317},
+						pos:  position{line: 269, col: 5, offset: 5920},
 						name: "PipeExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 254, col: 5, offset: 5336},
+						pos:  position{line: 270, col: 5, offset: 5939},
 						name: "Array",
 					},
 					&actionExpr{
-						pos: position{line: 378, col: 5, offset: 7123},
+						pos: position{line: 414, col: 5, offset: 7979},
 						run: (*parser).callonPrimary4,
 						expr: &seqExpr{
-							pos: position{line: 378, col: 7, offset: 7125},
+							pos: position{line: 414, col: 7, offset: 7981},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 378, col: 7, offset: 7125},
+									pos:        position{line: 414, col: 7, offset: 7981},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 378, col: 11, offset: 7129},
+									pos: position{line: 414, col: 11, offset: 7985},
 									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
+										pos: position{line: 422, col: 5, offset: 8194},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
+												pos: position{line: 422, col: 5, offset: 8194},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
+														pos: position{line: 422, col: 5, offset: 8194},
 														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
+															pos:        position{line: 422, col: 8, offset: 8197},
 															val:        "[\"\\\\\\n]",
 															chars:      []rune{'"', '\\', '\n'},
 															ignoreCase: false,
@@ -4683,44 +5570,44 @@ var g = &grammar{
 														},
 													},
 													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
+														line: 507, col: 5, offset: 9664,
 													},
 												},
 											},
 											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
+												pos: position{line: 423, col: 5, offset: 8231},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
+														pos:        position{line: 423, col: 5, offset: 8231},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
+														pos: position{line: 426, col: 5, offset: 8279},
 														alternatives: []interface{}{
 															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
+																pos:        position{line: 426, col: 5, offset: 8279},
 																val:        "\"",
 																ignoreCase: false,
 															},
 															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
+																pos: position{line: 427, col: 5, offset: 8287},
 																run: (*parser).callonPrimary17,
 																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
+																	pos: position{line: 427, col: 7, offset: 8289},
 																	alternatives: []interface{}{
 																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
+																			line: 507, col: 5, offset: 9664,
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 521, col: 5, offset: 9782},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
 																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
+																			pos: position{line: 524, col: 5, offset: 9796},
 																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
+																				line: 524, col: 6, offset: 9797,
 																			},
 																		},
 																	},
@@ -4734,7 +5621,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 378, col: 29, offset: 7147},
+									pos:        position{line: 414, col: 29, offset: 8003},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -4742,28 +5629,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 381, col: 5, offset: 7207},
+						pos: position{line: 417, col: 5, offset: 8063},
 						run: (*parser).callonPrimary24,
 						expr: &seqExpr{
-							pos: position{line: 381, col: 7, offset: 7209},
+							pos: position{line: 417, col: 7, offset: 8065},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 381, col: 7, offset: 7209},
+									pos:        position{line: 417, col: 7, offset: 8065},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 381, col: 11, offset: 7213},
+									pos: position{line: 417, col: 11, offset: 8069},
 									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
+										pos: position{line: 422, col: 5, offset: 8194},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
+												pos: position{line: 422, col: 5, offset: 8194},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
+														pos: position{line: 422, col: 5, offset: 8194},
 														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
+															pos:        position{line: 422, col: 8, offset: 8197},
 															val:        "[\"\\\\\\n]",
 															chars:      []rune{'"', '\\', '\n'},
 															ignoreCase: false,
@@ -4771,44 +5658,44 @@ var g = &grammar{
 														},
 													},
 													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
+														line: 507, col: 5, offset: 9664,
 													},
 												},
 											},
 											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
+												pos: position{line: 423, col: 5, offset: 8231},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
+														pos:        position{line: 423, col: 5, offset: 8231},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
+														pos: position{line: 426, col: 5, offset: 8279},
 														alternatives: []interface{}{
 															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
+																pos:        position{line: 426, col: 5, offset: 8279},
 																val:        "\"",
 																ignoreCase: false,
 															},
 															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
+																pos: position{line: 427, col: 5, offset: 8287},
 																run: (*parser).callonPrimary37,
 																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
+																	pos: position{line: 427, col: 7, offset: 8289},
 																	alternatives: []interface{}{
 																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
+																			line: 507, col: 5, offset: 9664,
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 521, col: 5, offset: 9782},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
 																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
+																			pos: position{line: 524, col: 5, offset: 9796},
 																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
+																				line: 524, col: 6, offset: 9797,
 																			},
 																		},
 																	},
@@ -4822,17 +5709,17 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 381, col: 31, offset: 7233},
+									pos: position{line: 417, col: 31, offset: 8089},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 480, col: 5, offset: 8837},
+											pos:        position{line: 521, col: 5, offset: 9782},
 											val:        "\n",
 											ignoreCase: false,
 										},
 										&notExpr{
-											pos: position{line: 483, col: 5, offset: 8851},
+											pos: position{line: 524, col: 5, offset: 9796},
 											expr: &anyMatcher{
-												line: 483, col: 6, offset: 8852,
+												line: 524, col: 6, offset: 9797,
 											},
 										},
 									},
@@ -4841,35 +5728,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 426, col: 5, offset: 8041},
+						pos: position{line: 462, col: 5, offset: 8897},
 						run: (*parser).callonPrimary47,
 						expr: &seqExpr{
-							pos: position{line: 426, col: 5, offset: 8041},
+							pos: position{line: 462, col: 5, offset: 8897},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4877,7 +5764,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4887,34 +5774,34 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 426, col: 8, offset: 8044},
+									pos:        position{line: 462, col: 8, offset: 8900},
 									val:        "true",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4922,7 +5809,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4935,35 +5822,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 429, col: 5, offset: 8115},
+						pos: position{line: 465, col: 5, offset: 8971},
 						run: (*parser).callonPrimary66,
 						expr: &seqExpr{
-							pos: position{line: 429, col: 5, offset: 8115},
+							pos: position{line: 465, col: 5, offset: 8971},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4971,7 +5858,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4981,34 +5868,34 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 429, col: 8, offset: 8118},
+									pos:        position{line: 465, col: 8, offset: 8974},
 									val:        "false",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 509, col: 5, offset: 9673},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 509, col: 7, offset: 9675},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 515, col: 5, offset: 9736},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 512, col: 5, offset: 9710},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 512, col: 5, offset: 9710},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 512, col: 10, offset: 9715},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 512, col: 10, offset: 9715},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -5016,7 +5903,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 521, col: 5, offset: 9782},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -5029,40 +5916,146 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 397, col: 5, offset: 7543},
+						pos: position{line: 470, col: 5, offset: 9060},
 						run: (*parser).callonPrimary85,
 						expr: &seqExpr{
-							pos: position{line: 397, col: 5, offset: 7543},
+							pos: position{line: 470, col: 5, offset: 9060},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 509, col: 5, offset: 9673},
+									expr: &choiceExpr{
+										pos: position{line: 509, col: 7, offset: 9675},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 515, col: 5, offset: 9736},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 512, col: 5, offset: 9710},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 512, col: 5, offset: 9710},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 512, col: 10, offset: 9715},
+														expr: &charClassMatcher{
+															pos:        position{line: 512, col: 10, offset: 9715},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 521, col: 5, offset: 9782},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 470, col: 8, offset: 9063},
+									val:        "null",
+									ignoreCase: false,
+								},
+								&notExpr{
+									pos: position{line: 470, col: 15, offset: 9070},
+									expr: &charClassMatcher{
+										pos:        position{line: 470, col: 16, offset: 9071},
+										val:        "[_0-9\\pL]",
+										chars:      []rune{'_'},
+										ranges:     []rune{'0', '9'},
+										classes:    []*unicode.RangeTable{rangeTable("L")},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 509, col: 5, offset: 9673},
+									expr: &choiceExpr{
+										pos: position{line: 509, col: 7, offset: 9675},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 515, col: 5, offset: 9736},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 512, col: 5, offset: 9710},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 512, col: 5, offset: 9710},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 512, col: 10, offset: 9715},
+														expr: &charClassMatcher{
+															pos:        position{line: 512, col: 10, offset: 9715},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 521, col: 5, offset: 9782},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 433, col: 5, offset: 8399},
+						run: (*parser).callonPrimary106,
+						expr: &seqExpr{
+							pos: position{line: 433, col: 5, offset: 8399},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 397, col: 5, offset: 7543},
+									pos:        position{line: 433, col: 5, offset: 8399},
 									val:        "/",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 397, col: 9, offset: 7547},
+									pos:   position{line: 433, col: 9, offset: 8403},
 									label: "pattern",
 									expr: &actionExpr{
-										pos: position{line: 402, col: 5, offset: 7624},
-										run: (*parser).callonPrimary89,
+										pos: position{line: 438, col: 5, offset: 8480},
+										run: (*parser).callonPrimary110,
 										expr: &labeledExpr{
-											pos:   position{line: 402, col: 5, offset: 7624},
+											pos:   position{line: 438, col: 5, offset: 8480},
 											label: "chars",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 402, col: 11, offset: 7630},
+												pos: position{line: 438, col: 11, offset: 8486},
 												expr: &choiceExpr{
-													pos: position{line: 407, col: 5, offset: 7714},
+													pos: position{line: 443, col: 5, offset: 8570},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 407, col: 5, offset: 7714},
-															run: (*parser).callonPrimary93,
+															pos: position{line: 443, col: 5, offset: 8570},
+															run: (*parser).callonPrimary114,
 															expr: &seqExpr{
-																pos: position{line: 407, col: 5, offset: 7714},
+																pos: position{line: 443, col: 5, offset: 8570},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 407, col: 5, offset: 7714},
+																		pos: position{line: 443, col: 5, offset: 8570},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 407, col: 6, offset: 7715},
+																			pos:        position{line: 443, col: 6, offset: 8571},
 																			val:        "[\\\\/]",
 																			chars:      []rune{'\\', '/'},
 																			ignoreCase: false,
@@ -5070,18 +6063,18 @@ var g = &grammar{
 																		},
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 407, col: 12, offset: 7721},
+																		pos:   position{line: 443, col: 12, offset: 8577},
 																		label: "re",
 																		expr: &actionExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
-																			run: (*parser).callonPrimary98,
+																			pos: position{line: 457, col: 5, offset: 8817},
+																			run: (*parser).callonPrimary119,
 																			expr: &seqExpr{
-																				pos: position{line: 421, col: 5, offset: 7961},
+																				pos: position{line: 457, col: 5, offset: 8817},
 																				exprs: []interface{}{
 																					&notExpr{
-																						pos: position{line: 421, col: 5, offset: 7961},
+																						pos: position{line: 457, col: 5, offset: 8817},
 																						expr: &charClassMatcher{
-																							pos:        position{line: 477, col: 5, offset: 8821},
+																							pos:        position{line: 518, col: 5, offset: 9766},
 																							val:        "[\\n\\r]",
 																							chars:      []rune{'\n', '\r'},
 																							ignoreCase: false,
@@ -5089,7 +6082,7 @@ var g = &grammar{
 																						},
 																					},
 																					&anyMatcher{
-																						line: 466, col: 5, offset: 8719,
+																						line: 507, col: 5, offset: 9664,
 																					},
 																				},
 																			},
@@ -5099,35 +6092,35 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 413, col: 5, offset: 7830},
-															run: (*parser).callonPrimary103,
+															pos: position{line: 449, col: 5, offset: 8686},
+															run: (*parser).callonPrimary124,
 															expr: &litMatcher{
-																pos:        position{line: 413, col: 5, offset: 7830},
+																pos:        position{line: 449, col: 5, offset: 8686},
 																val:        "\\/",
 																ignoreCase: false,
 															},
 														},
 														&actionExpr{
-															pos: position{line: 416, col: 5, offset: 7878},
-															run: (*parser).callonPrimary105,
+															pos: position{line: 452, col: 5, offset: 8734},
+															run: (*parser).callonPrimary126,
 															expr: &seqExpr{
-																pos: position{line: 416, col: 5, offset: 7878},
+																pos: position{line: 452, col: 5, offset: 8734},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 416, col: 5, offset: 7878},
+																		pos:        position{line: 452, col: 5, offset: 8734},
 																		val:        "\\",
 																		ignoreCase: false,
 																	},
 																	&actionExpr{
-																		pos: position{line: 421, col: 5, offset: 7961},
-																		run: (*parser).callonPrimary108,
+																		pos: position{line: 457, col: 5, offset: 8817},
+																		run: (*parser).callonPrimary129,
 																		expr: &seqExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
+																			pos: position{line: 457, col: 5, offset: 8817},
 																			exprs: []interface{}{
 																				&notExpr{
-																					pos: position{line: 421, col: 5, offset: 7961},
+																					pos: position{line: 457, col: 5, offset: 8817},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 477, col: 5, offset: 8821},
+																						pos:        position{line: 518, col: 5, offset: 9766},
 																						val:        "[\\n\\r]",
 																						chars:      []rune{'\n', '\r'},
 																						ignoreCase: false,
@@ -5135,7 +6128,7 @@ var g = &grammar{
 																					},
 																				},
 																				&anyMatcher{
-																					line: 466, col: 5, offset: 8719,
+																					line: 507, col: 5, offset: 9664,
 																				},
 																			},
 																		},
@@ -5150,7 +6143,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 397, col: 28, offset: 7566},
+									pos:        position{line: 433, col: 28, offset: 8422},
 									val:        "/",
 									ignoreCase: false,
 								},
@@ -5158,44 +6151,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 453, col: 5, offset: 8452},
-						run: (*parser).callonPrimary114,
+						pos: position{line: 494, col: 5, offset: 9397},
+						run: (*parser).callonPrimary135,
 						expr: &litMatcher{
-							pos:        position{line: 453, col: 5, offset: 8452},
+							pos:        position{line: 494, col: 5, offset: 9397},
 							val:        "<-",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 373, col: 5, offset: 7036},
-						run: (*parser).callonPrimary116,
+						pos: position{line: 409, col: 5, offset: 7892},
+						run: (*parser).callonPrimary137,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 373, col: 5, offset: 7036},
+							pos: position{line: 409, col: 5, offset: 7892},
 							expr: &seqExpr{
-								pos: position{line: 370, col: 5, offset: 6993},
+								pos: position{line: 406, col: 5, offset: 7849},
 								exprs: []interface{}{
 									&choiceExpr{
-										pos: position{line: 439, col: 6, offset: 8288},
+										pos: position{line: 480, col: 6, offset: 9233},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 439, col: 6, offset: 8288},
+												pos:        position{line: 480, col: 6, offset: 9233},
 												val:        "0",
 												ignoreCase: false,
 											},
 											&seqExpr{
-												pos: position{line: 439, col: 12, offset: 8294},
+												pos: position{line: 480, col: 12, offset: 9239},
 												exprs: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 447, col: 5, offset: 8412},
+														pos:        position{line: 488, col: 5, offset: 9357},
 														val:        "[1-9]",
 														ranges:     []rune{'1', '9'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 439, col: 25, offset: 8307},
+														pos: position{line: 480, col: 25, offset: 9252},
 														expr: &charClassMatcher{
-															pos:        position{line: 450, col: 5, offset: 8429},
+															pos:        position{line: 491, col: 5, offset: 9374},
 															val:        "[0-9]",
 															ranges:     []rune{'0', '9'},
 															ignoreCase: false,
@@ -5207,37 +6200,42 @@ var g = &grammar{
 										},
 									},
 									&choiceExpr{
-										pos: position{line: 361, col: 9, offset: 6843},
+										pos: position{line: 393, col: 9, offset: 7627},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 342, col: 5, offset: 6676},
+												pos:        position{line: 362, col: 5, offset: 7383},
 												val:        "ns",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 6, offset: 6704},
+												pos:        position{line: 365, col: 6, offset: 7411},
 												val:        "us",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 13, offset: 6711},
+												pos:        position{line: 365, col: 13, offset: 7418},
 												val:        "µs",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 20, offset: 6719},
+												pos:        position{line: 365, col: 20, offset: 7426},
 												val:        "μs",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 348, col: 5, offset: 6748},
+												pos:        position{line: 368, col: 5, offset: 7455},
 												val:        "ms",
 												ignoreCase: false,
 											},
+											&litMatcher{
+												pos:        position{line: 386, col: 5, offset: 7574},
+												val:        "mo",
+												ignoreCase: false,
+											},
 											&charClassMatcher{
-												pos:        position{line: 351, col: 5, offset: 6770},
-												val:        "[smh]",
-												chars:      []rune{'s', 'm', 'h'},
+												pos:        position{line: 371, col: 5, offset: 7477},
+												val:        "[smhdwy]",
+												chars:      []rune{'s', 'm', 'h', 'd', 'w', 'y'},
 												ignoreCase: false,
 												inverted:   false,
 											},
@@ -5248,148 +6246,148 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 337, col: 5, offset: 6588},
-						run: (*parser).callonPrimary132,
+						pos: position{line: 357, col: 5, offset: 7295},
+						run: (*parser).callonPrimary154,
 						expr: &seqExpr{
-							pos: position{line: 337, col: 5, offset: 6588},
+							pos: position{line: 357, col: 5, offset: 7295},
 							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 331, col: 18, offset: 6503},
+									pos:        position{line: 351, col: 18, offset: 7210},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 331, col: 32, offset: 6517},
+									pos:        position{line: 351, col: 32, offset: 7224},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 337, col: 14, offset: 6597},
+									pos:        position{line: 357, col: 14, offset: 7304},
 									val:        "T",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 328, col: 14, offset: 6433},
+									pos:        position{line: 348, col: 14, offset: 7140},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 328, col: 29, offset: 6448},
+									pos:        position{line: 348, col: 29, offset: 7155},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 491, col: 5, offset: 9374},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 328, col: 44, offset: 6463},
+									pos: position{line: 348, col: 44, offset: 7170},
 									expr: &seqExpr{
-										pos: position{line: 319, col: 5, offset: 6303},
+										pos: position{line: 339, col: 5, offset: 7010},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 319, col: 5, offset: 6303},
+												pos:        position{line: 339, col: 5, offset: 7010},
 												val:        ".",
 												ignoreCase: false,
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 319, col: 9, offset: 6307},
+												pos: position{line: 339, col: 9, offset: 7014},
 												expr: &charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
@@ -5400,51 +6398,51 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 325, col: 6, offset: 6386},
+									pos: position{line: 345, col: 6, offset: 7093},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 325, col: 6, offset: 6386},
+											pos:        position{line: 345, col: 6, offset: 7093},
 											val:        "Z",
 											ignoreCase: false,
 										},
 										&seqExpr{
-											pos: position{line: 322, col: 5, offset: 6333},
+											pos: position{line: 342, col: 5, offset: 7040},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 322, col: 6, offset: 6334},
+													pos:        position{line: 342, col: 6, offset: 7041},
 													val:        "[+-]",
 													chars:      []rune{'+', '-'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 322, col: 26, offset: 6354},
+													pos:        position{line: 342, col: 26, offset: 7061},
 													val:        ":",
 													ignoreCase: false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 491, col: 5, offset: 9374},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
@@ -5458,33 +6456,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 434, col: 5, offset: 8206},
-						run: (*parser).callonPrimary167,
+						pos: position{line: 475, col: 5, offset: 9151},
+						run: (*parser).callonPrimary189,
 						expr: &seqExpr{
-							pos: position{line: 434, col: 5, offset: 8206},
+							pos: position{line: 475, col: 5, offset: 9151},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 439, col: 6, offset: 8288},
+									pos: position{line: 480, col: 6, offset: 9233},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 439, col: 6, offset: 8288},
+											pos:        position{line: 480, col: 6, offset: 9233},
 											val:        "0",
 											ignoreCase: false,
 										},
 										&seqExpr{
-											pos: position{line: 439, col: 12, offset: 8294},
+											pos: position{line: 480, col: 12, offset: 9239},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 447, col: 5, offset: 8412},
+													pos:        position{line: 488, col: 5, offset: 9357},
 													val:        "[1-9]",
 													ranges:     []rune{'1', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 439, col: 25, offset: 8307},
+													pos: position{line: 480, col: 25, offset: 9252},
 													expr: &charClassMatcher{
-														pos:        position{line: 450, col: 5, offset: 8429},
+														pos:        position{line: 491, col: 5, offset: 9374},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -5496,14 +6494,14 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 434, col: 13, offset: 8214},
+									pos:        position{line: 475, col: 13, offset: 9159},
 									val:        ".",
 									ignoreCase: false,
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 434, col: 17, offset: 8218},
+									pos: position{line: 475, col: 17, offset: 9163},
 									expr: &charClassMatcher{
-										pos:        position{line: 450, col: 5, offset: 8429},
+										pos:        position{line: 491, col: 5, offset: 9374},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -5514,30 +6512,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 442, col: 5, offset: 8335},
-						run: (*parser).callonPrimary178,
+						pos: position{line: 483, col: 5, offset: 9280},
+						run: (*parser).callonPrimary200,
 						expr: &choiceExpr{
-							pos: position{line: 439, col: 6, offset: 8288},
+							pos: position{line: 480, col: 6, offset: 9233},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 439, col: 6, offset: 8288},
+									pos:        position{line: 480, col: 6, offset: 9233},
 									val:        "0",
 									ignoreCase: false,
 								},
 								&seqExpr{
-									pos: position{line: 439, col: 12, offset: 8294},
+									pos: position{line: 480, col: 12, offset: 9239},
 									exprs: []interface{}{
 										&charClassMatcher{
-											pos:        position{line: 447, col: 5, offset: 8412},
+											pos:        position{line: 488, col: 5, offset: 9357},
 											val:        "[1-9]",
 											ranges:     []rune{'1', '9'},
 											ignoreCase: false,
 											inverted:   false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 439, col: 25, offset: 8307},
+											pos: position{line: 480, col: 25, offset: 9252},
 											expr: &charClassMatcher{
-												pos:        position{line: 450, col: 5, offset: 8429},
+												pos:        position{line: 491, col: 5, offset: 9374},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -5550,21 +6548,21 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 256, col: 5, offset: 5358},
+						pos:  position{line: 272, col: 5, offset: 5961},
 						name: "CallExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 257, col: 5, offset: 5377},
+						pos:  position{line: 273, col: 5, offset: 5980},
 						name: "MemberExpressions",
 					},
 					&actionExpr{
-						pos: position{line: 460, col: 5, offset: 8638},
-						run: (*parser).callonPrimary187,
+						pos: position{line: 501, col: 5, offset: 9583},
+						run: (*parser).callonPrimary209,
 						expr: &seqExpr{
-							pos: position{line: 460, col: 5, offset: 8638},
+							pos: position{line: 501, col: 5, offset: 9583},
 							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 460, col: 5, offset: 8638},
+									pos:        position{line: 501, col: 5, offset: 9583},
 									val:        "[_\\pL]",
 									chars:      []rune{'_'},
 									classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -5572,9 +6570,9 @@ var g = &grammar{
 									inverted:   false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 460, col: 11, offset: 8644},
+									pos: position{line: 501, col: 11, offset: 9589},
 									expr: &charClassMatcher{
-										pos:        position{line: 460, col: 11, offset: 8644},
+										pos:        position{line: 501, col: 11, offset: 9589},
 										val:        "[_0-9\\pL]",
 										chars:      []rune{'_'},
 										ranges:     []rune{'0', '9'},
@@ -5587,15 +6585,15 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 259, col: 5, offset: 5414},
+						pos:  position{line: 275, col: 5, offset: 6017},
 						name: "ObjectExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 260, col: 5, offset: 5435},
+						pos:  position{line: 276, col: 5, offset: 6038},
 						name: "ArrowFunctionExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 261, col: 5, offset: 5463},
+						pos:  position{line: 277, col: 5, offset: 6066},
 						name: "Parens",
 					},
 				},
@@ -5603,42 +6601,42 @@ var g = &grammar{
 		},
 		{
 			name: "Parens",
-			pos:  position{line: 273, col: 1, offset: 5628},
+			pos:  position{line: 290, col: 1, offset: 6247},
 			expr: &actionExpr{
-				pos: position{line: 274, col: 5, offset: 5639},
+				pos: position{line: 291, col: 5, offset: 6258},
 				run: (*parser).callonParens1,
 				expr: &seqExpr{
-					pos: position{line: 274, col: 5, offset: 5639},
+					pos: position{line: 291, col: 5, offset: 6258},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 274, col: 5, offset: 5639},
+							pos:        position{line: 291, col: 5, offset: 6258},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5646,7 +6644,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5656,37 +6654,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 274, col: 12, offset: 5646},
+							pos:   position{line: 291, col: 12, offset: 6265},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 274, col: 17, offset: 5651},
+								pos:  position{line: 291, col: 17, offset: 6270},
 								name: "Expr",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5694,7 +6692,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5704,7 +6702,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 274, col: 25, offset: 5659},
+							pos:        position{line: 291, col: 25, offset: 6278},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -5714,42 +6712,42 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 278, col: 1, offset: 5695},
+			pos:  position{line: 295, col: 1, offset: 6314},
 			expr: &actionExpr{
-				pos: position{line: 279, col: 5, offset: 5705},
+				pos: position{line: 296, col: 5, offset: 6324},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 279, col: 5, offset: 5705},
+					pos: position{line: 296, col: 5, offset: 6324},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 279, col: 5, offset: 5705},
+							pos:        position{line: 296, col: 5, offset: 6324},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5757,7 +6755,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5767,40 +6765,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 279, col: 12, offset: 5712},
+							pos:   position{line: 296, col: 12, offset: 6331},
 							label: "elements",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 279, col: 21, offset: 5721},
+								pos: position{line: 296, col: 21, offset: 6340},
 								expr: &ruleRefExpr{
-									pos:  position{line: 279, col: 21, offset: 5721},
+									pos:  position{line: 296, col: 21, offset: 6340},
 									name: "ArrayElements",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5808,7 +6806,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5818,7 +6816,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 279, col: 39, offset: 5739},
+							pos:        position{line: 296, col: 39, offset: 6358},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -5828,45 +6826,45 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayElements",
-			pos:  position{line: 283, col: 1, offset: 5779},
+			pos:  position{line: 303, col: 1, offset: 6486},
 			expr: &actionExpr{
-				pos: position{line: 284, col: 5, offset: 5797},
+				pos: position{line: 304, col: 5, offset: 6504},
 				run: (*parser).callonArrayElements1,
 				expr: &seqExpr{
-					pos: position{line: 284, col: 5, offset: 5797},
+					pos: position{line: 304, col: 5, offset: 6504},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 284, col: 5, offset: 5797},
+							pos:   position{line: 304, col: 5, offset: 6504},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 11, offset: 5803},
+								pos:  position{line: 304, col: 11, offset: 6510},
 								name: "Primary",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5874,7 +6872,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5884,12 +6882,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 284, col: 22, offset: 5814},
+							pos:   position{line: 304, col: 22, offset: 6521},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 284, col: 27, offset: 5819},
+								pos: position{line: 304, col: 27, offset: 6526},
 								expr: &ruleRefExpr{
-									pos:  position{line: 284, col: 27, offset: 5819},
+									pos:  position{line: 304, col: 27, offset: 6526},
 									name: "ArrayRest",
 								},
 							},
@@ -5900,42 +6898,42 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayRest",
-			pos:  position{line: 288, col: 1, offset: 5891},
+			pos:  position{line: 308, col: 1, offset: 6598},
 			expr: &actionExpr{
-				pos: position{line: 289, col: 5, offset: 5905},
+				pos: position{line: 309, col: 5, offset: 6612},
 				run: (*parser).callonArrayRest1,
 				expr: &seqExpr{
-					pos: position{line: 289, col: 5, offset: 5905},
+					pos: position{line: 309, col: 5, offset: 6612},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 289, col: 5, offset: 5905},
+							pos:        position{line: 309, col: 5, offset: 6612},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 509, col: 5, offset: 9673},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 509, col: 7, offset: 9675},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 515, col: 5, offset: 9736},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 512, col: 5, offset: 9710},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 512, col: 5, offset: 9710},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 512, col: 10, offset: 9715},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 512, col: 10, offset: 9715},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5943,7 +6941,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 521, col: 5, offset: 9782},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5953,10 +6951,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 289, col: 12, offset: 5912},
+							pos:   position{line: 309, col: 12, offset: 6619},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 289, col: 20, offset: 5920},
+								pos:  position{line: 309, col: 20, offset: 6627},
 								name: "Primary",
 							},
 						},
@@ -5978,15 +6976,103 @@ func (p *parser) callonStart1() (interface{}, error) {
 	return p.cur.onStart1(stack["program"])
 }
 
-func (c *current) onProgram1(body interface{}) (interface{}, error) {
-	return program(body, c.text, c.pos)
+func (c *current) onProgram12() (interface{}, error) {
+	return identifier(c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram12()
+}
+
+func (c *current) onProgram6(name interface{}) (interface{}, error) {
+	return pkgclause(name, c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram6(stack["name"])
+}
+
+func (c *current) onProgram36() (interface{}, error) {
+	return identifier(c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram36() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram36()
+}
+
+func (c *current) onProgram58() (interface{}, error) {
+	return nil, errors.New("invalid escape character")
+
+}
+
+func (p *parser) callonProgram58() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram58()
+}
+
+func (c *current) onProgram45() (interface{}, error) {
+	return stringLiteral(c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram45() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram45()
+}
+
+func (c *current) onProgram78() (interface{}, error) {
+	return nil, errors.New("invalid escape character")
+
+}
+
+func (p *parser) callonProgram78() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram78()
+}
+
+func (c *current) onProgram65() (interface{}, error) {
+	return "", errors.New("string literal not terminated")
+
+}
+
+func (p *parser) callonProgram65() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram65()
+}
+
+func (c *current) onProgram28(as, path interface{}) (interface{}, error) {
+	return importdecl(as, path, c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram28() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram28(stack["as"], stack["path"])
+}
+
+func (c *current) onProgram1(pkg, imports, body interface{}) (interface{}, error) {
+	return program(pkg, imports, body, c.text, c.pos)
 
 }
 
 func (p *parser) callonProgram1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onProgram1(stack["body"])
+	return p.cur.onProgram1(stack["pkg"], stack["imports"], stack["body"])
 }
 
 func (c *current) onSourceElements1(head, tail interface{}) (interface{}, error) {
@@ -6253,147 +7339,158 @@ func (p *parser) callonPipeExpressionHead65() (interface{}, error) {
 	return p.cur.onPipeExpressionHead65()
 }
 
-func (c *current) onPipeExpressionHead97() (interface{}, error) {
+func (c *current) onPipeExpressionHead84() (interface{}, error) {
+	return nullLiteral(c.text, c.pos)
+
+}
+
+func (p *parser) callonPipeExpressionHead84() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPipeExpressionHead84()
+}
+
+func (c *current) onPipeExpressionHead118() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead97() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead118() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead97()
+	return p.cur.onPipeExpressionHead118()
 }
 
-func (c *current) onPipeExpressionHead92(re interface{}) (interface{}, error) {
+func (c *current) onPipeExpressionHead113(re interface{}) (interface{}, error) {
 	return re, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead92() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead113() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead92(stack["re"])
+	return p.cur.onPipeExpressionHead113(stack["re"])
 }
 
-func (c *current) onPipeExpressionHead102() (interface{}, error) {
+func (c *current) onPipeExpressionHead123() (interface{}, error) {
 	return []byte{'/'}, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead102() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead123() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead102()
+	return p.cur.onPipeExpressionHead123()
 }
 
-func (c *current) onPipeExpressionHead107() (interface{}, error) {
+func (c *current) onPipeExpressionHead128() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead107() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead128() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead107()
+	return p.cur.onPipeExpressionHead128()
 }
 
-func (c *current) onPipeExpressionHead104() (interface{}, error) {
+func (c *current) onPipeExpressionHead125() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead104() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead125() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead104()
+	return p.cur.onPipeExpressionHead125()
 }
 
-func (c *current) onPipeExpressionHead88(chars interface{}) (interface{}, error) {
+func (c *current) onPipeExpressionHead109(chars interface{}) (interface{}, error) {
 	return regexLiteral(chars, c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead88() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead109() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead88(stack["chars"])
+	return p.cur.onPipeExpressionHead109(stack["chars"])
 }
 
-func (c *current) onPipeExpressionHead84(pattern interface{}) (interface{}, error) {
+func (c *current) onPipeExpressionHead105(pattern interface{}) (interface{}, error) {
 	return pattern, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead84() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead105() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead84(stack["pattern"])
+	return p.cur.onPipeExpressionHead105(stack["pattern"])
 }
 
-func (c *current) onPipeExpressionHead113() (interface{}, error) {
+func (c *current) onPipeExpressionHead134() (interface{}, error) {
 	return pipeLiteral(c.text, c.pos), nil
 
 }
 
-func (p *parser) callonPipeExpressionHead113() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead134() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead113()
+	return p.cur.onPipeExpressionHead134()
 }
 
-func (c *current) onPipeExpressionHead115() (interface{}, error) {
+func (c *current) onPipeExpressionHead136() (interface{}, error) {
 	return durationLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead115() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead136() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead115()
+	return p.cur.onPipeExpressionHead136()
 }
 
-func (c *current) onPipeExpressionHead131() (interface{}, error) {
+func (c *current) onPipeExpressionHead153() (interface{}, error) {
 	return datetime(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead131() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead153() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead131()
+	return p.cur.onPipeExpressionHead153()
 }
 
-func (c *current) onPipeExpressionHead166() (interface{}, error) {
+func (c *current) onPipeExpressionHead188() (interface{}, error) {
 	return numberLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead166() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead188() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead166()
+	return p.cur.onPipeExpressionHead188()
 }
 
-func (c *current) onPipeExpressionHead177() (interface{}, error) {
+func (c *current) onPipeExpressionHead199() (interface{}, error) {
 	return integerLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead177() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead199() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead177()
+	return p.cur.onPipeExpressionHead199()
 }
 
-func (c *current) onPipeExpressionHead186() (interface{}, error) {
+func (c *current) onPipeExpressionHead208() (interface{}, error) {
 	return identifier(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead186() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead208() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead186()
+	return p.cur.onPipeExpressionHead208()
 }
 
 func (c *current) onPipeExpressionPipe1(call interface{}) (interface{}, error) {
@@ -6572,6 +7669,17 @@ func (p *parser) callonProperty1() (interface{}, error) {
 	return p.cur.onProperty1(stack["key"], stack["value"])
 }
 
+func (c *current) onConditionalExpression1(test, consequent, alternate interface{}) (interface{}, error) {
+	return conditionalExpression(test, consequent, alternate, c.text, c.pos)
+
+}
+
+func (p *parser) callonConditionalExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConditionalExpression1(stack["test"], stack["consequent"], stack["alternate"])
+}
+
 func (c *current) onLogicalExpression16() (interface{}, error) {
 	return logicalOp(c.text)
 
@@ -6770,147 +7878,158 @@ func (p *parser) callonPrimary66() (interface{}, error) {
 	return p.cur.onPrimary66()
 }
 
-func (c *current) onPrimary98() (interface{}, error) {
+func (c *current) onPrimary85() (interface{}, error) {
+	return nullLiteral(c.text, c.pos)
+
+}
+
+func (p *parser) callonPrimary85() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary85()
+}
+
+func (c *current) onPrimary119() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPrimary98() (interface{}, error) {
+func (p *parser) callonPrimary119() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary98()
+	return p.cur.onPrimary119()
 }
 
-func (c *current) onPrimary93(re interface{}) (interface{}, error) {
+func (c *current) onPrimary114(re interface{}) (interface{}, error) {
 	return re, nil
 
 }
 
-func (p *parser) callonPrimary93() (interface{}, error) {
+func (p *parser) callonPrimary114() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary93(stack["re"])
+	return p.cur.onPrimary114(stack["re"])
 }
 
-func (c *current) onPrimary103() (interface{}, error) {
+func (c *current) onPrimary124() (interface{}, error) {
 	return []byte{'/'}, nil
 
 }
 
-func (p *parser) callonPrimary103() (interface{}, error) {
+func (p *parser) callonPrimary124() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary103()
+	return p.cur.onPrimary124()
 }
 
-func (c *current) onPrimary108() (interface{}, error) {
+func (c *current) onPrimary129() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPrimary108() (interface{}, error) {
+func (p *parser) callonPrimary129() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary108()
+	return p.cur.onPrimary129()
 }
 
-func (c *current) onPrimary105() (interface{}, error) {
+func (c *current) onPrimary126() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPrimary105() (interface{}, error) {
+func (p *parser) callonPrimary126() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary105()
+	return p.cur.onPrimary126()
 }
 
-func (c *current) onPrimary89(chars interface{}) (interface{}, error) {
+func (c *current) onPrimary110(chars interface{}) (interface{}, error) {
 	return regexLiteral(chars, c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary89() (interface{}, error) {
+func (p *parser) callonPrimary110() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary89(stack["chars"])
+	return p.cur.onPrimary110(stack["chars"])
 }
 
-func (c *current) onPrimary85(pattern interface{}) (interface{}, error) {
+func (c *current) onPrimary106(pattern interface{}) (interface{}, error) {
 	return pattern, nil
 
 }
 
-func (p *parser) callonPrimary85() (interface{}, error) {
+func (p *parser) callonPrimary106() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary85(stack["pattern"])
+	return p.cur.onPrimary106(stack["pattern"])
 }
 
-func (c *current) onPrimary114() (interface{}, error) {
+func (c *current) onPrimary135() (interface{}, error) {
 	return pipeLiteral(c.text, c.pos), nil
 
 }
 
-func (p *parser) callonPrimary114() (interface{}, error) {
+func (p *parser) callonPrimary135() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary114()
+	return p.cur.onPrimary135()
 }
 
-func (c *current) onPrimary116() (interface{}, error) {
+func (c *current) onPrimary137() (interface{}, error) {
 	return durationLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary116() (interface{}, error) {
+func (p *parser) callonPrimary137() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary116()
+	return p.cur.onPrimary137()
 }
 
-func (c *current) onPrimary132() (interface{}, error) {
+func (c *current) onPrimary154() (interface{}, error) {
 	return datetime(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary132() (interface{}, error) {
+func (p *parser) callonPrimary154() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary132()
+	return p.cur.onPrimary154()
 }
 
-func (c *current) onPrimary167() (interface{}, error) {
+func (c *current) onPrimary189() (interface{}, error) {
 	return numberLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary167() (interface{}, error) {
+func (p *parser) callonPrimary189() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary167()
+	return p.cur.onPrimary189()
 }
 
-func (c *current) onPrimary178() (interface{}, error) {
+func (c *current) onPrimary200() (interface{}, error) {
 	return integerLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary178() (interface{}, error) {
+func (p *parser) callonPrimary200() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary178()
+	return p.cur.onPrimary200()
 }
 
-func (c *current) onPrimary187() (interface{}, error) {
+func (c *current) onPrimary209() (interface{}, error) {
 	return identifier(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary187() (interface{}, error) {
+func (p *parser) callonPrimary209() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary187()
+	return p.cur.onPrimary209()
 }
 
 func (c *current) onParens1(expr interface{}) (interface{}, error) {
@@ -6925,6 +8044,9 @@ func (p *parser) callonParens1() (interface{}, error) {
 }
 
 func (c *current) onArray1(elements interface{}) (interface{}, error) {
+	if elements == nil {
+		return array(nil, nil, c.text, c.pos), nil
+	}
 	return elements, nil
 
 }
@@ -8,14 +8,11 @@ import (
 	"github.com/influxdata/ifql/ast"
 )
 
-// NewAST parses ifql query and produces an ast.Program
+// NewAST parses ifql query and produces an ast.Program.
+// Syntax errors are reported as a diagnostic.List, along with the statements that could be parsed.
 func NewAST(ifql string, opts ...Option) (*ast.Program, error) {
 	// Turn on Debugging
 	opts = append(opts, Debug(true))
 
-	f, err := Parse("", []byte(ifql), opts...)
-	if err != nil {
-		return nil, err
-	}
-	return f.(*ast.Program), nil
+	return parseProgram(ifql, opts...)
 }
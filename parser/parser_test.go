@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/ast/asttest"
+	"github.com/influxdata/ifql/diagnostic"
 	"github.com/influxdata/ifql/parser"
 )
 
@@ -64,6 +65,115 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "package clause",
+			raw: `package foo
+			from()`,
+			want: &ast.Program{
+				Package: &ast.PackageClause{
+					Name: &ast.Identifier{Name: "foo"},
+				},
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.CallExpression{
+							Callee: &ast.Identifier{
+								Name: "from",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "imports",
+			raw: `import "path/foo"
+			import bar "path/baz"
+			foo.a()`,
+			want: &ast.Program{
+				Imports: []*ast.ImportDeclaration{
+					{
+						Path: &ast.StringLiteral{Value: "path/foo"},
+					},
+					{
+						As:   &ast.Identifier{Name: "bar"},
+						Path: &ast.StringLiteral{Value: "path/baz"},
+					},
+				},
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.CallExpression{
+							Callee: &ast.MemberExpression{
+								Object:   &ast.Identifier{Name: "foo"},
+								Property: &ast.Identifier{Name: "a"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "package without body",
+			raw: `package foo
+			import "bar"`,
+			want: &ast.Program{
+				Package: &ast.PackageClause{
+					Name: &ast.Identifier{Name: "foo"},
+				},
+				Imports: []*ast.ImportDeclaration{
+					{
+						Path: &ast.StringLiteral{Value: "bar"},
+					},
+				},
+				Body: []ast.Statement{},
+			},
+		},
+		{
+			name: "variable named like a keyword",
+			raw:  `imports = 1`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "imports"},
+							Init: &ast.IntegerLiteral{Value: 1},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "empty array",
+			raw:  `a = []`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "a"},
+							Init: &ast.ArrayExpression{},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "empty array default parameter",
+			raw:  `f = (by=[]) => by`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID: &ast.Identifier{Name: "f"},
+							Init: &ast.ArrowFunctionExpression{
+								Params: []*ast.Property{
+									{Key: &ast.Identifier{Name: "by"}, Value: &ast.ArrayExpression{}},
+								},
+								Body: &ast.Identifier{Name: "by"},
+							},
+						}},
+					},
+				},
+			},
+		},
 		{
 			name: "regex literal",
 			raw:  `/.*/`,
@@ -141,6 +251,34 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "declare variable as a duration",
+			raw:  `howdy = 1h30m`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "howdy"},
+							Init: &ast.DurationLiteral{Value: 90 * time.Minute},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "declare variable as a calendar duration",
+			raw:  `howdy = 1y2mo1w3d12h`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "howdy"},
+							Init: &ast.DurationLiteral{Months: 14, Value: 10*24*time.Hour + 12*time.Hour},
+						}},
+					},
+				},
+			},
+		},
 		{
 			name: "declare variable as an array",
 			raw:  `howdy = [1, 2, 3, 4]`,
@@ -676,6 +814,175 @@ a = 5.0
 				},
 			},
 		},
+		{
+			name: "conditional expression",
+			raw:  `level = if x > 90 then "crit" else "ok"`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID: &ast.Identifier{Name: "level"},
+							Init: &ast.ConditionalExpression{
+								Test: &ast.BinaryExpression{
+									Operator: ast.GreaterThanOperator,
+									Left:     &ast.Identifier{Name: "x"},
+									Right:    &ast.IntegerLiteral{Value: 90},
+								},
+								Consequent: &ast.StringLiteral{Value: "crit"},
+								Alternate:  &ast.StringLiteral{Value: "ok"},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "nested conditional expression",
+			raw:  `if a then 1 else if b then 2 else 3`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.ConditionalExpression{
+							Test:       &ast.Identifier{Name: "a"},
+							Consequent: &ast.IntegerLiteral{Value: 1},
+							Alternate: &ast.ConditionalExpression{
+								Test:       &ast.Identifier{Name: "b"},
+								Consequent: &ast.IntegerLiteral{Value: 2},
+								Alternate:  &ast.IntegerLiteral{Value: 3},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "exists expression",
+			raw:  `exists r.host and r.host == "a"`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.LogicalExpression{
+							Operator: ast.AndOperator,
+							Left: &ast.UnaryExpression{
+								Operator: ast.ExistsOperator,
+								Argument: &ast.MemberExpression{
+									Object:   &ast.Identifier{Name: "r"},
+									Property: &ast.Identifier{Name: "host"},
+								},
+							},
+							Right: &ast.BinaryExpression{
+								Operator: ast.EqualOperator,
+								Left: &ast.MemberExpression{
+									Object:   &ast.Identifier{Name: "r"},
+									Property: &ast.Identifier{Name: "host"},
+								},
+								Right: &ast.StringLiteral{Value: "a"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "not exists expression",
+			raw:  `not exists r.region or - -1 < 0`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.LogicalExpression{
+							Operator: ast.OrOperator,
+							Left: &ast.UnaryExpression{
+								Operator: ast.NotOperator,
+								Argument: &ast.UnaryExpression{
+									Operator: ast.ExistsOperator,
+									Argument: &ast.MemberExpression{
+										Object:   &ast.Identifier{Name: "r"},
+										Property: &ast.Identifier{Name: "region"},
+									},
+								},
+							},
+							Right: &ast.BinaryExpression{
+								Operator: ast.LessThanOperator,
+								Left: &ast.UnaryExpression{
+									Operator: ast.SubtractionOperator,
+									Argument: &ast.UnaryExpression{
+										Operator: ast.SubtractionOperator,
+										Argument: &ast.IntegerLiteral{Value: 1},
+									},
+								},
+								Right: &ast.IntegerLiteral{Value: 0},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "identifiers starting with keywords",
+			raw:  `iffy = existing`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "iffy"},
+							Init: &ast.Identifier{Name: "existing"},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "identifiers starting with unary operators",
+			raw:  `existsCount + notes`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.BinaryExpression{
+							Operator: ast.AdditionOperator,
+							Left:     &ast.Identifier{Name: "existsCount"},
+							Right:    &ast.Identifier{Name: "notes"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "conditional expression with identifiers starting with keywords",
+			raw:  `if ifx then thenValue else elseValue`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.ConditionalExpression{
+							Test:       &ast.Identifier{Name: "ifx"},
+							Consequent: &ast.Identifier{Name: "thenValue"},
+							Alternate:  &ast.Identifier{Name: "elseValue"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "null literal",
+			raw:  `r._value == null or nullable`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.LogicalExpression{
+							Operator: ast.OrOperator,
+							Left: &ast.BinaryExpression{
+								Operator: ast.EqualOperator,
+								Left: &ast.MemberExpression{
+									Object:   &ast.Identifier{Name: "r"},
+									Property: &ast.Identifier{Name: "_value"},
+								},
+								Right: &ast.NullLiteral{},
+							},
+							Right: &ast.Identifier{Name: "nullable"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "mix unary logical and binary expressions with extra parens",
 			raw: `
@@ -1574,6 +1881,76 @@ join(tables:[a,b], on:["t1"], fn: (a,b) => (a["_field"] - b["_field"]) / b["_fie
 	}
 }
 
+func TestNewAST_Recovery(t *testing.T) {
+	loc := func(line, col, end int) *ast.SourceLocation {
+		return &ast.SourceLocation{
+			Start: ast.Position{Line: line, Column: col},
+			End:   ast.Position{Line: line, Column: end},
+		}
+	}
+	tests := []struct {
+		name string
+		raw  string
+		// want are the identifiers of the declarations that could be parsed.
+		want      []string
+		wantDiags diagnostic.List
+	}{
+		{
+			name: "several errors",
+			raw:  "a = 1\nb = (\nc = 3 +\nd = 4\n",
+			want: []string{"a", "d"},
+			wantDiags: diagnostic.List{
+				diagnostic.Errorf(diagnostic.SyntaxError, loc(2, 6, 7), "unexpected end of input"),
+				diagnostic.Errorf(diagnostic.SyntaxError, loc(3, 8, 9), "unexpected end of input"),
+			},
+		},
+		{
+			name: "multiline statement",
+			raw: `a = from(db:"telegraf")
+	|> range(start:-1h
+	|> filter(fn: (r) => r._value > 1)
+b = 1`,
+			want: []string{"b"},
+			wantDiags: diagnostic.List{
+				diagnostic.Errorf(diagnostic.SyntaxError, loc(3, 36, 37), "unexpected end of input"),
+			},
+		},
+		{
+			name: "incomplete statement before a valid statement",
+			raw:  "a = 1\nb = 1 +\nc = from(db:\"y\")\n",
+			want: []string{"a", "c"},
+			wantDiags: diagnostic.List{
+				diagnostic.Errorf(diagnostic.SyntaxError, loc(2, 8, 9), "unexpected end of input"),
+			},
+		},
+		{
+			name: "gibberish",
+			raw:  "a = 1 &^*&H#IUJBN\n// comment\nb = 2",
+			want: []string{"b"},
+			wantDiags: diagnostic.List{
+				diagnostic.Errorf(diagnostic.SyntaxError, loc(1, 7, 8), `unexpected "&"`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			program, err := parser.NewAST(tt.raw)
+			if !cmp.Equal(tt.wantDiags, err) {
+				t.Errorf("unexpected diagnostics -want/+got %s", cmp.Diff(tt.wantDiags, err))
+			}
+			var got []string
+			for _, s := range program.Body {
+				got = append(got, s.(*ast.VariableDeclaration).Declarations[0].ID.Name)
+			}
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("unexpected statements -want/+got %s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
 var benchmarkQuery = []byte(`
 start = -10s
 
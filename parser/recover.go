@@ -0,0 +1,175 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/diagnostic"
+)
+
+// parseProgram parses an IFQL program, recovering from syntax errors.
+// When a statement cannot be parsed it is skipped and parsing resumes at the next statement,
+// so that the returned error is a diagnostic.List reporting every syntax error in the program.
+// The returned program contains the statements that could be parsed, even when there are errors.
+//
+// A statement starts on a line that begins with an identifier in the first column,
+// which is how IFQL scripts are written.
+func parseProgram(ifql string, opts ...Option) (*ast.Program, error) {
+	f, err := Parse("", []byte(ifql), opts...)
+	if err == nil {
+		return f.(*ast.Program), nil
+	}
+	r := &recovery{
+		lines: strings.SplitAfter(ifql, "\n"),
+		opts:  opts,
+	}
+	body := r.parse(0, len(r.lines))
+	if body == nil {
+		body = []ast.Statement{}
+	}
+	return &ast.Program{
+		BaseNode: base([]byte(ifql), position{line: 1, col: 1}),
+		Body:     body,
+	}, r.diags.Err()
+}
+
+type recovery struct {
+	lines []string
+	opts  []Option
+	diags diagnostic.List
+}
+
+// parse parses the lines [from, to) and returns their statements.
+// Errors are added to the diagnostics in source order.
+func (r *recovery) parse(from, to int) []ast.Statement {
+	if r.blank(from, to) {
+		return nil
+	}
+	// Pad the source with the preceding lines so that positions are relative to the whole program.
+	src := []byte(strings.Repeat("\n", from) + strings.Join(r.lines[from:to], ""))
+	f, err := Parse("", src, r.opts...)
+	if err == nil {
+		return f.(*ast.Program).Body
+	}
+	pe := firstError(err)
+	if pe == nil {
+		r.diags.Add(err, diagnostic.SyntaxError)
+		return nil
+	}
+
+	line := pe.pos.line - 1
+	if line < from {
+		line = from
+	}
+	if line >= to {
+		line = to - 1
+	}
+	start := from
+	for i := line; i > from; i-- {
+		if r.startsStatement(i) {
+			start = i
+			break
+		}
+	}
+	next := to
+	for i := line + 1; i < to; i++ {
+		if r.startsStatement(i) {
+			next = i
+			break
+		}
+	}
+
+	n := len(r.diags)
+	body := r.parse(from, start)
+	if len(r.diags) > n {
+		// The statement may only have failed because the previous one is incomplete and continues into it,
+		// the previous error has been reported, so parse the statement again on its own.
+		return append(body, r.parse(start, to)...)
+	}
+	r.diags = append(r.diags, r.syntaxError(pe, src, to))
+	return append(body, r.parse(next, to)...)
+}
+
+// blank reports whether the lines [from, to) contain only whitespace and comments.
+func (r *recovery) blank(from, to int) bool {
+	for _, l := range r.lines[from:to] {
+		l = strings.TrimSpace(l)
+		if l != "" && !strings.HasPrefix(l, "//") {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *recovery) startsStatement(i int) bool {
+	c, _ := utf8.DecodeRuneInString(r.lines[i])
+	return c == '_' || unicode.IsLetter(c)
+}
+
+func firstError(err error) *parserError {
+	switch e := err.(type) {
+	case *parserError:
+		return e
+	case errList:
+		for _, err := range e {
+			if pe := firstError(err); pe != nil {
+				return pe
+			}
+		}
+	}
+	return nil
+}
+
+// syntaxError creates a diagnostic from a parser error of the lines before to.
+// The generic errors of the generated parser, listing every expected rule, are replaced with the unexpected token.
+func (r *recovery) syntaxError(pe *parserError, src []byte, to int) *diagnostic.Diagnostic {
+	msg := pe.Inner.Error()
+	pos := ast.Position{Line: pe.pos.line, Column: pe.pos.col}
+	n := 1
+	if strings.HasPrefix(msg, "no match found") {
+		if tok := token(src[pe.pos.offset:]); tok != "" {
+			msg = fmt.Sprintf("unexpected %q", tok)
+			n = utf8.RuneCountInString(tok)
+		} else {
+			// Report the end of input right after the last line of code, not on the following line.
+			msg = "unexpected end of input"
+			for i := to - 1; i >= 0; i-- {
+				if l := strings.TrimRightFunc(r.lines[i], unicode.IsSpace); !r.blank(i, i+1) {
+					pos = ast.Position{Line: i + 1, Column: utf8.RuneCountInString(l) + 1}
+					break
+				}
+			}
+		}
+	}
+	return diagnostic.Errorf(diagnostic.SyntaxError, &ast.SourceLocation{
+		Start: pos,
+		End:   ast.Position{Line: pos.Line, Column: pos.Column + n},
+	}, "%s", msg)
+}
+
+// token returns the word or symbol at the start of src.
+func token(src []byte) string {
+	if len(src) == 0 {
+		return ""
+	}
+	c, size := utf8.DecodeRune(src)
+	if !isWordRune(c) {
+		return string(c)
+	}
+	end := size
+	for end < len(src) {
+		c, size := utf8.DecodeRune(src[end:])
+		if !isWordRune(c) {
+			break
+		}
+		end += size
+	}
+	return string(src[:end])
+}
+
+func isWordRune(c rune) bool {
+	return c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
@@ -16,13 +16,41 @@ func toIfaceSlice(v interface{}) []interface{} {
 	return v.([]interface{})
 }
 
-func program(body interface{}, text []byte, pos position) (*ast.Program, error) {
-	return &ast.Program{
-		Body:     body.([]ast.Statement),
+func program(pkg, imports, body interface{}, text []byte, pos position) (*ast.Program, error) {
+	p := &ast.Program{
+		Body:     []ast.Statement{},
+		BaseNode: base(text, pos),
+	}
+	if pkg != nil {
+		p.Package = toIfaceSlice(pkg)[0].(*ast.PackageClause) // Skip whitespace
+	}
+	for _, imp := range toIfaceSlice(imports) {
+		p.Imports = append(p.Imports, toIfaceSlice(imp)[0].(*ast.ImportDeclaration))
+	}
+	if body != nil {
+		p.Body = body.([]ast.Statement)
+	}
+	return p, nil
+}
+
+func pkgclause(name interface{}, text []byte, pos position) (*ast.PackageClause, error) {
+	return &ast.PackageClause{
+		Name:     name.(*ast.Identifier),
 		BaseNode: base(text, pos),
 	}, nil
 }
 
+func importdecl(as, path interface{}, text []byte, pos position) (*ast.ImportDeclaration, error) {
+	d := &ast.ImportDeclaration{
+		Path:     path.(*ast.StringLiteral),
+		BaseNode: base(text, pos),
+	}
+	if as != nil {
+		d.As = toIfaceSlice(as)[0].(*ast.Identifier)
+	}
+	return d, nil
+}
+
 func srcElems(head, tails interface{}) ([]ast.Statement, error) {
 	elems := []ast.Statement{head.(ast.Statement)}
 	for _, tail := range toIfaceSlice(tails) {
@@ -115,7 +143,7 @@ func memberexpr(object, property interface{}, text []byte, pos position) (*ast.M
 	}
 
 	if property != nil {
-		m.Property = property.(*ast.Identifier)
+		m.Property = property.(ast.Expression)
 	}
 
 	return m, nil
@@ -253,6 +281,15 @@ func unaryExpression(op, argument interface{}, text []byte, pos position) (*ast.
 	}, nil
 }
 
+func conditionalExpression(test, consequent, alternate interface{}, text []byte, pos position) (*ast.ConditionalExpression, error) {
+	return &ast.ConditionalExpression{
+		Test:       test.(ast.Expression),
+		Consequent: consequent.(ast.Expression),
+		Alternate:  alternate.(ast.Expression),
+		BaseNode:   base(text, pos),
+	}, nil
+}
+
 func operator(text []byte) (ast.OperatorKind, error) {
 	return ast.OperatorLookup(strings.ToLower(string(text))), nil
 }
@@ -281,6 +318,12 @@ func booleanLiteral(b bool, text []byte, pos position) (*ast.BooleanLiteral, err
 	}, nil
 }
 
+func nullLiteral(text []byte, pos position) (*ast.NullLiteral, error) {
+	return &ast.NullLiteral{
+		BaseNode: base(text, pos),
+	}, nil
+}
+
 func integerLiteral(text []byte, pos position) (*ast.IntegerLiteral, error) {
 	n, err := strconv.ParseInt(string(text), 10, 64)
 	if err != nil {
@@ -319,15 +362,47 @@ func regexLiteral(chars interface{}, text []byte, pos position) (*ast.RegexpLite
 	}, nil
 }
 
+// durationLiteral sums the magnitudes of the units of a duration, such as 1mo2d3h.
+// Months and years are counted in months, the other units have fixed lengths.
 func durationLiteral(text []byte, pos position) (*ast.DurationLiteral, error) {
-	d, err := time.ParseDuration(string(text))
-	if err != nil {
-		return nil, err
-	}
-	return &ast.DurationLiteral{
+	lit := &ast.DurationLiteral{
 		BaseNode: base(text, pos),
-		Value:    d,
-	}, nil
+	}
+	s := string(text)
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		j := i + strings.IndexFunc(s[i:], func(r rune) bool { return r >= '0' && r <= '9' })
+		if j < i {
+			j = len(s)
+		}
+		magnitude, unit := s[:i], s[i:j]
+		s = s[j:]
+
+		n, err := strconv.ParseInt(magnitude, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		var d time.Duration
+		switch unit {
+		case "y":
+			lit.Months += n * 12
+			continue
+		case "mo":
+			lit.Months += n
+			continue
+		case "w":
+			d = time.Duration(n) * 7 * 24 * time.Hour
+		case "d":
+			d = time.Duration(n) * 24 * time.Hour
+		default:
+			d, err = time.ParseDuration(magnitude + unit)
+			if err != nil {
+				return nil, err
+			}
+		}
+		lit.Value += d
+	}
+	return lit, nil
 }
 
 func datetime(text []byte, pos position) (*ast.DateTimeLiteral, error) {
@@ -342,16 +417,26 @@ func datetime(text []byte, pos position) (*ast.DateTimeLiteral, error) {
 }
 
 func base(text []byte, pos position) *ast.BaseNode {
+	end := ast.Position{
+		Line:   pos.line,
+		Column: pos.col,
+	}
+	// Columns count runes, like the positions of the parser.
+	for _, c := range string(text) {
+		if c == '\n' {
+			end.Line++
+			end.Column = 1
+			continue
+		}
+		end.Column++
+	}
 	return &ast.BaseNode{
 		Loc: &ast.SourceLocation{
 			Start: ast.Position{
 				Line:   pos.line,
 				Column: pos.col,
 			},
-			End: ast.Position{
-				Line:   pos.line,
-				Column: pos.col + len(text),
-			},
+			End:    end,
 			Source: source(text),
 		},
 	}
@@ -0,0 +1,519 @@
+// Package api serves the Prometheus HTTP query API from PromQL queries run by a controller.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/ifql/promql"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/control"
+	"github.com/influxdata/ifql/query/execute"
+)
+
+const (
+	// maxPoints is the most steps a range query may evaluate, the same as the Prometheus limit.
+	maxPoints = 11000
+	// defaultSeriesRange is how far back the series and labels endpoints look when no start is given.
+	defaultSeriesRange = time.Hour
+)
+
+// Controller runs the queries of the API.
+type Controller interface {
+	Query(ctx context.Context, qSpec *query.Spec) (*control.Query, error)
+}
+
+// Handler serves the Prometheus HTTP API, with responses in the same JSON shape as Prometheus.
+//
+//	GET|POST <prefix>/query         evaluate an instant query
+//	GET|POST <prefix>/query_range   evaluate a query over a range of time
+//	GET|POST <prefix>/series        list the series that match selectors
+//	GET|POST <prefix>/labels        list the label names
+//
+// The metric name of a series is read from its _metric tag,
+// and its other tags that start with an underscore are not labels.
+type Handler struct {
+	c      Controller
+	prefix string
+}
+
+// NewHandler creates a handler for the API, served at the path prefix.
+func NewHandler(c Controller, prefix string) *Handler {
+	return &Handler{
+		c:      c,
+		prefix: strings.TrimSuffix(prefix, "/"),
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.HasPrefix(req.URL.Path, h.prefix) {
+		http.NotFound(w, req)
+		return
+	}
+	if req.Method != http.MethodGet && req.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, errorBadData, errors.New("method not allowed"))
+		return
+	}
+	switch strings.Trim(strings.TrimPrefix(req.URL.Path, h.prefix), "/") {
+	case "query":
+		h.handleQuery(w, req)
+	case "query_range":
+		h.handleQueryRange(w, req)
+	case "series":
+		h.handleSeries(w, req)
+	case "labels":
+		h.handleLabels(w, req)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (h *Handler) handleQuery(w http.ResponseWriter, req *http.Request) {
+	t := time.Now()
+	if v := req.FormValue("time"); v != "" {
+		var err error
+		t, err = parseTime(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errorBadData, fmt.Errorf("invalid time: %v", err))
+			return
+		}
+	}
+	expr := req.FormValue("query")
+	spec, err := promql.BuildAt(expr, t)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	var d data
+	if err := h.run(req.Context(), spec, func(results map[string]execute.Result) (err error) {
+		if isRangeVector(expr) {
+			// A range vector is returned as all of its samples.
+			d.ResultType = "matrix"
+			d.Result, err = matrix(results, func(time.Time) bool { return true })
+			return err
+		}
+		d.ResultType = "vector"
+		d.Result, err = vector(results, t)
+		return err
+	}); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, errorExecution, err)
+		return
+	}
+	writeSuccess(w, d)
+}
+
+func (h *Handler) handleQueryRange(w http.ResponseWriter, req *http.Request) {
+	start, err := parseTime(req.FormValue("start"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorBadData, fmt.Errorf("invalid start: %v", err))
+		return
+	}
+	end, err := parseTime(req.FormValue("end"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorBadData, fmt.Errorf("invalid end: %v", err))
+		return
+	}
+	step, err := parseDuration(req.FormValue("step"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorBadData, fmt.Errorf("invalid step: %v", err))
+		return
+	}
+	if step <= 0 {
+		writeError(w, http.StatusBadRequest, errorBadData, errors.New("zero or negative query resolution step widths are not accepted"))
+		return
+	}
+	if end.Sub(start)/step > maxPoints {
+		writeError(w, http.StatusBadRequest, errorBadData, fmt.Errorf("exceeded maximum resolution of %d points per timeseries", maxPoints))
+		return
+	}
+	expr := req.FormValue("query")
+	if isRangeVector(expr) {
+		writeError(w, http.StatusBadRequest, errorBadData, errors.New("invalid expression type range vector for range query, must be an instant vector"))
+		return
+	}
+	spec, err := promql.BuildRange(expr, start, end, step)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	// The rows of the windows that stop at a step are the result of evaluating the query at that step.
+	isStep := func(t time.Time) bool {
+		return !t.Before(start) && !t.After(end) && t.Sub(start)%step == 0
+	}
+	d := data{ResultType: "matrix"}
+	if err := h.run(req.Context(), spec, func(results map[string]execute.Result) (err error) {
+		d.Result, err = matrix(results, isStep)
+		return err
+	}); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, errorExecution, err)
+		return
+	}
+	writeSuccess(w, d)
+}
+
+func (h *Handler) handleSeries(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	matches := req.Form["match[]"]
+	if len(matches) == 0 {
+		writeError(w, http.StatusBadRequest, errorBadData, errors.New("no match[] parameter provided"))
+		return
+	}
+	all, err := h.series(w, req, matches)
+	if err != nil {
+		return
+	}
+	result := make([]map[string]string, 0, len(all))
+	for _, s := range all {
+		result = append(result, s.metric)
+	}
+	writeSuccess(w, result)
+}
+
+func (h *Handler) handleLabels(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	matches := req.Form["match[]"]
+	if len(matches) == 0 {
+		// An empty selector matches all series.
+		matches = []string{""}
+	}
+	all, err := h.series(w, req, matches)
+	if err != nil {
+		return
+	}
+	names := make(map[string]bool)
+	for _, s := range all {
+		for name := range s.metric {
+			names[name] = true
+		}
+	}
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	writeSuccess(w, result)
+}
+
+// series finds the distinct series that match any of the selectors, writing the error response if it fails.
+func (h *Handler) series(w http.ResponseWriter, req *http.Request, matches []string) ([]*series, error) {
+	end := time.Now()
+	if v := req.FormValue("end"); v != "" {
+		t, err := parseTime(v)
+		if err != nil {
+			err = fmt.Errorf("invalid end: %v", err)
+			writeError(w, http.StatusBadRequest, errorBadData, err)
+			return nil, err
+		}
+		end = t
+	}
+	start := end.Add(-defaultSeriesRange)
+	if v := req.FormValue("start"); v != "" {
+		t, err := parseTime(v)
+		if err != nil {
+			err = fmt.Errorf("invalid start: %v", err)
+			writeError(w, http.StatusBadRequest, errorBadData, err)
+			return nil, err
+		}
+		start = t
+	}
+
+	found := make(map[string]*series)
+	for _, m := range matches {
+		spec, err := promql.BuildSeries(m, start, end)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errorBadData, err)
+			return nil, err
+		}
+		if err := h.run(req.Context(), spec, func(results map[string]execute.Result) error {
+			return eachBlock(results, func(b execute.Block) {
+				// Only the tags matter, the samples of the block are read to release it.
+				b.Times().DoTime(func([]execute.Time, execute.RowReader) {})
+				metric := labels(b.Tags())
+				key := metricKey(metric)
+				if found[key] == nil {
+					found[key] = &series{metric: metric}
+				}
+			})
+		}); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, errorExecution, err)
+			return nil, err
+		}
+	}
+	return sortSeries(found), nil
+}
+
+// run executes the query and passes its results to f, which must read all of them before it returns.
+func (h *Handler) run(ctx context.Context, spec *query.Spec, f func(results map[string]execute.Result) error) error {
+	q, err := h.c.Query(ctx, spec)
+	if err != nil {
+		return err
+	}
+	defer q.Done()
+	results, ok := <-q.Ready
+	if !ok {
+		return q.Err()
+	}
+	return f(results)
+}
+
+// isRangeVector reports whether the expression is a range vector selector.
+func isRangeVector(expr string) bool {
+	parsed, err := promql.ParsePromQL(expr)
+	if err != nil {
+		return false
+	}
+	sel, ok := parsed.(*promql.Selector)
+	return ok && sel.Range > 0
+}
+
+func eachBlock(results map[string]execute.Result, f func(b execute.Block)) error {
+	for _, r := range results {
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			f(b)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// vector is the result of an instant query, with the latest sample of each series at time t.
+func vector(results map[string]execute.Result, t time.Time) ([]sample, error) {
+	samples := []sample{}
+	if err := eachBlock(results, func(b execute.Block) {
+		metric := labels(b.Tags())
+		var v float64
+		var ok bool
+		b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+			for i := range ts {
+				// The latest sample is the last row with a value.
+				if rv, rok := value(rr, i); rok {
+					v, ok = rv, true
+				}
+			}
+		})
+		if ok {
+			samples = append(samples, sample{
+				Metric: metric,
+				Value:  point{t: t, v: v},
+			})
+		}
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return metricKey(samples[i].Metric) < metricKey(samples[j].Metric)
+	})
+	return samples, nil
+}
+
+// matrix is the samples of each series, from the rows whose times are included.
+func matrix(results map[string]execute.Result, include func(t time.Time) bool) ([]*series, error) {
+	found := make(map[string]*series)
+	if err := eachBlock(results, func(b execute.Block) {
+		metric := labels(b.Tags())
+		key := metricKey(metric)
+		b.Times().DoTime(func(ts []execute.Time, rr execute.RowReader) {
+			for i, t := range ts {
+				v, ok := value(rr, i)
+				if !ok || !include(t.Time()) {
+					continue
+				}
+				s := found[key]
+				if s == nil {
+					s = &series{metric: metric}
+					found[key] = s
+				}
+				s.Values = append(s.Values, point{t: t.Time(), v: v})
+			}
+		})
+	}); err != nil {
+		return nil, err
+	}
+	all := sortSeries(found)
+	for _, s := range all {
+		sort.Slice(s.Values, func(i, j int) bool {
+			return s.Values[i].t.Before(s.Values[j].t)
+		})
+	}
+	return all, nil
+}
+
+// labels converts the tags of a block to the labels of a series.
+func labels(tags execute.Tags) map[string]string {
+	metric := make(map[string]string, len(tags))
+	for k, v := range tags {
+		switch {
+		case k == "_metric":
+			metric["__name__"] = v
+		case strings.HasPrefix(k, "_"):
+		default:
+			metric[k] = v
+		}
+	}
+	return metric
+}
+
+func metricKey(metric map[string]string) string {
+	names := make([]string, 0, len(metric))
+	for k := range metric {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var key strings.Builder
+	for _, k := range names {
+		fmt.Fprintf(&key, "%s=%q,", k, metric[k])
+	}
+	return key.String()
+}
+
+// value reads the value of row i as a float, which is the only type of sample in Prometheus.
+// A null value has no sample.
+func value(rr execute.RowReader, i int) (float64, bool) {
+	cols := rr.Cols()
+	j := execute.ValueIdx(cols)
+	if j < 0 || rr.IsNull(i, j) {
+		return 0, false
+	}
+	switch cols[j].Type {
+	case execute.TFloat:
+		return rr.AtFloat(i, j), true
+	case execute.TInt:
+		return float64(rr.AtInt(i, j)), true
+	case execute.TUInt:
+		return float64(rr.AtUInt(i, j)), true
+	case execute.TBool:
+		if rr.AtBool(i, j) {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// parseTime parses a time as either a Unix timestamp in seconds or RFC3339.
+func parseTime(s string) (time.Time, error) {
+	if t, err := strconv.ParseFloat(s, 64); err == nil {
+		sec, frac := math.Modf(t)
+		return time.Unix(int64(sec), int64(math.Round(frac*float64(time.Second)))).UTC(), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("cannot parse %q to a valid timestamp", s)
+}
+
+// parseDuration parses a duration as either a number of seconds or a Go duration.
+func parseDuration(s string) (time.Duration, error) {
+	if d, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Duration(d * float64(time.Second)), nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	return 0, fmt.Errorf("cannot parse %q to a valid duration", s)
+}
+
+func sortSeries(found map[string]*series) []*series {
+	keys := make([]string, 0, len(found))
+	for k := range found {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	all := make([]*series, len(keys))
+	for i, k := range keys {
+		all[i] = found[k]
+	}
+	return all
+}
+
+const (
+	errorBadData   = "bad_data"
+	errorExecution = "execution"
+)
+
+type response struct {
+	Status    string      `json:"status"`
+	Data      interface{} `json:"data,omitempty"`
+	ErrorType string      `json:"errorType,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+type data struct {
+	ResultType string      `json:"resultType"`
+	Result     interface{} `json:"result"`
+}
+
+type sample struct {
+	Metric map[string]string `json:"metric"`
+	Value  point             `json:"value"`
+}
+
+type series struct {
+	metric map[string]string
+	Values []point `json:"values"`
+}
+
+func (s *series) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Metric map[string]string `json:"metric"`
+		Values []point           `json:"values"`
+	}{
+		Metric: s.metric,
+		Values: s.Values,
+	})
+}
+
+// point is a sample encoded as a pair of its time in Unix seconds and its value as a string.
+type point struct {
+	t time.Time
+	v float64
+}
+
+func (p point) MarshalJSON() ([]byte, error) {
+	ts := strconv.FormatFloat(float64(p.t.UnixNano())/1e9, 'f', -1, 64)
+	v := strconv.FormatFloat(p.v, 'f', -1, 64)
+	switch {
+	case math.IsNaN(p.v):
+		v = "NaN"
+	case math.IsInf(p.v, 1):
+		v = "+Inf"
+	case math.IsInf(p.v, -1):
+		v = "-Inf"
+	}
+	return []byte(fmt.Sprintf("[%s,%q]", ts, v)), nil
+}
+
+func writeSuccess(w http.ResponseWriter, d interface{}) {
+	writeJSON(w, http.StatusOK, response{Status: "success", Data: d})
+}
+
+func writeError(w http.ResponseWriter, code int, typ string, err error) {
+	writeJSON(w, code, response{Status: "error", ErrorType: typ, Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("failed to encode response:", err)
+	}
+}
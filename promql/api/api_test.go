@@ -0,0 +1,193 @@
+package api_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/ifql/promql/api"
+	"github.com/influxdata/ifql/query/control"
+	"github.com/influxdata/ifql/query/execute"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	s := execute.NewMemoryStorageReader()
+	// A sample every minute, half a minute before each step of the range queries.
+	var lines []string
+	for i := 0; i < 10; i++ {
+		ts := (30 + 60*i) * 1e9
+		lines = append(lines,
+			fmt.Sprintf("http_requests,_metric=http_requests,job=api value=%d %d", i+1, ts),
+			fmt.Sprintf("http_requests,_metric=http_requests,job=web value=%d %d", 2*(i+1), ts),
+		)
+	}
+	if err := s.WriteLineProtocol("prometheus", strings.Join(lines, "\n")); err != nil {
+		t.Fatal(err)
+	}
+	c := control.New(control.Config{
+		ConcurrencyQuota: 1,
+		MemoryBytesQuota: math.MaxInt64,
+		ExecutorConfig: execute.Config{
+			StorageReader: s,
+		},
+	})
+	return httptest.NewServer(api.NewHandler(c, "/api/v1"))
+}
+
+func TestHandler(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		params   url.Values
+		wantCode int
+		want     string
+	}{
+		{
+			name:     "instant vector",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {"http_requests"}, "time": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"__name__":"http_requests","job":"api"},"value":[600,"10"]},{"metric":{"__name__":"http_requests","job":"web"},"value":[600,"20"]}]}}`,
+		},
+		{
+			name:     "instant aggregate",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {"sum by (job) (http_requests)"}, "time": {"1970-01-01T00:05:00Z"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"job":"api"},"value":[300,"5"]},{"metric":{"job":"web"},"value":[300,"10"]}]}}`,
+		},
+		{
+			name:     "range vector",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {`http_requests{job="api"}[2m]`}, "time": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"__name__":"http_requests","job":"api"},"values":[[510,"9"],[570,"10"]]}]}}`,
+		},
+		{
+			name:     "range query",
+			path:     "/api/v1/query_range",
+			params:   url.Values{"query": {"sum(http_requests)"}, "start": {"300"}, "end": {"600"}, "step": {"60"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{},"values":[[300,"15"],[360,"18"],[420,"21"],[480,"24"],[540,"27"],[600,"30"]]}]}}`,
+		},
+		{
+			name:     "range query with vector matching",
+			path:     "/api/v1/query_range",
+			params:   url.Values{"query": {`http_requests{job="api"} * on(job) http_requests{job="api"}`}, "start": {"300"}, "end": {"600"}, "step": {"60"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"job":"api"},"values":[[300,"25"],[360,"36"],[420,"49"],[480,"64"],[540,"81"],[600,"100"]]}]}}`,
+		},
+		{
+			// The increase of 4 between the first and last samples, a minute apart, is extrapolated by half a minute to each end of the range.
+			name:     "rate",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {`rate(http_requests{job="api"}[5m])`}, "time": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"__name__":"http_requests","job":"api"},"value":[600,"0.016666666666666666"]}]}}`,
+		},
+		{
+			name:     "increase",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {`increase(http_requests[5m])`}, "time": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"__name__":"http_requests","job":"api"},"value":[600,"5"]},{"metric":{"__name__":"http_requests","job":"web"},"value":[600,"10"]}]}}`,
+		},
+		{
+			name:     "delta",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {`delta(http_requests{job="web"}[5m])`}, "time": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"__name__":"http_requests","job":"web"},"value":[600,"10"]}]}}`,
+		},
+		{
+			name:     "sum over time",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {`sum_over_time(http_requests{job="api"}[5m])`}, "time": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"__name__":"http_requests","job":"api"},"value":[600,"40"]}]}}`,
+		},
+		{
+			name:     "vector matching on all labels",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {`rate(http_requests[5m]) / http_requests`}, "time": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"job":"api"},"value":[600,"0.0016666666666666666"]},{"metric":{"job":"web"},"value":[600,"0.0016666666666666666"]}]}}`,
+		},
+		{
+			name:     "range query of rate",
+			path:     "/api/v1/query_range",
+			params:   url.Values{"query": {`rate(http_requests{job="web"}[2m])`}, "start": {"300"}, "end": {"420"}, "step": {"60"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"__name__":"http_requests","job":"web"},"values":[[300,"0.03333333333333333"],[360,"0.03333333333333333"],[420,"0.03333333333333333"]]}]}}`,
+		},
+		{
+			name:     "range query of range vector",
+			path:     "/api/v1/query_range",
+			params:   url.Values{"query": {"http_requests[5m]"}, "start": {"300"}, "end": {"600"}, "step": {"60"}},
+			wantCode: http.StatusBadRequest,
+			want:     `{"status":"error","errorType":"bad_data","error":"invalid expression type range vector for range query, must be an instant vector"}`,
+		},
+		{
+			name:     "range query too many points",
+			path:     "/api/v1/query_range",
+			params:   url.Values{"query": {"http_requests"}, "start": {"0"}, "end": {"600"}, "step": {"1ms"}},
+			wantCode: http.StatusBadRequest,
+			want:     `{"status":"error","errorType":"bad_data","error":"exceeded maximum resolution of 11000 points per timeseries"}`,
+		},
+		{
+			name:     "invalid time",
+			path:     "/api/v1/query",
+			params:   url.Values{"query": {"http_requests"}, "time": {"yesterday"}},
+			wantCode: http.StatusBadRequest,
+			want:     `{"status":"error","errorType":"bad_data","error":"invalid time: cannot parse \"yesterday\" to a valid timestamp"}`,
+		},
+		{
+			name:     "series",
+			path:     "/api/v1/series",
+			params:   url.Values{"match[]": {`http_requests{job=~"a.*"}`, `http_requests{job="web"}`}, "start": {"0"}, "end": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":[{"__name__":"http_requests","job":"api"},{"__name__":"http_requests","job":"web"}]}`,
+		},
+		{
+			name:     "series without match",
+			path:     "/api/v1/series",
+			wantCode: http.StatusBadRequest,
+			want:     `{"status":"error","errorType":"bad_data","error":"no match[] parameter provided"}`,
+		},
+		{
+			name:     "labels",
+			path:     "/api/v1/labels",
+			params:   url.Values{"start": {"0"}, "end": {"600"}},
+			wantCode: http.StatusOK,
+			want:     `{"status":"success","data":["__name__","job"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.PostForm(ts.URL+tt.path, tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+			}
+			if got := strings.TrimSpace(string(body)); got != tt.want {
+				t.Errorf("unexpected response:\ngot  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
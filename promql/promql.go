@@ -24,34 +24,34 @@ var g = &grammar{
 			name: "Grammar",
 			pos:  position{line: 8, col: 1, offset: 60},
 			expr: &actionExpr{
-				pos: position{line: 8, col: 12, offset: 71},
+				pos: position{line: 8, col: 11, offset: 70},
 				run: (*parser).callonGrammar1,
 				expr: &seqExpr{
-					pos: position{line: 8, col: 12, offset: 71},
+					pos: position{line: 8, col: 11, offset: 70},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 8, col: 12, offset: 71},
+							pos:   position{line: 8, col: 11, offset: 70},
 							label: "grammar",
 							expr: &choiceExpr{
-								pos: position{line: 8, col: 22, offset: 81},
+								pos: position{line: 8, col: 21, offset: 80},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 8, col: 22, offset: 81},
+										pos:  position{line: 8, col: 21, offset: 80},
 										name: "Comment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 8, col: 32, offset: 91},
-										name: "AggregateExpression",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 8, col: 54, offset: 113},
-										name: "VectorSelector",
+										pos:  position{line: 8, col: 31, offset: 90},
+										name: "Expression",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 8, col: 71, offset: 130},
+							pos:  position{line: 8, col: 44, offset: 103},
+							name: "__",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 8, col: 47, offset: 106},
 							name: "EOF",
 						},
 					},
@@ -60,39 +60,39 @@ var g = &grammar{
 		},
 		{
 			name: "SourceChar",
-			pos:  position{line: 12, col: 1, offset: 163},
+			pos:  position{line: 12, col: 1, offset: 139},
 			expr: &anyMatcher{
-				line: 12, col: 14, offset: 176,
+				line: 12, col: 14, offset: 152,
 			},
 		},
 		{
 			name: "Comment",
-			pos:  position{line: 14, col: 1, offset: 179},
+			pos:  position{line: 14, col: 1, offset: 155},
 			expr: &actionExpr{
-				pos: position{line: 14, col: 11, offset: 189},
+				pos: position{line: 14, col: 11, offset: 165},
 				run: (*parser).callonComment1,
 				expr: &seqExpr{
-					pos: position{line: 14, col: 11, offset: 189},
+					pos: position{line: 14, col: 11, offset: 165},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 14, col: 11, offset: 189},
+							pos:        position{line: 14, col: 11, offset: 165},
 							val:        "#",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 14, col: 15, offset: 193},
+							pos: position{line: 14, col: 15, offset: 169},
 							expr: &seqExpr{
-								pos: position{line: 14, col: 17, offset: 195},
+								pos: position{line: 14, col: 17, offset: 171},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 14, col: 17, offset: 195},
+										pos: position{line: 14, col: 17, offset: 171},
 										expr: &ruleRefExpr{
-											pos:  position{line: 14, col: 18, offset: 196},
+											pos:  position{line: 14, col: 18, offset: 172},
 											name: "EOL",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 14, col: 22, offset: 200},
+										pos:  position{line: 14, col: 22, offset: 176},
 										name: "SourceChar",
 									},
 								},
@@ -104,15 +104,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 18, col: 1, offset: 260},
+			pos:  position{line: 18, col: 1, offset: 236},
 			expr: &actionExpr{
-				pos: position{line: 18, col: 14, offset: 273},
+				pos: position{line: 18, col: 14, offset: 249},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 18, col: 14, offset: 273},
+					pos:   position{line: 18, col: 14, offset: 249},
 					label: "ident",
 					expr: &ruleRefExpr{
-						pos:  position{line: 18, col: 20, offset: 279},
+						pos:  position{line: 18, col: 20, offset: 255},
 						name: "IdentifierName",
 					},
 				},
@@ -120,21 +120,21 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 26, col: 1, offset: 463},
+			pos:  position{line: 26, col: 1, offset: 439},
 			expr: &actionExpr{
-				pos: position{line: 26, col: 18, offset: 480},
+				pos: position{line: 26, col: 18, offset: 456},
 				run: (*parser).callonIdentifierName1,
 				expr: &seqExpr{
-					pos: position{line: 26, col: 18, offset: 480},
+					pos: position{line: 26, col: 18, offset: 456},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 26, col: 18, offset: 480},
+							pos:  position{line: 26, col: 18, offset: 456},
 							name: "IdentifierStart",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 26, col: 34, offset: 496},
+							pos: position{line: 26, col: 34, offset: 472},
 							expr: &ruleRefExpr{
-								pos:  position{line: 26, col: 34, offset: 496},
+								pos:  position{line: 26, col: 34, offset: 472},
 								name: "IdentifierPart",
 							},
 						},
@@ -144,9 +144,9 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 29, col: 1, offset: 547},
+			pos:  position{line: 29, col: 1, offset: 523},
 			expr: &charClassMatcher{
-				pos:        position{line: 29, col: 19, offset: 565},
+				pos:        position{line: 29, col: 19, offset: 541},
 				val:        "[\\pL_]",
 				chars:      []rune{'_'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -156,16 +156,16 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierPart",
-			pos:  position{line: 30, col: 1, offset: 572},
+			pos:  position{line: 30, col: 1, offset: 548},
 			expr: &choiceExpr{
-				pos: position{line: 30, col: 18, offset: 589},
+				pos: position{line: 30, col: 18, offset: 565},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 30, col: 18, offset: 589},
+						pos:  position{line: 30, col: 18, offset: 565},
 						name: "IdentifierStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 30, col: 36, offset: 607},
+						pos:        position{line: 30, col: 36, offset: 583},
 						val:        "[\\p{Nd}]",
 						classes:    []*unicode.RangeTable{rangeTable("Nd")},
 						ignoreCase: false,
@@ -176,74 +176,74 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 32, col: 1, offset: 617},
+			pos:  position{line: 32, col: 1, offset: 593},
 			expr: &choiceExpr{
-				pos: position{line: 32, col: 17, offset: 633},
+				pos: position{line: 32, col: 17, offset: 609},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 32, col: 17, offset: 633},
+						pos: position{line: 32, col: 17, offset: 609},
 						run: (*parser).callonStringLiteral2,
 						expr: &choiceExpr{
-							pos: position{line: 32, col: 19, offset: 635},
+							pos: position{line: 32, col: 19, offset: 611},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 32, col: 19, offset: 635},
+									pos: position{line: 32, col: 19, offset: 611},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 32, col: 19, offset: 635},
+											pos:        position{line: 32, col: 19, offset: 611},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 32, col: 23, offset: 639},
+											pos: position{line: 32, col: 23, offset: 615},
 											expr: &ruleRefExpr{
-												pos:  position{line: 32, col: 23, offset: 639},
+												pos:  position{line: 32, col: 23, offset: 615},
 												name: "DoubleStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 32, col: 41, offset: 657},
+											pos:        position{line: 32, col: 41, offset: 633},
 											val:        "\"",
 											ignoreCase: false,
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 32, col: 47, offset: 663},
+									pos: position{line: 32, col: 47, offset: 639},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 32, col: 47, offset: 663},
+											pos:        position{line: 32, col: 47, offset: 639},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 32, col: 51, offset: 667},
+											pos:  position{line: 32, col: 51, offset: 643},
 											name: "SingleStringChar",
 										},
 										&litMatcher{
-											pos:        position{line: 32, col: 68, offset: 684},
+											pos:        position{line: 32, col: 68, offset: 660},
 											val:        "'",
 											ignoreCase: false,
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 32, col: 74, offset: 690},
+									pos: position{line: 32, col: 74, offset: 666},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 32, col: 74, offset: 690},
+											pos:        position{line: 32, col: 74, offset: 666},
 											val:        "`",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 32, col: 78, offset: 694},
+											pos: position{line: 32, col: 78, offset: 670},
 											expr: &ruleRefExpr{
-												pos:  position{line: 32, col: 78, offset: 694},
+												pos:  position{line: 32, col: 78, offset: 670},
 												name: "RawStringChar",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 32, col: 93, offset: 709},
+											pos:        position{line: 32, col: 93, offset: 685},
 											val:        "`",
 											ignoreCase: false,
 										},
@@ -253,35 +253,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 38, col: 5, offset: 855},
+						pos: position{line: 38, col: 5, offset: 831},
 						run: (*parser).callonStringLiteral18,
 						expr: &choiceExpr{
-							pos: position{line: 38, col: 7, offset: 857},
+							pos: position{line: 38, col: 7, offset: 833},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 38, col: 9, offset: 859},
+									pos: position{line: 38, col: 9, offset: 835},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 38, col: 9, offset: 859},
+											pos:        position{line: 38, col: 9, offset: 835},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 38, col: 13, offset: 863},
+											pos: position{line: 38, col: 13, offset: 839},
 											expr: &ruleRefExpr{
-												pos:  position{line: 38, col: 13, offset: 863},
+												pos:  position{line: 38, col: 13, offset: 839},
 												name: "DoubleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 38, col: 33, offset: 883},
+											pos: position{line: 38, col: 33, offset: 859},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 38, col: 33, offset: 883},
+													pos:  position{line: 38, col: 33, offset: 859},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 38, col: 39, offset: 889},
+													pos:  position{line: 38, col: 39, offset: 865},
 													name: "EOF",
 												},
 											},
@@ -289,29 +289,29 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 38, col: 51, offset: 901},
+									pos: position{line: 38, col: 51, offset: 877},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 38, col: 51, offset: 901},
+											pos:        position{line: 38, col: 51, offset: 877},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 38, col: 55, offset: 905},
+											pos: position{line: 38, col: 55, offset: 881},
 											expr: &ruleRefExpr{
-												pos:  position{line: 38, col: 55, offset: 905},
+												pos:  position{line: 38, col: 55, offset: 881},
 												name: "SingleStringChar",
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 38, col: 75, offset: 925},
+											pos: position{line: 38, col: 75, offset: 901},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 38, col: 75, offset: 925},
+													pos:  position{line: 38, col: 75, offset: 901},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 38, col: 81, offset: 931},
+													pos:  position{line: 38, col: 81, offset: 907},
 													name: "EOF",
 												},
 											},
@@ -319,22 +319,22 @@ var g = &grammar{
 									},
 								},
 								&seqExpr{
-									pos: position{line: 38, col: 91, offset: 941},
+									pos: position{line: 38, col: 91, offset: 917},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 38, col: 91, offset: 941},
+											pos:        position{line: 38, col: 91, offset: 917},
 											val:        "`",
 											ignoreCase: false,
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 38, col: 95, offset: 945},
+											pos: position{line: 38, col: 95, offset: 921},
 											expr: &ruleRefExpr{
-												pos:  position{line: 38, col: 95, offset: 945},
+												pos:  position{line: 38, col: 95, offset: 921},
 												name: "RawStringChar",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 38, col: 110, offset: 960},
+											pos:  position{line: 38, col: 110, offset: 936},
 											name: "EOF",
 										},
 									},
@@ -347,51 +347,51 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleStringChar",
-			pos:  position{line: 42, col: 1, offset: 1031},
+			pos:  position{line: 42, col: 1, offset: 1007},
 			expr: &choiceExpr{
-				pos: position{line: 42, col: 20, offset: 1050},
+				pos: position{line: 42, col: 20, offset: 1026},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 42, col: 20, offset: 1050},
+						pos: position{line: 42, col: 20, offset: 1026},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 42, col: 20, offset: 1050},
+								pos: position{line: 42, col: 20, offset: 1026},
 								expr: &choiceExpr{
-									pos: position{line: 42, col: 23, offset: 1053},
+									pos: position{line: 42, col: 23, offset: 1029},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 42, col: 23, offset: 1053},
+											pos:        position{line: 42, col: 23, offset: 1029},
 											val:        "\"",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 42, col: 29, offset: 1059},
+											pos:        position{line: 42, col: 29, offset: 1035},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 42, col: 36, offset: 1066},
+											pos:  position{line: 42, col: 36, offset: 1042},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 42, col: 42, offset: 1072},
+								pos:  position{line: 42, col: 42, offset: 1048},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 42, col: 55, offset: 1085},
+						pos: position{line: 42, col: 55, offset: 1061},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 42, col: 55, offset: 1085},
+								pos:        position{line: 42, col: 55, offset: 1061},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 42, col: 60, offset: 1090},
+								pos:  position{line: 42, col: 60, offset: 1066},
 								name: "DoubleStringEscape",
 							},
 						},
@@ -401,51 +401,51 @@ var g = &grammar{
 		},
 		{
 			name: "SingleStringChar",
-			pos:  position{line: 43, col: 1, offset: 1109},
+			pos:  position{line: 43, col: 1, offset: 1085},
 			expr: &choiceExpr{
-				pos: position{line: 43, col: 20, offset: 1128},
+				pos: position{line: 43, col: 20, offset: 1104},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 43, col: 20, offset: 1128},
+						pos: position{line: 43, col: 20, offset: 1104},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 43, col: 20, offset: 1128},
+								pos: position{line: 43, col: 20, offset: 1104},
 								expr: &choiceExpr{
-									pos: position{line: 43, col: 23, offset: 1131},
+									pos: position{line: 43, col: 23, offset: 1107},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 43, col: 23, offset: 1131},
+											pos:        position{line: 43, col: 23, offset: 1107},
 											val:        "'",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 43, col: 29, offset: 1137},
+											pos:        position{line: 43, col: 29, offset: 1113},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 43, col: 36, offset: 1144},
+											pos:  position{line: 43, col: 36, offset: 1120},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 43, col: 42, offset: 1150},
+								pos:  position{line: 43, col: 42, offset: 1126},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 43, col: 55, offset: 1163},
+						pos: position{line: 43, col: 55, offset: 1139},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 43, col: 55, offset: 1163},
+								pos:        position{line: 43, col: 55, offset: 1139},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 43, col: 60, offset: 1168},
+								pos:  position{line: 43, col: 60, offset: 1144},
 								name: "SingleStringEscape",
 							},
 						},
@@ -455,20 +455,20 @@ var g = &grammar{
 		},
 		{
 			name: "RawStringChar",
-			pos:  position{line: 44, col: 1, offset: 1187},
+			pos:  position{line: 44, col: 1, offset: 1163},
 			expr: &seqExpr{
-				pos: position{line: 44, col: 17, offset: 1203},
+				pos: position{line: 44, col: 17, offset: 1179},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 44, col: 17, offset: 1203},
+						pos: position{line: 44, col: 17, offset: 1179},
 						expr: &litMatcher{
-							pos:        position{line: 44, col: 18, offset: 1204},
+							pos:        position{line: 44, col: 18, offset: 1180},
 							val:        "`",
 							ignoreCase: false,
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 44, col: 22, offset: 1208},
+						pos:  position{line: 44, col: 22, offset: 1184},
 						name: "SourceChar",
 					},
 				},
@@ -476,40 +476,40 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleStringEscape",
-			pos:  position{line: 46, col: 1, offset: 1220},
+			pos:  position{line: 46, col: 1, offset: 1196},
 			expr: &choiceExpr{
-				pos: position{line: 46, col: 22, offset: 1241},
+				pos: position{line: 46, col: 22, offset: 1217},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 46, col: 24, offset: 1243},
+						pos: position{line: 46, col: 24, offset: 1219},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 46, col: 24, offset: 1243},
+								pos:        position{line: 46, col: 24, offset: 1219},
 								val:        "\"",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 46, col: 30, offset: 1249},
+								pos:  position{line: 46, col: 30, offset: 1225},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 47, col: 7, offset: 1278},
+						pos: position{line: 47, col: 7, offset: 1254},
 						run: (*parser).callonDoubleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 47, col: 9, offset: 1280},
+							pos: position{line: 47, col: 9, offset: 1256},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 47, col: 9, offset: 1280},
+									pos:  position{line: 47, col: 9, offset: 1256},
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 47, col: 22, offset: 1293},
+									pos:  position{line: 47, col: 22, offset: 1269},
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 47, col: 28, offset: 1299},
+									pos:  position{line: 47, col: 28, offset: 1275},
 									name: "EOF",
 								},
 							},
@@ -520,40 +520,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleStringEscape",
-			pos:  position{line: 50, col: 1, offset: 1364},
+			pos:  position{line: 50, col: 1, offset: 1340},
 			expr: &choiceExpr{
-				pos: position{line: 50, col: 22, offset: 1385},
+				pos: position{line: 50, col: 22, offset: 1361},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 50, col: 24, offset: 1387},
+						pos: position{line: 50, col: 24, offset: 1363},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 50, col: 24, offset: 1387},
+								pos:        position{line: 50, col: 24, offset: 1363},
 								val:        "'",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 50, col: 30, offset: 1393},
+								pos:  position{line: 50, col: 30, offset: 1369},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 51, col: 7, offset: 1422},
+						pos: position{line: 51, col: 7, offset: 1398},
 						run: (*parser).callonSingleStringEscape5,
 						expr: &choiceExpr{
-							pos: position{line: 51, col: 9, offset: 1424},
+							pos: position{line: 51, col: 9, offset: 1400},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 51, col: 9, offset: 1424},
+									pos:  position{line: 51, col: 9, offset: 1400},
 									name: "SourceChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 51, col: 22, offset: 1437},
+									pos:  position{line: 51, col: 22, offset: 1413},
 									name: "EOL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 51, col: 28, offset: 1443},
+									pos:  position{line: 51, col: 28, offset: 1419},
 									name: "EOF",
 								},
 							},
@@ -564,28 +564,28 @@ var g = &grammar{
 		},
 		{
 			name: "CommonEscapeSequence",
-			pos:  position{line: 55, col: 1, offset: 1509},
+			pos:  position{line: 55, col: 1, offset: 1485},
 			expr: &choiceExpr{
-				pos: position{line: 55, col: 24, offset: 1532},
+				pos: position{line: 55, col: 24, offset: 1508},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 55, col: 24, offset: 1532},
+						pos:  position{line: 55, col: 24, offset: 1508},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 55, col: 43, offset: 1551},
+						pos:  position{line: 55, col: 43, offset: 1527},
 						name: "OctalEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 55, col: 57, offset: 1565},
+						pos:  position{line: 55, col: 57, offset: 1541},
 						name: "HexEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 55, col: 69, offset: 1577},
+						pos:  position{line: 55, col: 69, offset: 1553},
 						name: "LongUnicodeEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 55, col: 89, offset: 1597},
+						pos:  position{line: 55, col: 89, offset: 1573},
 						name: "ShortUnicodeEscape",
 					},
 				},
@@ -593,47 +593,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 56, col: 1, offset: 1616},
+			pos:  position{line: 56, col: 1, offset: 1592},
 			expr: &choiceExpr{
-				pos: position{line: 56, col: 20, offset: 1635},
+				pos: position{line: 56, col: 20, offset: 1611},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 56, col: 20, offset: 1635},
+						pos:        position{line: 56, col: 20, offset: 1611},
 						val:        "a",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 56, col: 26, offset: 1641},
+						pos:        position{line: 56, col: 26, offset: 1617},
 						val:        "b",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 56, col: 32, offset: 1647},
+						pos:        position{line: 56, col: 32, offset: 1623},
 						val:        "n",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 56, col: 38, offset: 1653},
+						pos:        position{line: 56, col: 38, offset: 1629},
 						val:        "f",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 56, col: 44, offset: 1659},
+						pos:        position{line: 56, col: 44, offset: 1635},
 						val:        "r",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 56, col: 50, offset: 1665},
+						pos:        position{line: 56, col: 50, offset: 1641},
 						val:        "t",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 56, col: 56, offset: 1671},
+						pos:        position{line: 56, col: 56, offset: 1647},
 						val:        "v",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 56, col: 62, offset: 1677},
+						pos:        position{line: 56, col: 62, offset: 1653},
 						val:        "\\",
 						ignoreCase: false,
 					},
@@ -642,50 +642,50 @@ var g = &grammar{
 		},
 		{
 			name: "OctalEscape",
-			pos:  position{line: 57, col: 1, offset: 1682},
+			pos:  position{line: 57, col: 1, offset: 1658},
 			expr: &choiceExpr{
-				pos: position{line: 57, col: 15, offset: 1696},
+				pos: position{line: 57, col: 15, offset: 1672},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 57, col: 15, offset: 1696},
+						pos: position{line: 57, col: 15, offset: 1672},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 57, col: 15, offset: 1696},
+								pos:  position{line: 57, col: 15, offset: 1672},
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 57, col: 26, offset: 1707},
+								pos:  position{line: 57, col: 26, offset: 1683},
 								name: "OctalDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 57, col: 37, offset: 1718},
+								pos:  position{line: 57, col: 37, offset: 1694},
 								name: "OctalDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 58, col: 7, offset: 1735},
+						pos: position{line: 58, col: 7, offset: 1711},
 						run: (*parser).callonOctalEscape6,
 						expr: &seqExpr{
-							pos: position{line: 58, col: 7, offset: 1735},
+							pos: position{line: 58, col: 7, offset: 1711},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 58, col: 7, offset: 1735},
+									pos:  position{line: 58, col: 7, offset: 1711},
 									name: "OctalDigit",
 								},
 								&choiceExpr{
-									pos: position{line: 58, col: 20, offset: 1748},
+									pos: position{line: 58, col: 20, offset: 1724},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 58, col: 20, offset: 1748},
+											pos:  position{line: 58, col: 20, offset: 1724},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 58, col: 33, offset: 1761},
+											pos:  position{line: 58, col: 33, offset: 1737},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 58, col: 39, offset: 1767},
+											pos:  position{line: 58, col: 39, offset: 1743},
 											name: "EOF",
 										},
 									},
@@ -698,52 +698,52 @@ var g = &grammar{
 		},
 		{
 			name: "HexEscape",
-			pos:  position{line: 61, col: 1, offset: 1828},
+			pos:  position{line: 61, col: 1, offset: 1804},
 			expr: &choiceExpr{
-				pos: position{line: 61, col: 13, offset: 1840},
+				pos: position{line: 61, col: 13, offset: 1816},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 61, col: 13, offset: 1840},
+						pos: position{line: 61, col: 13, offset: 1816},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 61, col: 13, offset: 1840},
+								pos:        position{line: 61, col: 13, offset: 1816},
 								val:        "x",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 61, col: 17, offset: 1844},
+								pos:  position{line: 61, col: 17, offset: 1820},
 								name: "HexDigit",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 61, col: 26, offset: 1853},
+								pos:  position{line: 61, col: 26, offset: 1829},
 								name: "HexDigit",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 62, col: 7, offset: 1868},
+						pos: position{line: 62, col: 7, offset: 1844},
 						run: (*parser).callonHexEscape6,
 						expr: &seqExpr{
-							pos: position{line: 62, col: 7, offset: 1868},
+							pos: position{line: 62, col: 7, offset: 1844},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 62, col: 7, offset: 1868},
+									pos:        position{line: 62, col: 7, offset: 1844},
 									val:        "x",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 62, col: 13, offset: 1874},
+									pos: position{line: 62, col: 13, offset: 1850},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 62, col: 13, offset: 1874},
+											pos:  position{line: 62, col: 13, offset: 1850},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 62, col: 26, offset: 1887},
+											pos:  position{line: 62, col: 26, offset: 1863},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 62, col: 32, offset: 1893},
+											pos:  position{line: 62, col: 32, offset: 1869},
 											name: "EOF",
 										},
 									},
@@ -756,80 +756,80 @@ var g = &grammar{
 		},
 		{
 			name: "LongUnicodeEscape",
-			pos:  position{line: 65, col: 1, offset: 1960},
+			pos:  position{line: 65, col: 1, offset: 1936},
 			expr: &choiceExpr{
-				pos: position{line: 66, col: 5, offset: 1985},
+				pos: position{line: 66, col: 5, offset: 1961},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 66, col: 5, offset: 1985},
+						pos: position{line: 66, col: 5, offset: 1961},
 						run: (*parser).callonLongUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 66, col: 5, offset: 1985},
+							pos: position{line: 66, col: 5, offset: 1961},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 66, col: 5, offset: 1985},
+									pos:        position{line: 66, col: 5, offset: 1961},
 									val:        "U",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 9, offset: 1989},
+									pos:  position{line: 66, col: 9, offset: 1965},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 18, offset: 1998},
+									pos:  position{line: 66, col: 18, offset: 1974},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 27, offset: 2007},
+									pos:  position{line: 66, col: 27, offset: 1983},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 36, offset: 2016},
+									pos:  position{line: 66, col: 36, offset: 1992},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 45, offset: 2025},
+									pos:  position{line: 66, col: 45, offset: 2001},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 54, offset: 2034},
+									pos:  position{line: 66, col: 54, offset: 2010},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 63, offset: 2043},
+									pos:  position{line: 66, col: 63, offset: 2019},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 66, col: 72, offset: 2052},
+									pos:  position{line: 66, col: 72, offset: 2028},
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 69, col: 7, offset: 2154},
+						pos: position{line: 69, col: 7, offset: 2130},
 						run: (*parser).callonLongUnicodeEscape13,
 						expr: &seqExpr{
-							pos: position{line: 69, col: 7, offset: 2154},
+							pos: position{line: 69, col: 7, offset: 2130},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 69, col: 7, offset: 2154},
+									pos:        position{line: 69, col: 7, offset: 2130},
 									val:        "U",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 69, col: 13, offset: 2160},
+									pos: position{line: 69, col: 13, offset: 2136},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 69, col: 13, offset: 2160},
+											pos:  position{line: 69, col: 13, offset: 2136},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 69, col: 26, offset: 2173},
+											pos:  position{line: 69, col: 26, offset: 2149},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 69, col: 32, offset: 2179},
+											pos:  position{line: 69, col: 32, offset: 2155},
 											name: "EOF",
 										},
 									},
@@ -842,64 +842,64 @@ var g = &grammar{
 		},
 		{
 			name: "ShortUnicodeEscape",
-			pos:  position{line: 72, col: 1, offset: 2242},
+			pos:  position{line: 72, col: 1, offset: 2218},
 			expr: &choiceExpr{
-				pos: position{line: 73, col: 5, offset: 2268},
+				pos: position{line: 73, col: 5, offset: 2244},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 73, col: 5, offset: 2268},
+						pos: position{line: 73, col: 5, offset: 2244},
 						run: (*parser).callonShortUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 73, col: 5, offset: 2268},
+							pos: position{line: 73, col: 5, offset: 2244},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 73, col: 5, offset: 2268},
+									pos:        position{line: 73, col: 5, offset: 2244},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 73, col: 9, offset: 2272},
+									pos:  position{line: 73, col: 9, offset: 2248},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 73, col: 18, offset: 2281},
+									pos:  position{line: 73, col: 18, offset: 2257},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 73, col: 27, offset: 2290},
+									pos:  position{line: 73, col: 27, offset: 2266},
 									name: "HexDigit",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 73, col: 36, offset: 2299},
+									pos:  position{line: 73, col: 36, offset: 2275},
 									name: "HexDigit",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 76, col: 7, offset: 2401},
+						pos: position{line: 76, col: 7, offset: 2377},
 						run: (*parser).callonShortUnicodeEscape9,
 						expr: &seqExpr{
-							pos: position{line: 76, col: 7, offset: 2401},
+							pos: position{line: 76, col: 7, offset: 2377},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 76, col: 7, offset: 2401},
+									pos:        position{line: 76, col: 7, offset: 2377},
 									val:        "u",
 									ignoreCase: false,
 								},
 								&choiceExpr{
-									pos: position{line: 76, col: 13, offset: 2407},
+									pos: position{line: 76, col: 13, offset: 2383},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 76, col: 13, offset: 2407},
+											pos:  position{line: 76, col: 13, offset: 2383},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 76, col: 26, offset: 2420},
+											pos:  position{line: 76, col: 26, offset: 2396},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 76, col: 32, offset: 2426},
+											pos:  position{line: 76, col: 32, offset: 2402},
 											name: "EOF",
 										},
 									},
@@ -912,9 +912,9 @@ var g = &grammar{
 		},
 		{
 			name: "OctalDigit",
-			pos:  position{line: 80, col: 1, offset: 2490},
+			pos:  position{line: 80, col: 1, offset: 2466},
 			expr: &charClassMatcher{
-				pos:        position{line: 80, col: 14, offset: 2503},
+				pos:        position{line: 80, col: 14, offset: 2479},
 				val:        "[0-7]",
 				ranges:     []rune{'0', '7'},
 				ignoreCase: false,
@@ -923,9 +923,9 @@ var g = &grammar{
 		},
 		{
 			name: "DecimalDigit",
-			pos:  position{line: 81, col: 1, offset: 2509},
+			pos:  position{line: 81, col: 1, offset: 2485},
 			expr: &charClassMatcher{
-				pos:        position{line: 81, col: 16, offset: 2524},
+				pos:        position{line: 81, col: 16, offset: 2500},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -934,9 +934,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 82, col: 1, offset: 2530},
+			pos:  position{line: 82, col: 1, offset: 2506},
 			expr: &charClassMatcher{
-				pos:        position{line: 82, col: 12, offset: 2541},
+				pos:        position{line: 82, col: 12, offset: 2517},
 				val:        "[0-9a-f]i",
 				ranges:     []rune{'0', '9', 'a', 'f'},
 				ignoreCase: true,
@@ -945,44 +945,44 @@ var g = &grammar{
 		},
 		{
 			name: "CharClassMatcher",
-			pos:  position{line: 84, col: 1, offset: 2552},
+			pos:  position{line: 84, col: 1, offset: 2528},
 			expr: &choiceExpr{
-				pos: position{line: 84, col: 20, offset: 2571},
+				pos: position{line: 84, col: 20, offset: 2547},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 84, col: 20, offset: 2571},
+						pos: position{line: 84, col: 20, offset: 2547},
 						run: (*parser).callonCharClassMatcher2,
 						expr: &seqExpr{
-							pos: position{line: 84, col: 20, offset: 2571},
+							pos: position{line: 84, col: 20, offset: 2547},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 84, col: 20, offset: 2571},
+									pos:        position{line: 84, col: 20, offset: 2547},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 84, col: 24, offset: 2575},
+									pos: position{line: 84, col: 24, offset: 2551},
 									expr: &choiceExpr{
-										pos: position{line: 84, col: 26, offset: 2577},
+										pos: position{line: 84, col: 26, offset: 2553},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 84, col: 26, offset: 2577},
+												pos:  position{line: 84, col: 26, offset: 2553},
 												name: "ClassCharRange",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 84, col: 43, offset: 2594},
+												pos:  position{line: 84, col: 43, offset: 2570},
 												name: "ClassChar",
 											},
 											&seqExpr{
-												pos: position{line: 84, col: 55, offset: 2606},
+												pos: position{line: 84, col: 55, offset: 2582},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 84, col: 55, offset: 2606},
+														pos:        position{line: 84, col: 55, offset: 2582},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&ruleRefExpr{
-														pos:  position{line: 84, col: 60, offset: 2611},
+														pos:  position{line: 84, col: 60, offset: 2587},
 														name: "UnicodeClassEscape",
 													},
 												},
@@ -991,14 +991,14 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 84, col: 82, offset: 2633},
+									pos:        position{line: 84, col: 82, offset: 2609},
 									val:        "]",
 									ignoreCase: false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 84, col: 86, offset: 2637},
+									pos: position{line: 84, col: 86, offset: 2613},
 									expr: &litMatcher{
-										pos:        position{line: 84, col: 86, offset: 2637},
+										pos:        position{line: 84, col: 86, offset: 2613},
 										val:        "i",
 										ignoreCase: false,
 									},
@@ -1007,44 +1007,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 86, col: 5, offset: 2679},
+						pos: position{line: 86, col: 5, offset: 2655},
 						run: (*parser).callonCharClassMatcher15,
 						expr: &seqExpr{
-							pos: position{line: 86, col: 5, offset: 2679},
+							pos: position{line: 86, col: 5, offset: 2655},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 86, col: 5, offset: 2679},
+									pos:        position{line: 86, col: 5, offset: 2655},
 									val:        "[",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 86, col: 9, offset: 2683},
+									pos: position{line: 86, col: 9, offset: 2659},
 									expr: &seqExpr{
-										pos: position{line: 86, col: 11, offset: 2685},
+										pos: position{line: 86, col: 11, offset: 2661},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 86, col: 11, offset: 2685},
+												pos: position{line: 86, col: 11, offset: 2661},
 												expr: &ruleRefExpr{
-													pos:  position{line: 86, col: 14, offset: 2688},
+													pos:  position{line: 86, col: 14, offset: 2664},
 													name: "EOL",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 86, col: 20, offset: 2694},
+												pos:  position{line: 86, col: 20, offset: 2670},
 												name: "SourceChar",
 											},
 										},
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 86, col: 36, offset: 2710},
+									pos: position{line: 86, col: 36, offset: 2686},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 86, col: 36, offset: 2710},
+											pos:  position{line: 86, col: 36, offset: 2686},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 86, col: 42, offset: 2716},
+											pos:  position{line: 86, col: 42, offset: 2692},
 											name: "EOF",
 										},
 									},
@@ -1057,21 +1057,21 @@ var g = &grammar{
 		},
 		{
 			name: "ClassCharRange",
-			pos:  position{line: 90, col: 1, offset: 2788},
+			pos:  position{line: 90, col: 1, offset: 2764},
 			expr: &seqExpr{
-				pos: position{line: 90, col: 18, offset: 2805},
+				pos: position{line: 90, col: 18, offset: 2781},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 90, col: 18, offset: 2805},
+						pos:  position{line: 90, col: 18, offset: 2781},
 						name: "ClassChar",
 					},
 					&litMatcher{
-						pos:        position{line: 90, col: 28, offset: 2815},
+						pos:        position{line: 90, col: 28, offset: 2791},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 90, col: 32, offset: 2819},
+						pos:  position{line: 90, col: 32, offset: 2795},
 						name: "ClassChar",
 					},
 				},
@@ -1079,51 +1079,51 @@ var g = &grammar{
 		},
 		{
 			name: "ClassChar",
-			pos:  position{line: 91, col: 1, offset: 2829},
+			pos:  position{line: 91, col: 1, offset: 2805},
 			expr: &choiceExpr{
-				pos: position{line: 91, col: 13, offset: 2841},
+				pos: position{line: 91, col: 13, offset: 2817},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 91, col: 13, offset: 2841},
+						pos: position{line: 91, col: 13, offset: 2817},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 91, col: 13, offset: 2841},
+								pos: position{line: 91, col: 13, offset: 2817},
 								expr: &choiceExpr{
-									pos: position{line: 91, col: 16, offset: 2844},
+									pos: position{line: 91, col: 16, offset: 2820},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 91, col: 16, offset: 2844},
+											pos:        position{line: 91, col: 16, offset: 2820},
 											val:        "]",
 											ignoreCase: false,
 										},
 										&litMatcher{
-											pos:        position{line: 91, col: 22, offset: 2850},
+											pos:        position{line: 91, col: 22, offset: 2826},
 											val:        "\\",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 91, col: 29, offset: 2857},
+											pos:  position{line: 91, col: 29, offset: 2833},
 											name: "EOL",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 91, col: 35, offset: 2863},
+								pos:  position{line: 91, col: 35, offset: 2839},
 								name: "SourceChar",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 91, col: 48, offset: 2876},
+						pos: position{line: 91, col: 48, offset: 2852},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 91, col: 48, offset: 2876},
+								pos:        position{line: 91, col: 48, offset: 2852},
 								val:        "\\",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 91, col: 53, offset: 2881},
+								pos:  position{line: 91, col: 53, offset: 2857},
 								name: "CharClassEscape",
 							},
 						},
@@ -1133,51 +1133,51 @@ var g = &grammar{
 		},
 		{
 			name: "CharClassEscape",
-			pos:  position{line: 92, col: 1, offset: 2897},
+			pos:  position{line: 92, col: 1, offset: 2873},
 			expr: &choiceExpr{
-				pos: position{line: 92, col: 19, offset: 2915},
+				pos: position{line: 92, col: 19, offset: 2891},
 				alternatives: []interface{}{
 					&choiceExpr{
-						pos: position{line: 92, col: 21, offset: 2917},
+						pos: position{line: 92, col: 21, offset: 2893},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 92, col: 21, offset: 2917},
+								pos:        position{line: 92, col: 21, offset: 2893},
 								val:        "]",
 								ignoreCase: false,
 							},
 							&ruleRefExpr{
-								pos:  position{line: 92, col: 27, offset: 2923},
+								pos:  position{line: 92, col: 27, offset: 2899},
 								name: "CommonEscapeSequence",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 93, col: 7, offset: 2952},
+						pos: position{line: 93, col: 7, offset: 2928},
 						run: (*parser).callonCharClassEscape5,
 						expr: &seqExpr{
-							pos: position{line: 93, col: 7, offset: 2952},
+							pos: position{line: 93, col: 7, offset: 2928},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 93, col: 7, offset: 2952},
+									pos: position{line: 93, col: 7, offset: 2928},
 									expr: &litMatcher{
-										pos:        position{line: 93, col: 8, offset: 2953},
+										pos:        position{line: 93, col: 8, offset: 2929},
 										val:        "p",
 										ignoreCase: false,
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 93, col: 14, offset: 2959},
+									pos: position{line: 93, col: 14, offset: 2935},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 93, col: 14, offset: 2959},
+											pos:  position{line: 93, col: 14, offset: 2935},
 											name: "SourceChar",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 93, col: 27, offset: 2972},
+											pos:  position{line: 93, col: 27, offset: 2948},
 											name: "EOL",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 93, col: 33, offset: 2978},
+											pos:  position{line: 93, col: 33, offset: 2954},
 											name: "EOF",
 										},
 									},
@@ -1190,49 +1190,49 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeClassEscape",
-			pos:  position{line: 97, col: 1, offset: 3044},
+			pos:  position{line: 97, col: 1, offset: 3020},
 			expr: &seqExpr{
-				pos: position{line: 97, col: 22, offset: 3065},
+				pos: position{line: 97, col: 22, offset: 3041},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 97, col: 22, offset: 3065},
+						pos:        position{line: 97, col: 22, offset: 3041},
 						val:        "p",
 						ignoreCase: false,
 					},
 					&choiceExpr{
-						pos: position{line: 98, col: 7, offset: 3078},
+						pos: position{line: 98, col: 7, offset: 3054},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 98, col: 7, offset: 3078},
+								pos:  position{line: 98, col: 7, offset: 3054},
 								name: "SingleCharUnicodeClass",
 							},
 							&actionExpr{
-								pos: position{line: 99, col: 7, offset: 3107},
+								pos: position{line: 99, col: 7, offset: 3083},
 								run: (*parser).callonUnicodeClassEscape5,
 								expr: &seqExpr{
-									pos: position{line: 99, col: 7, offset: 3107},
+									pos: position{line: 99, col: 7, offset: 3083},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 99, col: 7, offset: 3107},
+											pos: position{line: 99, col: 7, offset: 3083},
 											expr: &litMatcher{
-												pos:        position{line: 99, col: 8, offset: 3108},
+												pos:        position{line: 99, col: 8, offset: 3084},
 												val:        "{",
 												ignoreCase: false,
 											},
 										},
 										&choiceExpr{
-											pos: position{line: 99, col: 14, offset: 3114},
+											pos: position{line: 99, col: 14, offset: 3090},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 99, col: 14, offset: 3114},
+													pos:  position{line: 99, col: 14, offset: 3090},
 													name: "SourceChar",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 99, col: 27, offset: 3127},
+													pos:  position{line: 99, col: 27, offset: 3103},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 99, col: 33, offset: 3133},
+													pos:  position{line: 99, col: 33, offset: 3109},
 													name: "EOF",
 												},
 											},
@@ -1241,26 +1241,26 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 100, col: 7, offset: 3204},
+								pos: position{line: 100, col: 7, offset: 3180},
 								run: (*parser).callonUnicodeClassEscape13,
 								expr: &seqExpr{
-									pos: position{line: 100, col: 7, offset: 3204},
+									pos: position{line: 100, col: 7, offset: 3180},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 100, col: 7, offset: 3204},
+											pos:        position{line: 100, col: 7, offset: 3180},
 											val:        "{",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 100, col: 11, offset: 3208},
+											pos:   position{line: 100, col: 11, offset: 3184},
 											label: "ident",
 											expr: &ruleRefExpr{
-												pos:  position{line: 100, col: 17, offset: 3214},
+												pos:  position{line: 100, col: 17, offset: 3190},
 												name: "IdentifierName",
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 100, col: 32, offset: 3229},
+											pos:        position{line: 100, col: 32, offset: 3205},
 											val:        "}",
 											ignoreCase: false,
 										},
@@ -1268,34 +1268,34 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 106, col: 7, offset: 3393},
+								pos: position{line: 106, col: 7, offset: 3369},
 								run: (*parser).callonUnicodeClassEscape19,
 								expr: &seqExpr{
-									pos: position{line: 106, col: 7, offset: 3393},
+									pos: position{line: 106, col: 7, offset: 3369},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 106, col: 7, offset: 3393},
+											pos:        position{line: 106, col: 7, offset: 3369},
 											val:        "{",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 106, col: 11, offset: 3397},
+											pos:  position{line: 106, col: 11, offset: 3373},
 											name: "IdentifierName",
 										},
 										&choiceExpr{
-											pos: position{line: 106, col: 28, offset: 3414},
+											pos: position{line: 106, col: 28, offset: 3390},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 106, col: 28, offset: 3414},
+													pos:        position{line: 106, col: 28, offset: 3390},
 													val:        "]",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 106, col: 34, offset: 3420},
+													pos:  position{line: 106, col: 34, offset: 3396},
 													name: "EOL",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 106, col: 40, offset: 3426},
+													pos:  position{line: 106, col: 40, offset: 3402},
 													name: "EOF",
 												},
 											},
@@ -1310,9 +1310,9 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharUnicodeClass",
-			pos:  position{line: 111, col: 1, offset: 3506},
+			pos:  position{line: 111, col: 1, offset: 3482},
 			expr: &charClassMatcher{
-				pos:        position{line: 111, col: 26, offset: 3531},
+				pos:        position{line: 111, col: 26, offset: 3507},
 				val:        "[LMNCPZS]",
 				chars:      []rune{'L', 'M', 'N', 'C', 'P', 'Z', 'S'},
 				ignoreCase: false,
@@ -1321,39 +1321,39 @@ var g = &grammar{
 		},
 		{
 			name: "Number",
-			pos:  position{line: 114, col: 1, offset: 3543},
+			pos:  position{line: 114, col: 1, offset: 3519},
 			expr: &actionExpr{
-				pos: position{line: 114, col: 10, offset: 3552},
+				pos: position{line: 114, col: 10, offset: 3528},
 				run: (*parser).callonNumber1,
 				expr: &seqExpr{
-					pos: position{line: 114, col: 10, offset: 3552},
+					pos: position{line: 114, col: 10, offset: 3528},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 114, col: 10, offset: 3552},
+							pos: position{line: 114, col: 10, offset: 3528},
 							expr: &litMatcher{
-								pos:        position{line: 114, col: 10, offset: 3552},
+								pos:        position{line: 114, col: 10, offset: 3528},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 114, col: 15, offset: 3557},
+							pos:  position{line: 114, col: 15, offset: 3533},
 							name: "Integer",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 114, col: 23, offset: 3565},
+							pos: position{line: 114, col: 23, offset: 3541},
 							expr: &seqExpr{
-								pos: position{line: 114, col: 25, offset: 3567},
+								pos: position{line: 114, col: 25, offset: 3543},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 114, col: 25, offset: 3567},
+										pos:        position{line: 114, col: 25, offset: 3543},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 114, col: 29, offset: 3571},
+										pos: position{line: 114, col: 29, offset: 3547},
 										expr: &ruleRefExpr{
-											pos:  position{line: 114, col: 29, offset: 3571},
+											pos:  position{line: 114, col: 29, offset: 3547},
 											name: "Digit",
 										},
 									},
@@ -1366,29 +1366,29 @@ var g = &grammar{
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 118, col: 1, offset: 3623},
+			pos:  position{line: 118, col: 1, offset: 3599},
 			expr: &choiceExpr{
-				pos: position{line: 118, col: 11, offset: 3633},
+				pos: position{line: 118, col: 11, offset: 3609},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 118, col: 11, offset: 3633},
+						pos:        position{line: 118, col: 11, offset: 3609},
 						val:        "0",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 118, col: 17, offset: 3639},
+						pos: position{line: 118, col: 17, offset: 3615},
 						run: (*parser).callonInteger3,
 						expr: &seqExpr{
-							pos: position{line: 118, col: 17, offset: 3639},
+							pos: position{line: 118, col: 17, offset: 3615},
 							exprs: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 118, col: 17, offset: 3639},
+									pos:  position{line: 118, col: 17, offset: 3615},
 									name: "NonZeroDigit",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 118, col: 30, offset: 3652},
+									pos: position{line: 118, col: 30, offset: 3628},
 									expr: &ruleRefExpr{
-										pos:  position{line: 118, col: 30, offset: 3652},
+										pos:  position{line: 118, col: 30, offset: 3628},
 										name: "Digit",
 									},
 								},
@@ -1400,9 +1400,9 @@ var g = &grammar{
 		},
 		{
 			name: "NonZeroDigit",
-			pos:  position{line: 122, col: 1, offset: 3716},
+			pos:  position{line: 122, col: 1, offset: 3692},
 			expr: &charClassMatcher{
-				pos:        position{line: 122, col: 16, offset: 3731},
+				pos:        position{line: 122, col: 16, offset: 3707},
 				val:        "[1-9]",
 				ranges:     []rune{'1', '9'},
 				ignoreCase: false,
@@ -1411,9 +1411,9 @@ var g = &grammar{
 		},
 		{
 			name: "Digit",
-			pos:  position{line: 123, col: 1, offset: 3737},
+			pos:  position{line: 123, col: 1, offset: 3713},
 			expr: &charClassMatcher{
-				pos:        position{line: 123, col: 9, offset: 3745},
+				pos:        position{line: 123, col: 9, offset: 3721},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -1422,31 +1422,31 @@ var g = &grammar{
 		},
 		{
 			name: "LabelBlock",
-			pos:  position{line: 125, col: 1, offset: 3752},
+			pos:  position{line: 125, col: 1, offset: 3728},
 			expr: &choiceExpr{
-				pos: position{line: 125, col: 14, offset: 3765},
+				pos: position{line: 125, col: 14, offset: 3741},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 125, col: 14, offset: 3765},
+						pos: position{line: 125, col: 14, offset: 3741},
 						run: (*parser).callonLabelBlock2,
 						expr: &seqExpr{
-							pos: position{line: 125, col: 14, offset: 3765},
+							pos: position{line: 125, col: 14, offset: 3741},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 125, col: 14, offset: 3765},
+									pos:        position{line: 125, col: 14, offset: 3741},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 125, col: 18, offset: 3769},
+									pos:   position{line: 125, col: 18, offset: 3745},
 									label: "block",
 									expr: &ruleRefExpr{
-										pos:  position{line: 125, col: 24, offset: 3775},
+										pos:  position{line: 125, col: 24, offset: 3751},
 										name: "LabelMatches",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 125, col: 37, offset: 3788},
+									pos:        position{line: 125, col: 37, offset: 3764},
 									val:        "}",
 									ignoreCase: false,
 								},
@@ -1454,22 +1454,22 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 127, col: 5, offset: 3820},
+						pos: position{line: 127, col: 5, offset: 3796},
 						run: (*parser).callonLabelBlock8,
 						expr: &seqExpr{
-							pos: position{line: 127, col: 5, offset: 3820},
+							pos: position{line: 127, col: 5, offset: 3796},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 127, col: 5, offset: 3820},
+									pos:        position{line: 127, col: 5, offset: 3796},
 									val:        "{",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 127, col: 9, offset: 3824},
+									pos:  position{line: 127, col: 9, offset: 3800},
 									name: "LabelMatches",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 127, col: 22, offset: 3837},
+									pos:  position{line: 127, col: 22, offset: 3813},
 									name: "EOF",
 								},
 							},
@@ -1480,12 +1480,12 @@ var g = &grammar{
 		},
 		{
 			name: "NanoSecondUnits",
-			pos:  position{line: 131, col: 1, offset: 3902},
+			pos:  position{line: 131, col: 1, offset: 3878},
 			expr: &actionExpr{
-				pos: position{line: 131, col: 19, offset: 3920},
+				pos: position{line: 131, col: 19, offset: 3896},
 				run: (*parser).callonNanoSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 131, col: 19, offset: 3920},
+					pos:        position{line: 131, col: 19, offset: 3896},
 					val:        "ns",
 					ignoreCase: false,
 				},
@@ -1493,25 +1493,25 @@ var g = &grammar{
 		},
 		{
 			name: "MicroSecondUnits",
-			pos:  position{line: 136, col: 1, offset: 4025},
+			pos:  position{line: 136, col: 1, offset: 4001},
 			expr: &actionExpr{
-				pos: position{line: 136, col: 20, offset: 4044},
+				pos: position{line: 136, col: 20, offset: 4020},
 				run: (*parser).callonMicroSecondUnits1,
 				expr: &choiceExpr{
-					pos: position{line: 136, col: 21, offset: 4045},
+					pos: position{line: 136, col: 21, offset: 4021},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 136, col: 21, offset: 4045},
+							pos:        position{line: 136, col: 21, offset: 4021},
 							val:        "us",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 136, col: 28, offset: 4052},
+							pos:        position{line: 136, col: 28, offset: 4028},
 							val:        "µs",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 136, col: 35, offset: 4060},
+							pos:        position{line: 136, col: 35, offset: 4036},
 							val:        "μs",
 							ignoreCase: false,
 						},
@@ -1521,12 +1521,12 @@ var g = &grammar{
 		},
 		{
 			name: "MilliSecondUnits",
-			pos:  position{line: 141, col: 1, offset: 4169},
+			pos:  position{line: 141, col: 1, offset: 4145},
 			expr: &actionExpr{
-				pos: position{line: 141, col: 20, offset: 4188},
+				pos: position{line: 141, col: 20, offset: 4164},
 				run: (*parser).callonMilliSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 141, col: 20, offset: 4188},
+					pos:        position{line: 141, col: 20, offset: 4164},
 					val:        "ms",
 					ignoreCase: false,
 				},
@@ -1534,12 +1534,12 @@ var g = &grammar{
 		},
 		{
 			name: "SecondUnits",
-			pos:  position{line: 146, col: 1, offset: 4295},
+			pos:  position{line: 146, col: 1, offset: 4271},
 			expr: &actionExpr{
-				pos: position{line: 146, col: 15, offset: 4309},
+				pos: position{line: 146, col: 15, offset: 4285},
 				run: (*parser).callonSecondUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 146, col: 15, offset: 4309},
+					pos:        position{line: 146, col: 15, offset: 4285},
 					val:        "s",
 					ignoreCase: false,
 				},
@@ -1547,12 +1547,12 @@ var g = &grammar{
 		},
 		{
 			name: "MinuteUnits",
-			pos:  position{line: 150, col: 1, offset: 4346},
+			pos:  position{line: 150, col: 1, offset: 4322},
 			expr: &actionExpr{
-				pos: position{line: 150, col: 15, offset: 4360},
+				pos: position{line: 150, col: 15, offset: 4336},
 				run: (*parser).callonMinuteUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 150, col: 15, offset: 4360},
+					pos:        position{line: 150, col: 15, offset: 4336},
 					val:        "m",
 					ignoreCase: false,
 				},
@@ -1560,12 +1560,12 @@ var g = &grammar{
 		},
 		{
 			name: "HourUnits",
-			pos:  position{line: 154, col: 1, offset: 4397},
+			pos:  position{line: 154, col: 1, offset: 4373},
 			expr: &actionExpr{
-				pos: position{line: 154, col: 13, offset: 4409},
+				pos: position{line: 154, col: 13, offset: 4385},
 				run: (*parser).callonHourUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 154, col: 13, offset: 4409},
+					pos:        position{line: 154, col: 13, offset: 4385},
 					val:        "h",
 					ignoreCase: false,
 				},
@@ -1573,12 +1573,12 @@ var g = &grammar{
 		},
 		{
 			name: "DayUnits",
-			pos:  position{line: 158, col: 1, offset: 4444},
+			pos:  position{line: 158, col: 1, offset: 4420},
 			expr: &actionExpr{
-				pos: position{line: 158, col: 12, offset: 4455},
+				pos: position{line: 158, col: 12, offset: 4431},
 				run: (*parser).callonDayUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 158, col: 12, offset: 4455},
+					pos:        position{line: 158, col: 12, offset: 4431},
 					val:        "d",
 					ignoreCase: false,
 				},
@@ -1586,12 +1586,12 @@ var g = &grammar{
 		},
 		{
 			name: "WeekUnits",
-			pos:  position{line: 164, col: 1, offset: 4663},
+			pos:  position{line: 164, col: 1, offset: 4639},
 			expr: &actionExpr{
-				pos: position{line: 164, col: 13, offset: 4675},
+				pos: position{line: 164, col: 13, offset: 4651},
 				run: (*parser).callonWeekUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 164, col: 13, offset: 4675},
+					pos:        position{line: 164, col: 13, offset: 4651},
 					val:        "w",
 					ignoreCase: false,
 				},
@@ -1599,12 +1599,12 @@ var g = &grammar{
 		},
 		{
 			name: "YearUnits",
-			pos:  position{line: 170, col: 1, offset: 4886},
+			pos:  position{line: 170, col: 1, offset: 4862},
 			expr: &actionExpr{
-				pos: position{line: 170, col: 13, offset: 4898},
+				pos: position{line: 170, col: 13, offset: 4874},
 				run: (*parser).callonYearUnits1,
 				expr: &litMatcher{
-					pos:        position{line: 170, col: 13, offset: 4898},
+					pos:        position{line: 170, col: 13, offset: 4874},
 					val:        "y",
 					ignoreCase: false,
 				},
@@ -1612,44 +1612,44 @@ var g = &grammar{
 		},
 		{
 			name: "DurationUnits",
-			pos:  position{line: 176, col: 1, offset: 5095},
+			pos:  position{line: 176, col: 1, offset: 5071},
 			expr: &choiceExpr{
-				pos: position{line: 176, col: 18, offset: 5112},
+				pos: position{line: 176, col: 18, offset: 5088},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 18, offset: 5112},
+						pos:  position{line: 176, col: 18, offset: 5088},
 						name: "NanoSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 36, offset: 5130},
+						pos:  position{line: 176, col: 36, offset: 5106},
 						name: "MicroSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 55, offset: 5149},
+						pos:  position{line: 176, col: 55, offset: 5125},
 						name: "MilliSecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 74, offset: 5168},
+						pos:  position{line: 176, col: 74, offset: 5144},
 						name: "SecondUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 88, offset: 5182},
+						pos:  position{line: 176, col: 88, offset: 5158},
 						name: "MinuteUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 102, offset: 5196},
+						pos:  position{line: 176, col: 102, offset: 5172},
 						name: "HourUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 114, offset: 5208},
+						pos:  position{line: 176, col: 114, offset: 5184},
 						name: "DayUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 125, offset: 5219},
+						pos:  position{line: 176, col: 125, offset: 5195},
 						name: "WeekUnits",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 176, col: 137, offset: 5231},
+						pos:  position{line: 176, col: 137, offset: 5207},
 						name: "YearUnits",
 					},
 				},
@@ -1657,26 +1657,26 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 178, col: 1, offset: 5243},
+			pos:  position{line: 178, col: 1, offset: 5219},
 			expr: &actionExpr{
-				pos: position{line: 178, col: 12, offset: 5254},
+				pos: position{line: 178, col: 12, offset: 5230},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 178, col: 12, offset: 5254},
+					pos: position{line: 178, col: 12, offset: 5230},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 178, col: 12, offset: 5254},
+							pos:   position{line: 178, col: 12, offset: 5230},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 178, col: 16, offset: 5258},
+								pos:  position{line: 178, col: 16, offset: 5234},
 								name: "Integer",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 178, col: 24, offset: 5266},
+							pos:   position{line: 178, col: 24, offset: 5242},
 							label: "units",
 							expr: &ruleRefExpr{
-								pos:  position{line: 178, col: 30, offset: 5272},
+								pos:  position{line: 178, col: 30, offset: 5248},
 								name: "DurationUnits",
 							},
 						},
@@ -1686,82 +1686,82 @@ var g = &grammar{
 		},
 		{
 			name: "Operators",
-			pos:  position{line: 184, col: 1, offset: 5421},
+			pos:  position{line: 184, col: 1, offset: 5397},
 			expr: &choiceExpr{
-				pos: position{line: 184, col: 13, offset: 5433},
+				pos: position{line: 184, col: 13, offset: 5409},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 184, col: 13, offset: 5433},
+						pos:        position{line: 184, col: 13, offset: 5409},
 						val:        "-",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 19, offset: 5439},
+						pos:        position{line: 184, col: 19, offset: 5415},
 						val:        "+",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 25, offset: 5445},
+						pos:        position{line: 184, col: 25, offset: 5421},
 						val:        "*",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 31, offset: 5451},
+						pos:        position{line: 184, col: 31, offset: 5427},
 						val:        "%",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 37, offset: 5457},
+						pos:        position{line: 184, col: 37, offset: 5433},
 						val:        "/",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 43, offset: 5463},
+						pos:        position{line: 184, col: 43, offset: 5439},
 						val:        "==",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 50, offset: 5470},
+						pos:        position{line: 184, col: 50, offset: 5446},
 						val:        "!=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 57, offset: 5477},
+						pos:        position{line: 184, col: 57, offset: 5453},
 						val:        "<=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 64, offset: 5484},
+						pos:        position{line: 184, col: 64, offset: 5460},
 						val:        "<",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 70, offset: 5490},
+						pos:        position{line: 184, col: 70, offset: 5466},
 						val:        ">=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 77, offset: 5497},
+						pos:        position{line: 184, col: 77, offset: 5473},
 						val:        ">",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 83, offset: 5503},
+						pos:        position{line: 184, col: 83, offset: 5479},
 						val:        "=~",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 90, offset: 5510},
+						pos:        position{line: 184, col: 90, offset: 5486},
 						val:        "!~",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 97, offset: 5517},
+						pos:        position{line: 184, col: 97, offset: 5493},
 						val:        "^",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 184, col: 103, offset: 5523},
+						pos:        position{line: 184, col: 103, offset: 5499},
 						val:        "=",
 						ignoreCase: false,
 					},
@@ -1770,42 +1770,42 @@ var g = &grammar{
 		},
 		{
 			name: "LabelOperators",
-			pos:  position{line: 186, col: 1, offset: 5528},
+			pos:  position{line: 186, col: 1, offset: 5504},
 			expr: &choiceExpr{
-				pos: position{line: 186, col: 19, offset: 5546},
+				pos: position{line: 186, col: 19, offset: 5522},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 186, col: 19, offset: 5546},
+						pos: position{line: 186, col: 19, offset: 5522},
 						run: (*parser).callonLabelOperators2,
 						expr: &litMatcher{
-							pos:        position{line: 186, col: 19, offset: 5546},
+							pos:        position{line: 186, col: 19, offset: 5522},
 							val:        "!=",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 188, col: 5, offset: 5582},
+						pos: position{line: 188, col: 5, offset: 5558},
 						run: (*parser).callonLabelOperators4,
 						expr: &litMatcher{
-							pos:        position{line: 188, col: 5, offset: 5582},
+							pos:        position{line: 188, col: 5, offset: 5558},
 							val:        "=~",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 190, col: 5, offset: 5620},
+						pos: position{line: 190, col: 5, offset: 5596},
 						run: (*parser).callonLabelOperators6,
 						expr: &litMatcher{
-							pos:        position{line: 190, col: 5, offset: 5620},
+							pos:        position{line: 190, col: 5, offset: 5596},
 							val:        "!~",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 192, col: 5, offset: 5660},
+						pos: position{line: 192, col: 5, offset: 5636},
 						run: (*parser).callonLabelOperators8,
 						expr: &litMatcher{
-							pos:        position{line: 192, col: 5, offset: 5660},
+							pos:        position{line: 192, col: 5, offset: 5636},
 							val:        "=",
 							ignoreCase: false,
 						},
@@ -1815,57 +1815,57 @@ var g = &grammar{
 		},
 		{
 			name: "Label",
-			pos:  position{line: 196, col: 1, offset: 5691},
+			pos:  position{line: 196, col: 1, offset: 5667},
 			expr: &ruleRefExpr{
-				pos:  position{line: 196, col: 9, offset: 5699},
+				pos:  position{line: 196, col: 9, offset: 5675},
 				name: "Identifier",
 			},
 		},
 		{
 			name: "LabelMatch",
-			pos:  position{line: 197, col: 1, offset: 5710},
+			pos:  position{line: 197, col: 1, offset: 5686},
 			expr: &actionExpr{
-				pos: position{line: 197, col: 14, offset: 5723},
+				pos: position{line: 197, col: 14, offset: 5699},
 				run: (*parser).callonLabelMatch1,
 				expr: &seqExpr{
-					pos: position{line: 197, col: 14, offset: 5723},
+					pos: position{line: 197, col: 14, offset: 5699},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 197, col: 14, offset: 5723},
+							pos:   position{line: 197, col: 14, offset: 5699},
 							label: "label",
 							expr: &ruleRefExpr{
-								pos:  position{line: 197, col: 20, offset: 5729},
+								pos:  position{line: 197, col: 20, offset: 5705},
 								name: "Label",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 197, col: 26, offset: 5735},
+							pos:  position{line: 197, col: 26, offset: 5711},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 197, col: 29, offset: 5738},
+							pos:   position{line: 197, col: 29, offset: 5714},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 197, col: 32, offset: 5741},
+								pos:  position{line: 197, col: 32, offset: 5717},
 								name: "LabelOperators",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 197, col: 47, offset: 5756},
+							pos:  position{line: 197, col: 47, offset: 5732},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 197, col: 50, offset: 5759},
+							pos:   position{line: 197, col: 50, offset: 5735},
 							label: "match",
 							expr: &choiceExpr{
-								pos: position{line: 197, col: 58, offset: 5767},
+								pos: position{line: 197, col: 58, offset: 5743},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 197, col: 58, offset: 5767},
+										pos:  position{line: 197, col: 58, offset: 5743},
 										name: "StringLiteral",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 197, col: 74, offset: 5783},
+										pos:  position{line: 197, col: 74, offset: 5759},
 										name: "Number",
 									},
 								},
@@ -1877,32 +1877,32 @@ var g = &grammar{
 		},
 		{
 			name: "LabelMatches",
-			pos:  position{line: 200, col: 1, offset: 5873},
+			pos:  position{line: 200, col: 1, offset: 5849},
 			expr: &actionExpr{
-				pos: position{line: 200, col: 16, offset: 5888},
+				pos: position{line: 200, col: 16, offset: 5864},
 				run: (*parser).callonLabelMatches1,
 				expr: &seqExpr{
-					pos: position{line: 200, col: 16, offset: 5888},
+					pos: position{line: 200, col: 16, offset: 5864},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 200, col: 16, offset: 5888},
+							pos:   position{line: 200, col: 16, offset: 5864},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 200, col: 22, offset: 5894},
+								pos:  position{line: 200, col: 22, offset: 5870},
 								name: "LabelMatch",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 33, offset: 5905},
+							pos:  position{line: 200, col: 33, offset: 5881},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 200, col: 36, offset: 5908},
+							pos:   position{line: 200, col: 36, offset: 5884},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 200, col: 41, offset: 5913},
+								pos: position{line: 200, col: 41, offset: 5889},
 								expr: &ruleRefExpr{
-									pos:  position{line: 200, col: 41, offset: 5913},
+									pos:  position{line: 200, col: 41, offset: 5889},
 									name: "LabelMatchesRest",
 								},
 							},
@@ -1913,27 +1913,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelMatchesRest",
-			pos:  position{line: 204, col: 1, offset: 5992},
+			pos:  position{line: 204, col: 1, offset: 5968},
 			expr: &actionExpr{
-				pos: position{line: 204, col: 21, offset: 6012},
+				pos: position{line: 204, col: 21, offset: 5988},
 				run: (*parser).callonLabelMatchesRest1,
 				expr: &seqExpr{
-					pos: position{line: 204, col: 21, offset: 6012},
+					pos: position{line: 204, col: 21, offset: 5988},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 204, col: 21, offset: 6012},
+							pos:        position{line: 204, col: 21, offset: 5988},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 204, col: 25, offset: 6016},
+							pos:  position{line: 204, col: 25, offset: 5992},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 204, col: 28, offset: 6019},
+							pos:   position{line: 204, col: 28, offset: 5995},
 							label: "match",
 							expr: &ruleRefExpr{
-								pos:  position{line: 204, col: 34, offset: 6025},
+								pos:  position{line: 204, col: 34, offset: 6001},
 								name: "LabelMatch",
 							},
 						},
@@ -1943,27 +1943,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelList",
-			pos:  position{line: 208, col: 1, offset: 6063},
+			pos:  position{line: 208, col: 1, offset: 6039},
 			expr: &choiceExpr{
-				pos: position{line: 208, col: 13, offset: 6075},
+				pos: position{line: 208, col: 13, offset: 6051},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 208, col: 13, offset: 6075},
+						pos: position{line: 208, col: 13, offset: 6051},
 						run: (*parser).callonLabelList2,
 						expr: &seqExpr{
-							pos: position{line: 208, col: 14, offset: 6076},
+							pos: position{line: 208, col: 14, offset: 6052},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 208, col: 14, offset: 6076},
+									pos:        position{line: 208, col: 14, offset: 6052},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 208, col: 18, offset: 6080},
+									pos:  position{line: 208, col: 18, offset: 6056},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 208, col: 21, offset: 6083},
+									pos:        position{line: 208, col: 21, offset: 6059},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -1971,49 +1971,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 210, col: 6, offset: 6115},
+						pos: position{line: 210, col: 6, offset: 6103},
 						run: (*parser).callonLabelList7,
 						expr: &seqExpr{
-							pos: position{line: 210, col: 6, offset: 6115},
+							pos: position{line: 210, col: 6, offset: 6103},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 210, col: 6, offset: 6115},
+									pos:        position{line: 210, col: 6, offset: 6103},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 210, col: 10, offset: 6119},
+									pos:  position{line: 210, col: 10, offset: 6107},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 210, col: 13, offset: 6122},
+									pos:   position{line: 210, col: 13, offset: 6110},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 210, col: 19, offset: 6128},
+										pos:  position{line: 210, col: 19, offset: 6116},
 										name: "Label",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 210, col: 25, offset: 6134},
+									pos:  position{line: 210, col: 25, offset: 6122},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 210, col: 28, offset: 6137},
+									pos:   position{line: 210, col: 28, offset: 6125},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 210, col: 33, offset: 6142},
+										pos: position{line: 210, col: 33, offset: 6130},
 										expr: &ruleRefExpr{
-											pos:  position{line: 210, col: 33, offset: 6142},
+											pos:  position{line: 210, col: 33, offset: 6130},
 											name: "LabelListRest",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 210, col: 48, offset: 6157},
+									pos:  position{line: 210, col: 48, offset: 6145},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 210, col: 51, offset: 6160},
+									pos:        position{line: 210, col: 51, offset: 6148},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2025,27 +2025,27 @@ var g = &grammar{
 		},
 		{
 			name: "LabelListRest",
-			pos:  position{line: 214, col: 1, offset: 6226},
+			pos:  position{line: 214, col: 1, offset: 6214},
 			expr: &actionExpr{
-				pos: position{line: 214, col: 18, offset: 6243},
+				pos: position{line: 214, col: 18, offset: 6231},
 				run: (*parser).callonLabelListRest1,
 				expr: &seqExpr{
-					pos: position{line: 214, col: 18, offset: 6243},
+					pos: position{line: 214, col: 18, offset: 6231},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 214, col: 18, offset: 6243},
+							pos:        position{line: 214, col: 18, offset: 6231},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 214, col: 22, offset: 6247},
+							pos:  position{line: 214, col: 22, offset: 6235},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 214, col: 25, offset: 6250},
+							pos:   position{line: 214, col: 25, offset: 6238},
 							label: "label",
 							expr: &ruleRefExpr{
-								pos:  position{line: 214, col: 31, offset: 6256},
+								pos:  position{line: 214, col: 31, offset: 6244},
 								name: "Label",
 							},
 						},
@@ -2055,62 +2055,62 @@ var g = &grammar{
 		},
 		{
 			name: "VectorSelector",
-			pos:  position{line: 218, col: 1, offset: 6289},
+			pos:  position{line: 218, col: 1, offset: 6277},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 18, offset: 6306},
+				pos: position{line: 218, col: 18, offset: 6294},
 				run: (*parser).callonVectorSelector1,
 				expr: &seqExpr{
-					pos: position{line: 218, col: 18, offset: 6306},
+					pos: position{line: 218, col: 18, offset: 6294},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 218, col: 18, offset: 6306},
+							pos:   position{line: 218, col: 18, offset: 6294},
 							label: "metric",
 							expr: &ruleRefExpr{
-								pos:  position{line: 218, col: 25, offset: 6313},
+								pos:  position{line: 218, col: 25, offset: 6301},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 36, offset: 6324},
+							pos:  position{line: 218, col: 36, offset: 6312},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 40, offset: 6328},
+							pos:   position{line: 218, col: 40, offset: 6316},
 							label: "block",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 218, col: 46, offset: 6334},
+								pos: position{line: 218, col: 46, offset: 6322},
 								expr: &ruleRefExpr{
-									pos:  position{line: 218, col: 46, offset: 6334},
+									pos:  position{line: 218, col: 46, offset: 6322},
 									name: "LabelBlock",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 58, offset: 6346},
+							pos:  position{line: 218, col: 58, offset: 6334},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 61, offset: 6349},
+							pos:   position{line: 218, col: 61, offset: 6337},
 							label: "rng",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 218, col: 65, offset: 6353},
+								pos: position{line: 218, col: 65, offset: 6341},
 								expr: &ruleRefExpr{
-									pos:  position{line: 218, col: 65, offset: 6353},
+									pos:  position{line: 218, col: 65, offset: 6341},
 									name: "Range",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 72, offset: 6360},
+							pos:  position{line: 218, col: 72, offset: 6348},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 75, offset: 6363},
+							pos:   position{line: 218, col: 75, offset: 6351},
 							label: "offset",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 218, col: 82, offset: 6370},
+								pos: position{line: 218, col: 82, offset: 6358},
 								expr: &ruleRefExpr{
-									pos:  position{line: 218, col: 82, offset: 6370},
+									pos:  position{line: 218, col: 82, offset: 6358},
 									name: "Offset",
 								},
 							},
@@ -2121,36 +2121,36 @@ var g = &grammar{
 		},
 		{
 			name: "Range",
-			pos:  position{line: 222, col: 1, offset: 6448},
+			pos:  position{line: 222, col: 1, offset: 6436},
 			expr: &actionExpr{
-				pos: position{line: 222, col: 9, offset: 6456},
+				pos: position{line: 222, col: 9, offset: 6444},
 				run: (*parser).callonRange1,
 				expr: &seqExpr{
-					pos: position{line: 222, col: 9, offset: 6456},
+					pos: position{line: 222, col: 9, offset: 6444},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 222, col: 9, offset: 6456},
+							pos:        position{line: 222, col: 9, offset: 6444},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 222, col: 13, offset: 6460},
+							pos:  position{line: 222, col: 13, offset: 6448},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 222, col: 16, offset: 6463},
+							pos:   position{line: 222, col: 16, offset: 6451},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 222, col: 20, offset: 6467},
+								pos:  position{line: 222, col: 20, offset: 6455},
 								name: "Duration",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 222, col: 29, offset: 6476},
+							pos:  position{line: 222, col: 29, offset: 6464},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 222, col: 32, offset: 6479},
+							pos:        position{line: 222, col: 32, offset: 6467},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2160,27 +2160,27 @@ var g = &grammar{
 		},
 		{
 			name: "Offset",
-			pos:  position{line: 226, col: 1, offset: 6508},
+			pos:  position{line: 226, col: 1, offset: 6496},
 			expr: &actionExpr{
-				pos: position{line: 226, col: 10, offset: 6517},
+				pos: position{line: 226, col: 10, offset: 6505},
 				run: (*parser).callonOffset1,
 				expr: &seqExpr{
-					pos: position{line: 226, col: 10, offset: 6517},
+					pos: position{line: 226, col: 10, offset: 6505},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 226, col: 10, offset: 6517},
+							pos:        position{line: 226, col: 10, offset: 6505},
 							val:        "offset",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 226, col: 20, offset: 6527},
+							pos:  position{line: 226, col: 20, offset: 6515},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 226, col: 23, offset: 6530},
+							pos:   position{line: 226, col: 23, offset: 6518},
 							label: "dur",
 							expr: &ruleRefExpr{
-								pos:  position{line: 226, col: 27, offset: 6534},
+								pos:  position{line: 226, col: 27, offset: 6522},
 								name: "Duration",
 							},
 						},
@@ -2190,12 +2190,12 @@ var g = &grammar{
 		},
 		{
 			name: "CountValueOperator",
-			pos:  position{line: 230, col: 1, offset: 6568},
+			pos:  position{line: 230, col: 1, offset: 6556},
 			expr: &actionExpr{
-				pos: position{line: 230, col: 22, offset: 6589},
+				pos: position{line: 230, col: 22, offset: 6577},
 				run: (*parser).callonCountValueOperator1,
 				expr: &litMatcher{
-					pos:        position{line: 230, col: 22, offset: 6589},
+					pos:        position{line: 230, col: 22, offset: 6577},
 					val:        "count_values",
 					ignoreCase: true,
 				},
@@ -2203,28 +2203,28 @@ var g = &grammar{
 		},
 		{
 			name: "BinaryAggregateOperators",
-			pos:  position{line: 236, col: 1, offset: 6674},
+			pos:  position{line: 236, col: 1, offset: 6662},
 			expr: &actionExpr{
-				pos: position{line: 236, col: 29, offset: 6702},
+				pos: position{line: 236, col: 29, offset: 6690},
 				run: (*parser).callonBinaryAggregateOperators1,
 				expr: &labeledExpr{
-					pos:   position{line: 236, col: 29, offset: 6702},
+					pos:   position{line: 236, col: 29, offset: 6690},
 					label: "op",
 					expr: &choiceExpr{
-						pos: position{line: 236, col: 33, offset: 6706},
+						pos: position{line: 236, col: 33, offset: 6694},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 236, col: 33, offset: 6706},
+								pos:        position{line: 236, col: 33, offset: 6694},
 								val:        "topk",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 236, col: 43, offset: 6716},
+								pos:        position{line: 236, col: 43, offset: 6704},
 								val:        "bottomk",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 236, col: 56, offset: 6729},
+								pos:        position{line: 236, col: 56, offset: 6717},
 								val:        "quantile",
 								ignoreCase: true,
 							},
@@ -2235,48 +2235,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryAggregateOperators",
-			pos:  position{line: 242, col: 1, offset: 6831},
+			pos:  position{line: 242, col: 1, offset: 6819},
 			expr: &actionExpr{
-				pos: position{line: 242, col: 27, offset: 6857},
+				pos: position{line: 242, col: 27, offset: 6845},
 				run: (*parser).callonUnaryAggregateOperators1,
 				expr: &labeledExpr{
-					pos:   position{line: 242, col: 27, offset: 6857},
+					pos:   position{line: 242, col: 27, offset: 6845},
 					label: "op",
 					expr: &choiceExpr{
-						pos: position{line: 242, col: 31, offset: 6861},
+						pos: position{line: 242, col: 31, offset: 6849},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 242, col: 31, offset: 6861},
+								pos:        position{line: 242, col: 31, offset: 6849},
 								val:        "sum",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 242, col: 40, offset: 6870},
+								pos:        position{line: 242, col: 40, offset: 6858},
 								val:        "min",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 242, col: 49, offset: 6879},
+								pos:        position{line: 242, col: 49, offset: 6867},
 								val:        "max",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 242, col: 58, offset: 6888},
+								pos:        position{line: 242, col: 58, offset: 6876},
 								val:        "avg",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 242, col: 67, offset: 6897},
+								pos:        position{line: 242, col: 67, offset: 6885},
 								val:        "stddev",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 242, col: 79, offset: 6909},
+								pos:        position{line: 242, col: 79, offset: 6897},
 								val:        "stdvar",
 								ignoreCase: true,
 							},
 							&litMatcher{
-								pos:        position{line: 242, col: 91, offset: 6921},
+								pos:        position{line: 242, col: 91, offset: 6909},
 								val:        "count",
 								ignoreCase: true,
 							},
@@ -2287,20 +2287,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateOperators",
-			pos:  position{line: 248, col: 1, offset: 7020},
+			pos:  position{line: 248, col: 1, offset: 7008},
 			expr: &choiceExpr{
-				pos: position{line: 248, col: 22, offset: 7041},
+				pos: position{line: 248, col: 22, offset: 7029},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 248, col: 22, offset: 7041},
+						pos:  position{line: 248, col: 22, offset: 7029},
 						name: "CountValueOperator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 248, col: 43, offset: 7062},
+						pos:  position{line: 248, col: 43, offset: 7050},
 						name: "BinaryAggregateOperators",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 248, col: 70, offset: 7089},
+						pos:  position{line: 248, col: 70, offset: 7077},
 						name: "UnaryAggregateOperators",
 					},
 				},
@@ -2308,41 +2308,41 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateBy",
-			pos:  position{line: 250, col: 1, offset: 7114},
+			pos:  position{line: 250, col: 1, offset: 7102},
 			expr: &actionExpr{
-				pos: position{line: 250, col: 15, offset: 7128},
+				pos: position{line: 250, col: 15, offset: 7116},
 				run: (*parser).callonAggregateBy1,
 				expr: &seqExpr{
-					pos: position{line: 250, col: 15, offset: 7128},
+					pos: position{line: 250, col: 15, offset: 7116},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 250, col: 15, offset: 7128},
+							pos:        position{line: 250, col: 15, offset: 7116},
 							val:        "by",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 250, col: 21, offset: 7134},
+							pos:  position{line: 250, col: 21, offset: 7122},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 250, col: 24, offset: 7137},
+							pos:   position{line: 250, col: 24, offset: 7125},
 							label: "labels",
 							expr: &ruleRefExpr{
-								pos:  position{line: 250, col: 31, offset: 7144},
+								pos:  position{line: 250, col: 31, offset: 7132},
 								name: "LabelList",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 250, col: 41, offset: 7154},
+							pos:  position{line: 250, col: 41, offset: 7142},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 250, col: 44, offset: 7157},
+							pos:   position{line: 250, col: 44, offset: 7145},
 							label: "keep",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 250, col: 49, offset: 7162},
+								pos: position{line: 250, col: 49, offset: 7150},
 								expr: &litMatcher{
-									pos:        position{line: 250, col: 49, offset: 7162},
+									pos:        position{line: 250, col: 49, offset: 7150},
 									val:        "keep_common",
 									ignoreCase: true,
 								},
@@ -2354,27 +2354,27 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateWithout",
-			pos:  position{line: 258, col: 1, offset: 7308},
+			pos:  position{line: 258, col: 1, offset: 7296},
 			expr: &actionExpr{
-				pos: position{line: 258, col: 20, offset: 7327},
+				pos: position{line: 258, col: 20, offset: 7315},
 				run: (*parser).callonAggregateWithout1,
 				expr: &seqExpr{
-					pos: position{line: 258, col: 20, offset: 7327},
+					pos: position{line: 258, col: 20, offset: 7315},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 258, col: 20, offset: 7327},
+							pos:        position{line: 258, col: 20, offset: 7315},
 							val:        "without",
 							ignoreCase: true,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 258, col: 31, offset: 7338},
+							pos:  position{line: 258, col: 31, offset: 7326},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 258, col: 34, offset: 7341},
+							pos:   position{line: 258, col: 34, offset: 7329},
 							label: "labels",
 							expr: &ruleRefExpr{
-								pos:  position{line: 258, col: 41, offset: 7348},
+								pos:  position{line: 258, col: 41, offset: 7336},
 								name: "LabelList",
 							},
 						},
@@ -2384,16 +2384,16 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateGroup",
-			pos:  position{line: 265, col: 1, offset: 7460},
+			pos:  position{line: 265, col: 1, offset: 7448},
 			expr: &choiceExpr{
-				pos: position{line: 265, col: 18, offset: 7477},
+				pos: position{line: 265, col: 18, offset: 7465},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 265, col: 18, offset: 7477},
+						pos:  position{line: 265, col: 18, offset: 7465},
 						name: "AggregateBy",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 265, col: 32, offset: 7491},
+						pos:  position{line: 265, col: 32, offset: 7479},
 						name: "AggregateWithout",
 					},
 				},
@@ -2401,86 +2401,86 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateExpression",
-			pos:  position{line: 267, col: 1, offset: 7509},
+			pos:  position{line: 267, col: 1, offset: 7497},
 			expr: &choiceExpr{
-				pos: position{line: 268, col: 1, offset: 7531},
+				pos: position{line: 268, col: 1, offset: 7519},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 268, col: 1, offset: 7531},
+						pos: position{line: 268, col: 1, offset: 7519},
 						run: (*parser).callonAggregateExpression2,
 						expr: &seqExpr{
-							pos: position{line: 268, col: 1, offset: 7531},
+							pos: position{line: 268, col: 1, offset: 7519},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 268, col: 1, offset: 7531},
+									pos:   position{line: 268, col: 1, offset: 7519},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 268, col: 4, offset: 7534},
+										pos:  position{line: 268, col: 4, offset: 7522},
 										name: "CountValueOperator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 268, col: 24, offset: 7554},
+									pos:  position{line: 268, col: 24, offset: 7542},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 268, col: 27, offset: 7557},
+									pos:        position{line: 268, col: 27, offset: 7545},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 268, col: 31, offset: 7561},
+									pos:  position{line: 268, col: 31, offset: 7549},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 268, col: 34, offset: 7564},
+									pos:   position{line: 268, col: 34, offset: 7552},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 268, col: 40, offset: 7570},
+										pos:  position{line: 268, col: 40, offset: 7558},
 										name: "StringLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 268, col: 54, offset: 7584},
+									pos:  position{line: 268, col: 54, offset: 7572},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 268, col: 57, offset: 7587},
+									pos:        position{line: 268, col: 57, offset: 7575},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 268, col: 61, offset: 7591},
+									pos:  position{line: 268, col: 61, offset: 7579},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 268, col: 64, offset: 7594},
+									pos:   position{line: 268, col: 64, offset: 7582},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 268, col: 71, offset: 7601},
-										name: "VectorSelector",
+										pos:  position{line: 268, col: 71, offset: 7589},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 268, col: 86, offset: 7616},
+									pos:  position{line: 268, col: 82, offset: 7600},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 268, col: 89, offset: 7619},
+									pos:        position{line: 268, col: 85, offset: 7603},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 268, col: 93, offset: 7623},
+									pos:  position{line: 268, col: 89, offset: 7607},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 268, col: 96, offset: 7626},
+									pos:   position{line: 268, col: 92, offset: 7610},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 268, col: 102, offset: 7632},
+										pos: position{line: 268, col: 98, offset: 7616},
 										expr: &ruleRefExpr{
-											pos:  position{line: 268, col: 102, offset: 7632},
+											pos:  position{line: 268, col: 98, offset: 7616},
 											name: "AggregateGroup",
 										},
 									},
@@ -2489,82 +2489,82 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 274, col: 1, offset: 7780},
+						pos: position{line: 274, col: 1, offset: 7765},
 						run: (*parser).callonAggregateExpression22,
 						expr: &seqExpr{
-							pos: position{line: 274, col: 1, offset: 7780},
+							pos: position{line: 274, col: 1, offset: 7765},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 274, col: 1, offset: 7780},
+									pos:   position{line: 274, col: 1, offset: 7765},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 274, col: 4, offset: 7783},
+										pos:  position{line: 274, col: 4, offset: 7768},
 										name: "CountValueOperator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 274, col: 24, offset: 7803},
+									pos:  position{line: 274, col: 24, offset: 7788},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 274, col: 27, offset: 7806},
+									pos:   position{line: 274, col: 27, offset: 7791},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 274, col: 33, offset: 7812},
+										pos: position{line: 274, col: 33, offset: 7797},
 										expr: &ruleRefExpr{
-											pos:  position{line: 274, col: 33, offset: 7812},
+											pos:  position{line: 274, col: 33, offset: 7797},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 274, col: 49, offset: 7828},
+									pos:  position{line: 274, col: 49, offset: 7813},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 274, col: 52, offset: 7831},
+									pos:        position{line: 274, col: 52, offset: 7816},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 274, col: 56, offset: 7835},
+									pos:  position{line: 274, col: 56, offset: 7820},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 274, col: 59, offset: 7838},
+									pos:   position{line: 274, col: 59, offset: 7823},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 274, col: 65, offset: 7844},
+										pos:  position{line: 274, col: 65, offset: 7829},
 										name: "StringLiteral",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 274, col: 79, offset: 7858},
+									pos:  position{line: 274, col: 79, offset: 7843},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 274, col: 82, offset: 7861},
+									pos:        position{line: 274, col: 82, offset: 7846},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 274, col: 86, offset: 7865},
+									pos:  position{line: 274, col: 86, offset: 7850},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 274, col: 89, offset: 7868},
+									pos:   position{line: 274, col: 89, offset: 7853},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 274, col: 96, offset: 7875},
-										name: "VectorSelector",
+										pos:  position{line: 274, col: 96, offset: 7860},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 274, col: 111, offset: 7890},
+									pos:  position{line: 274, col: 107, offset: 7871},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 274, col: 114, offset: 7893},
+									pos:        position{line: 274, col: 110, offset: 7874},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2572,81 +2572,81 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 280, col: 1, offset: 8029},
+						pos: position{line: 280, col: 1, offset: 8011},
 						run: (*parser).callonAggregateExpression42,
 						expr: &seqExpr{
-							pos: position{line: 280, col: 1, offset: 8029},
+							pos: position{line: 280, col: 1, offset: 8011},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 280, col: 1, offset: 8029},
+									pos:   position{line: 280, col: 1, offset: 8011},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 280, col: 4, offset: 8032},
+										pos:  position{line: 280, col: 4, offset: 8014},
 										name: "BinaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 30, offset: 8058},
+									pos:  position{line: 280, col: 30, offset: 8040},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 280, col: 33, offset: 8061},
+									pos:        position{line: 280, col: 33, offset: 8043},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 37, offset: 8065},
+									pos:  position{line: 280, col: 37, offset: 8047},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 280, col: 41, offset: 8069},
+									pos:   position{line: 280, col: 41, offset: 8051},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 280, col: 47, offset: 8075},
+										pos:  position{line: 280, col: 47, offset: 8057},
 										name: "Number",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 54, offset: 8082},
+									pos:  position{line: 280, col: 54, offset: 8064},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 280, col: 57, offset: 8085},
+									pos:        position{line: 280, col: 57, offset: 8067},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 61, offset: 8089},
+									pos:  position{line: 280, col: 61, offset: 8071},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 280, col: 64, offset: 8092},
+									pos:   position{line: 280, col: 64, offset: 8074},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 280, col: 71, offset: 8099},
-										name: "VectorSelector",
+										pos:  position{line: 280, col: 71, offset: 8081},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 86, offset: 8114},
+									pos:  position{line: 280, col: 82, offset: 8092},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 280, col: 89, offset: 8117},
+									pos:        position{line: 280, col: 85, offset: 8095},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 93, offset: 8121},
+									pos:  position{line: 280, col: 89, offset: 8099},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 280, col: 96, offset: 8124},
+									pos:   position{line: 280, col: 92, offset: 8102},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 280, col: 102, offset: 8130},
+										pos: position{line: 280, col: 98, offset: 8108},
 										expr: &ruleRefExpr{
-											pos:  position{line: 280, col: 102, offset: 8130},
+											pos:  position{line: 280, col: 98, offset: 8108},
 											name: "AggregateGroup",
 										},
 									},
@@ -2655,82 +2655,82 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 286, col: 1, offset: 8271},
+						pos: position{line: 286, col: 1, offset: 8250},
 						run: (*parser).callonAggregateExpression62,
 						expr: &seqExpr{
-							pos: position{line: 286, col: 1, offset: 8271},
+							pos: position{line: 286, col: 1, offset: 8250},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 286, col: 1, offset: 8271},
+									pos:   position{line: 286, col: 1, offset: 8250},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 286, col: 4, offset: 8274},
+										pos:  position{line: 286, col: 4, offset: 8253},
 										name: "BinaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 30, offset: 8300},
+									pos:  position{line: 286, col: 30, offset: 8279},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 286, col: 33, offset: 8303},
+									pos:   position{line: 286, col: 33, offset: 8282},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 286, col: 39, offset: 8309},
+										pos: position{line: 286, col: 39, offset: 8288},
 										expr: &ruleRefExpr{
-											pos:  position{line: 286, col: 39, offset: 8309},
+											pos:  position{line: 286, col: 39, offset: 8288},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 55, offset: 8325},
+									pos:  position{line: 286, col: 55, offset: 8304},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 286, col: 58, offset: 8328},
+									pos:        position{line: 286, col: 58, offset: 8307},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 62, offset: 8332},
+									pos:  position{line: 286, col: 62, offset: 8311},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 286, col: 66, offset: 8336},
+									pos:   position{line: 286, col: 66, offset: 8315},
 									label: "param",
 									expr: &ruleRefExpr{
-										pos:  position{line: 286, col: 72, offset: 8342},
+										pos:  position{line: 286, col: 72, offset: 8321},
 										name: "Number",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 79, offset: 8349},
+									pos:  position{line: 286, col: 79, offset: 8328},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 286, col: 82, offset: 8352},
+									pos:        position{line: 286, col: 82, offset: 8331},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 86, offset: 8356},
+									pos:  position{line: 286, col: 86, offset: 8335},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 286, col: 89, offset: 8359},
+									pos:   position{line: 286, col: 89, offset: 8338},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 286, col: 96, offset: 8366},
-										name: "VectorSelector",
+										pos:  position{line: 286, col: 96, offset: 8345},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 111, offset: 8381},
+									pos:  position{line: 286, col: 107, offset: 8356},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 286, col: 114, offset: 8384},
+									pos:        position{line: 286, col: 110, offset: 8359},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2738,60 +2738,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 292, col: 1, offset: 8513},
+						pos: position{line: 292, col: 1, offset: 8489},
 						run: (*parser).callonAggregateExpression82,
 						expr: &seqExpr{
-							pos: position{line: 292, col: 1, offset: 8513},
+							pos: position{line: 292, col: 1, offset: 8489},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 292, col: 1, offset: 8513},
+									pos:   position{line: 292, col: 1, offset: 8489},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 292, col: 4, offset: 8516},
+										pos:  position{line: 292, col: 4, offset: 8492},
 										name: "UnaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 292, col: 29, offset: 8541},
+									pos:  position{line: 292, col: 29, offset: 8517},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 292, col: 32, offset: 8544},
+									pos:        position{line: 292, col: 32, offset: 8520},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 292, col: 36, offset: 8548},
+									pos:  position{line: 292, col: 36, offset: 8524},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 292, col: 39, offset: 8551},
+									pos:   position{line: 292, col: 39, offset: 8527},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 292, col: 46, offset: 8558},
-										name: "VectorSelector",
+										pos:  position{line: 292, col: 46, offset: 8534},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 292, col: 61, offset: 8573},
+									pos:  position{line: 292, col: 57, offset: 8545},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 292, col: 64, offset: 8576},
+									pos:        position{line: 292, col: 60, offset: 8548},
 									val:        ")",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 292, col: 68, offset: 8580},
+									pos:  position{line: 292, col: 64, offset: 8552},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 292, col: 71, offset: 8583},
+									pos:   position{line: 292, col: 67, offset: 8555},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 292, col: 77, offset: 8589},
+										pos: position{line: 292, col: 73, offset: 8561},
 										expr: &ruleRefExpr{
-											pos:  position{line: 292, col: 77, offset: 8589},
+											pos:  position{line: 292, col: 73, offset: 8561},
 											name: "AggregateGroup",
 										},
 									},
@@ -2800,61 +2800,61 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 296, col: 1, offset: 8682},
+						pos: position{line: 296, col: 1, offset: 8655},
 						run: (*parser).callonAggregateExpression97,
 						expr: &seqExpr{
-							pos: position{line: 296, col: 1, offset: 8682},
+							pos: position{line: 296, col: 1, offset: 8655},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 296, col: 1, offset: 8682},
+									pos:   position{line: 296, col: 1, offset: 8655},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 296, col: 4, offset: 8685},
+										pos:  position{line: 296, col: 4, offset: 8658},
 										name: "UnaryAggregateOperators",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 296, col: 29, offset: 8710},
+									pos:  position{line: 296, col: 29, offset: 8683},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 296, col: 32, offset: 8713},
+									pos:   position{line: 296, col: 32, offset: 8686},
 									label: "group",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 296, col: 38, offset: 8719},
+										pos: position{line: 296, col: 38, offset: 8692},
 										expr: &ruleRefExpr{
-											pos:  position{line: 296, col: 38, offset: 8719},
+											pos:  position{line: 296, col: 38, offset: 8692},
 											name: "AggregateGroup",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 296, col: 54, offset: 8735},
+									pos:  position{line: 296, col: 54, offset: 8708},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 296, col: 57, offset: 8738},
+									pos:        position{line: 296, col: 57, offset: 8711},
 									val:        "(",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 296, col: 61, offset: 8742},
+									pos:  position{line: 296, col: 61, offset: 8715},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 296, col: 64, offset: 8745},
+									pos:   position{line: 296, col: 64, offset: 8718},
 									label: "vector",
 									expr: &ruleRefExpr{
-										pos:  position{line: 296, col: 71, offset: 8752},
-										name: "VectorSelector",
+										pos:  position{line: 296, col: 71, offset: 8725},
+										name: "Expression",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 296, col: 86, offset: 8767},
+									pos:  position{line: 296, col: 82, offset: 8736},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 296, col: 89, offset: 8770},
+									pos:        position{line: 296, col: 85, offset: 8739},
 									val:        ")",
 									ignoreCase: false,
 								},
@@ -2865,110 +2865,326 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "__",
-			pos:  position{line: 300, col: 1, offset: 8850},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 300, col: 6, offset: 8855},
-				expr: &choiceExpr{
-					pos: position{line: 300, col: 8, offset: 8857},
-					alternatives: []interface{}{
+			name: "FunctionName",
+			pos:  position{line: 300, col: 1, offset: 8820},
+			expr: &actionExpr{
+				pos: position{line: 300, col: 16, offset: 8835},
+				run: (*parser).callonFunctionName1,
+				expr: &seqExpr{
+					pos: position{line: 300, col: 16, offset: 8835},
+					exprs: []interface{}{
+						&choiceExpr{
+							pos: position{line: 300, col: 18, offset: 8837},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 300, col: 18, offset: 8837},
+									val:        "rate",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 28, offset: 8847},
+									val:        "irate",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 39, offset: 8858},
+									val:        "increase",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 53, offset: 8872},
+									val:        "delta",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 64, offset: 8883},
+									val:        "avg_over_time",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 83, offset: 8902},
+									val:        "min_over_time",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 102, offset: 8921},
+									val:        "max_over_time",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 121, offset: 8940},
+									val:        "sum_over_time",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 300, col: 140, offset: 8959},
+									val:        "count_over_time",
+									ignoreCase: true,
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 300, col: 161, offset: 8980},
+							expr: &ruleRefExpr{
+								pos:  position{line: 300, col: 162, offset: 8981},
+								name: "IdentifierPart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionCall",
+			pos:  position{line: 304, col: 1, offset: 9049},
+			expr: &actionExpr{
+				pos: position{line: 304, col: 16, offset: 9064},
+				run: (*parser).callonFunctionCall1,
+				expr: &seqExpr{
+					pos: position{line: 304, col: 16, offset: 9064},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 304, col: 16, offset: 9064},
+							label: "fn",
+							expr: &ruleRefExpr{
+								pos:  position{line: 304, col: 19, offset: 9067},
+								name: "FunctionName",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 300, col: 8, offset: 8857},
-							name: "Whitespace",
+							pos:  position{line: 304, col: 32, offset: 9080},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 304, col: 35, offset: 9083},
+							val:        "(",
+							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 300, col: 21, offset: 8870},
-							name: "EOL",
+							pos:  position{line: 304, col: 39, offset: 9087},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 304, col: 42, offset: 9090},
+							label: "arg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 304, col: 46, offset: 9094},
+								name: "Expression",
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 300, col: 27, offset: 8876},
-							name: "Comment",
+							pos:  position{line: 304, col: 57, offset: 9105},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 304, col: 60, offset: 9108},
+							val:        ")",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "_",
-			pos:  position{line: 301, col: 1, offset: 8887},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 301, col: 5, offset: 8891},
-				expr: &ruleRefExpr{
-					pos:  position{line: 301, col: 5, offset: 8891},
-					name: "Whitespace",
+			name: "ParenExpression",
+			pos:  position{line: 308, col: 1, offset: 9175},
+			expr: &actionExpr{
+				pos: position{line: 308, col: 19, offset: 9193},
+				run: (*parser).callonParenExpression1,
+				expr: &seqExpr{
+					pos: position{line: 308, col: 19, offset: 9193},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 308, col: 19, offset: 9193},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 308, col: 23, offset: 9197},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 308, col: 26, offset: 9200},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 308, col: 31, offset: 9205},
+								name: "Expression",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 308, col: 42, offset: 9216},
+							name: "__",
+						},
+						&litMatcher{
+							pos:        position{line: 308, col: 45, offset: 9219},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "Whitespace",
-			pos:  position{line: 303, col: 1, offset: 8904},
-			expr: &charClassMatcher{
-				pos:        position{line: 303, col: 14, offset: 8917},
-				val:        "[ \\t\\r]",
-				chars:      []rune{' ', '\t', '\r'},
-				ignoreCase: false,
-				inverted:   false,
+			name: "PrimaryExpression",
+			pos:  position{line: 312, col: 1, offset: 9249},
+			expr: &choiceExpr{
+				pos: position{line: 312, col: 21, offset: 9269},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 312, col: 21, offset: 9269},
+						name: "ParenExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 312, col: 39, offset: 9287},
+						name: "AggregateExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 312, col: 61, offset: 9309},
+						name: "FunctionCall",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 312, col: 76, offset: 9324},
+						name: "Number",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 312, col: 85, offset: 9333},
+						name: "VectorSelector",
+					},
+				},
 			},
 		},
 		{
-			name: "EOL",
-			pos:  position{line: 304, col: 1, offset: 8925},
-			expr: &litMatcher{
-				pos:        position{line: 304, col: 7, offset: 8931},
-				val:        "\n",
-				ignoreCase: false,
+			name: "GroupModifier",
+			pos:  position{line: 314, col: 1, offset: 9349},
+			expr: &actionExpr{
+				pos: position{line: 314, col: 17, offset: 9365},
+				run: (*parser).callonGroupModifier1,
+				expr: &seqExpr{
+					pos: position{line: 314, col: 17, offset: 9365},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 314, col: 17, offset: 9365},
+							label: "side",
+							expr: &choiceExpr{
+								pos: position{line: 314, col: 24, offset: 9372},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 314, col: 24, offset: 9372},
+										val:        "group_left",
+										ignoreCase: true,
+									},
+									&litMatcher{
+										pos:        position{line: 314, col: 40, offset: 9388},
+										val:        "group_right",
+										ignoreCase: true,
+									},
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 314, col: 57, offset: 9405},
+							expr: &ruleRefExpr{
+								pos:  position{line: 314, col: 58, offset: 9406},
+								name: "IdentifierPart",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 314, col: 73, offset: 9421},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 314, col: 76, offset: 9424},
+							label: "labels",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 314, col: 83, offset: 9431},
+								expr: &ruleRefExpr{
+									pos:  position{line: 314, col: 83, offset: 9431},
+									name: "LabelList",
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "EOS",
-			pos:  position{line: 305, col: 1, offset: 8936},
-			expr: &choiceExpr{
-				pos: position{line: 305, col: 7, offset: 8942},
-				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 305, col: 7, offset: 8942},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 305, col: 7, offset: 8942},
-								name: "__",
-							},
-							&litMatcher{
-								pos:        position{line: 305, col: 10, offset: 8945},
-								val:        ";",
-								ignoreCase: false,
+			name: "VectorMatching",
+			pos:  position{line: 318, col: 1, offset: 9506},
+			expr: &actionExpr{
+				pos: position{line: 318, col: 18, offset: 9523},
+				run: (*parser).callonVectorMatching1,
+				expr: &seqExpr{
+					pos: position{line: 318, col: 18, offset: 9523},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 318, col: 18, offset: 9523},
+							label: "kind",
+							expr: &choiceExpr{
+								pos: position{line: 318, col: 25, offset: 9530},
+								alternatives: []interface{}{
+									&litMatcher{
+										pos:        position{line: 318, col: 25, offset: 9530},
+										val:        "on",
+										ignoreCase: true,
+									},
+									&litMatcher{
+										pos:        position{line: 318, col: 33, offset: 9538},
+										val:        "ignoring",
+										ignoreCase: true,
+									},
+								},
 							},
 						},
-					},
-					&seqExpr{
-						pos: position{line: 305, col: 16, offset: 8951},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 305, col: 16, offset: 8951},
-								name: "_",
+						&ruleRefExpr{
+							pos:  position{line: 318, col: 47, offset: 9552},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 318, col: 50, offset: 9555},
+							label: "labels",
+							expr: &ruleRefExpr{
+								pos:  position{line: 318, col: 57, offset: 9562},
+								name: "LabelList",
 							},
-							&zeroOrOneExpr{
-								pos: position{line: 305, col: 18, offset: 8953},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 318, col: 67, offset: 9572},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 318, col: 70, offset: 9575},
+							label: "group",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 318, col: 76, offset: 9581},
 								expr: &ruleRefExpr{
-									pos:  position{line: 305, col: 18, offset: 8953},
-									name: "SingleLineComment",
+									pos:  position{line: 318, col: 76, offset: 9581},
+									name: "GroupModifier",
 								},
 							},
-							&ruleRefExpr{
-								pos:  position{line: 305, col: 37, offset: 8972},
-								name: "EOL",
-							},
 						},
 					},
-					&seqExpr{
-						pos: position{line: 305, col: 43, offset: 8978},
-						exprs: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 305, col: 43, offset: 8978},
-								name: "__",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 305, col: 46, offset: 8981},
-								name: "EOF",
+				},
+			},
+		},
+		{
+			name: "BoolModifier",
+			pos:  position{line: 322, col: 1, offset: 9684},
+			expr: &actionExpr{
+				pos: position{line: 322, col: 16, offset: 9699},
+				run: (*parser).callonBoolModifier1,
+				expr: &seqExpr{
+					pos: position{line: 322, col: 16, offset: 9699},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 322, col: 16, offset: 9699},
+							val:        "bool",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 322, col: 24, offset: 9707},
+							expr: &ruleRefExpr{
+								pos:  position{line: 322, col: 25, offset: 9708},
+								name: "IdentifierPart",
 							},
 						},
 					},
@@ -2976,51 +3192,856 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EOF",
-			pos:  position{line: 307, col: 1, offset: 8986},
-			expr: &notExpr{
-				pos: position{line: 307, col: 7, offset: 8992},
-				expr: &anyMatcher{
-					line: 307, col: 8, offset: 8993,
+			name: "PowerOperator",
+			pos:  position{line: 326, col: 1, offset: 9749},
+			expr: &actionExpr{
+				pos: position{line: 326, col: 17, offset: 9765},
+				run: (*parser).callonPowerOperator1,
+				expr: &litMatcher{
+					pos:        position{line: 326, col: 17, offset: 9765},
+					val:        "^",
+					ignoreCase: false,
 				},
 			},
 		},
-	},
-}
-
-func (c *current) onGrammar1(grammar interface{}) (interface{}, error) {
-	return grammar, nil
-}
-
-func (p *parser) callonGrammar1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onGrammar1(stack["grammar"])
-}
-
-func (c *current) onComment1() (interface{}, error) {
-	return &Comment{string(c.text)}, nil
-}
-
-func (p *parser) callonComment1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onComment1()
-}
-
-func (c *current) onIdentifier1(ident interface{}) (interface{}, error) {
-	i := string(c.text)
-	if reservedWords[i] {
-		return nil, errors.New("identifier is a reserved word")
-	}
-	return &Identifier{ident.(string)}, nil
-}
-
-func (p *parser) callonIdentifier1() (interface{}, error) {
-	stack := p.vstack[len(p.vstack)-1]
-	_ = stack
-	return p.cur.onIdentifier1(stack["ident"])
-}
+		{
+			name: "MultiplicativeOperators",
+			pos:  position{line: 330, col: 1, offset: 9827},
+			expr: &actionExpr{
+				pos: position{line: 330, col: 27, offset: 9853},
+				run: (*parser).callonMultiplicativeOperators1,
+				expr: &choiceExpr{
+					pos: position{line: 330, col: 29, offset: 9855},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 330, col: 29, offset: 9855},
+							val:        "*",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 330, col: 35, offset: 9861},
+							val:        "/",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 330, col: 41, offset: 9867},
+							val:        "%",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdditiveOperators",
+			pos:  position{line: 334, col: 1, offset: 9931},
+			expr: &actionExpr{
+				pos: position{line: 334, col: 21, offset: 9951},
+				run: (*parser).callonAdditiveOperators1,
+				expr: &choiceExpr{
+					pos: position{line: 334, col: 23, offset: 9953},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 334, col: 23, offset: 9953},
+							val:        "+",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 334, col: 29, offset: 9959},
+							val:        "-",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonOperators",
+			pos:  position{line: 338, col: 1, offset: 10023},
+			expr: &actionExpr{
+				pos: position{line: 338, col: 23, offset: 10045},
+				run: (*parser).callonComparisonOperators1,
+				expr: &choiceExpr{
+					pos: position{line: 338, col: 25, offset: 10047},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 338, col: 25, offset: 10047},
+							val:        "==",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 338, col: 32, offset: 10054},
+							val:        "!=",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 338, col: 39, offset: 10061},
+							val:        "<=",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 338, col: 46, offset: 10068},
+							val:        "<",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 338, col: 52, offset: 10074},
+							val:        ">=",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 338, col: 59, offset: 10081},
+							val:        ">",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AndUnlessOperators",
+			pos:  position{line: 342, col: 1, offset: 10145},
+			expr: &actionExpr{
+				pos: position{line: 342, col: 22, offset: 10166},
+				run: (*parser).callonAndUnlessOperators1,
+				expr: &seqExpr{
+					pos: position{line: 342, col: 22, offset: 10166},
+					exprs: []interface{}{
+						&choiceExpr{
+							pos: position{line: 342, col: 24, offset: 10168},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 342, col: 24, offset: 10168},
+									val:        "and",
+									ignoreCase: true,
+								},
+								&litMatcher{
+									pos:        position{line: 342, col: 33, offset: 10177},
+									val:        "unless",
+									ignoreCase: true,
+								},
+							},
+						},
+						&notExpr{
+							pos: position{line: 342, col: 45, offset: 10189},
+							expr: &ruleRefExpr{
+								pos:  position{line: 342, col: 46, offset: 10190},
+								name: "IdentifierPart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OrOperator",
+			pos:  position{line: 346, col: 1, offset: 10263},
+			expr: &actionExpr{
+				pos: position{line: 346, col: 14, offset: 10276},
+				run: (*parser).callonOrOperator1,
+				expr: &seqExpr{
+					pos: position{line: 346, col: 14, offset: 10276},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 346, col: 14, offset: 10276},
+							val:        "or",
+							ignoreCase: true,
+						},
+						&notExpr{
+							pos: position{line: 346, col: 20, offset: 10282},
+							expr: &ruleRefExpr{
+								pos:  position{line: 346, col: 21, offset: 10283},
+								name: "IdentifierPart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PowerExpression",
+			pos:  position{line: 350, col: 1, offset: 10356},
+			expr: &actionExpr{
+				pos: position{line: 350, col: 19, offset: 10374},
+				run: (*parser).callonPowerExpression1,
+				expr: &seqExpr{
+					pos: position{line: 350, col: 19, offset: 10374},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 350, col: 19, offset: 10374},
+							label: "base",
+							expr: &ruleRefExpr{
+								pos:  position{line: 350, col: 24, offset: 10379},
+								name: "PrimaryExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 350, col: 42, offset: 10397},
+							label: "rest",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 350, col: 47, offset: 10402},
+								expr: &ruleRefExpr{
+									pos:  position{line: 350, col: 47, offset: 10402},
+									name: "PowerOperand",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PowerOperand",
+			pos:  position{line: 358, col: 1, offset: 10582},
+			expr: &actionExpr{
+				pos: position{line: 358, col: 16, offset: 10597},
+				run: (*parser).callonPowerOperand1,
+				expr: &seqExpr{
+					pos: position{line: 358, col: 16, offset: 10597},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 358, col: 16, offset: 10597},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 358, col: 19, offset: 10600},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 358, col: 22, offset: 10603},
+								name: "PowerOperator",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 358, col: 36, offset: 10617},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 358, col: 39, offset: 10620},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 358, col: 48, offset: 10629},
+								expr: &ruleRefExpr{
+									pos:  position{line: 358, col: 48, offset: 10629},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 358, col: 64, offset: 10645},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 358, col: 67, offset: 10648},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 358, col: 72, offset: 10653},
+								name: "PowerExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeExpression",
+			pos:  position{line: 362, col: 1, offset: 10763},
+			expr: &actionExpr{
+				pos: position{line: 362, col: 28, offset: 10790},
+				run: (*parser).callonMultiplicativeExpression1,
+				expr: &seqExpr{
+					pos: position{line: 362, col: 28, offset: 10790},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 362, col: 28, offset: 10790},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 362, col: 34, offset: 10796},
+								name: "PowerExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 362, col: 50, offset: 10812},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 362, col: 55, offset: 10817},
+								expr: &ruleRefExpr{
+									pos:  position{line: 362, col: 55, offset: 10817},
+									name: "MultiplicativeOperand",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeOperand",
+			pos:  position{line: 366, col: 1, offset: 10896},
+			expr: &actionExpr{
+				pos: position{line: 366, col: 25, offset: 10920},
+				run: (*parser).callonMultiplicativeOperand1,
+				expr: &seqExpr{
+					pos: position{line: 366, col: 25, offset: 10920},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 366, col: 25, offset: 10920},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 366, col: 28, offset: 10923},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 366, col: 31, offset: 10926},
+								name: "MultiplicativeOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 366, col: 55, offset: 10950},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 366, col: 58, offset: 10953},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 366, col: 67, offset: 10962},
+								expr: &ruleRefExpr{
+									pos:  position{line: 366, col: 67, offset: 10962},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 366, col: 83, offset: 10978},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 366, col: 86, offset: 10981},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 366, col: 91, offset: 10986},
+								name: "PowerExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdditiveExpression",
+			pos:  position{line: 370, col: 1, offset: 11096},
+			expr: &actionExpr{
+				pos: position{line: 370, col: 22, offset: 11117},
+				run: (*parser).callonAdditiveExpression1,
+				expr: &seqExpr{
+					pos: position{line: 370, col: 22, offset: 11117},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 370, col: 22, offset: 11117},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 370, col: 28, offset: 11123},
+								name: "MultiplicativeExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 370, col: 53, offset: 11148},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 370, col: 58, offset: 11153},
+								expr: &ruleRefExpr{
+									pos:  position{line: 370, col: 58, offset: 11153},
+									name: "AdditiveOperand",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AdditiveOperand",
+			pos:  position{line: 374, col: 1, offset: 11226},
+			expr: &actionExpr{
+				pos: position{line: 374, col: 19, offset: 11244},
+				run: (*parser).callonAdditiveOperand1,
+				expr: &seqExpr{
+					pos: position{line: 374, col: 19, offset: 11244},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 374, col: 19, offset: 11244},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 374, col: 22, offset: 11247},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 374, col: 25, offset: 11250},
+								name: "AdditiveOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 374, col: 43, offset: 11268},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 374, col: 46, offset: 11271},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 374, col: 55, offset: 11280},
+								expr: &ruleRefExpr{
+									pos:  position{line: 374, col: 55, offset: 11280},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 374, col: 71, offset: 11296},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 374, col: 74, offset: 11299},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 374, col: 79, offset: 11304},
+								name: "MultiplicativeExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonExpression",
+			pos:  position{line: 378, col: 1, offset: 11423},
+			expr: &actionExpr{
+				pos: position{line: 378, col: 24, offset: 11446},
+				run: (*parser).callonComparisonExpression1,
+				expr: &seqExpr{
+					pos: position{line: 378, col: 24, offset: 11446},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 378, col: 24, offset: 11446},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 378, col: 30, offset: 11452},
+								name: "AdditiveExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 378, col: 49, offset: 11471},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 378, col: 54, offset: 11476},
+								expr: &ruleRefExpr{
+									pos:  position{line: 378, col: 54, offset: 11476},
+									name: "ComparisonOperand",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonOperand",
+			pos:  position{line: 382, col: 1, offset: 11551},
+			expr: &actionExpr{
+				pos: position{line: 382, col: 21, offset: 11571},
+				run: (*parser).callonComparisonOperand1,
+				expr: &seqExpr{
+					pos: position{line: 382, col: 21, offset: 11571},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 382, col: 21, offset: 11571},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 382, col: 24, offset: 11574},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 382, col: 27, offset: 11577},
+								name: "ComparisonOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 382, col: 47, offset: 11597},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 382, col: 50, offset: 11600},
+							label: "returnBool",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 382, col: 61, offset: 11611},
+								expr: &ruleRefExpr{
+									pos:  position{line: 382, col: 61, offset: 11611},
+									name: "BoolModifier",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 382, col: 75, offset: 11625},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 382, col: 78, offset: 11628},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 382, col: 87, offset: 11637},
+								expr: &ruleRefExpr{
+									pos:  position{line: 382, col: 87, offset: 11637},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 382, col: 103, offset: 11653},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 382, col: 106, offset: 11656},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 382, col: 111, offset: 11661},
+								name: "AdditiveExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AndUnlessExpression",
+			pos:  position{line: 386, col: 1, offset: 11786},
+			expr: &actionExpr{
+				pos: position{line: 386, col: 23, offset: 11808},
+				run: (*parser).callonAndUnlessExpression1,
+				expr: &seqExpr{
+					pos: position{line: 386, col: 23, offset: 11808},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 386, col: 23, offset: 11808},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 386, col: 29, offset: 11814},
+								name: "ComparisonExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 386, col: 50, offset: 11835},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 386, col: 55, offset: 11840},
+								expr: &ruleRefExpr{
+									pos:  position{line: 386, col: 55, offset: 11840},
+									name: "AndUnlessOperand",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AndUnlessOperand",
+			pos:  position{line: 390, col: 1, offset: 11914},
+			expr: &actionExpr{
+				pos: position{line: 390, col: 20, offset: 11933},
+				run: (*parser).callonAndUnlessOperand1,
+				expr: &seqExpr{
+					pos: position{line: 390, col: 20, offset: 11933},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 390, col: 20, offset: 11933},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 390, col: 23, offset: 11936},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 390, col: 26, offset: 11939},
+								name: "AndUnlessOperators",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 390, col: 45, offset: 11958},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 390, col: 48, offset: 11961},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 390, col: 57, offset: 11970},
+								expr: &ruleRefExpr{
+									pos:  position{line: 390, col: 57, offset: 11970},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 390, col: 73, offset: 11986},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 390, col: 76, offset: 11989},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 390, col: 81, offset: 11994},
+								name: "ComparisonExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OrExpression",
+			pos:  position{line: 394, col: 1, offset: 12109},
+			expr: &actionExpr{
+				pos: position{line: 394, col: 16, offset: 12124},
+				run: (*parser).callonOrExpression1,
+				expr: &seqExpr{
+					pos: position{line: 394, col: 16, offset: 12124},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 394, col: 16, offset: 12124},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 394, col: 22, offset: 12130},
+								name: "AndUnlessExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 394, col: 42, offset: 12150},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 394, col: 47, offset: 12155},
+								expr: &ruleRefExpr{
+									pos:  position{line: 394, col: 47, offset: 12155},
+									name: "OrOperand",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OrOperand",
+			pos:  position{line: 398, col: 1, offset: 12222},
+			expr: &actionExpr{
+				pos: position{line: 398, col: 13, offset: 12234},
+				run: (*parser).callonOrOperand1,
+				expr: &seqExpr{
+					pos: position{line: 398, col: 13, offset: 12234},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 398, col: 13, offset: 12234},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 398, col: 16, offset: 12237},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 398, col: 19, offset: 12240},
+								name: "OrOperator",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 398, col: 30, offset: 12251},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 398, col: 33, offset: 12254},
+							label: "matching",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 398, col: 42, offset: 12263},
+								expr: &ruleRefExpr{
+									pos:  position{line: 398, col: 42, offset: 12263},
+									name: "VectorMatching",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 398, col: 58, offset: 12279},
+							name: "__",
+						},
+						&labeledExpr{
+							pos:   position{line: 398, col: 61, offset: 12282},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 398, col: 66, offset: 12287},
+								name: "AndUnlessExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Expression",
+			pos:  position{line: 402, col: 1, offset: 12401},
+			expr: &ruleRefExpr{
+				pos:  position{line: 402, col: 14, offset: 12414},
+				name: "OrExpression",
+			},
+		},
+		{
+			name: "__",
+			pos:  position{line: 404, col: 1, offset: 12428},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 404, col: 6, offset: 12433},
+				expr: &choiceExpr{
+					pos: position{line: 404, col: 8, offset: 12435},
+					alternatives: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 404, col: 8, offset: 12435},
+							name: "Whitespace",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 404, col: 21, offset: 12448},
+							name: "EOL",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 404, col: 27, offset: 12454},
+							name: "Comment",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "_",
+			pos:  position{line: 405, col: 1, offset: 12465},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 405, col: 5, offset: 12469},
+				expr: &ruleRefExpr{
+					pos:  position{line: 405, col: 5, offset: 12469},
+					name: "Whitespace",
+				},
+			},
+		},
+		{
+			name: "Whitespace",
+			pos:  position{line: 407, col: 1, offset: 12482},
+			expr: &charClassMatcher{
+				pos:        position{line: 407, col: 14, offset: 12495},
+				val:        "[ \\t\\r]",
+				chars:      []rune{' ', '\t', '\r'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
+		{
+			name: "EOL",
+			pos:  position{line: 408, col: 1, offset: 12503},
+			expr: &litMatcher{
+				pos:        position{line: 408, col: 7, offset: 12509},
+				val:        "\n",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "EOS",
+			pos:  position{line: 409, col: 1, offset: 12514},
+			expr: &choiceExpr{
+				pos: position{line: 409, col: 7, offset: 12520},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 409, col: 7, offset: 12520},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 409, col: 7, offset: 12520},
+								name: "__",
+							},
+							&litMatcher{
+								pos:        position{line: 409, col: 10, offset: 12523},
+								val:        ";",
+								ignoreCase: false,
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 409, col: 16, offset: 12529},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 409, col: 16, offset: 12529},
+								name: "_",
+							},
+							&zeroOrOneExpr{
+								pos: position{line: 409, col: 18, offset: 12531},
+								expr: &ruleRefExpr{
+									pos:  position{line: 409, col: 18, offset: 12531},
+									name: "SingleLineComment",
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 409, col: 37, offset: 12550},
+								name: "EOL",
+							},
+						},
+					},
+					&seqExpr{
+						pos: position{line: 409, col: 43, offset: 12556},
+						exprs: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 409, col: 43, offset: 12556},
+								name: "__",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 409, col: 46, offset: 12559},
+								name: "EOF",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EOF",
+			pos:  position{line: 411, col: 1, offset: 12564},
+			expr: &notExpr{
+				pos: position{line: 411, col: 7, offset: 12570},
+				expr: &anyMatcher{
+					line: 411, col: 8, offset: 12463,
+				},
+			},
+		},
+	},
+}
+
+func (c *current) onGrammar1(grammar interface{}) (interface{}, error) {
+	return grammar, nil
+}
+
+func (p *parser) callonGrammar1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGrammar1(stack["grammar"])
+}
+
+func (c *current) onComment1() (interface{}, error) {
+	return &Comment{string(c.text)}, nil
+}
+
+func (p *parser) callonComment1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComment1()
+}
+
+func (c *current) onIdentifier1(ident interface{}) (interface{}, error) {
+	i := string(c.text)
+	if reservedWords[i] {
+		return nil, errors.New("identifier is a reserved word")
+	}
+	return &Identifier{ident.(string)}, nil
+}
+
+func (p *parser) callonIdentifier1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIdentifier1(stack["ident"])
+}
 
 func (c *current) onIdentifierName1() (interface{}, error) {
 	return string(c.text), nil
@@ -3425,7 +4446,7 @@ func (p *parser) callonLabelMatchesRest1() (interface{}, error) {
 }
 
 func (c *current) onLabelList2() (interface{}, error) {
-	return nil, nil
+	return []*Identifier{}, nil
 }
 
 func (p *parser) callonLabelList2() (interface{}, error) {
@@ -3550,7 +4571,7 @@ func (p *parser) callonAggregateWithout1() (interface{}, error) {
 func (c *current) onAggregateExpression2(op, param, vector, group interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*StringLiteral)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expression), group)
 }
 
 func (p *parser) callonAggregateExpression2() (interface{}, error) {
@@ -3562,7 +4583,7 @@ func (p *parser) callonAggregateExpression2() (interface{}, error) {
 func (c *current) onAggregateExpression22(op, group, param, vector interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*StringLiteral)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expression), group)
 }
 
 func (p *parser) callonAggregateExpression22() (interface{}, error) {
@@ -3574,7 +4595,7 @@ func (p *parser) callonAggregateExpression22() (interface{}, error) {
 func (c *current) onAggregateExpression42(op, param, vector, group interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*Number)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expression), group)
 }
 
 func (p *parser) callonAggregateExpression42() (interface{}, error) {
@@ -3586,7 +4607,7 @@ func (p *parser) callonAggregateExpression42() (interface{}, error) {
 func (c *current) onAggregateExpression62(op, group, param, vector interface{}) (interface{}, error) {
 	oper := op.(*Operator)
 	oper.Arg = param.(*Number)
-	return NewAggregateExpr(oper, vector.(*Selector), group)
+	return NewAggregateExpr(oper, vector.(Expression), group)
 }
 
 func (p *parser) callonAggregateExpression62() (interface{}, error) {
@@ -3596,7 +4617,7 @@ func (p *parser) callonAggregateExpression62() (interface{}, error) {
 }
 
 func (c *current) onAggregateExpression82(op, vector, group interface{}) (interface{}, error) {
-	return NewAggregateExpr(op.(*Operator), vector.(*Selector), group)
+	return NewAggregateExpr(op.(*Operator), vector.(Expression), group)
 }
 
 func (p *parser) callonAggregateExpression82() (interface{}, error) {
@@ -3606,7 +4627,7 @@ func (p *parser) callonAggregateExpression82() (interface{}, error) {
 }
 
 func (c *current) onAggregateExpression97(op, group, vector interface{}) (interface{}, error) {
-	return NewAggregateExpr(op.(*Operator), vector.(*Selector), group)
+	return NewAggregateExpr(op.(*Operator), vector.(Expression), group)
 }
 
 func (p *parser) callonAggregateExpression97() (interface{}, error) {
@@ -3615,6 +4636,249 @@ func (p *parser) callonAggregateExpression97() (interface{}, error) {
 	return p.cur.onAggregateExpression97(stack["op"], stack["group"], stack["vector"])
 }
 
+func (c *current) onFunctionName1() (interface{}, error) {
+	return strings.ToLower(string(c.text)), nil
+}
+
+func (p *parser) callonFunctionName1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionName1()
+}
+
+func (c *current) onFunctionCall1(fn, arg interface{}) (interface{}, error) {
+	return NewFunctionCall(fn.(string), arg.(Expression))
+}
+
+func (p *parser) callonFunctionCall1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunctionCall1(stack["fn"], stack["arg"])
+}
+
+func (c *current) onParenExpression1(expr interface{}) (interface{}, error) {
+	return expr, nil
+}
+
+func (p *parser) callonParenExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParenExpression1(stack["expr"])
+}
+
+func (c *current) onGroupModifier1(side, labels interface{}) (interface{}, error) {
+	return NewGroupModifier(string(side.([]byte)), labels)
+}
+
+func (p *parser) callonGroupModifier1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupModifier1(stack["side"], stack["labels"])
+}
+
+func (c *current) onVectorMatching1(kind, labels, group interface{}) (interface{}, error) {
+	return NewVectorMatching(string(kind.([]byte)), labels.([]*Identifier), group)
+}
+
+func (p *parser) callonVectorMatching1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVectorMatching1(stack["kind"], stack["labels"], stack["group"])
+}
+
+func (c *current) onBoolModifier1() (interface{}, error) {
+	return true, nil
+}
+
+func (p *parser) callonBoolModifier1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBoolModifier1()
+}
+
+func (c *current) onPowerOperator1() (interface{}, error) {
+	return ToBinaryOperatorKind(string(c.text)), nil
+}
+
+func (p *parser) callonPowerOperator1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerOperator1()
+}
+
+func (c *current) onMultiplicativeOperators1() (interface{}, error) {
+	return ToBinaryOperatorKind(string(c.text)), nil
+}
+
+func (p *parser) callonMultiplicativeOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeOperators1()
+}
+
+func (c *current) onAdditiveOperators1() (interface{}, error) {
+	return ToBinaryOperatorKind(string(c.text)), nil
+}
+
+func (p *parser) callonAdditiveOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveOperators1()
+}
+
+func (c *current) onComparisonOperators1() (interface{}, error) {
+	return ToBinaryOperatorKind(string(c.text)), nil
+}
+
+func (p *parser) callonComparisonOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonOperators1()
+}
+
+func (c *current) onAndUnlessOperators1() (interface{}, error) {
+	return ToBinaryOperatorKind(string(c.text)), nil
+}
+
+func (p *parser) callonAndUnlessOperators1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAndUnlessOperators1()
+}
+
+func (c *current) onOrOperator1() (interface{}, error) {
+	return ToBinaryOperatorKind(string(c.text)), nil
+}
+
+func (p *parser) callonOrOperator1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrOperator1()
+}
+
+func (c *current) onPowerExpression1(base, rest interface{}) (interface{}, error) {
+	if rest == nil {
+		return base, nil
+	}
+	return NewBinaryExpr(base.(Expression), []interface{}{rest})
+}
+
+func (p *parser) callonPowerExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerExpression1(stack["base"], stack["rest"])
+}
+
+func (c *current) onPowerOperand1(op, matching, expr interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOperatorKind), false, matching, expr.(Expression))
+}
+
+func (p *parser) callonPowerOperand1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPowerOperand1(stack["op"], stack["matching"], stack["expr"])
+}
+
+func (c *current) onMultiplicativeExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExpr(first.(Expression), rest)
+}
+
+func (p *parser) callonMultiplicativeExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onMultiplicativeOperand1(op, matching, expr interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOperatorKind), false, matching, expr.(Expression))
+}
+
+func (p *parser) callonMultiplicativeOperand1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplicativeOperand1(stack["op"], stack["matching"], stack["expr"])
+}
+
+func (c *current) onAdditiveExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExpr(first.(Expression), rest)
+}
+
+func (p *parser) callonAdditiveExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onAdditiveOperand1(op, matching, expr interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOperatorKind), false, matching, expr.(Expression))
+}
+
+func (p *parser) callonAdditiveOperand1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdditiveOperand1(stack["op"], stack["matching"], stack["expr"])
+}
+
+func (c *current) onComparisonExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExpr(first.(Expression), rest)
+}
+
+func (p *parser) callonComparisonExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onComparisonOperand1(op, returnBool, matching, expr interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOperatorKind), returnBool != nil, matching, expr.(Expression))
+}
+
+func (p *parser) callonComparisonOperand1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonOperand1(stack["op"], stack["returnBool"], stack["matching"], stack["expr"])
+}
+
+func (c *current) onAndUnlessExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExpr(first.(Expression), rest)
+}
+
+func (p *parser) callonAndUnlessExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAndUnlessExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onAndUnlessOperand1(op, matching, expr interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOperatorKind), false, matching, expr.(Expression))
+}
+
+func (p *parser) callonAndUnlessOperand1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAndUnlessOperand1(stack["op"], stack["matching"], stack["expr"])
+}
+
+func (c *current) onOrExpression1(first, rest interface{}) (interface{}, error) {
+	return NewBinaryExpr(first.(Expression), rest)
+}
+
+func (p *parser) callonOrExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrExpression1(stack["first"], stack["rest"])
+}
+
+func (c *current) onOrOperand1(op, matching, expr interface{}) (interface{}, error) {
+	return NewBinaryOperand(op.(BinaryOperatorKind), false, matching, expr.(Expression))
+}
+
+func (p *parser) callonOrOperand1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrOperand1(stack["op"], stack["matching"], stack["expr"])
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
@@ -1,8 +1,11 @@
 package promql
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
 )
 
@@ -25,3 +28,76 @@ func Build(promql string, opts ...Option) (*query.Spec, error) {
 	}
 	return builder.QuerySpec()
 }
+
+// BuildAt builds the query for an expression evaluated once, at time t.
+func BuildAt(promql string, t time.Time, opts ...Option) (*query.Spec, error) {
+	b := newSpecBuilder()
+	b.start, b.end = t, t
+	return buildExpression(promql, b, opts...)
+}
+
+// BuildRange builds the query for an expression evaluated at every step from start to end.
+// The selectors of the expression are windowed by the step, so each row of the result is timed at the step it was evaluated for.
+// Rows timed before start, after end or between steps come from windows that are cut short by the range and must be ignored.
+func BuildRange(promql string, start, end time.Time, step time.Duration, opts ...Option) (*query.Spec, error) {
+	if step <= 0 {
+		return nil, errors.New("step must be positive")
+	}
+	if end.Before(start) {
+		return nil, errors.New("end must not be before start")
+	}
+	b := newSpecBuilder()
+	b.start, b.end, b.step = start, end, step
+	return buildExpression(promql, b, opts...)
+}
+
+func buildExpression(promql string, b *specBuilder, opts ...Option) (*query.Spec, error) {
+	parsed, err := ParsePromQL(promql, opts...)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := parsed.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("unable to build %q as it is not an expression", promql)
+	}
+	return b.buildSpec(e)
+}
+
+// BuildSeries builds a query for the series that match a vector selector between start and end.
+// Each block of the result is one series, and holds its latest sample.
+// All series are matched when the selector is empty.
+func BuildSeries(selector string, start, end time.Time, opts ...Option) (*query.Spec, error) {
+	b := newSpecBuilder()
+	id := b.add(newFromOperation())
+	id = b.add(&query.Operation{
+		ID: "range", // TODO: Change this to a UUID
+		Spec: &functions.RangeOpSpec{
+			Start: query.Time{
+				Absolute: start,
+			},
+			Stop: query.Time{
+				Absolute: end,
+			},
+		},
+	}, id)
+	if selector != "" {
+		parsed, err := ParsePromQL(selector, opts...)
+		if err != nil {
+			return nil, err
+		}
+		sel, ok := parsed.(*Selector)
+		if !ok || sel.Range != 0 {
+			return nil, fmt.Errorf("expected vector selector, got %q", selector)
+		}
+		where, err := NewWhereOperation(sel.Name, sel.LabelMatchers)
+		if err != nil {
+			return nil, err
+		}
+		id = b.add(where, id)
+	}
+	b.add(&query.Operation{
+		ID:   "last",
+		Spec: &functions.LastOpSpec{},
+	}, id)
+	return b.spec, nil
+}
@@ -1,6 +1,9 @@
 package promql
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -337,6 +340,108 @@ func TestParsePromQL(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "rate aggregated by label",
+			promql: `sum(rate(http_requests_total[5m])) by (job)`,
+			want: &AggregateExpr{
+				Op: &Operator{
+					Kind: SumKind,
+				},
+				Selector: &FunctionCall{
+					Name: "rate",
+					Selector: &Selector{
+						Name:  "http_requests_total",
+						Range: 5 * time.Minute,
+					},
+				},
+				Aggregate: &Aggregate{
+					By: true,
+					Labels: []*Identifier{
+						{
+							Name: "job",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "rate of an instant vector",
+			promql:  `rate(http_requests_total)`,
+			wantErr: true,
+			want:    "",
+		},
+		{
+			name:   "binary operators by precedence",
+			promql: `a + b * 2`,
+			want: &BinaryExpr{
+				Op:  AddKind,
+				LHS: &Selector{Name: "a"},
+				RHS: &BinaryExpr{
+					Op:  MulKind,
+					LHS: &Selector{Name: "b"},
+					RHS: &Number{Val: 2},
+				},
+			},
+		},
+		{
+			name:   "binary operators are left associative",
+			promql: `(a - b) - 1`,
+			want: &BinaryExpr{
+				Op: SubKind,
+				LHS: &BinaryExpr{
+					Op:  SubKind,
+					LHS: &Selector{Name: "a"},
+					RHS: &Selector{Name: "b"},
+				},
+				RHS: &Number{Val: 1},
+			},
+		},
+		{
+			name:   "function over time",
+			promql: `avg_over_time(latency[5m])`,
+			want: &FunctionCall{
+				Name:     "avg_over_time",
+				Selector: &Selector{Name: "latency", Range: 5 * time.Minute},
+			},
+		},
+		{
+			name:   "comparison with bool modifier",
+			promql: `a > bool 5`,
+			want: &BinaryExpr{
+				Op:         GtrKind,
+				LHS:        &Selector{Name: "a"},
+				RHS:        &Number{Val: 5},
+				ReturnBool: true,
+			},
+		},
+		{
+			name:   "vector matching with group_left",
+			promql: `a / on(job, instance) group_left(version) b`,
+			want: &BinaryExpr{
+				Op:  DivKind,
+				LHS: &Selector{Name: "a"},
+				RHS: &Selector{Name: "b"},
+				Matching: &VectorMatching{
+					On:     true,
+					Labels: []*Identifier{{Name: "job"}, {Name: "instance"}},
+					Group: &GroupModifier{
+						Labels: []*Identifier{{Name: "version"}},
+					},
+				},
+			},
+		},
+		{
+			name:   "vector matching ignoring labels",
+			promql: `a - ignoring(code) b`,
+			want: &BinaryExpr{
+				Op:  SubKind,
+				LHS: &Selector{Name: "a"},
+				RHS: &Selector{Name: "b"},
+				Matching: &VectorMatching{
+					Labels: []*Identifier{{Name: "code"}},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -369,6 +474,13 @@ func TestBuild(t *testing.T) {
 						ID:   query.OperationID("from"),
 						Spec: &functions.FromOpSpec{Database: "prometheus"},
 					},
+					{
+						ID: query.OperationID("range"),
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -5 * time.Minute},
+							Stop:  query.Now,
+						},
+					},
 					{
 						ID: "where",
 						Spec: &functions.FilterOpSpec{
@@ -419,6 +531,12 @@ func TestBuild(t *testing.T) {
 							},
 						},
 					},
+					{
+						ID: query.OperationID("last"), Spec: &functions.LastOpSpec{},
+					},
+					{
+						ID: query.OperationID("merge"), Spec: &functions.GroupOpSpec{},
+					},
 					{
 						ID: query.OperationID("count"), Spec: &functions.CountOpSpec{},
 					},
@@ -426,10 +544,22 @@ func TestBuild(t *testing.T) {
 				Edges: []query.Edge{
 					{
 						Parent: query.OperationID("from"),
+						Child:  query.OperationID("range"),
+					},
+					{
+						Parent: query.OperationID("range"),
 						Child:  query.OperationID("where"),
 					},
 					{
 						Parent: query.OperationID("where"),
+						Child:  query.OperationID("last"),
+					},
+					{
+						Parent: query.OperationID("last"),
+						Child:  query.OperationID("merge"),
+					},
+					{
+						Parent: query.OperationID("merge"),
 						Child:  query.OperationID("count"),
 					},
 				},
@@ -447,7 +577,8 @@ func TestBuild(t *testing.T) {
 					{
 						ID: query.OperationID("range"),
 						Spec: &functions.RangeOpSpec{
-							Start: query.Time{Relative: -time.Minute * 7},
+							Start: query.Time{IsRelative: true, Relative: -time.Minute * 7},
+							Stop:  query.Time{IsRelative: true, Relative: -time.Minute * 5},
 						},
 					},
 					{
@@ -485,6 +616,10 @@ func TestBuild(t *testing.T) {
 							},
 						},
 					},
+					{
+						ID:   query.OperationID("shift"),
+						Spec: &functions.ShiftOpSpec{Shift: query.Duration{Fixed: 5 * time.Minute}},
+					},
 				},
 				Edges: []query.Edge{
 					{
@@ -495,6 +630,10 @@ func TestBuild(t *testing.T) {
 						Parent: query.OperationID("range"),
 						Child:  query.OperationID("where"),
 					},
+					{
+						Parent: query.OperationID("where"),
+						Child:  query.OperationID("shift"),
+					},
 				},
 			},
 		},
@@ -511,7 +650,8 @@ func TestBuild(t *testing.T) {
 					{
 						ID: query.OperationID("range"),
 						Spec: &functions.RangeOpSpec{
-							Start: query.Time{Relative: -170 * time.Hour},
+							Start: query.Time{IsRelative: true, Relative: -170 * time.Hour},
+							Stop:  query.Now,
 						},
 					},
 					{
@@ -549,6 +689,9 @@ func TestBuild(t *testing.T) {
 							},
 						},
 					},
+					{
+						ID: query.OperationID("merge"), Spec: &functions.GroupOpSpec{},
+					},
 					{
 						ID: query.OperationID("sum"), Spec: &functions.SumOpSpec{},
 					},
@@ -564,11 +707,656 @@ func TestBuild(t *testing.T) {
 					},
 					{
 						Parent: query.OperationID("where"),
+						Child:  query.OperationID("merge"),
+					},
+					{
+						Parent: query.OperationID("merge"),
 						Child:  query.OperationID("sum"),
 					},
 				},
 			},
 		},
+		{
+			name:   "rate aggregated by label",
+			promql: `sum(rate(http_requests_total[5m])) by (job)`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -5 * time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where", "http_requests_total"),
+					rateOperation("reduce", "rate", 300),
+					rateOperation("filter", "rate", 300),
+					rateOperation("map", "rate", 300),
+					{
+						ID:   "merge",
+						Spec: &functions.GroupOpSpec{By: []string{"job"}},
+					},
+					{
+						ID:   "sum",
+						Spec: &functions.SumOpSpec{},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "where", Child: "reduce"},
+					{Parent: "reduce", Child: "filter"},
+					{Parent: "filter", Child: "map"},
+					{Parent: "map", Child: "merge"},
+					{Parent: "merge", Child: "sum"},
+				},
+			},
+		},
+		{
+			name:   "topk by label",
+			promql: `topk(3, http_requests_total[1m]) by (job)`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where", "http_requests_total"),
+					{
+						ID:   "merge",
+						Spec: &functions.GroupOpSpec{By: []string{"job"}},
+					},
+					{
+						ID:   "sort",
+						Spec: &functions.SortOpSpec{Cols: []string{"_value"}, Desc: true},
+					},
+					{
+						ID:   "limit",
+						Spec: &functions.LimitOpSpec{N: 3},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "where", Child: "merge"},
+					{Parent: "merge", Child: "sort"},
+					{Parent: "sort", Child: "limit"},
+				},
+			},
+		},
+		{
+			name:   "stdvar without label",
+			promql: `stdvar without (instance) (latency[1m])`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where", "latency"),
+					{
+						ID:   "merge",
+						Spec: &functions.GroupOpSpec{Except: []string{"instance"}},
+					},
+					{
+						ID: "stddev",
+						Spec: &functions.StddevOpSpec{
+							Mode: functions.PopulationStddevMode,
+						},
+					},
+					{
+						ID: "map",
+						Spec: &functions.MapOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.MultiplicationOperator,
+									Left:     recordValueExpr(),
+									Right:    recordValueExpr(),
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "where", Child: "merge"},
+					{Parent: "merge", Child: "stddev"},
+					{Parent: "stddev", Child: "map"},
+				},
+			},
+		},
+		{
+			name:   "comparison with a scalar",
+			promql: `10 < irate(errors_total[1m])`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where", "errors_total"),
+					{
+						ID: "derivative",
+						Spec: &functions.DerivativeOpSpec{
+							Unit:        query.Duration{Fixed: time.Second},
+							NonNegative: true,
+						},
+					},
+					{
+						ID:   "last",
+						Spec: &functions.LastOpSpec{},
+					},
+					{
+						ID: "filter",
+						Spec: &functions.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.LessThanOperator,
+									Left:     &semantic.FloatLiteral{Value: 10},
+									Right:    recordValueExpr(),
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "where", Child: "derivative"},
+					{Parent: "derivative", Child: "last"},
+					{Parent: "last", Child: "filter"},
+				},
+			},
+		},
+		{
+			name:   "arithmetic between vectors matched on a label",
+			promql: `errors[1m] / on(job) requests[1m]`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where", "errors"),
+					{
+						ID:   "from1",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range1",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where1", "requests"),
+					{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							On: []string{"job"},
+							TableNames: map[query.OperationID]string{
+								"where":  "left",
+								"where1": "right",
+							},
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.DivisionOperator,
+									Left: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "t"},
+											Property: "left",
+										},
+										Property: "_value",
+									},
+									Right: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "t"},
+											Property: "right",
+										},
+										Property: "_value",
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "from1", Child: "range1"},
+					{Parent: "range1", Child: "where1"},
+					{Parent: "where", Child: "join"},
+					{Parent: "where1", Child: "join"},
+				},
+			},
+		},
+		{
+			name:   "arithmetic between vectors matched on all labels",
+			promql: `errors[1m] / ignoring(code) requests[1m]`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where", "errors"),
+					{
+						ID:   "from1",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range1",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where1", "requests"),
+					{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							Except: []string{"_field", "_measurement", "_metric", "code"},
+							TableNames: map[query.OperationID]string{
+								"where":  "left",
+								"where1": "right",
+							},
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.DivisionOperator,
+									Left: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "t"},
+											Property: "left",
+										},
+										Property: "_value",
+									},
+									Right: &semantic.MemberExpression{
+										Object: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "t"},
+											Property: "right",
+										},
+										Property: "_value",
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "from1", Child: "range1"},
+					{Parent: "range1", Child: "where1"},
+					{Parent: "where", Child: "join"},
+					{Parent: "where1", Child: "join"},
+				},
+			},
+		},
+		{
+			name:   "count_values by label",
+			promql: `count_values("version", build_version[1m]) by (job)`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute},
+							Stop:  query.Now,
+						},
+					},
+					whereMetric("where", "build_version"),
+					{
+						ID:   "set",
+						Spec: &functions.SetOpSpec{Key: "version", Column: "_value"},
+					},
+					{
+						ID:   "merge",
+						Spec: &functions.GroupOpSpec{By: []string{"job", "version"}},
+					},
+					{
+						ID:   "count",
+						Spec: &functions.CountOpSpec{},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "where", Child: "set"},
+					{Parent: "set", Child: "merge"},
+					{Parent: "merge", Child: "count"},
+				},
+			},
+		},
+		{
+			name:    "count_values without a label name",
+			promql:  `count_values(1, build_version)`,
+			wantErr: true,
+		},
+		{
+			name:   "comparison between vectors",
+			promql: `a > b`,
+			want: &query.Spec{
+				Operations: append(append(instantVectorOperations("", "a"), instantVectorOperations("1", "b")...),
+					&query.Operation{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							Except: []string{"_field", "_measurement", "_metric"},
+							TableNames: map[query.OperationID]string{
+								"last":  "left",
+								"last1": "right",
+							},
+							Fn: joinFunction(&semantic.ConditionalExpression{
+								Test: &semantic.BinaryExpression{
+									Operator: ast.GreaterThanOperator,
+									Left:     tableValueExpr("left"),
+									Right:    tableValueExpr("right"),
+								},
+								Consequent: tableValueExpr("left"),
+								Alternate:  &semantic.NullLiteral{},
+							}),
+						},
+					},
+					&query.Operation{
+						ID: "filter",
+						Spec: &functions.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.UnaryExpression{
+									Operator: ast.ExistsOperator,
+									Argument: recordValueExpr(),
+								},
+							},
+						},
+					},
+				),
+				Edges: append(append(instantVectorEdges(""), instantVectorEdges("1")...),
+					query.Edge{Parent: "last", Child: "join"},
+					query.Edge{Parent: "last1", Child: "join"},
+					query.Edge{Parent: "join", Child: "filter"},
+				),
+			},
+		},
+		{
+			name:   "comparison between vectors with the bool modifier",
+			promql: `a > bool b`,
+			want: &query.Spec{
+				Operations: append(append(instantVectorOperations("", "a"), instantVectorOperations("1", "b")...),
+					&query.Operation{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							Except: []string{"_field", "_measurement", "_metric"},
+							TableNames: map[query.OperationID]string{
+								"last":  "left",
+								"last1": "right",
+							},
+							Fn: joinFunction(&semantic.ConditionalExpression{
+								Test: &semantic.BinaryExpression{
+									Operator: ast.GreaterThanOperator,
+									Left:     tableValueExpr("left"),
+									Right:    tableValueExpr("right"),
+								},
+								Consequent: &semantic.FloatLiteral{Value: 1},
+								Alternate:  &semantic.FloatLiteral{Value: 0},
+							}),
+						},
+					},
+				),
+				Edges: append(append(instantVectorEdges(""), instantVectorEdges("1")...),
+					query.Edge{Parent: "last", Child: "join"},
+					query.Edge{Parent: "last1", Child: "join"},
+				),
+			},
+		},
+		{
+			name:   "set operator and",
+			promql: `a and b`,
+			want: &query.Spec{
+				Operations: append(append(instantVectorOperations("", "a"), instantVectorOperations("1", "b")...),
+					&query.Operation{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							Except: []string{"_field", "_measurement", "_metric"},
+							TableNames: map[query.OperationID]string{
+								"last":  "left",
+								"last1": "right",
+							},
+							Fn: joinFunction(tableValueExpr("left")),
+						},
+					},
+				),
+				Edges: append(append(instantVectorEdges(""), instantVectorEdges("1")...),
+					query.Edge{Parent: "last", Child: "join"},
+					query.Edge{Parent: "last1", Child: "join"},
+				),
+			},
+		},
+		{
+			name:   "set operator or matched on a label",
+			promql: `a or on(job) b`,
+			want: &query.Spec{
+				Operations: append(append(instantVectorOperations("", "a"), instantVectorOperations("1", "b")...),
+					&query.Operation{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							On: []string{"job"},
+							TableNames: map[query.OperationID]string{
+								"last":  "left",
+								"last1": "right",
+							},
+							Method: "full",
+							Fn: joinFunction(&semantic.ConditionalExpression{
+								Test: &semantic.UnaryExpression{
+									Operator: ast.ExistsOperator,
+									Argument: tableValueExpr("left"),
+								},
+								Consequent: tableValueExpr("left"),
+								Alternate:  tableValueExpr("right"),
+							}),
+						},
+					},
+				),
+				Edges: append(append(instantVectorEdges(""), instantVectorEdges("1")...),
+					query.Edge{Parent: "last", Child: "join"},
+					query.Edge{Parent: "last1", Child: "join"},
+				),
+			},
+		},
+		{
+			name:   "set operator unless",
+			promql: `a unless b`,
+			want: &query.Spec{
+				Operations: append(append(instantVectorOperations("", "a"), instantVectorOperations("1", "b")...),
+					&query.Operation{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							Except: []string{"_field", "_measurement", "_metric"},
+							TableNames: map[query.OperationID]string{
+								"last":  "left",
+								"last1": "right",
+							},
+							Method: "left",
+							Left:   "left",
+							Fn: joinFunction(&semantic.ConditionalExpression{
+								Test: &semantic.UnaryExpression{
+									Operator: ast.ExistsOperator,
+									Argument: tableValueExpr("right"),
+								},
+								Consequent: &semantic.NullLiteral{},
+								Alternate:  tableValueExpr("left"),
+							}),
+						},
+					},
+					&query.Operation{
+						ID: "filter",
+						Spec: &functions.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.UnaryExpression{
+									Operator: ast.ExistsOperator,
+									Argument: recordValueExpr(),
+								},
+							},
+						},
+					},
+				),
+				Edges: append(append(instantVectorEdges(""), instantVectorEdges("1")...),
+					query.Edge{Parent: "last", Child: "join"},
+					query.Edge{Parent: "last1", Child: "join"},
+					query.Edge{Parent: "join", Child: "filter"},
+				),
+			},
+		},
+		{
+			name:   "comparison with a scalar and the bool modifier",
+			promql: `a > bool 5`,
+			want: &query.Spec{
+				Operations: append(instantVectorOperations("", "a"),
+					&query.Operation{
+						ID: "map",
+						Spec: &functions.MapOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.ConditionalExpression{
+									Test: &semantic.BinaryExpression{
+										Operator: ast.GreaterThanOperator,
+										Left:     recordValueExpr(),
+										Right:    &semantic.FloatLiteral{Value: 5},
+									},
+									Consequent: &semantic.FloatLiteral{Value: 1},
+									Alternate:  &semantic.FloatLiteral{Value: 0},
+								},
+							},
+						},
+					},
+				),
+				Edges: append(instantVectorEdges(""), query.Edge{Parent: "last", Child: "map"}),
+			},
+		},
+		{
+			name:    "set operator with a scalar",
+			promql:  `a and 1`,
+			wantErr: true,
+		},
+		{
+			name:    "bool modifier on arithmetic",
+			promql:  `a + bool b`,
+			wantErr: true,
+		},
+		{
+			name:   "vector matching with group_left",
+			promql: `a / on(job) group_left(version) b`,
+			want: &query.Spec{
+				Operations: append(append(instantVectorOperations("", "a"), instantVectorOperations("1", "b")...),
+					&query.Operation{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							On: []string{"job"},
+							TableNames: map[query.OperationID]string{
+								"last":  "left",
+								"last1": "right",
+							},
+							Many:    "left",
+							Include: []string{"version"},
+							Fn: joinFunction(&semantic.BinaryExpression{
+								Operator: ast.DivisionOperator,
+								Left:     tableValueExpr("left"),
+								Right:    tableValueExpr("right"),
+							}),
+						},
+					},
+				),
+				Edges: append(append(instantVectorEdges(""), instantVectorEdges("1")...),
+					query.Edge{Parent: "last", Child: "join"},
+					query.Edge{Parent: "last1", Child: "join"},
+				),
+			},
+		},
+		{
+			name:   "vector matching with group_right",
+			promql: `a * ignoring(code) group_right b`,
+			want: &query.Spec{
+				Operations: append(append(instantVectorOperations("", "a"), instantVectorOperations("1", "b")...),
+					&query.Operation{
+						ID: "join",
+						Spec: &functions.JoinOpSpec{
+							Except: []string{"_field", "_measurement", "_metric", "code"},
+							TableNames: map[query.OperationID]string{
+								"last":  "left",
+								"last1": "right",
+							},
+							Many: "right",
+							Fn: joinFunction(&semantic.BinaryExpression{
+								Operator: ast.MultiplicationOperator,
+								Left:     tableValueExpr("left"),
+								Right:    tableValueExpr("right"),
+							}),
+						},
+					},
+				),
+				Edges: append(append(instantVectorEdges(""), instantVectorEdges("1")...),
+					query.Edge{Parent: "last", Child: "join"},
+					query.Edge{Parent: "last1", Child: "join"},
+				),
+			},
+		},
+		{
+			name:    "group_left with a set operator",
+			promql:  `a and on(job) group_left b`,
+			wantErr: true,
+		},
+		{
+			name:    "quantile out of range",
+			promql:  `quantile(1.5, latency[1m])`,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -585,3 +1373,354 @@ func TestBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildAt(t *testing.T) {
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, err := BuildAt(`http_requests offset 1m`, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &query.Spec{
+		Operations: []*query.Operation{
+			{
+				ID:   "from",
+				Spec: &functions.FromOpSpec{Database: "prometheus"},
+			},
+			{
+				ID: "range",
+				Spec: &functions.RangeOpSpec{
+					Start: query.Time{Absolute: now.Add(-6 * time.Minute)},
+					Stop:  query.Time{Absolute: now.Add(-time.Minute)},
+				},
+			},
+			whereMetric("where", "http_requests"),
+			{
+				ID:   "last",
+				Spec: &functions.LastOpSpec{},
+			},
+			{
+				ID:   "shift",
+				Spec: &functions.ShiftOpSpec{Shift: query.Duration{Fixed: time.Minute}},
+			},
+		},
+		Edges: []query.Edge{
+			{Parent: "from", Child: "range"},
+			{Parent: "range", Child: "where"},
+			{Parent: "where", Child: "last"},
+			{Parent: "last", Child: "shift"},
+		},
+	}
+	opts := append(semantictest.CmpOptions, []cmp.Option{cmp.AllowUnexported(query.Spec{}), cmpopts.IgnoreUnexported(query.Spec{})}...)
+	if !cmp.Equal(want, got, opts...) {
+		t.Errorf("BuildAt() -want/+got\n%s", cmp.Diff(want, got, opts...))
+	}
+}
+
+func TestBuildRange(t *testing.T) {
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	tests := []struct {
+		name    string
+		promql  string
+		step    time.Duration
+		want    *query.Spec
+		wantErr bool
+	}{
+		{
+			name:   "instant vector",
+			promql: `http_requests`,
+			step:   time.Minute,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{Absolute: start.Add(-5 * time.Minute)},
+							Stop:  query.Time{Absolute: end.Add(time.Minute)},
+						},
+					},
+					whereMetric("where", "http_requests"),
+					{
+						ID: "window",
+						Spec: &functions.WindowOpSpec{
+							Every:  query.Duration{Fixed: time.Minute},
+							Period: query.Duration{Fixed: 5 * time.Minute},
+							Start:  query.Time{Absolute: start},
+						},
+					},
+					{
+						ID:   "last",
+						Spec: &functions.LastOpSpec{},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "where", Child: "window"},
+					{Parent: "window", Child: "last"},
+				},
+			},
+		},
+		{
+			name:   "rate with offset",
+			promql: `sum(rate(http_requests[10m] offset 1h))`,
+			step:   15 * time.Second,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from",
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: "range",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{Absolute: start.Add(-70 * time.Minute)},
+							Stop:  query.Time{Absolute: end.Add(15*time.Second - time.Hour)},
+						},
+					},
+					whereMetric("where", "http_requests"),
+					{
+						ID: "window",
+						Spec: &functions.WindowOpSpec{
+							Every:  query.Duration{Fixed: 15 * time.Second},
+							Period: query.Duration{Fixed: 10 * time.Minute},
+							Start:  query.Time{Absolute: start.Add(-time.Hour)},
+						},
+					},
+					{
+						ID:   "shift",
+						Spec: &functions.ShiftOpSpec{Shift: query.Duration{Fixed: time.Hour}},
+					},
+					rateOperation("reduce", "rate", 600),
+					rateOperation("filter", "rate", 600),
+					rateOperation("map", "rate", 600),
+					{
+						ID:   "shift1",
+						Spec: &functions.ShiftOpSpec{Shift: query.Duration{Fixed: -time.Nanosecond}},
+					},
+					{
+						ID: "window1",
+						Spec: &functions.WindowOpSpec{
+							Every:  query.Duration{Fixed: 15 * time.Second},
+							Period: query.Duration{Fixed: 15 * time.Second},
+							Start:  query.Time{Absolute: start},
+						},
+					},
+					{
+						ID:   "merge",
+						Spec: &functions.GroupOpSpec{},
+					},
+					{
+						ID:   "sum",
+						Spec: &functions.SumOpSpec{},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "range"},
+					{Parent: "range", Child: "where"},
+					{Parent: "where", Child: "window"},
+					{Parent: "window", Child: "shift"},
+					{Parent: "shift", Child: "reduce"},
+					{Parent: "reduce", Child: "filter"},
+					{Parent: "filter", Child: "map"},
+					{Parent: "map", Child: "shift1"},
+					{Parent: "shift1", Child: "window1"},
+					{Parent: "window1", Child: "merge"},
+					{Parent: "merge", Child: "sum"},
+				},
+			},
+		},
+		{
+			name:    "zero step",
+			promql:  `http_requests`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildRange(tt.promql, start, end, tt.step)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BuildRange() %s error = %v, wantErr %v", tt.promql, err, tt.wantErr)
+				return
+			}
+			opts := append(semantictest.CmpOptions, []cmp.Option{cmp.AllowUnexported(query.Spec{}), cmpopts.IgnoreUnexported(query.Spec{})}...)
+			if !cmp.Equal(tt.want, got, opts...) {
+				t.Errorf("BuildRange() = %s -want/+got\n%s", tt.promql, cmp.Diff(tt.want, got, opts...))
+			}
+		})
+	}
+}
+
+func TestBuildSeries(t *testing.T) {
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	got, err := BuildSeries(`http_requests`, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &query.Spec{
+		Operations: []*query.Operation{
+			{
+				ID:   "from",
+				Spec: &functions.FromOpSpec{Database: "prometheus"},
+			},
+			{
+				ID: "range",
+				Spec: &functions.RangeOpSpec{
+					Start: query.Time{Absolute: start},
+					Stop:  query.Time{Absolute: end},
+				},
+			},
+			whereMetric("where", "http_requests"),
+			{
+				ID:   "last",
+				Spec: &functions.LastOpSpec{},
+			},
+		},
+		Edges: []query.Edge{
+			{Parent: "from", Child: "range"},
+			{Parent: "range", Child: "where"},
+			{Parent: "where", Child: "last"},
+		},
+	}
+	opts := append(semantictest.CmpOptions, []cmp.Option{cmp.AllowUnexported(query.Spec{}), cmpopts.IgnoreUnexported(query.Spec{})}...)
+	if !cmp.Equal(want, got, opts...) {
+		t.Errorf("BuildSeries() -want/+got\n%s", cmp.Diff(want, got, opts...))
+	}
+
+	if _, err := BuildSeries(`http_requests[5m]`, start, end); err == nil {
+		t.Error("expected error for a range vector selector")
+	}
+}
+
+// rateOperation returns the operation of rate, increase or delta over a range of the given seconds with the ID,
+// which is one of reduce, filter or map.
+// The operations are compiled from the IFQL that computes the function like Prometheus.
+func rateOperation(id query.OperationID, name string, rng float64) *query.Operation {
+	// The times of the samples and of the end of the range are in seconds.
+	now := "float(v: int(v: r._time)) / 1000000000.0"
+	sampled := "(r.lastTime - r.firstTime)"
+	average := fmt.Sprintf("%s / float(v: r.count - 1)", sampled)
+	increase := "r._value - accumulator.last"
+	toStart := fmt.Sprintf("(r.firstTime - (%s - %v.0))", now, rng)
+	if name != "delta" {
+		increase = fmt.Sprintf("if r._value < accumulator.last then r._value else %s", increase)
+		toZero := fmt.Sprintf("%s * r.first / r.increase", sampled)
+		toStart = fmt.Sprintf("(if r.increase > 0.0 and r.first >= 0.0 and %s < %s then %s else %s)", toZero, toStart, toZero, toStart)
+	}
+	toEnd := fmt.Sprintf("(%s - r.lastTime)", now)
+	extrapolate := func(d string) string {
+		return fmt.Sprintf("(if %s < %s * 1.1 then %s else %s / 2.0)", d, average, d, average)
+	}
+	value := fmt.Sprintf("r.increase * ((%s + %s + %s) / %s)", sampled, extrapolate(toStart), extrapolate(toEnd), sampled)
+	if name == "rate" {
+		value = fmt.Sprintf("(%s) / %v.0", value, rng)
+	}
+	src := fmt.Sprintf(`from(db:"prometheus")
+	|> reduce(
+		fn: (r, accumulator) => ({
+			count: accumulator.count + 1,
+			first: if accumulator.count == 0 then r._value else accumulator.first,
+			firstTime: if accumulator.count == 0 then %s else accumulator.firstTime,
+			increase: if accumulator.count == 0 then 0.0 else accumulator.increase + (%s),
+			last: r._value,
+			lastTime: %s
+		}),
+		identity: {count: 0, first: 0.0, firstTime: 0.0, increase: 0.0, last: 0.0, lastTime: 0.0}
+	)
+	|> filter(fn: (r) => r.count > 1)
+	|> map(fn: (r) => %s)`, now, increase, now, value)
+	spec, err := query.Compile(context.Background(), src)
+	if err != nil {
+		panic(err)
+	}
+	for _, o := range spec.Operations {
+		if strings.HasPrefix(string(o.ID), string(id)) {
+			o.ID = id
+			return o
+		}
+	}
+	panic(fmt.Sprintf("no %s operation", id))
+}
+
+// whereMetric creates the filter of a selector of the metric without label matchers.
+func whereMetric(id query.OperationID, metric string) *query.Operation {
+	return &query.Operation{
+		ID: id,
+		Spec: &functions.FilterOpSpec{
+			Fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+				Body: &semantic.BinaryExpression{
+					Operator: ast.EqualOperator,
+					Left: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_metric",
+					},
+					Right: &semantic.StringLiteral{Value: metric},
+				},
+			},
+		},
+	}
+}
+
+// instantVectorOperations returns the operations of the instant vector selector of the metric,
+// with IDs ending in the suffix.
+func instantVectorOperations(suffix, metric string) []*query.Operation {
+	return []*query.Operation{
+		{
+			ID:   query.OperationID("from" + suffix),
+			Spec: &functions.FromOpSpec{Database: "prometheus"},
+		},
+		{
+			ID: query.OperationID("range" + suffix),
+			Spec: &functions.RangeOpSpec{
+				Start: query.Time{IsRelative: true, Relative: -5 * time.Minute},
+				Stop:  query.Now,
+			},
+		},
+		whereMetric(query.OperationID("where"+suffix), metric),
+		{
+			ID:   query.OperationID("last" + suffix),
+			Spec: &functions.LastOpSpec{},
+		},
+	}
+}
+
+// instantVectorEdges returns the edges between the operations of instantVectorOperations.
+func instantVectorEdges(suffix string) []query.Edge {
+	return []query.Edge{
+		{Parent: query.OperationID("from" + suffix), Child: query.OperationID("range" + suffix)},
+		{Parent: query.OperationID("range" + suffix), Child: query.OperationID("where" + suffix)},
+		{Parent: query.OperationID("where" + suffix), Child: query.OperationID("last" + suffix)},
+	}
+}
+
+// joinFunction creates a join function of the tables t.
+func joinFunction(body semantic.Expression) *semantic.FunctionExpression {
+	return &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+		Body:   body,
+	}
+}
+
+// tableValueExpr references the value of the named table of the join parameter t.
+func tableValueExpr(table string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object: &semantic.MemberExpression{
+			Object:   &semantic.IdentifierExpression{Name: "t"},
+			Property: table,
+		},
+		Property: "_value",
+	}
+}
+
+func recordValueExpr() *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object:   &semantic.IdentifierExpression{Name: "r"},
+		Property: "_value",
+	}
+}
@@ -1,7 +1,10 @@
 package promql
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -9,9 +12,14 @@ import (
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/semantic"
 )
 
+// lookbackDelta is how far back an instant vector selector looks for the latest sample of each series.
+// It is the same as the Prometheus default.
+const lookbackDelta = 5 * time.Minute
+
 type ArgKind int
 
 const (
@@ -27,6 +35,86 @@ type QueryBuilder interface {
 	QuerySpec() (*query.Spec, error)
 }
 
+// Expression is a PromQL expression, which evaluates to either a vector or a scalar.
+type Expression interface {
+	QueryBuilder
+	// build adds the operations of the expression to the spec and returns the ID of its final operation.
+	build(b *specBuilder) (query.OperationID, error)
+	// labels reports the labels of the series produced by the expression, if they are known.
+	labels() ([]string, bool)
+}
+
+// specBuilder builds a query.Spec out of the operations of one or more expressions.
+type specBuilder struct {
+	spec *query.Spec
+	ids  map[query.OperationID]int
+
+	// start and end are the times the expression is evaluated between.
+	// When they are zero, the expression is evaluated once at the time the query runs.
+	start, end time.Time
+	// step is the interval between evaluations of a range query.
+	step time.Duration
+}
+
+func newSpecBuilder() *specBuilder {
+	return &specBuilder{
+		spec: new(query.Spec),
+		ids:  make(map[query.OperationID]int),
+	}
+}
+
+// add appends the operation as a child of the parents, and returns its ID.
+// Operations that share an ID are numbered in the order they are added, so all IDs are unique.
+func (b *specBuilder) add(op *query.Operation, parents ...query.OperationID) query.OperationID {
+	id := op.ID
+	if n := b.ids[id]; n > 0 {
+		op.ID = query.OperationID(fmt.Sprintf("%s%d", id, n))
+	}
+	b.ids[id]++
+	b.spec.Operations = append(b.spec.Operations, op)
+	for _, p := range parents {
+		b.spec.Edges = append(b.spec.Edges, query.Edge{
+			Parent: p,
+			Child:  op.ID,
+		})
+	}
+	return op.ID
+}
+
+func buildSpec(e Expression) (*query.Spec, error) {
+	return newSpecBuilder().buildSpec(e)
+}
+
+func (b *specBuilder) buildSpec(e Expression) (*query.Spec, error) {
+	if _, err := e.build(b); err != nil {
+		return nil, err
+	}
+	return b.spec, nil
+}
+
+// rangeOp creates the range of a selector, which is relative to now unless the builder has evaluation times.
+func (b *specBuilder) rangeOp(rng, offset time.Duration) (*query.Operation, error) {
+	if b.end.IsZero() {
+		return NewRangeOp(rng, offset)
+	}
+	if rng == 0 {
+		rng = lookbackDelta
+	}
+	// A range query reads one step past the end, so the windows that are cut short by the end of the range
+	// stop after the last step rather than at it.
+	return &query.Operation{
+		ID: "range", // TODO: Change this to a UUID
+		Spec: &functions.RangeOpSpec{
+			Start: query.Time{
+				Absolute: b.start.Add(-rng - offset),
+			},
+			Stop: query.Time{
+				Absolute: b.end.Add(b.step - offset),
+			},
+		},
+	}, nil
+}
+
 type Arg interface {
 	Type() ArgKind
 	Value() interface{}
@@ -90,6 +178,18 @@ func (n *Number) Value() interface{} {
 	return n.Val
 }
 
+func (n *Number) QuerySpec() (*query.Spec, error) {
+	return buildSpec(n)
+}
+
+func (n *Number) build(*specBuilder) (query.OperationID, error) {
+	return "", fmt.Errorf("scalar %v cannot be queried on its own, it must be combined with a vector", n.Val)
+}
+
+func (n *Number) labels() ([]string, bool) {
+	return nil, true
+}
+
 func NewNumber(val string) (*Number, error) {
 	num, err := strconv.ParseFloat(val, 64)
 	if err != nil {
@@ -141,60 +241,92 @@ type Selector struct {
 }
 
 func (s *Selector) QuerySpec() (*query.Spec, error) {
-	parent := "from"
-	ops := []*query.Operation{
-		{
-			ID: "from", // TODO: Change this to a UUID
-			Spec: &functions.FromOpSpec{
-				Database: "prometheus",
-			},
-		},
+	return buildSpec(s)
+}
+
+func (s *Selector) build(b *specBuilder) (query.OperationID, error) {
+	id := b.add(newFromOperation())
+
+	rng, err := b.rangeOp(s.Range, s.Offset)
+	if err != nil {
+		return "", err
 	}
-	edges := []query.Edge{}
+	id = b.add(rng, id)
 
-	rng, err := NewRangeOp(s.Range, s.Offset)
+	where, err := NewWhereOperation(s.Name, s.LabelMatchers)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	id = b.add(where, id)
 
-	if rng != nil {
-		ops = append(ops, rng)
-		edge := query.Edge{
-			Parent: query.OperationID(parent),
-			Child:  "range",
+	if b.step > 0 {
+		// Each window holds the samples the selector sees at one step of the range query,
+		// and stops at the time of that step.
+		period := s.Range
+		if period == 0 {
+			period = lookbackDelta
 		}
-		parent = "range"
-		edges = append(edges, edge)
+		id = b.add(&query.Operation{
+			ID: "window",
+			Spec: &functions.WindowOpSpec{
+				Every:  query.Duration{Fixed: b.step},
+				Period: query.Duration{Fixed: period},
+				Start: query.Time{
+					Absolute: b.start.Add(-s.Offset),
+				},
+			},
+		}, id)
 	}
 
-	where, err := NewWhereOperation(s.Name, s.LabelMatchers)
-	if err != nil {
-		return nil, err
+	if s.Range == 0 {
+		// An instant vector is the latest sample of each series.
+		id = b.add(&query.Operation{
+			ID:   "last",
+			Spec: &functions.LastOpSpec{},
+		}, id)
 	}
-
-	ops = append(ops, where)
-	edge := query.Edge{
-		Parent: query.OperationID(parent),
-		Child:  "where",
+	if s.Offset != 0 {
+		// Move the samples forward, so they line up with the samples of vectors without an offset.
+		id = b.add(&query.Operation{
+			ID: "shift",
+			Spec: &functions.ShiftOpSpec{
+				Shift: query.Duration{Fixed: s.Offset},
+			},
+		}, id)
 	}
-	parent = "where"
-	edges = append(edges, edge)
+	return id, nil
+}
 
-	return &query.Spec{
-		Operations: ops,
-		Edges:      edges,
-	}, nil
+func (s *Selector) labels() ([]string, bool) {
+	return nil, false
 }
 
+// newFromOperation reads from the database that holds the Prometheus series.
+func newFromOperation() *query.Operation {
+	return &query.Operation{
+		ID: "from", // TODO: Change this to a UUID
+		Spec: &functions.FromOpSpec{
+			Database: "prometheus",
+		},
+	}
+}
+
+// NewRangeOp creates the range of a selector.
+// A selector without a range is an instant vector, which looks back for the latest sample of each series.
 func NewRangeOp(rng, offset time.Duration) (*query.Operation, error) {
-	if rng == 0 && offset == 0 {
-		return nil, nil
+	if rng == 0 {
+		rng = lookbackDelta
 	}
 	return &query.Operation{
 		ID: "range", // TODO: Change this to a UUID
 		Spec: &functions.RangeOpSpec{
 			Start: query.Time{
-				Relative: -rng - offset,
+				IsRelative: true,
+				Relative:   -rng - offset,
+			},
+			Stop: query.Time{
+				IsRelative: true,
+				Relative:   -offset,
 			},
 		},
 	}, nil
@@ -203,8 +335,8 @@ func NewRangeOp(rng, offset time.Duration) (*query.Operation, error) {
 var operatorLookup = map[MatchKind]ast.OperatorKind{
 	Equal:        ast.EqualOperator,
 	NotEqual:     ast.NotEqualOperator,
-	RegexMatch:   ast.EqualOperator,
-	RegexNoMatch: ast.NotEqualOperator,
+	RegexMatch:   ast.RegexpMatchOperator,
+	RegexNoMatch: ast.NotRegexpMatchOperator,
 }
 
 func NewWhereOperation(metricName string, labels []*LabelMatcher) (*query.Operation, error) {
@@ -233,11 +365,25 @@ func NewWhereOperation(metricName string, labels []*LabelMatcher) (*query.Operat
 			Property: label.Name,
 		}
 		var value semantic.Expression
-		if label.Value.Type() == StringKind {
+		switch {
+		case label.Kind == RegexMatch || label.Kind == RegexNoMatch:
+			str, ok := label.Value.Value().(string)
+			if !ok {
+				return nil, fmt.Errorf("regular expression for label %q must be a string", label.Name)
+			}
+			// Prometheus regular expressions are fully anchored.
+			re, err := regexp.Compile("^(?:" + str + ")$")
+			if err != nil {
+				return nil, err
+			}
+			value = &semantic.RegexpLiteral{
+				Value: re,
+			}
+		case label.Value.Type() == StringKind:
 			value = &semantic.StringLiteral{
 				Value: label.Value.Value().(string),
 			}
-		} else if label.Value.Type() == NumberKind {
+		case label.Value.Type() == NumberKind:
 			value = &semantic.FloatLiteral{
 				Value: label.Value.Value().(float64),
 			}
@@ -256,10 +402,7 @@ func NewWhereOperation(metricName string, labels []*LabelMatcher) (*query.Operat
 	return &query.Operation{
 		ID: "where", // TODO: Change this to a UUID
 		Spec: &functions.FilterOpSpec{
-			Fn: &semantic.FunctionExpression{
-				Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
-				Body:   node,
-			},
+			Fn: recordFunction(node),
 		},
 	}, nil
 }
@@ -300,12 +443,14 @@ type Aggregate struct {
 }
 
 func (a *Aggregate) QuerySpec() (*query.Operation, error) {
+	keys := identifierNames(a.Labels)
 	if a.Without {
-		return nil, fmt.Errorf("Unable to merge using `without`")
-	}
-	keys := make([]string, len(a.Labels))
-	for i := range a.Labels {
-		keys[i] = a.Labels[i].Name
+		return &query.Operation{
+			ID: "merge",
+			Spec: &functions.GroupOpSpec{
+				Except: keys,
+			},
+		}, nil
 	}
 	var keep []string
 	if a.KeepCommon {
@@ -338,34 +483,35 @@ const (
 	CountKind
 )
 
+var operatorNames = map[OperatorKind]string{
+	CountValuesKind: "count_values",
+	TopKind:         "topk",
+	BottomKind:      "bottomk",
+	QuantileKind:    "quantile",
+	SumKind:         "sum",
+	MinKind:         "min",
+	MaxKind:         "max",
+	AvgKind:         "avg",
+	StdevKind:       "stddev",
+	StdVarKind:      "stdvar",
+	CountKind:       "count",
+}
+
+func (k OperatorKind) String() string {
+	if name, ok := operatorNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("OperatorKind(%d)", int(k))
+}
+
 func ToOperatorKind(op string) OperatorKind {
 	op = strings.ToLower(op)
-	switch op {
-	case "count_values":
-		return CountValuesKind
-	case "topk":
-		return TopKind
-	case "bottomk":
-		return BottomKind
-	case "quantile":
-		return QuantileKind
-	case "sum":
-		return SumKind
-	case "min":
-		return MinKind
-	case "max":
-		return MaxKind
-	case "avg":
-		return AvgKind
-	case "stddev":
-		return StdevKind
-	case "stdvar":
-		return StdVarKind
-	case "count":
-		return CountKind
-	default:
-		return UnknownOpKind
+	for k, name := range operatorNames {
+		if name == op {
+			return k
+		}
 	}
+	return UnknownOpKind
 }
 
 type Operator struct {
@@ -373,100 +519,230 @@ type Operator struct {
 	Arg  Arg          `json:"arg,omitempty"`
 }
 
-func (o *Operator) QuerySpec() (*query.Operation, error) {
+// QuerySpec returns the operations that apply the operator to each group of series, in order.
+func (o *Operator) QuerySpec() ([]*query.Operation, error) {
 	switch o.Kind {
-	case CountValuesKind, BottomKind, QuantileKind, StdVarKind:
-		return nil, fmt.Errorf("Unable to run %d yet", o.Kind)
-	case CountKind:
-		return &query.Operation{
+	case CountValuesKind:
+		// The sample values are turned into the label before the series are grouped, see AggregateExpr.build.
+		return []*query.Operation{{
 			ID:   "count",
 			Spec: &functions.CountOpSpec{},
+		}}, nil
+	case TopKind, BottomKind:
+		n, err := o.number()
+		if err != nil {
+			return nil, err
+		}
+		if n < 1 || n != math.Trunc(n) {
+			return nil, fmt.Errorf("parameter of %s must be a positive integer, got %v", o.Kind, n)
+		}
+		return []*query.Operation{
+			{
+				ID: "sort",
+				Spec: &functions.SortOpSpec{
+					Cols: []string{execute.DefaultValueColLabel},
+					Desc: o.Kind == TopKind,
+				},
+			},
+			{
+				ID: "limit",
+				Spec: &functions.LimitOpSpec{
+					N: int64(n),
+				},
+			},
 		}, nil
-	//case TopKind:
-	//	return &query.Operation{
-	//		ID:   "top",
-	//		Spec: &functions.TopOpSpec{}, // TODO: Top doesn't have arg yet
-	//	}, nil
+	case QuantileKind:
+		q, err := o.number()
+		if err != nil {
+			return nil, err
+		}
+		if q < 0 || q > 1 {
+			return nil, fmt.Errorf("parameter of quantile must be between 0 and 1, got %v", q)
+		}
+		return []*query.Operation{{
+			ID: "percentile",
+			Spec: &functions.PercentileOpSpec{
+				Percentile: q,
+			},
+		}}, nil
+	case CountKind:
+		return []*query.Operation{{
+			ID:   "count",
+			Spec: &functions.CountOpSpec{},
+		}}, nil
 	case SumKind:
-		return &query.Operation{
+		return []*query.Operation{{
 			ID:   "sum",
 			Spec: &functions.SumOpSpec{},
+		}}, nil
+	case MinKind:
+		return []*query.Operation{{
+			ID:   "min",
+			Spec: &functions.MinOpSpec{},
+		}}, nil
+	case MaxKind:
+		return []*query.Operation{{
+			ID:   "max",
+			Spec: &functions.MaxOpSpec{},
+		}}, nil
+	case AvgKind:
+		return []*query.Operation{{
+			ID:   "mean",
+			Spec: &functions.MeanOpSpec{},
+		}}, nil
+	case StdevKind:
+		// Prometheus computes the standard deviation of the population of series.
+		return []*query.Operation{{
+			ID: "stddev",
+			Spec: &functions.StddevOpSpec{
+				Mode: functions.PopulationStddevMode,
+			},
+		}}, nil
+	case StdVarKind:
+		// The variance is the square of the standard deviation.
+		return []*query.Operation{
+			{
+				ID: "stddev",
+				Spec: &functions.StddevOpSpec{
+					Mode: functions.PopulationStddevMode,
+				},
+			},
+			newMapOperation(&semantic.BinaryExpression{
+				Operator: ast.MultiplicationOperator,
+				Left:     recordValue(),
+				Right:    recordValue(),
+			}),
 		}, nil
-	//case MinKind:
-	//	return &query.Operation{
-	//		ID:   "min",
-	//		Spec: &functions.MinOpSpec{},
-	//	}, nil
-	//case MaxKind:
-	//	return &query.Operation{
-	//		ID:   "max",
-	//		Spec: &functions.MaxOpSpec{},
-	//	}, nil
-	//case AvgKind:
-	//	return &query.Operation{
-	//		ID:   "mean",
-	//		Spec: &functions.MeanOpSpec{},
-	//	}, nil
-	//case StdevKind:
-	//	return &query.Operation{
-	//		ID:   "stddev",
-	//		Spec: &functions.StddevOpSpec{},
-	//	}, nil
 	default:
 		return nil, fmt.Errorf("Unknown Op kind %d", o.Kind)
 	}
 }
 
+// label returns the label parameter of the operator.
+func (o *Operator) label() (string, error) {
+	l, ok := o.Arg.(*StringLiteral)
+	if !ok || l.String == "" {
+		return "", fmt.Errorf("%s requires a label name parameter", o.Kind)
+	}
+	return l.String, nil
+}
+
+// number returns the numeric parameter of the operator.
+func (o *Operator) number() (float64, error) {
+	n, ok := o.Arg.(*Number)
+	if !ok {
+		return 0, fmt.Errorf("%s requires a numeric parameter", o.Kind)
+	}
+	return n.Val, nil
+}
+
 type AggregateExpr struct {
-	Op        *Operator  `json:"op,omitempty"`
-	Selector  *Selector  `json:"selector,omitempty"`
+	Op *Operator `json:"op,omitempty"`
+	// Selector is the expression whose series are aggregated.
+	Selector  Expression `json:"selector,omitempty"`
 	Aggregate *Aggregate `json:"aggregate,omitempty"`
 }
 
 func (a *AggregateExpr) QuerySpec() (*query.Spec, error) {
-	spec, err := a.Selector.QuerySpec()
+	return buildSpec(a)
+}
+
+func (a *AggregateExpr) build(b *specBuilder) (query.OperationID, error) {
+	id, err := a.Selector.build(b)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	if a.Aggregate != nil {
-		agg, err := a.Aggregate.QuerySpec()
+	if b.step > 0 {
+		// The series of a range query have a row at each step.
+		// The rows are moved just before their step and windowed by step,
+		// so the operator is applied to the series of each step and aggregates are stamped with the time of the step.
+		id = b.add(&query.Operation{
+			ID: "shift",
+			Spec: &functions.ShiftOpSpec{
+				Shift: query.Duration{Fixed: -time.Nanosecond},
+			},
+		}, id)
+		id = b.add(&query.Operation{
+			ID: "window",
+			Spec: &functions.WindowOpSpec{
+				Every:  query.Duration{Fixed: b.step},
+				Period: query.Duration{Fixed: b.step},
+				Start: query.Time{
+					Absolute: b.start,
+				},
+			},
+		}, id)
+	}
+
+	var label string
+	if a.Op.Kind == CountValuesKind {
+		// The value of each sample is set as the label, so the series are also grouped by their values.
+		label, err = a.Op.label()
 		if err != nil {
-			return nil, err
+			return "", err
 		}
+		id = b.add(&query.Operation{
+			ID: "set",
+			Spec: &functions.SetOpSpec{
+				Key:    label,
+				Column: execute.DefaultValueColLabel,
+			},
+		}, id)
+	}
 
-		parent := query.OperationID("from")
-		if len(spec.Edges) > 0 {
-			tail := spec.Edges[len(spec.Edges)-1]
-			parent = tail.Child
+	group := &query.Operation{
+		// Without a grouping clause all series are aggregated together.
+		ID:   "merge",
+		Spec: &functions.GroupOpSpec{},
+	}
+	if a.Aggregate != nil {
+		group, err = a.Aggregate.QuerySpec()
+		if err != nil {
+			return "", err
 		}
-
-		spec.Operations = append(spec.Operations, agg)
-		spec.Edges = append(spec.Edges, query.Edge{
-			Parent: parent,
-			Child:  agg.ID,
-		})
 	}
+	if spec := group.Spec.(*functions.GroupOpSpec); label != "" && len(spec.Except) == 0 {
+		spec.By = append(spec.By, label)
+	}
+	id = b.add(group, id)
 
-	op, err := a.Op.QuerySpec()
+	ops, err := a.Op.QuerySpec()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	for _, op := range ops {
+		id = b.add(op, id)
+	}
+	if b.step > 0 && (a.Op.Kind == TopKind || a.Op.Kind == BottomKind) {
+		// The selected rows keep their time, which is moved back to the step.
+		id = b.add(&query.Operation{
+			ID: "shift",
+			Spec: &functions.ShiftOpSpec{
+				Shift: query.Duration{Fixed: time.Nanosecond},
+			},
+		}, id)
+	}
+	return id, nil
+}
 
-	parent := query.OperationID("from")
-	if len(spec.Edges) > 0 {
-		tail := spec.Edges[len(spec.Edges)-1]
-		parent = tail.Child
+func (a *AggregateExpr) labels() ([]string, bool) {
+	if a.Aggregate != nil && a.Aggregate.Without {
+		return nil, false
 	}
-	spec.Operations = append(spec.Operations, op)
-	spec.Edges = append(spec.Edges, query.Edge{
-		Parent: parent,
-		Child:  op.ID,
-	})
-	return spec, nil
+	labels := []string{}
+	if a.Aggregate != nil {
+		labels = identifierNames(a.Aggregate.Labels)
+	}
+	if a.Op.Kind == CountValuesKind {
+		if label, err := a.Op.label(); err == nil {
+			labels = append(labels, label)
+		}
+	}
+	return labels, true
 }
 
-func NewAggregateExpr(op *Operator, selector *Selector, group interface{}) (*AggregateExpr, error) {
+func NewAggregateExpr(op *Operator, selector Expression, group interface{}) (*AggregateExpr, error) {
 	expr := &AggregateExpr{
 		Op:       op,
 		Selector: selector,
@@ -477,6 +753,828 @@ func NewAggregateExpr(op *Operator, selector *Selector, group interface{}) (*Agg
 	return expr, nil
 }
 
+// FunctionCall is a call to a function over a range vector.
+type FunctionCall struct {
+	Name     string    `json:"name,omitempty"`
+	Selector *Selector `json:"selector,omitempty"`
+}
+
+func NewFunctionCall(name string, arg Expression) (*FunctionCall, error) {
+	sel, ok := arg.(*Selector)
+	if !ok || sel.Range == 0 {
+		return nil, fmt.Errorf("expected range vector as the argument of %s", name)
+	}
+	return &FunctionCall{
+		Name:     name,
+		Selector: sel,
+	}, nil
+}
+
+func (f *FunctionCall) QuerySpec() (*query.Spec, error) {
+	return buildSpec(f)
+}
+
+func (f *FunctionCall) build(b *specBuilder) (query.OperationID, error) {
+	id, err := f.Selector.build(b)
+	if err != nil {
+		return "", err
+	}
+	switch f.Name {
+	case "increase", "rate", "delta":
+		return f.extrapolatedRate(b, id)
+	case "irate":
+		// The instant rate is the per second rate between the last two samples.
+		id = b.add(&query.Operation{
+			ID: "derivative",
+			Spec: &functions.DerivativeOpSpec{
+				Unit:        query.Duration{Fixed: time.Second},
+				NonNegative: true,
+			},
+		}, id)
+		id = b.add(&query.Operation{
+			ID:   "last",
+			Spec: &functions.LastOpSpec{},
+		}, id)
+	case "avg_over_time", "min_over_time", "max_over_time", "sum_over_time", "count_over_time":
+		// The samples of each series are aggregated, as the aggregation operator of the same name aggregates series.
+		op := &Operator{Kind: ToOperatorKind(strings.TrimSuffix(f.Name, "_over_time"))}
+		ops, err := op.QuerySpec()
+		if err != nil {
+			return "", err
+		}
+		for _, op := range ops {
+			id = b.add(op, id)
+		}
+	default:
+		return "", fmt.Errorf("unknown function %s", f.Name)
+	}
+	return id, nil
+}
+
+// extrapolatedRate builds rate, increase and delta the way Prometheus computes them.
+//
+// The difference between the first and the last sample of the range,
+// corrected for counter resets by rate and increase, is extrapolated to the edges of the range.
+// It is extrapolated by at most half the average interval between samples past the first and last samples,
+// and a counter is not extrapolated below zero.
+// Series with fewer than two samples in the range have no result.
+func (f *FunctionCall) extrapolatedRate(b *specBuilder, id query.OperationID) (query.OperationID, error) {
+	isCounter := f.Name != "delta"
+	first := semantic.Expression(accumulatorProperty("first"))
+	// A decrease of a counter is a reset, after which the counter has increased by its new value.
+	increase := semantic.Expression(&semantic.BinaryExpression{
+		Operator: ast.SubtractionOperator,
+		Left:     recordValue(),
+		Right:    accumulatorProperty("last"),
+	})
+	if isCounter {
+		increase = &semantic.ConditionalExpression{
+			Test: &semantic.BinaryExpression{
+				Operator: ast.LessThanOperator,
+				Left:     recordValue(),
+				Right:    accumulatorProperty("last"),
+			},
+			Consequent: recordValue(),
+			Alternate:  increase,
+		}
+	}
+	isFirst := func() semantic.Expression {
+		return &semantic.BinaryExpression{
+			Operator: ast.EqualOperator,
+			Left:     accumulatorProperty("count"),
+			Right:    &semantic.IntegerLiteral{Value: 0},
+		}
+	}
+	id = b.add(&query.Operation{
+		ID: "reduce",
+		Spec: &functions.ReduceOpSpec{
+			Fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+					{Key: &semantic.Identifier{Name: "accumulator"}},
+				},
+				Body: &semantic.ObjectExpression{
+					Properties: []*semantic.Property{
+						{
+							Key: &semantic.Identifier{Name: "count"},
+							Value: &semantic.BinaryExpression{
+								Operator: ast.AdditionOperator,
+								Left:     accumulatorProperty("count"),
+								Right:    &semantic.IntegerLiteral{Value: 1},
+							},
+						},
+						{
+							Key: &semantic.Identifier{Name: "first"},
+							Value: &semantic.ConditionalExpression{
+								Test:       isFirst(),
+								Consequent: recordValue(),
+								Alternate:  first,
+							},
+						},
+						{
+							Key: &semantic.Identifier{Name: "firstTime"},
+							Value: &semantic.ConditionalExpression{
+								Test:       isFirst(),
+								Consequent: seconds(recordTime()),
+								Alternate:  accumulatorProperty("firstTime"),
+							},
+						},
+						{
+							Key: &semantic.Identifier{Name: "increase"},
+							Value: &semantic.ConditionalExpression{
+								Test:       isFirst(),
+								Consequent: &semantic.FloatLiteral{Value: 0},
+								Alternate: &semantic.BinaryExpression{
+									Operator: ast.AdditionOperator,
+									Left:     accumulatorProperty("increase"),
+									Right:    increase,
+								},
+							},
+						},
+						{
+							Key:   &semantic.Identifier{Name: "last"},
+							Value: recordValue(),
+						},
+						{
+							Key:   &semantic.Identifier{Name: "lastTime"},
+							Value: seconds(recordTime()),
+						},
+					},
+				},
+			},
+			Identity: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{
+					{Key: &semantic.Identifier{Name: "count"}, Value: &semantic.IntegerLiteral{Value: 0}},
+					{Key: &semantic.Identifier{Name: "first"}, Value: &semantic.FloatLiteral{Value: 0}},
+					{Key: &semantic.Identifier{Name: "firstTime"}, Value: &semantic.FloatLiteral{Value: 0}},
+					{Key: &semantic.Identifier{Name: "increase"}, Value: &semantic.FloatLiteral{Value: 0}},
+					{Key: &semantic.Identifier{Name: "last"}, Value: &semantic.FloatLiteral{Value: 0}},
+					{Key: &semantic.Identifier{Name: "lastTime"}, Value: &semantic.FloatLiteral{Value: 0}},
+				},
+			},
+		},
+	}, id)
+	id = b.add(&query.Operation{
+		ID: "filter",
+		Spec: &functions.FilterOpSpec{
+			Fn: recordFunction(&semantic.BinaryExpression{
+				Operator: ast.GreaterThanOperator,
+				Left:     recordProperty("count"),
+				Right:    &semantic.IntegerLiteral{Value: 1},
+			}),
+		},
+	}, id)
+
+	// The reduced record is stamped with the end of the range, all durations are in seconds.
+	rng := f.Selector.Range.Seconds()
+	sampled := func() semantic.Expression {
+		return subtract(recordProperty("lastTime"), recordProperty("firstTime"))
+	}
+	average := func() semantic.Expression {
+		return &semantic.BinaryExpression{
+			Operator: ast.DivisionOperator,
+			Left:     sampled(),
+			Right:    convert("float", subtract(recordProperty("count"), &semantic.IntegerLiteral{Value: 1})),
+		}
+	}
+	toStart := func() semantic.Expression {
+		start := subtract(seconds(recordTime()), &semantic.FloatLiteral{Value: rng})
+		return subtract(recordProperty("firstTime"), start)
+	}
+	if isCounter {
+		// The counter is not extrapolated to before the time it would have been zero.
+		plain := toStart
+		toZero := func() semantic.Expression {
+			return &semantic.BinaryExpression{
+				Operator: ast.DivisionOperator,
+				Left: &semantic.BinaryExpression{
+					Operator: ast.MultiplicationOperator,
+					Left:     sampled(),
+					Right:    recordProperty("first"),
+				},
+				Right: recordProperty("increase"),
+			}
+		}
+		toStart = func() semantic.Expression {
+			return &semantic.ConditionalExpression{
+				Test: &semantic.LogicalExpression{
+					Operator: ast.AndOperator,
+					Left: &semantic.LogicalExpression{
+						Operator: ast.AndOperator,
+						Left: &semantic.BinaryExpression{
+							Operator: ast.GreaterThanOperator,
+							Left:     recordProperty("increase"),
+							Right:    &semantic.FloatLiteral{Value: 0},
+						},
+						Right: &semantic.BinaryExpression{
+							Operator: ast.GreaterThanEqualOperator,
+							Left:     recordProperty("first"),
+							Right:    &semantic.FloatLiteral{Value: 0},
+						},
+					},
+					Right: &semantic.BinaryExpression{
+						Operator: ast.LessThanOperator,
+						Left:     toZero(),
+						Right:    plain(),
+					},
+				},
+				Consequent: toZero(),
+				Alternate:  plain(),
+			}
+		}
+	}
+	toEnd := func() semantic.Expression {
+		return subtract(seconds(recordTime()), recordProperty("lastTime"))
+	}
+	// extrapolate is the duration past a sample to extrapolate to,
+	// which is the duration to the edge of the range if it is not much more than the average interval between samples.
+	extrapolate := func(d func() semantic.Expression) semantic.Expression {
+		return &semantic.ConditionalExpression{
+			Test: &semantic.BinaryExpression{
+				Operator: ast.LessThanOperator,
+				Left:     d(),
+				Right: &semantic.BinaryExpression{
+					Operator: ast.MultiplicationOperator,
+					Left:     average(),
+					Right:    &semantic.FloatLiteral{Value: 1.1},
+				},
+			},
+			Consequent: d(),
+			Alternate: &semantic.BinaryExpression{
+				Operator: ast.DivisionOperator,
+				Left:     average(),
+				Right:    &semantic.FloatLiteral{Value: 2},
+			},
+		}
+	}
+	interval := &semantic.BinaryExpression{
+		Operator: ast.AdditionOperator,
+		Left: &semantic.BinaryExpression{
+			Operator: ast.AdditionOperator,
+			Left:     sampled(),
+			Right:    extrapolate(toStart),
+		},
+		Right: extrapolate(toEnd),
+	}
+	value := semantic.Expression(&semantic.BinaryExpression{
+		Operator: ast.MultiplicationOperator,
+		Left:     recordProperty("increase"),
+		Right: &semantic.BinaryExpression{
+			Operator: ast.DivisionOperator,
+			Left:     interval,
+			Right:    sampled(),
+		},
+	})
+	if f.Name == "rate" {
+		// The rate is the per second average of the increase over the range.
+		value = &semantic.BinaryExpression{
+			Operator: ast.DivisionOperator,
+			Left:     value,
+			Right:    &semantic.FloatLiteral{Value: rng},
+		}
+	}
+	return b.add(newMapOperation(value), id), nil
+}
+
+func (f *FunctionCall) labels() ([]string, bool) {
+	return f.Selector.labels()
+}
+
+// BinaryOperatorKind is an enum for the binary operators.
+type BinaryOperatorKind int
+
+// Possible BinaryOperatorKinds.
+const (
+	UnknownBinaryOpKind BinaryOperatorKind = iota
+	AddKind
+	SubKind
+	MulKind
+	DivKind
+	ModKind
+	PowKind
+	EqlKind
+	NeqKind
+	GtrKind
+	LssKind
+	GteKind
+	LteKind
+	AndKind
+	OrKind
+	UnlessKind
+)
+
+var binaryOperatorTokens = map[BinaryOperatorKind]string{
+	AddKind:    "+",
+	SubKind:    "-",
+	MulKind:    "*",
+	DivKind:    "/",
+	ModKind:    "%",
+	PowKind:    "^",
+	EqlKind:    "==",
+	NeqKind:    "!=",
+	GtrKind:    ">",
+	LssKind:    "<",
+	GteKind:    ">=",
+	LteKind:    "<=",
+	AndKind:    "and",
+	OrKind:     "or",
+	UnlessKind: "unless",
+}
+
+// binaryOperatorLookup maps the binary operators that IFQL supports to their IFQL operators.
+var binaryOperatorLookup = map[BinaryOperatorKind]ast.OperatorKind{
+	AddKind: ast.AdditionOperator,
+	SubKind: ast.SubtractionOperator,
+	MulKind: ast.MultiplicationOperator,
+	DivKind: ast.DivisionOperator,
+	EqlKind: ast.EqualOperator,
+	NeqKind: ast.NotEqualOperator,
+	GtrKind: ast.GreaterThanOperator,
+	LssKind: ast.LessThanOperator,
+	GteKind: ast.GreaterThanEqualOperator,
+	LteKind: ast.LessThanEqualOperator,
+}
+
+func (k BinaryOperatorKind) String() string {
+	if token, ok := binaryOperatorTokens[k]; ok {
+		return token
+	}
+	return fmt.Sprintf("BinaryOperatorKind(%d)", int(k))
+}
+
+func ToBinaryOperatorKind(op string) BinaryOperatorKind {
+	op = strings.ToLower(op)
+	for k, token := range binaryOperatorTokens {
+		if token == op {
+			return k
+		}
+	}
+	return UnknownBinaryOpKind
+}
+
+func (k BinaryOperatorKind) isComparison() bool {
+	return k >= EqlKind && k <= LteKind
+}
+
+func (k BinaryOperatorKind) isSetOperator() bool {
+	return k >= AndKind && k <= UnlessKind
+}
+
+// GroupModifier makes vector matching many-to-one (group_left) or one-to-many (group_right).
+type GroupModifier struct {
+	Right  bool          `json:"right,omitempty"`
+	Labels []*Identifier `json:"labels,omitempty"`
+}
+
+func NewGroupModifier(side string, labels interface{}) (*GroupModifier, error) {
+	g := &GroupModifier{
+		Right: strings.ToLower(side) == "group_right",
+	}
+	if labels != nil {
+		g.Labels = labels.([]*Identifier)
+	}
+	return g, nil
+}
+
+// VectorMatching describes which series of the two vectors of a binary expression are combined.
+type VectorMatching struct {
+	// On is true if the series are matched on the labels, otherwise the labels are ignored.
+	On     bool           `json:"on,omitempty"`
+	Labels []*Identifier  `json:"labels,omitempty"`
+	Group  *GroupModifier `json:"group,omitempty"`
+}
+
+func NewVectorMatching(kind string, labels []*Identifier, group interface{}) (*VectorMatching, error) {
+	m := &VectorMatching{
+		On:     strings.ToLower(kind) == "on",
+		Labels: labels,
+	}
+	if group != nil {
+		m.Group = group.(*GroupModifier)
+	}
+	return m, nil
+}
+
+// BinaryExpr applies a binary operator to two expressions.
+//
+// Arithmetic between a vector and a scalar maps each sample, and comparisons with a scalar filter the samples,
+// or map them to 1 or 0 with the bool modifier.
+// Binary operators between two vectors join the series of the vectors on their matching labels,
+// which are the labels listed by on(), or else all labels except the metric name and the labels listed by ignoring().
+// The joined series only have their matching labels, except with group_left or group_right,
+// where the series of the many side keep their labels and the labels listed by the modifier are copied from the one side.
+type BinaryExpr struct {
+	Op         BinaryOperatorKind `json:"op,omitempty"`
+	LHS        Expression         `json:"lhs,omitempty"`
+	RHS        Expression         `json:"rhs,omitempty"`
+	ReturnBool bool               `json:"return_bool,omitempty"`
+	Matching   *VectorMatching    `json:"matching,omitempty"`
+}
+
+// NewBinaryOperand creates a binary expression that is missing its left hand side.
+func NewBinaryOperand(op BinaryOperatorKind, returnBool bool, matching interface{}, rhs Expression) (*BinaryExpr, error) {
+	e := &BinaryExpr{
+		Op:         op,
+		RHS:        rhs,
+		ReturnBool: returnBool,
+	}
+	if matching != nil {
+		e.Matching = matching.(*VectorMatching)
+	}
+	return e, nil
+}
+
+// NewBinaryExpr combines the first expression with the rest of the operands, from left to right.
+func NewBinaryExpr(first Expression, rest interface{}) (Expression, error) {
+	expr := first
+	for _, r := range toIfaceSlice(rest) {
+		if operand, ok := r.(*BinaryExpr); ok {
+			operand.LHS = expr
+			expr = operand
+		}
+	}
+	return expr, nil
+}
+
+func (e *BinaryExpr) QuerySpec() (*query.Spec, error) {
+	return buildSpec(e)
+}
+
+func (e *BinaryExpr) build(b *specBuilder) (query.OperationID, error) {
+	lhs, lhsScalar := scalarValue(e.LHS)
+	rhs, rhsScalar := scalarValue(e.RHS)
+	switch {
+	case e.ReturnBool && !e.Op.isComparison():
+		return "", fmt.Errorf("bool modifier can only be used on comparison operators, got %s", e.Op)
+	case lhsScalar && rhsScalar:
+		return "", errors.New("binary expression between scalars cannot be queried on its own, it must be combined with a vector")
+	case e.Op.isSetOperator() && (lhsScalar || rhsScalar):
+		return "", fmt.Errorf("set operator %s is only allowed between two vectors", e.Op)
+	}
+	op, ok := binaryOperatorLookup[e.Op]
+	if !ok && !e.Op.isSetOperator() {
+		return "", fmt.Errorf("operator %s is not supported between vectors", e.Op)
+	}
+
+	if lhsScalar || rhsScalar {
+		if e.Matching != nil {
+			return "", errors.New("vector matching is only allowed between two vectors")
+		}
+		vector, left, right := e.LHS, semantic.Expression(recordValue()), semantic.Expression(&semantic.FloatLiteral{Value: rhs})
+		if lhsScalar {
+			vector, left, right = e.RHS, &semantic.FloatLiteral{Value: lhs}, recordValue()
+		}
+		id, err := vector.build(b)
+		if err != nil {
+			return "", err
+		}
+		body := &semantic.BinaryExpression{
+			Operator: op,
+			Left:     left,
+			Right:    right,
+		}
+		switch {
+		case e.ReturnBool:
+			return b.add(newMapOperation(boolValue(body)), id), nil
+		case e.Op.isComparison():
+			// Comparisons keep the samples for which they are true.
+			return b.add(&query.Operation{
+				ID: "filter",
+				Spec: &functions.FilterOpSpec{
+					Fn: recordFunction(body),
+				},
+			}, id), nil
+		}
+		return b.add(newMapOperation(body), id), nil
+	}
+
+	var many string
+	var include []string
+	if e.Matching != nil && e.Matching.Group != nil {
+		if e.Op.isSetOperator() {
+			return "", fmt.Errorf("group_left and group_right cannot be used with set operator %s", e.Op)
+		}
+		many = "left"
+		if e.Matching.Group.Right {
+			many = "right"
+		}
+		if len(e.Matching.Group.Labels) > 0 {
+			include = identifierNames(e.Matching.Group.Labels)
+		}
+	}
+	lhsID, err := e.LHS.build(b)
+	if err != nil {
+		return "", err
+	}
+	rhsID, err := e.RHS.build(b)
+	if err != nil {
+		return "", err
+	}
+
+	// The samples that a comparison without the bool modifier or unless drops are null, and are filtered out after the join.
+	var method, left string
+	var body semantic.Expression
+	switch e.Op {
+	case AndKind:
+		// and keeps the samples of the left vector that match a sample of the right vector.
+		body = tableValue("left")
+	case OrKind:
+		// or keeps the samples of the left vector, and the samples of the right vector that match none of them.
+		method = functions.JoinMethodFull
+		body = &semantic.ConditionalExpression{
+			Test:       tableValueExists("left"),
+			Consequent: tableValue("left"),
+			Alternate:  tableValue("right"),
+		}
+	case UnlessKind:
+		// unless keeps the samples of the left vector that match no sample of the right vector.
+		method, left = functions.JoinMethodLeft, "left"
+		body = &semantic.ConditionalExpression{
+			Test:       tableValueExists("right"),
+			Consequent: &semantic.NullLiteral{},
+			Alternate:  tableValue("left"),
+		}
+	default:
+		body = &semantic.BinaryExpression{
+			Operator: op,
+			Left:     tableValue("left"),
+			Right:    tableValue("right"),
+		}
+		switch {
+		case e.ReturnBool:
+			body = boolValue(body)
+		case e.Op.isComparison():
+			// Comparisons keep the samples of the left vector for which they are true.
+			body = &semantic.ConditionalExpression{
+				Test:       body,
+				Consequent: tableValue("left"),
+				Alternate:  &semantic.NullLiteral{},
+			}
+		}
+	}
+	on, ignoring := e.matching()
+	id := b.add(&query.Operation{
+		ID: "join",
+		Spec: &functions.JoinOpSpec{
+			On:     on,
+			Except: ignoring,
+			TableNames: map[query.OperationID]string{
+				lhsID: "left",
+				rhsID: "right",
+			},
+			Method:  method,
+			Left:    left,
+			Many:    many,
+			Include: include,
+			Fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+				Body:   body,
+			},
+		},
+	}, lhsID, rhsID)
+	if e.Op == UnlessKind || (e.Op.isComparison() && !e.ReturnBool) {
+		id = b.add(&query.Operation{
+			ID: "filter",
+			Spec: &functions.FilterOpSpec{
+				Fn: recordFunction(&semantic.UnaryExpression{
+					Operator: ast.ExistsOperator,
+					Argument: recordValue(),
+				}),
+			},
+		}, id)
+	}
+	return id, nil
+}
+
+// boolValue maps the result of a comparison to 1 if it is true and to 0 otherwise.
+func boolValue(comparison semantic.Expression) semantic.Expression {
+	return &semantic.ConditionalExpression{
+		Test:       comparison,
+		Consequent: &semantic.FloatLiteral{Value: 1},
+		Alternate:  &semantic.FloatLiteral{Value: 0},
+	}
+}
+
+// seriesTags are the tags of a series that are not its labels, or are the metric name.
+var seriesTags = []string{"_field", "_measurement", "_metric"}
+
+// matching returns the labels on which the series of two vectors are joined, if they are listed by on().
+// Otherwise the series are joined on all of their other labels, and the labels they are not joined on are returned.
+func (e *BinaryExpr) matching() (on, ignoring []string) {
+	if e.Matching != nil && e.Matching.On {
+		return identifierNames(e.Matching.Labels), nil
+	}
+	ignoring = append(ignoring, seriesTags...)
+	if e.Matching != nil {
+		ignoring = append(ignoring, identifierNames(e.Matching.Labels)...)
+	}
+	return nil, ignoring
+}
+
+func (e *BinaryExpr) labels() ([]string, bool) {
+	if _, ok := scalarValue(e.LHS); ok {
+		return e.RHS.labels()
+	}
+	if _, ok := scalarValue(e.RHS); ok {
+		return e.LHS.labels()
+	}
+	if e.Matching != nil && e.Matching.Group != nil {
+		// The joined series have the labels of the many side and the labels copied from the one side.
+		many := e.LHS
+		if e.Matching.Group.Right {
+			many = e.RHS
+		}
+		labels, ok := many.labels()
+		if !ok {
+			return nil, false
+		}
+		for _, l := range identifierNames(e.Matching.Group.Labels) {
+			if !containsString(labels, l) {
+				labels = append(labels, l)
+			}
+		}
+		return labels, true
+	}
+	on, ignoring := e.matching()
+	if ignoring == nil {
+		return on, true
+	}
+	lhs, lhsOK := e.LHS.labels()
+	rhs, rhsOK := e.RHS.labels()
+	if !lhsOK || !rhsOK {
+		return nil, false
+	}
+	// The joined series have the labels of both series.
+	labels := make([]string, 0, len(lhs))
+	for _, l := range lhs {
+		if containsString(rhs, l) && !containsString(ignoring, l) {
+			labels = append(labels, l)
+		}
+	}
+	return labels, true
+}
+
+// scalar evaluates the expression if both of its sides are scalars.
+func (e *BinaryExpr) scalar() (float64, bool) {
+	lhs, ok := scalarValue(e.LHS)
+	if !ok {
+		return 0, false
+	}
+	rhs, ok := scalarValue(e.RHS)
+	if !ok {
+		return 0, false
+	}
+	switch e.Op {
+	case AddKind:
+		return lhs + rhs, true
+	case SubKind:
+		return lhs - rhs, true
+	case MulKind:
+		return lhs * rhs, true
+	case DivKind:
+		return lhs / rhs, true
+	case ModKind:
+		return math.Mod(lhs, rhs), true
+	case PowKind:
+		return math.Pow(lhs, rhs), true
+	default:
+		return 0, false
+	}
+}
+
+// scalarValue reports the value of the expression if it is a scalar.
+func scalarValue(e Expression) (float64, bool) {
+	switch e := e.(type) {
+	case *Number:
+		return e.Val, true
+	case *BinaryExpr:
+		return e.scalar()
+	default:
+		return 0, false
+	}
+}
+
+// recordValue references the value of the record r.
+func recordValue() *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object: &semantic.IdentifierExpression{
+			Name: "r",
+		},
+		Property: execute.DefaultValueColLabel,
+	}
+}
+
+// recordTime references the time of the record r.
+func recordTime() *semantic.MemberExpression {
+	return recordProperty(execute.TimeColLabel)
+}
+
+// recordProperty references a property of the record r.
+func recordProperty(property string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object: &semantic.IdentifierExpression{
+			Name: "r",
+		},
+		Property: property,
+	}
+}
+
+// accumulatorProperty references a property of the accumulator of a reduce function.
+func accumulatorProperty(property string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object: &semantic.IdentifierExpression{
+			Name: "accumulator",
+		},
+		Property: property,
+	}
+}
+
+// seconds converts a time to the number of seconds since the Unix epoch.
+func seconds(t semantic.Expression) semantic.Expression {
+	return &semantic.BinaryExpression{
+		Operator: ast.DivisionOperator,
+		Left:     convert("float", convert("int", t)),
+		Right:    &semantic.FloatLiteral{Value: float64(time.Second)},
+	}
+}
+
+// convert calls the builtin function that converts a value to another type.
+func convert(name string, v semantic.Expression) *semantic.CallExpression {
+	return &semantic.CallExpression{
+		Callee: &semantic.IdentifierExpression{Name: name},
+		Arguments: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{{
+				Key:   &semantic.Identifier{Name: "v"},
+				Value: v,
+			}},
+		},
+	}
+}
+
+func subtract(left, right semantic.Expression) semantic.Expression {
+	return &semantic.BinaryExpression{
+		Operator: ast.SubtractionOperator,
+		Left:     left,
+		Right:    right,
+	}
+}
+
+// tableValue references the value of the named table of the join parameter t.
+func tableValue(table string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object: &semantic.MemberExpression{
+			Object: &semantic.IdentifierExpression{
+				Name: "t",
+			},
+			Property: table,
+		},
+		Property: execute.DefaultValueColLabel,
+	}
+}
+
+// tableValueExists checks whether the named table of the join parameter t has a value.
+func tableValueExists(table string) *semantic.UnaryExpression {
+	return &semantic.UnaryExpression{
+		Operator: ast.ExistsOperator,
+		Argument: tableValue(table),
+	}
+}
+
+// recordFunction creates a function of the record r.
+func recordFunction(body semantic.Expression) *semantic.FunctionExpression {
+	return &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+		Body:   body,
+	}
+}
+
+// newMapOperation creates an operation that replaces the value of each record.
+func newMapOperation(body semantic.Expression) *query.Operation {
+	return &query.Operation{
+		ID: "map",
+		Spec: &functions.MapOpSpec{
+			Fn: recordFunction(body),
+		},
+	}
+}
+
+func identifierNames(ids []*Identifier) []string {
+	names := make([]string, len(ids))
+	for i := range ids {
+		names[i] = ids[i].Name
+	}
+	return names
+}
+
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
 type Comment struct {
 	Source string `json:"source,omitempty"`
 }
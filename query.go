@@ -24,10 +24,20 @@ func init() {
 
 type Config struct {
 	Hosts []string
+	// WriteAddr is the address of the InfluxDB HTTP API used by the to function.
+	// The to function is unavailable if no address is given.
+	WriteAddr string
 
 	ConcurrencyQuota int
 	MemoryBytesQuota int
 
+	// SearchPath are the directories searched for the packages that queries import.
+	SearchPath []string
+
+	// CSVDir is the directory of the files that the fromCSV function reads.
+	// Reading files is disabled if no directory is given.
+	CSVDir string
+
 	Verbose bool
 }
 
@@ -45,13 +55,23 @@ func NewController(conf Config) (*Controller, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create storage reader")
 	}
+	var w execute.PointsWriter
+	if conf.WriteAddr != "" {
+		w, err = execute.NewHTTPPointsWriter(conf.WriteAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create points writer")
+		}
+	}
 	c := control.Config{
 		ConcurrencyQuota: conf.ConcurrencyQuota,
 		MemoryBytesQuota: int64(conf.MemoryBytesQuota),
 		ExecutorConfig: execute.Config{
 			StorageReader: s,
+			PointsWriter:  w,
+			CSVDir:        conf.CSVDir,
 		},
-		Verbose: conf.Verbose,
+		SearchPath: conf.SearchPath,
+		Verbose:    conf.Verbose,
 	}
 	return control.New(c), nil
 }
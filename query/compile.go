@@ -7,6 +7,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/influxdata/ifql/diagnostic"
 	"github.com/influxdata/ifql/interpreter"
 	"github.com/influxdata/ifql/parser"
 	"github.com/influxdata/ifql/semantic"
@@ -30,38 +31,61 @@ func Verbose(v bool) Option {
 	}
 }
 
+// WithLoader imports the packages of the query with the loader,
+// by default only the registered packages can be imported.
+func WithLoader(l *Loader) Option {
+	return func(o *options) {
+		o.loader = l
+	}
+}
+
 type options struct {
 	verbose bool
+	loader  *Loader
 }
 
 // Compile evaluates an IFQL script producing a query Spec.
+// Problems with the script are returned as a diagnostic.List.
 func Compile(ctx context.Context, q string, opts ...Option) (*Spec, error) {
 	o := new(options)
 	for _, opt := range opts {
 		opt(o)
 	}
+	if o.loader == nil {
+		o.loader = NewLoader()
+	}
 	s, _ := opentracing.StartSpanFromContext(ctx, "parse")
 	astProg, err := parser.NewAST(q)
 	if err != nil {
-		return nil, err
+		return nil, diagnostic.FromError(err, diagnostic.SyntaxError)
 	}
 	s.Finish()
 	s, _ = opentracing.StartSpanFromContext(ctx, "compile")
 	defer s.Finish()
 
+	// Create top-level builtin scope
+	scope := builtinScope.Nest()
+	declarations := builtinDeclarations.Copy()
+
+	// Declare the imported packages
+	if err := o.loader.Import(astProg, scope, declarations); err != nil {
+		return nil, diagnostic.FromError(err, diagnostic.ImportError)
+	}
+
 	// Convert AST program to a semantic program
-	semProg, err := semantic.New(astProg, builtinDeclarations.Copy())
+	semProg, err := semantic.New(astProg, declarations.Copy())
 	if err != nil {
-		return nil, err
+		return nil, diagnostic.FromError(err, diagnostic.SemanticError)
+	}
+	// Check the types of the program before any of it is evaluated
+	if err := semantic.Infer(semProg, declarations); err != nil {
+		return nil, diagnostic.FromError(err, diagnostic.TypeError)
 	}
-
-	// Create top-level builtin scope
-	scope := builtinScope.Nest()
 
 	// Create new query domain
 	d := new(queryDomain)
 	if err := interpreter.Eval(semProg, scope, d); err != nil {
-		return nil, err
+		return nil, diagnostic.FromError(err, diagnostic.EvaluationError)
 	}
 	spec := d.ToSpec()
 
@@ -186,6 +210,8 @@ var builtins = make(map[string]string)
 var finalized bool
 
 // RegisterBuiltIn adds any variable declarations in the script to the builtin scope.
+// If the script has a package clause, the declarations are also members of the package it names,
+// which programs can import with the name of the package as its path.
 func RegisterBuiltIn(name, script string) {
 	if finalized {
 		panic(errors.New("already finalized, cannot register builtin"))
@@ -193,29 +219,81 @@ func RegisterBuiltIn(name, script string) {
 	builtins[name] = script
 }
 
+// RegisterBuiltInValue adds the value to the builtin scope with the name.
+// The value is declared with the type t, which can be more precise than the type of the value,
+// such as the signature of a native function.
+func RegisterBuiltInValue(name string, v interpreter.Value, t semantic.Type) {
+	if finalized {
+		panic(errors.New("already finalized, cannot register builtin value"))
+	}
+	if _, ok := builtinDeclarations[name]; ok {
+		panic(fmt.Errorf("duplicate registration for builtin %q", name))
+	}
+	builtinScope.Set(name, v)
+	builtinDeclarations[name] = semantic.NewExternalVariableDeclaration(name, t)
+}
+
 // FinalizeRegistration must be called to complete registration.
-// Future calls to RegisterFunction or RegisterBuiltIn will panic.
+// Future calls to RegisterFunction, RegisterBuiltIn, RegisterBuiltInValue or RegisterPackage will panic.
 func FinalizeRegistration() {
 	finalized = true
-	for name, script := range builtins {
-		astProg, err := parser.NewAST(script)
+	l := &loader{
+		packages: packages,
+		loading:  make(map[string]bool),
+	}
+
+	// Group the builtins by their package, in a consistent order
+	names := make([]string, 0, len(builtins))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var paths []string
+	grouped := make(map[string][]file)
+	for _, name := range names {
+		astProg, err := parser.NewAST(builtins[name])
 		if err != nil {
 			panic(errors.Wrapf(err, "failed to parse builtin %q", name))
 		}
-		semProg, err := semantic.New(astProg, builtinDeclarations)
+		var path string
+		if astProg.Package != nil {
+			path = astProg.Package.Name.Name
+		}
+		if _, ok := grouped[path]; !ok {
+			paths = append(paths, path)
+		}
+		grouped[path] = append(grouped[path], file{name: fmt.Sprintf("builtin %q", name), prog: astProg})
+	}
+	for _, path := range paths {
+		p, err := l.eval(grouped[path])
 		if err != nil {
-			panic(errors.Wrapf(err, "failed to create semantic graph for builtin %q", name))
+			panic(err)
 		}
+		for name, v := range p.values {
+			builtinScope.Set(name, v)
+			builtinDeclarations[name] = p.declarations[name]
+		}
+		if path != "" {
+			packages[path] = p
+		}
+	}
 
-		// Create new query domain
-		d := new(queryDomain)
-
-		if err := interpreter.Eval(semProg, builtinScope, d); err != nil {
-			panic(errors.Wrapf(err, "failed to evaluate builtin %q", name))
+	paths = paths[:0]
+	for path := range packageScripts {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if _, ok := packages[path]; ok {
+			panic(fmt.Errorf("duplicate registration for package %q", path))
+		}
+		if _, err := l.load(path); err != nil {
+			panic(errors.Wrapf(err, "failed to load package %q", path))
 		}
 	}
-	// free builtins list
+	// free builtins and packages lists
 	builtins = nil
+	packageScripts = nil
 }
 
 func BuiltIns() (*interpreter.Scope, semantic.DeclarationScope) {
@@ -392,34 +470,48 @@ func (a Arguments) GetRequiredTime(name string) (Time, error) {
 	return qt, nil
 }
 
+// GetDuration returns a fixed duration argument, durations with calendar months are an error.
 func (a Arguments) GetDuration(name string) (Duration, bool, error) {
-	v, ok := a.Get(name)
-	if !ok {
-		return 0, false, nil
+	d, ok, err := a.GetCalendarDuration(name)
+	if err != nil || !ok {
+		return d, ok, err
+	}
+	if d.IsCalendar() {
+		return Duration{}, ok, fmt.Errorf("keyword argument %q must be a fixed duration, got %v", name, d)
 	}
-	return (Duration)(v.Value().(time.Duration)), ok, nil
+	return d, ok, nil
 }
 
 func (a Arguments) GetRequiredDuration(name string) (Duration, error) {
 	d, ok, err := a.GetDuration(name)
 	if err != nil {
-		return 0, err
+		return Duration{}, err
 	}
 	if !ok {
-		return 0, fmt.Errorf("missing required keyword argument %q", name)
+		return Duration{}, fmt.Errorf("missing required keyword argument %q", name)
 	}
 	return d, nil
 }
 
+// GetCalendarDuration returns a duration argument that may include calendar months.
+func (a Arguments) GetCalendarDuration(name string) (Duration, bool, error) {
+	v, ok := a.Get(name)
+	if !ok {
+		return Duration{}, false, nil
+	}
+	return Duration(v.Value().(interpreter.Duration)), ok, nil
+}
+
 func ToQueryTime(value interpreter.Value) (Time, error) {
 	switch v := value.Value().(type) {
 	case time.Time:
 		return Time{
 			Absolute: v,
 		}, nil
-	case time.Duration:
+	case interpreter.Duration:
 		return Time{
-			Relative:   v,
+			Months:     v.Months,
+			Relative:   v.Fixed,
 			IsRelative: true,
 		}, nil
 	case int64:
@@ -25,6 +25,7 @@ type Controller struct {
 	cancelRequest chan QueryID
 
 	verbose bool
+	loader  *query.Loader
 
 	lplanner plan.LogicalPlanner
 	pplanner plan.Planner
@@ -39,12 +40,19 @@ type Config struct {
 	ConcurrencyQuota int
 	MemoryBytesQuota int64
 	ExecutorConfig   execute.Config
-	Verbose          bool
+	// SearchPath are the directories searched for the packages that queries import.
+	SearchPath []string
+	Verbose    bool
 }
 
 type QueryID uint64
 
 func New(c Config) *Controller {
+	pplanner := plan.NewPlanner()
+	if caps, ok := c.ExecutorConfig.StorageReader.(plan.Capabilities); ok {
+		// Push down the operations the storage layer supports.
+		pplanner = plan.NewPlannerWithCapabilities(caps)
+	}
 	ctrl := &Controller{
 		newQueries:           make(chan *Query),
 		queries:              make(map[QueryID]*Query),
@@ -54,9 +62,10 @@ func New(c Config) *Controller {
 		availableConcurrency: c.ConcurrencyQuota,
 		availableMemory:      c.MemoryBytesQuota,
 		lplanner:             plan.NewLogicalPlanner(),
-		pplanner:             plan.NewPlanner(),
+		pplanner:             pplanner,
 		executor:             execute.NewExecutor(c.ExecutorConfig),
 		verbose:              c.Verbose,
+		loader:               query.NewLoader(c.SearchPath...),
 	}
 	go ctrl.run()
 	return ctrl
@@ -66,7 +75,14 @@ func New(c Config) *Controller {
 // The query will first be compiled before submitting for execution.
 // Done must be called on any returned Query objects.
 func (c *Controller) QueryWithCompile(ctx context.Context, queryStr string) (*Query, error) {
-	q := c.createQuery(ctx)
+	return c.QueryWithCompileAt(ctx, queryStr, time.Now().UTC())
+}
+
+// QueryWithCompileAt is the same as QueryWithCompile, except that relative times in the query
+// are resolved against now instead of the time the query was submitted.
+// Done must be called on any returned Query objects.
+func (c *Controller) QueryWithCompileAt(ctx context.Context, queryStr string, now time.Time) (*Query, error) {
+	q := c.createQuery(ctx, now)
 	err := c.compileQuery(q, queryStr)
 	if err != nil {
 		return nil, err
@@ -79,13 +95,13 @@ func (c *Controller) QueryWithCompile(ctx context.Context, queryStr string) (*Qu
 // The spec must not be modified while the query is still active.
 // Done must be called on any returned Query objects.
 func (c *Controller) Query(ctx context.Context, qSpec *query.Spec) (*Query, error) {
-	q := c.createQuery(ctx)
+	q := c.createQuery(ctx, time.Now().UTC())
 	q.Spec = *qSpec
 	err := c.enqueueQuery(q)
 	return q, err
 }
 
-func (c *Controller) createQuery(ctx context.Context) *Query {
+func (c *Controller) createQuery(ctx context.Context, now time.Time) *Query {
 	id := c.nextID()
 	cctx, cancel := context.WithCancel(ctx)
 	ready := make(chan map[string]execute.Result, 1)
@@ -93,7 +109,7 @@ func (c *Controller) createQuery(ctx context.Context) *Query {
 		id:        id,
 		state:     Created,
 		c:         c,
-		now:       time.Now().UTC(),
+		now:       now,
 		ready:     ready,
 		Ready:     ready,
 		parentCtx: cctx,
@@ -101,9 +117,14 @@ func (c *Controller) createQuery(ctx context.Context) *Query {
 	}
 }
 
+// Loader returns the loader of the packages that queries import.
+func (c *Controller) Loader() *query.Loader {
+	return c.loader
+}
+
 func (c *Controller) compileQuery(q *Query, queryStr string) error {
 	q.compile()
-	spec, err := query.Compile(q.compilingCtx, queryStr, query.Verbose(c.verbose))
+	spec, err := query.Compile(q.compilingCtx, queryStr, query.Verbose(c.verbose), query.WithLoader(c.loader))
 	if err != nil {
 		return errors.Wrap(err, "failed to compile query")
 	}
@@ -330,6 +351,8 @@ func (q *Query) Done() {
 		q.executeSpan.Finish()
 		executingGauge.Dec()
 
+		// Stop any execution that remains, its results will not be read.
+		q.cancel()
 		q.state = Finished
 	case Errored:
 		// The query has already been finished in the call to setErr.
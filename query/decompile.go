@@ -0,0 +1,311 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/pkg/errors"
+)
+
+// DecompilableOperationSpec is an OperationSpec that can be written as a call to the function that creates it.
+// The function has the name of the kind of the operation.
+type DecompilableOperationSpec interface {
+	OperationSpec
+	// DecompileArguments returns the arguments of the call, except for the table that is piped to it.
+	// Arguments that have their default values can be left out.
+	DecompileArguments() []DecompiledArgument
+}
+
+// DecompiledArgument is an argument of a decompiled call.
+//
+// Its value is one of the types string, bool, int, int64, float64, []string, time.Duration, Duration, time.Time, Time,
+// *semantic.FunctionExpression or ast.Expression.
+// Operations that are not piped their parents refer to them with values of the types OperationID or map[string]OperationID.
+type DecompiledArgument struct {
+	Key   string
+	Value interface{}
+}
+
+// Decompile writes the query spec as an IFQL program, which compiles to an equivalent spec.
+//
+// Each operation becomes a call to its function, which is piped its parent.
+// The operations with several children, or which are referred to by the arguments of their children,
+// are declared as variables, named after their IDs.
+// The operations without children are the expression statements of the program.
+func Decompile(spec *Spec) (*ast.Program, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	d := &decompiler{
+		spec:      spec,
+		exprs:     make(map[OperationID]ast.Expression, len(spec.Operations)),
+		names:     make(map[string]bool),
+		reference: make(map[OperationID]bool),
+	}
+	// The names of the functions cannot be used as variables.
+	for _, o := range spec.Operations {
+		d.names[string(o.Spec.Kind())] = true
+	}
+	args := make(map[OperationID][]DecompiledArgument, len(spec.Operations))
+	for _, o := range spec.Operations {
+		s, ok := o.Spec.(DecompilableOperationSpec)
+		if !ok {
+			return nil, fmt.Errorf("cannot decompile operation %q of kind %q", o.ID, o.Spec.Kind())
+		}
+		args[o.ID] = s.DecompileArguments()
+		for _, a := range args[o.ID] {
+			for _, id := range references(a.Value) {
+				d.reference[id] = true
+			}
+		}
+	}
+
+	prog := &ast.Program{
+		Body: []ast.Statement{},
+	}
+	err := spec.Walk(func(o *Operation) error {
+		expr, err := d.call(o, args[o.ID])
+		if err != nil {
+			return errors.Wrapf(err, "failed to decompile operation %q", o.ID)
+		}
+		children := spec.Children(o.ID)
+		switch {
+		case len(children) > 1 || d.reference[o.ID]:
+			id := &ast.Identifier{Name: d.name(o.ID)}
+			prog.Body = append(prog.Body, &ast.VariableDeclaration{
+				Declarations: []*ast.VariableDeclarator{{
+					ID:   id,
+					Init: expr,
+				}},
+			})
+			d.exprs[o.ID] = id
+		case len(children) == 1:
+			d.exprs[o.ID] = expr
+		default:
+			prog.Body = append(prog.Body, &ast.ExpressionStatement{
+				Expression: expr,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prog, nil
+}
+
+type decompiler struct {
+	spec *Spec
+	// exprs are the expressions of the operations that have been decompiled.
+	exprs map[OperationID]ast.Expression
+	// names are the names that have been used, by variables or by functions.
+	names map[string]bool
+	// reference are the operations referred to by the arguments of their children.
+	reference map[OperationID]bool
+}
+
+func (d *decompiler) call(o *Operation, args []DecompiledArgument) (ast.Expression, error) {
+	call := &ast.CallExpression{
+		Callee: &ast.Identifier{Name: string(o.Spec.Kind())},
+	}
+	if len(args) > 0 {
+		obj := &ast.ObjectExpression{
+			Properties: make([]*ast.Property, len(args)),
+		}
+		for i, a := range args {
+			v, err := d.expression(a.Value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid argument %q", a.Key)
+			}
+			obj.Properties[i] = &ast.Property{
+				Key:   &ast.Identifier{Name: a.Key},
+				Value: v,
+			}
+		}
+		call.Arguments = []ast.Expression{obj}
+	}
+
+	// Operations whose arguments refer to their parents are not piped them.
+	referred := false
+	for _, a := range args {
+		if len(references(a.Value)) > 0 {
+			referred = true
+		}
+	}
+	parents := d.spec.Parents(o.ID)
+	switch {
+	case len(parents) == 0 || referred:
+		return call, nil
+	case len(parents) == 1:
+		return &ast.PipeExpression{
+			Argument: d.exprs[parents[0].ID],
+			Call:     call,
+		}, nil
+	default:
+		return nil, fmt.Errorf("operation has %d parents, but its arguments do not refer to them", len(parents))
+	}
+}
+
+// name returns a unique variable name for the operation.
+func (d *decompiler) name(id OperationID) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, string(id))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	for d.names[name] || keywords[name] {
+		name += "_"
+	}
+	d.names[name] = true
+	return name
+}
+
+// keywords cannot be used as variable names.
+var keywords = map[string]bool{
+	"and":        true,
+	"or":         true,
+	"not":        true,
+	"empty":      true,
+	"in":         true,
+	"startswith": true,
+	"return":     true,
+	"package":    true,
+	"import":     true,
+	"true":       true,
+	"false":      true,
+}
+
+func (d *decompiler) expression(v interface{}) (ast.Expression, error) {
+	switch v := v.(type) {
+	case ast.Expression:
+		return v, nil
+	case string:
+		return &ast.StringLiteral{Value: v}, nil
+	case bool:
+		return &ast.BooleanLiteral{Value: v}, nil
+	case int:
+		return integer(int64(v)), nil
+	case int64:
+		return integer(v), nil
+	case float64:
+		if v < 0 {
+			return &ast.UnaryExpression{
+				Operator: ast.SubtractionOperator,
+				Argument: &ast.FloatLiteral{Value: -v},
+			}, nil
+		}
+		return &ast.FloatLiteral{Value: v}, nil
+	case []string:
+		a := &ast.ArrayExpression{
+			Elements: make([]ast.Expression, len(v)),
+		}
+		for i, s := range v {
+			a.Elements[i] = &ast.StringLiteral{Value: s}
+		}
+		return a, nil
+	case time.Duration:
+		return duration(0, v), nil
+	case Duration:
+		return duration(v.Months, v.Fixed), nil
+	case time.Time:
+		return &ast.DateTimeLiteral{Value: v}, nil
+	case Time:
+		if v.IsRelative {
+			return duration(v.Months, v.Relative), nil
+		}
+		return &ast.DateTimeLiteral{Value: v.Absolute}, nil
+	case *semantic.FunctionExpression:
+		if v == nil {
+			return nil, errors.New("missing function")
+		}
+		return semanticExpression(v)
+	case *semantic.ObjectExpression:
+		if v == nil {
+			return nil, errors.New("missing object")
+		}
+		return semanticExpression(v)
+	case OperationID:
+		e, ok := d.exprs[v]
+		if !ok {
+			return nil, fmt.Errorf("operation %q is not a parent", v)
+		}
+		return e, nil
+	case map[string]OperationID:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		obj := &ast.ObjectExpression{
+			Properties: make([]*ast.Property, len(keys)),
+		}
+		for i, k := range keys {
+			e, err := d.expression(v[k])
+			if err != nil {
+				return nil, err
+			}
+			obj.Properties[i] = &ast.Property{
+				Key:   &ast.Identifier{Name: k},
+				Value: e,
+			}
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("cannot decompile value of type %T", v)
+	}
+}
+
+// references returns the operations that the value of an argument refers to.
+func references(v interface{}) []OperationID {
+	switch v := v.(type) {
+	case OperationID:
+		return []OperationID{v}
+	case map[string]OperationID:
+		ids := make([]OperationID, 0, len(v))
+		for _, id := range v {
+			ids = append(ids, id)
+		}
+		return ids
+	default:
+		return nil
+	}
+}
+
+// There are no negative literals, so negative values are the negation of literals.
+
+func integer(i int64) ast.Expression {
+	if i < 0 {
+		return &ast.UnaryExpression{
+			Operator: ast.SubtractionOperator,
+			Argument: &ast.IntegerLiteral{Value: -i},
+		}
+	}
+	return &ast.IntegerLiteral{Value: i}
+}
+
+func duration(months int64, d time.Duration) ast.Expression {
+	if months <= 0 && d <= 0 && (months < 0 || d < 0) {
+		return &ast.UnaryExpression{
+			Operator: ast.SubtractionOperator,
+			Argument: &ast.DurationLiteral{Value: -d, Months: -months},
+		}
+	}
+	return &ast.DurationLiteral{Value: d, Months: months}
+}
+
+func semanticExpression(e semantic.Expression) (ast.Expression, error) {
+	n, err := semantic.ToAST(e)
+	if err != nil {
+		return nil, err
+	}
+	return n.(ast.Expression), nil
+}
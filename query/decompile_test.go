@@ -0,0 +1,238 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/format"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+)
+
+func TestDecompile(t *testing.T) {
+	lastHour := query.Time{IsRelative: true, Relative: -time.Hour}
+	tests := []struct {
+		name    string
+		spec    *query.Spec
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "pipe chain",
+			spec: &query.Spec{
+				Operations: []*query.Operation{
+					{ID: "from0", Spec: &functions.FromOpSpec{Database: "telegraf"}},
+					{ID: "range1", Spec: &functions.RangeOpSpec{Start: lastHour, Stop: query.Now}},
+					{
+						ID: "filter2",
+						Spec: &functions.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.EqualOperator,
+									Left: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "_measurement",
+									},
+									Right: &semantic.StringLiteral{Value: "cpu"},
+								},
+							},
+						},
+					},
+					{ID: "mean3", Spec: &functions.MeanOpSpec{}},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "range1", Child: "filter2"},
+					{Parent: "filter2", Child: "mean3"},
+				},
+			},
+			want: `from(db:"telegraf")
+    |> range(start:-1h)
+    |> filter(fn:(r) => r._measurement == "cpu")
+    |> mean()
+`,
+		},
+		{
+			name: "shared parents and join",
+			spec: &query.Spec{
+				Operations: []*query.Operation{
+					{ID: "from0", Spec: &functions.FromOpSpec{Database: "a"}},
+					{ID: "range1", Spec: &functions.RangeOpSpec{Start: lastHour, Stop: query.Now}},
+					{ID: "from2", Spec: &functions.FromOpSpec{Database: "b"}},
+					{ID: "range3", Spec: &functions.RangeOpSpec{Start: lastHour, Stop: query.Now}},
+					{
+						ID: "join4",
+						Spec: &functions.JoinOpSpec{
+							TableNames: map[query.OperationID]string{"range1": "a", "range3": "b"},
+							On:         []string{"host"},
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+								Body: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{{
+										Key: &semantic.Identifier{Name: "v"},
+										Value: &semantic.BinaryExpression{
+											Operator: ast.AdditionOperator,
+											Left: &semantic.MemberExpression{
+												Object: &semantic.MemberExpression{
+													Object:   &semantic.IdentifierExpression{Name: "t"},
+													Property: "a",
+												},
+												Property: "_value",
+											},
+											Right: &semantic.MemberExpression{
+												Object: &semantic.MemberExpression{
+													Object:   &semantic.IdentifierExpression{Name: "t"},
+													Property: "b",
+												},
+												Property: "_value",
+											},
+										},
+									}},
+								},
+							},
+						},
+					},
+					{ID: "yield5", Spec: &functions.YieldOpSpec{Name: "joined"}},
+					{ID: "count6", Spec: &functions.CountOpSpec{}},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "from2", Child: "range3"},
+					{Parent: "range1", Child: "join4"},
+					{Parent: "range3", Child: "join4"},
+					{Parent: "join4", Child: "yield5"},
+					{Parent: "range1", Child: "count6"},
+				},
+			},
+			want: `range3 = from(db:"b") |> range(start:-1h)
+range1 = from(db:"a") |> range(start:-1h)
+range1 |> count()
+join(tables:{a:range1, b:range3}, on:["host"], fn:(t) => ({v:t.a._value + t.b._value}))
+    |> yield(name:"joined")
+`,
+		},
+		{
+			name: "left join",
+			spec: &query.Spec{
+				Operations: []*query.Operation{
+					{ID: "from0", Spec: &functions.FromOpSpec{Database: "a"}},
+					{ID: "from1", Spec: &functions.FromOpSpec{Database: "b"}},
+					{
+						ID: "join2",
+						Spec: &functions.JoinOpSpec{
+							TableNames: map[query.OperationID]string{"from0": "a", "from1": "b"},
+							On:         []string{"host"},
+							Method:     "left",
+							Left:       "b",
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "t"}}},
+								Body: &semantic.MemberExpression{
+									Object: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "t"},
+										Property: "b",
+									},
+									Property: "_value",
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from1", Child: "join2"},
+					{Parent: "from0", Child: "join2"},
+				},
+			},
+			want: `from1 = from(db:"b")
+from0 = from(db:"a")
+join(tables:{a:from0, b:from1}, on:["host"], method:"left", left:"b", fn:(t) => t.b._value)
+`,
+		},
+		{
+			name: "reduce identity",
+			spec: &query.Spec{
+				Operations: []*query.Operation{
+					{ID: "from0", Spec: &functions.FromOpSpec{Database: "telegraf"}},
+					{
+						ID: "reduce1",
+						Spec: &functions.ReduceOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{
+									{Key: &semantic.Identifier{Name: "r"}},
+									{Key: &semantic.Identifier{Name: "accumulator"}},
+								},
+								Body: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{{
+										Key: &semantic.Identifier{Name: "sum"},
+										Value: &semantic.BinaryExpression{
+											Operator: ast.AdditionOperator,
+											Left: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "accumulator"},
+												Property: "sum",
+											},
+											Right: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "r"},
+												Property: "_value",
+											},
+										},
+									}},
+								},
+							},
+							Identity: &semantic.ObjectExpression{
+								Properties: []*semantic.Property{{
+									Key:   &semantic.Identifier{Name: "sum"},
+									Value: &semantic.FloatLiteral{Value: 0.5},
+								}},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "reduce1"},
+				},
+			},
+			want: `from(db:"telegraf")
+    |> reduce(fn:(r, accumulator) => ({sum:accumulator.sum + r._value}), identity:{sum:0.5})
+`,
+		},
+		{
+			name: "missing function",
+			spec: &query.Spec{
+				Operations: []*query.Operation{
+					{ID: "from0", Spec: &functions.FromOpSpec{Database: "telegraf"}},
+					{ID: "filter1", Spec: &functions.FilterOpSpec{}},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "filter1"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			prog, err := query.Decompile(tc.spec)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := format.Format(prog)
+			if got != tc.want {
+				t.Fatalf("unexpected source:\ngot:\n%s\nwant:\n%s", got, tc.want)
+			}
+			// The decompiled source compiles.
+			if _, err := query.Compile(context.Background(), got); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
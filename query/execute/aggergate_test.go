@@ -348,6 +348,110 @@ func TestAggregate_Process(t *testing.T) {
 				}}
 			},
 		},
+		{
+			name: "null values",
+			bounds: execute.Bounds{
+				Start: 0,
+				Stop:  100,
+			},
+			agg: sumAgg,
+			data: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  100,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 1.0, nil},
+					{execute.Time(10), nil, nil},
+					{execute.Time(20), 2.0, nil},
+				},
+			}},
+			want: func(b execute.Bounds) []*executetest.Block {
+				return []*executetest.Block{{
+					Bnds: b,
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(100), 3.0, nil},
+					},
+				}}
+			},
+		},
+		{
+			name: "empty block",
+			bounds: execute.Bounds{
+				Start: 0,
+				Stop:  100,
+			},
+			agg: sumAgg,
+			data: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  100,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+			}},
+			want: func(b execute.Bounds) []*executetest.Block {
+				return []*executetest.Block{{
+					Bnds: b,
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(100), nil},
+					},
+				}}
+			},
+		},
+		{
+			name: "count null values",
+			bounds: execute.Bounds{
+				Start: 0,
+				Stop:  100,
+			},
+			agg: countAgg,
+			data: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  100,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "x", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "y", Type: execute.TFloat, Kind: execute.ValueColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 1.0, nil},
+					{execute.Time(10), nil, nil},
+					{execute.Time(20), 2.0, nil},
+				},
+			}},
+			want: func(b execute.Bounds) []*executetest.Block {
+				return []*executetest.Block{{
+					Bnds: b,
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "x", Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "y", Type: execute.TInt, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(100), int64(2), int64(0)},
+					},
+				}}
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -77,39 +77,66 @@ func (t *aggregateTransformation) Process(id DatasetID, b Block) error {
 
 		// TODO(nathanielc): This reads the block multiple times (once per value column), is that OK?
 		values := b.Col(j)
+		// Null values are skipped, so that they do not skew the aggregate.
+		hasValues := false
 		var vf ValueFunc
 		switch c.Type {
 		case TBool:
 			f := t.agg.NewBoolAgg()
-			values.DoBool(func(vs []bool, _ RowReader) {
+			values.DoBool(func(vs []bool, rr RowReader) {
+				if rows := NonNullRows(len(vs), rr, j); rows != nil {
+					vs = nonNullBools(vs, rows)
+				}
+				hasValues = hasValues || len(vs) > 0
 				f.DoBool(vs)
 			})
 			vf = f
 		case TInt:
 			f := t.agg.NewIntAgg()
-			values.DoInt(func(vs []int64, _ RowReader) {
+			values.DoInt(func(vs []int64, rr RowReader) {
+				if rows := NonNullRows(len(vs), rr, j); rows != nil {
+					vs = nonNullInts(vs, rows)
+				}
+				hasValues = hasValues || len(vs) > 0
 				f.DoInt(vs)
 			})
 			vf = f
 		case TUInt:
 			f := t.agg.NewUIntAgg()
-			values.DoUInt(func(vs []uint64, _ RowReader) {
+			values.DoUInt(func(vs []uint64, rr RowReader) {
+				if rows := NonNullRows(len(vs), rr, j); rows != nil {
+					vs = nonNullUInts(vs, rows)
+				}
+				hasValues = hasValues || len(vs) > 0
 				f.DoUInt(vs)
 			})
 			vf = f
 		case TFloat:
 			f := t.agg.NewFloatAgg()
-			values.DoFloat(func(vs []float64, _ RowReader) {
+			values.DoFloat(func(vs []float64, rr RowReader) {
+				if rows := NonNullRows(len(vs), rr, j); rows != nil {
+					vs = nonNullFloats(vs, rows)
+				}
+				hasValues = hasValues || len(vs) > 0
 				f.DoFloat(vs)
 			})
 			vf = f
 		case TString:
 			f := t.agg.NewStringAgg()
-			values.DoString(func(vs []string, _ RowReader) {
+			values.DoString(func(vs []string, rr RowReader) {
+				if rows := NonNullRows(len(vs), rr, j); rows != nil {
+					vs = nonNullStrings(vs, rows)
+				}
+				hasValues = hasValues || len(vs) > 0
 				f.DoString(vs)
 			})
 			vf = f
 		}
+		if _, ok := vf.(NonNullAgg); !ok && !hasValues {
+			// Every value was null, or the block is empty, so the aggregate is null as well.
+			builder.AppendNil(j)
+			continue
+		}
 		switch vf.Type() {
 		case TBool:
 			v := vf.(BoolValueFunc)
@@ -152,6 +179,12 @@ type Aggregate interface {
 type ValueFunc interface {
 	Type() DataType
 }
+
+// NonNullAgg is implemented by aggregates that produce a value even when every value
+// they are given is null, for example a count. Otherwise such an aggregate is null.
+type NonNullAgg interface {
+	NonNull()
+}
 type DoBoolAgg interface {
 	ValueFunc
 	DoBool([]bool)
@@ -131,6 +131,10 @@ func AppendBlock(b Block, builder BlockBuilder, colMap []int) {
 				continue
 			}
 			for i := range ts {
+				if rr.IsNull(i, colMap[j]) {
+					builder.AppendNil(j)
+					continue
+				}
 				switch c.Type {
 				case TBool:
 					builder.AppendBool(j, rr.AtBool(i, colMap[j]))
@@ -156,6 +160,10 @@ func AppendBlock(b Block, builder BlockBuilder, colMap []int) {
 // The colMap is a map of builder columnm index to rr column index.
 func AppendRow(i int, rr RowReader, builder BlockBuilder, colMap []int) {
 	for j, c := range builder.Cols() {
+		if rr.IsNull(i, colMap[j]) {
+			builder.AppendNil(j)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			builder.AppendBool(j, rr.AtBool(i, colMap[j]))
@@ -179,6 +187,10 @@ func AppendRow(i int, rr RowReader, builder BlockBuilder, colMap []int) {
 // The colMap is a map of builder columnm index to rr column index.
 func AppendRowForCols(i int, rr RowReader, builder BlockBuilder, cols []ColMeta, colMap []int) {
 	for j, c := range cols {
+		if rr.IsNull(i, colMap[j]) {
+			builder.AppendNil(j)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			builder.AppendBool(j, rr.AtBool(i, colMap[j]))
@@ -264,6 +276,10 @@ type BlockBuilder interface {
 	// SetCommonString sets a single value for the entire column.
 	SetCommonString(j int, value string)
 
+	// AppendNil appends a null value to the column.
+	// Common columns cannot contain null values.
+	AppendNil(j int)
+
 	AppendBool(j int, value bool)
 	AppendInt(j int, value int64)
 	AppendUInt(j int, value uint64)
@@ -401,6 +417,9 @@ type RowReader interface {
 	AtString(i, j int) string
 	// AtTime returns the time value of another column and given index.
 	AtTime(i, j int) Time
+	// IsNull reports whether the value of another column and given index is null.
+	// The At* methods return the zero value of the column type for null values.
+	IsNull(i, j int) bool
 }
 
 func TagsForRow(i int, rr RowReader) Tags {
@@ -589,7 +608,9 @@ func (b ColListBlockBuilder) AddCol(c ColMeta) int {
 
 func (b ColListBlockBuilder) SetBool(i int, j int, value bool) {
 	b.checkColType(j, TBool)
-	b.blk.cols[j].(*boolColumn).data[i] = value
+	col := b.blk.cols[j].(*boolColumn)
+	col.data[i] = value
+	col.unsetNull(i)
 }
 func (b ColListBlockBuilder) AppendBool(j int, value bool) {
 	b.checkColType(j, TBool)
@@ -606,7 +627,9 @@ func (b ColListBlockBuilder) AppendBools(j int, values []bool) {
 
 func (b ColListBlockBuilder) SetInt(i int, j int, value int64) {
 	b.checkColType(j, TInt)
-	b.blk.cols[j].(*intColumn).data[i] = value
+	col := b.blk.cols[j].(*intColumn)
+	col.data[i] = value
+	col.unsetNull(i)
 }
 func (b ColListBlockBuilder) AppendInt(j int, value int64) {
 	b.checkColType(j, TInt)
@@ -623,7 +646,9 @@ func (b ColListBlockBuilder) AppendInts(j int, values []int64) {
 
 func (b ColListBlockBuilder) SetUInt(i int, j int, value uint64) {
 	b.checkColType(j, TUInt)
-	b.blk.cols[j].(*uintColumn).data[i] = value
+	col := b.blk.cols[j].(*uintColumn)
+	col.data[i] = value
+	col.unsetNull(i)
 }
 func (b ColListBlockBuilder) AppendUInt(j int, value uint64) {
 	b.checkColType(j, TUInt)
@@ -640,7 +665,9 @@ func (b ColListBlockBuilder) AppendUInts(j int, values []uint64) {
 
 func (b ColListBlockBuilder) SetFloat(i int, j int, value float64) {
 	b.checkColType(j, TFloat)
-	b.blk.cols[j].(*floatColumn).data[i] = value
+	col := b.blk.cols[j].(*floatColumn)
+	col.data[i] = value
+	col.unsetNull(i)
 }
 func (b ColListBlockBuilder) AppendFloat(j int, value float64) {
 	b.checkColType(j, TFloat)
@@ -657,7 +684,9 @@ func (b ColListBlockBuilder) AppendFloats(j int, values []float64) {
 
 func (b ColListBlockBuilder) SetString(i int, j int, value string) {
 	b.checkColType(j, TString)
-	b.blk.cols[j].(*stringColumn).data[i] = value
+	col := b.blk.cols[j].(*stringColumn)
+	col.data[i] = value
+	col.unsetNull(i)
 }
 func (b ColListBlockBuilder) AppendString(j int, value string) {
 	meta := b.blk.cols[j].Meta()
@@ -696,7 +725,9 @@ func (b ColListBlockBuilder) SetCommonString(j int, value string) {
 
 func (b ColListBlockBuilder) SetTime(i int, j int, value Time) {
 	b.checkColType(j, TTime)
-	b.blk.cols[j].(*timeColumn).data[i] = value
+	col := b.blk.cols[j].(*timeColumn)
+	col.data[i] = value
+	col.unsetNull(i)
 }
 func (b ColListBlockBuilder) AppendTime(j int, value Time) {
 	b.checkColType(j, TTime)
@@ -711,6 +742,41 @@ func (b ColListBlockBuilder) AppendTimes(j int, values []Time) {
 	b.blk.nrows = len(col.data)
 }
 
+func (b ColListBlockBuilder) AppendNil(j int) {
+	meta := b.blk.colMeta[j]
+	if meta.Common {
+		panic(fmt.Errorf("cannot append a null value to the column %s, which has all common values", meta.Label))
+	}
+	switch meta.Type {
+	case TBool:
+		b.AppendBool(j, false)
+		col := b.blk.cols[j].(*boolColumn)
+		col.SetNull(len(col.data) - 1)
+	case TInt:
+		b.AppendInt(j, 0)
+		col := b.blk.cols[j].(*intColumn)
+		col.SetNull(len(col.data) - 1)
+	case TUInt:
+		b.AppendUInt(j, 0)
+		col := b.blk.cols[j].(*uintColumn)
+		col.SetNull(len(col.data) - 1)
+	case TFloat:
+		b.AppendFloat(j, 0)
+		col := b.blk.cols[j].(*floatColumn)
+		col.SetNull(len(col.data) - 1)
+	case TString:
+		b.AppendString(j, "")
+		col := b.blk.cols[j].(*stringColumn)
+		col.SetNull(len(col.data) - 1)
+	case TTime:
+		b.AppendTime(j, 0)
+		col := b.blk.cols[j].(*timeColumn)
+		col.SetNull(len(col.data) - 1)
+	default:
+		PanicUnknownType(meta.Type)
+	}
+}
+
 func (b ColListBlockBuilder) checkColType(j int, typ DataType) {
 	checkColType(b.blk.colMeta[j], typ)
 }
@@ -859,6 +925,10 @@ func (b *ColListBlock) AtTime(i, j int) Time {
 	return b.cols[j].(*timeColumn).data[i]
 }
 
+func (b *ColListBlock) IsNull(i, j int) bool {
+	return b.cols[j].IsNull(i)
+}
+
 func (b *ColListBlock) Copy() *ColListBlock {
 	cpy := new(ColListBlock)
 	cpy.bounds = b.bounds
@@ -948,6 +1018,10 @@ func (itr colListValueIterator) AtTime(i, j int) Time {
 	return itr.cols[j].(*timeColumn).data[i]
 }
 
+func (itr colListValueIterator) IsNull(i, j int) bool {
+	return itr.cols[j].IsNull(i)
+}
+
 type colListBlockSorter struct {
 	cols []int
 	desc bool
@@ -984,10 +1058,12 @@ type column interface {
 	Equal(i, j int) bool
 	Less(i, j int) bool
 	Swap(i, j int)
+	IsNull(i int) bool
 }
 
 type boolColumn struct {
 	ColMeta
+	nullBitmap
 	data  []bool
 	alloc *Allocator
 }
@@ -999,11 +1075,13 @@ func (c *boolColumn) Meta() ColMeta {
 func (c *boolColumn) Clear() {
 	c.alloc.Free(len(c.data), boolSize)
 	c.data = c.data[0:0]
+	c.clearNulls()
 }
 func (c *boolColumn) Copy() column {
 	cpy := &boolColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:    c.ColMeta,
+		nullBitmap: c.copyNulls(),
+		alloc:      c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Bools(l, l)
@@ -1011,9 +1089,15 @@ func (c *boolColumn) Copy() column {
 	return cpy
 }
 func (c *boolColumn) Equal(i, j int) bool {
+	if equal, ok := c.equalNulls(i, j); ok {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *boolColumn) Less(i, j int) bool {
+	if less, ok := c.lessNulls(i, j); ok {
+		return less
+	}
 	if c.data[i] == c.data[j] {
 		return false
 	}
@@ -1021,10 +1105,12 @@ func (c *boolColumn) Less(i, j int) bool {
 }
 func (c *boolColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swapNulls(i, j)
 }
 
 type intColumn struct {
 	ColMeta
+	nullBitmap
 	data  []int64
 	alloc *Allocator
 }
@@ -1036,11 +1122,13 @@ func (c *intColumn) Meta() ColMeta {
 func (c *intColumn) Clear() {
 	c.alloc.Free(len(c.data), int64Size)
 	c.data = c.data[0:0]
+	c.clearNulls()
 }
 func (c *intColumn) Copy() column {
 	cpy := &intColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:    c.ColMeta,
+		nullBitmap: c.copyNulls(),
+		alloc:      c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Ints(l, l)
@@ -1048,17 +1136,25 @@ func (c *intColumn) Copy() column {
 	return cpy
 }
 func (c *intColumn) Equal(i, j int) bool {
+	if equal, ok := c.equalNulls(i, j); ok {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *intColumn) Less(i, j int) bool {
+	if less, ok := c.lessNulls(i, j); ok {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *intColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swapNulls(i, j)
 }
 
 type uintColumn struct {
 	ColMeta
+	nullBitmap
 	data  []uint64
 	alloc *Allocator
 }
@@ -1070,11 +1166,13 @@ func (c *uintColumn) Meta() ColMeta {
 func (c *uintColumn) Clear() {
 	c.alloc.Free(len(c.data), uint64Size)
 	c.data = c.data[0:0]
+	c.clearNulls()
 }
 func (c *uintColumn) Copy() column {
 	cpy := &uintColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:    c.ColMeta,
+		nullBitmap: c.copyNulls(),
+		alloc:      c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.UInts(l, l)
@@ -1082,17 +1180,25 @@ func (c *uintColumn) Copy() column {
 	return cpy
 }
 func (c *uintColumn) Equal(i, j int) bool {
+	if equal, ok := c.equalNulls(i, j); ok {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *uintColumn) Less(i, j int) bool {
+	if less, ok := c.lessNulls(i, j); ok {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *uintColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swapNulls(i, j)
 }
 
 type floatColumn struct {
 	ColMeta
+	nullBitmap
 	data  []float64
 	alloc *Allocator
 }
@@ -1104,11 +1210,13 @@ func (c *floatColumn) Meta() ColMeta {
 func (c *floatColumn) Clear() {
 	c.alloc.Free(len(c.data), float64Size)
 	c.data = c.data[0:0]
+	c.clearNulls()
 }
 func (c *floatColumn) Copy() column {
 	cpy := &floatColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:    c.ColMeta,
+		nullBitmap: c.copyNulls(),
+		alloc:      c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Floats(l, l)
@@ -1116,17 +1224,25 @@ func (c *floatColumn) Copy() column {
 	return cpy
 }
 func (c *floatColumn) Equal(i, j int) bool {
+	if equal, ok := c.equalNulls(i, j); ok {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *floatColumn) Less(i, j int) bool {
+	if less, ok := c.lessNulls(i, j); ok {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *floatColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swapNulls(i, j)
 }
 
 type stringColumn struct {
 	ColMeta
+	nullBitmap
 	data  []string
 	alloc *Allocator
 }
@@ -1138,11 +1254,13 @@ func (c *stringColumn) Meta() ColMeta {
 func (c *stringColumn) Clear() {
 	c.alloc.Free(len(c.data), stringSize)
 	c.data = c.data[0:0]
+	c.clearNulls()
 }
 func (c *stringColumn) Copy() column {
 	cpy := &stringColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:    c.ColMeta,
+		nullBitmap: c.copyNulls(),
+		alloc:      c.alloc,
 	}
 
 	l := len(c.data)
@@ -1151,17 +1269,25 @@ func (c *stringColumn) Copy() column {
 	return cpy
 }
 func (c *stringColumn) Equal(i, j int) bool {
+	if equal, ok := c.equalNulls(i, j); ok {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *stringColumn) Less(i, j int) bool {
+	if less, ok := c.lessNulls(i, j); ok {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *stringColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swapNulls(i, j)
 }
 
 type timeColumn struct {
 	ColMeta
+	nullBitmap
 	data  []Time
 	alloc *Allocator
 }
@@ -1173,11 +1299,13 @@ func (c *timeColumn) Meta() ColMeta {
 func (c *timeColumn) Clear() {
 	c.alloc.Free(len(c.data), timeSize)
 	c.data = c.data[0:0]
+	c.clearNulls()
 }
 func (c *timeColumn) Copy() column {
 	cpy := &timeColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:    c.ColMeta,
+		nullBitmap: c.copyNulls(),
+		alloc:      c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Times(l, l)
@@ -1185,13 +1313,20 @@ func (c *timeColumn) Copy() column {
 	return cpy
 }
 func (c *timeColumn) Equal(i, j int) bool {
+	if equal, ok := c.equalNulls(i, j); ok {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *timeColumn) Less(i, j int) bool {
+	if less, ok := c.lessNulls(i, j); ok {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *timeColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swapNulls(i, j)
 }
 
 //commonStrColumn has the same string value for all rows
@@ -1217,6 +1352,9 @@ func (c *commonStrColumn) Less(i, j int) bool {
 	return false
 }
 func (c *commonStrColumn) Swap(i, j int) {}
+func (c *commonStrColumn) IsNull(i int) bool {
+	return false
+}
 
 type BlockBuilderCache interface {
 	// BlockBuilder returns an existing or new BlockBuilder for the given meta data.
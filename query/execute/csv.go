@@ -0,0 +1,706 @@
+package execute
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ResultEncoder encodes a named result into a writer.
+type ResultEncoder interface {
+	Encode(w io.Writer, name string, r Result) error
+}
+
+// ResultDecoder decodes all results contained in a reader.
+type ResultDecoder interface {
+	Decode(r io.Reader) (map[string]Result, error)
+}
+
+// ResultIterator iterates over a sequence of named results.
+type ResultIterator interface {
+	// More reports whether there is another result.
+	// Any blocks of the previous result that have not been consumed are discarded.
+	More() bool
+	// Next returns the name of the next result and the result itself.
+	Next() (string, Result)
+	// Err reports the first error encountered while iterating.
+	Err() error
+}
+
+const (
+	datatypeAnnotation = "#datatype"
+	kindAnnotation     = "#kind"
+	commonAnnotation   = "#common"
+	defaultAnnotation  = "#default"
+
+	resultLabel = "result"
+	errorLabel  = "error"
+	startLabel  = "_start"
+	stopLabel   = "_stop"
+
+	// recordStartIdx is the index of the first column of the block in a CSV record.
+	recordStartIdx = 4
+
+	boolDatatype    = "boolean"
+	intDatatype     = "long"
+	uintDatatype    = "unsignedLong"
+	floatDatatype   = "double"
+	stringDatatype  = "string"
+	timeDatatype    = "dateTime"
+	timeDatatypeRFC = "dateTime:RFC3339"
+)
+
+// CSVResultEncoder encodes results as annotated CSV.
+//
+// The annotated CSV format writes each block as its own CSV table.
+// Every table starts with a set of annotation rows, followed by a header row and the data rows.
+// Annotation rows have their first field prefixed with '#', all other rows leave the first field empty.
+//
+// The annotations are:
+//
+// * #datatype - the DataType of each column
+// * #kind     - the ColKind of each block column
+// * #common   - whether each block column has a single value shared by all rows
+// * #default  - the values shared by all rows, i.e. the result name, block bounds and common column values
+//
+// The first three columns of each table are reserved for the result name and the bounds of the block.
+// All remaining columns map directly to the columns of the block.
+// Null values are written as empty fields. An empty field is decoded as null for all data types except string,
+// since an empty string is itself a valid value.
+//
+// An error that ends the results early, such as a failure while executing the query,
+// is written as a table with a single string column labeled "error", see EncodeError.
+//
+// Example:
+//
+//	#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string
+//	#kind,,,,time,value,tag
+//	#common,,,,false,false,true
+//	#default,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,,,server01
+//	,result,_start,_stop,_time,_value,host
+//	,_result,2018-01-01T00:00:00Z,2018-01-01T01:00:00Z,2018-01-01T00:00:10Z,42.5,server01
+type CSVResultEncoder struct{}
+
+// NewCSVResultEncoder creates a new CSVResultEncoder.
+func NewCSVResultEncoder() *CSVResultEncoder {
+	return new(CSVResultEncoder)
+}
+
+// Encode writes every block of the result as an annotated CSV table.
+func (e *CSVResultEncoder) Encode(w io.Writer, name string, r Result) error {
+	writer := csv.NewWriter(w)
+	first := true
+	err := r.Blocks().Do(func(b Block) error {
+		if !first {
+			// Separate tables with an empty line, to make the output easier to read.
+			// CSV readers ignore empty lines.
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		first = false
+		if err := writeBlock(writer, name, b); err != nil {
+			return err
+		}
+		writer.Flush()
+		return writer.Error()
+	})
+	if err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// EncodeError writes err as a table with an error column, which ends the results.
+// The decoder reports the error when it reads the table.
+//
+// Example:
+//
+//	#datatype,string
+//	,error
+//	,failed to execute query
+func (e *CSVResultEncoder) EncodeError(w io.Writer, err error) error {
+	writer := csv.NewWriter(w)
+	for _, record := range [][]string{
+		{datatypeAnnotation, stringDatatype},
+		{"", errorLabel},
+		{"", err.Error()},
+	} {
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeBlock(writer *csv.Writer, name string, b Block) error {
+	cols := b.Cols()
+	bounds := b.Bounds()
+	tags := b.Tags()
+	l := recordStartIdx + len(cols)
+
+	datatypes := make([]string, l)
+	kinds := make([]string, l)
+	commons := make([]string, l)
+	defaults := make([]string, l)
+	header := make([]string, l)
+
+	datatypes[0] = datatypeAnnotation
+	kinds[0] = kindAnnotation
+	commons[0] = commonAnnotation
+	defaults[0] = defaultAnnotation
+
+	datatypes[1] = stringDatatype
+	datatypes[2] = timeDatatypeRFC
+	datatypes[3] = timeDatatypeRFC
+	header[1] = resultLabel
+	header[2] = startLabel
+	header[3] = stopLabel
+	defaults[1] = name
+	defaults[2] = formatTime(bounds.Start)
+	defaults[3] = formatTime(bounds.Stop)
+
+	for j, c := range cols {
+		idx := recordStartIdx + j
+		datatype, err := encodeDataType(c.Type)
+		if err != nil {
+			return err
+		}
+		datatypes[idx] = datatype
+		kinds[idx] = c.Kind.String()
+		commons[idx] = strconv.FormatBool(c.Common)
+		header[idx] = c.Label
+		if c.Common && c.Type == TString {
+			defaults[idx] = tags[c.Label]
+		}
+	}
+
+	for _, record := range [][]string{datatypes, kinds, commons, defaults, header} {
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, l)
+	record[1] = name
+	record[2] = formatTime(bounds.Start)
+	record[3] = formatTime(bounds.Stop)
+	var err error
+	b.Times().DoTime(func(ts []Time, rr RowReader) {
+		if err != nil {
+			return
+		}
+		for i := range ts {
+			for j, c := range cols {
+				record[recordStartIdx+j] = encodeValue(i, j, c, rr)
+			}
+			if err = writer.Write(record); err != nil {
+				return
+			}
+		}
+	})
+	return err
+}
+
+func encodeDataType(typ DataType) (string, error) {
+	switch typ {
+	case TBool:
+		return boolDatatype, nil
+	case TInt:
+		return intDatatype, nil
+	case TUInt:
+		return uintDatatype, nil
+	case TFloat:
+		return floatDatatype, nil
+	case TString:
+		return stringDatatype, nil
+	case TTime:
+		return timeDatatypeRFC, nil
+	default:
+		return "", fmt.Errorf("cannot encode data type %v", typ)
+	}
+}
+
+func decodeDataType(datatype string) (DataType, error) {
+	switch datatype {
+	case boolDatatype:
+		return TBool, nil
+	case intDatatype:
+		return TInt, nil
+	case uintDatatype:
+		return TUInt, nil
+	case floatDatatype:
+		return TFloat, nil
+	case stringDatatype:
+		return TString, nil
+	case timeDatatype, timeDatatypeRFC:
+		return TTime, nil
+	default:
+		return TInvalid, fmt.Errorf("unsupported data type %q", datatype)
+	}
+}
+
+func decodeColKind(kind string) (ColKind, error) {
+	switch kind {
+	case "time":
+		return TimeColKind, nil
+	case "tag":
+		return TagColKind, nil
+	case "value":
+		return ValueColKind, nil
+	default:
+		return InvalidColKind, fmt.Errorf("unsupported column kind %q", kind)
+	}
+}
+
+func encodeValue(i, j int, c ColMeta, rr RowReader) string {
+	if rr.IsNull(i, j) {
+		return ""
+	}
+	switch c.Type {
+	case TBool:
+		return strconv.FormatBool(rr.AtBool(i, j))
+	case TInt:
+		return strconv.FormatInt(rr.AtInt(i, j), 10)
+	case TUInt:
+		return strconv.FormatUint(rr.AtUInt(i, j), 10)
+	case TFloat:
+		return strconv.FormatFloat(rr.AtFloat(i, j), 'f', -1, 64)
+	case TString:
+		return rr.AtString(i, j)
+	case TTime:
+		return formatTime(rr.AtTime(i, j))
+	default:
+		PanicUnknownType(c.Type)
+		return ""
+	}
+}
+
+func formatTime(t Time) string {
+	return t.Time().Format(time.RFC3339Nano)
+}
+
+func parseTime(s string) (Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, err
+	}
+	return Time(t.UnixNano()), nil
+}
+
+// CSVResultDecoder decodes results from annotated CSV.
+type CSVResultDecoder struct {
+	alloc *Allocator
+}
+
+// NewCSVResultDecoder creates a new CSVResultDecoder.
+// Decoded blocks are not subject to any memory limit.
+func NewCSVResultDecoder() *CSVResultDecoder {
+	return &CSVResultDecoder{
+		alloc: &Allocator{Limit: math.MaxInt64},
+	}
+}
+
+// Decode reads all tables from r and returns the results they belong to.
+// The blocks of each result are held in memory.
+func (d *CSVResultDecoder) Decode(r io.Reader) (map[string]Result, error) {
+	results := make(map[string]Result)
+	itr := d.Results(r)
+	for itr.More() {
+		name, result := itr.Next()
+		br, ok := results[name].(*blockResult)
+		if !ok {
+			br = new(blockResult)
+			results[name] = br
+		}
+		if err := result.Blocks().Do(func(b Block) error {
+			br.blocks = append(br.blocks, b)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := itr.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Results returns an iterator over the results in r.
+// The results are decoded as they are read from r, holding only a single block in memory at a time.
+func (d *CSVResultDecoder) Results(r io.Reader) ResultIterator {
+	reader := csv.NewReader(r)
+	// The number of fields changes from table to table.
+	reader.FieldsPerRecord = -1
+	return &csvResultIterator{
+		tables: &csvTableReader{
+			reader: reader,
+			alloc:  d.alloc,
+		},
+	}
+}
+
+// csvResultIterator groups consecutive tables with the same result name into a single result.
+type csvResultIterator struct {
+	tables *csvTableReader
+
+	// next is the first table of the next result
+	next    *csvTable
+	current *csvStreamResult
+	err     error
+}
+
+func (itr *csvResultIterator) More() bool {
+	if itr.err != nil {
+		return false
+	}
+	if itr.current != nil {
+		// Skip any blocks of the current result that were not consumed.
+		if err := itr.current.Do(func(Block) error { return nil }); err != nil {
+			return false
+		}
+		itr.current = nil
+	}
+	if itr.next == nil {
+		t, err := itr.tables.read()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			itr.err = err
+			return false
+		}
+		itr.next = t
+	}
+	return true
+}
+
+func (itr *csvResultIterator) Next() (string, Result) {
+	itr.current = &csvStreamResult{
+		itr:   itr,
+		name:  itr.next.name,
+		first: itr.next,
+	}
+	itr.next = nil
+	return itr.current.name, itr.current
+}
+
+func (itr *csvResultIterator) Err() error {
+	return itr.err
+}
+
+// csvStreamResult is a Result which decodes its blocks as they are read.
+// Its blocks can only be read once.
+type csvStreamResult struct {
+	itr   *csvResultIterator
+	name  string
+	first *csvTable
+	done  bool
+}
+
+func (r *csvStreamResult) Blocks() BlockIterator {
+	return r
+}
+
+func (r *csvStreamResult) Do(f func(Block) error) error {
+	for !r.done {
+		t := r.first
+		r.first = nil
+		if t == nil {
+			var err error
+			t, err = r.itr.tables.read()
+			if err == io.EOF {
+				r.done = true
+				return nil
+			}
+			if err != nil {
+				r.itr.err = err
+				r.done = true
+				return err
+			}
+			if t.name != r.name {
+				// The table belongs to the next result
+				r.itr.next = t
+				r.done = true
+				return nil
+			}
+		}
+		b, err := t.builder.Block()
+		if err != nil {
+			return err
+		}
+		if err := f(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *csvStreamResult) abort(error) {}
+
+// csvTableReader reads complete tables from a CSV reader.
+type csvTableReader struct {
+	reader *csv.Reader
+	alloc  *Allocator
+
+	// peek is a record that was read but belongs to the next table.
+	peek []string
+	// n is the number of records read
+	n int
+}
+
+func (tr *csvTableReader) readRecord() ([]string, error) {
+	if tr.peek != nil {
+		record := tr.peek
+		tr.peek = nil
+		return record, nil
+	}
+	for {
+		record, err := tr.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		tr.n++
+		if len(record) > 0 {
+			return record, nil
+		}
+	}
+}
+
+// read reads the next table, io.EOF is returned if there are no more tables.
+func (tr *csvTableReader) read() (*csvTable, error) {
+	t := &csvTable{alloc: tr.alloc}
+	for {
+		record, err := tr.readRecord()
+		if err == io.EOF {
+			if t.headerRead {
+				return t, nil
+			}
+			if t.datatypes != nil || t.kinds != nil || t.commons != nil || t.defaults != nil {
+				return nil, errors.New("missing header row for last table")
+			}
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isAnnotation(record) {
+			if t.headerRead {
+				// The annotation starts the next table
+				tr.peek = record
+				return t, nil
+			}
+			t.annotate(record)
+			continue
+		}
+		if t.datatypes == nil {
+			return nil, fmt.Errorf("record %d: missing table annotations", tr.n)
+		}
+		if !t.headerRead && len(record) > 1 && record[1] == errorLabel {
+			return nil, tr.readError()
+		}
+		if !t.headerRead {
+			if err := t.readHeader(record); err != nil {
+				return nil, errors.Wrapf(err, "record %d", tr.n)
+			}
+			continue
+		}
+		if err := t.appendRecord(record); err != nil {
+			return nil, errors.Wrapf(err, "record %d", tr.n)
+		}
+	}
+}
+
+// readError reads the message of an error table, whose header has been read.
+func (tr *csvTableReader) readError() error {
+	record, err := tr.readRecord()
+	if err == io.EOF {
+		return errors.New("missing message of error table")
+	}
+	if err != nil {
+		return err
+	}
+	if len(record) < 2 {
+		return fmt.Errorf("record %d: missing error message", tr.n)
+	}
+	return errors.New(record[1])
+}
+
+func isAnnotation(record []string) bool {
+	return len(record[0]) > 0 && record[0][0] == '#'
+}
+
+// csvTable accumulates the rows of a single table into a block.
+type csvTable struct {
+	alloc *Allocator
+
+	datatypes []string
+	kinds     []string
+	commons   []string
+	defaults  []string
+
+	headerRead bool
+	name       string
+	cols       []ColMeta
+	builder    *ColListBlockBuilder
+}
+
+func (t *csvTable) annotate(record []string) {
+	switch record[0] {
+	case datatypeAnnotation:
+		t.datatypes = record
+	case kindAnnotation:
+		t.kinds = record
+	case commonAnnotation:
+		t.commons = record
+	case defaultAnnotation:
+		t.defaults = record
+	default:
+		// Ignore unknown annotations
+	}
+}
+
+func (t *csvTable) readHeader(header []string) error {
+	l := len(header)
+	if l < recordStartIdx {
+		return fmt.Errorf("header has %d columns, expected at least %d", l, recordStartIdx)
+	}
+	if header[1] != resultLabel || header[2] != startLabel || header[3] != stopLabel {
+		return fmt.Errorf("header must start with the columns %q, %q and %q", resultLabel, startLabel, stopLabel)
+	}
+	for _, a := range []struct {
+		name   string
+		record []string
+	}{
+		{datatypeAnnotation, t.datatypes},
+		{kindAnnotation, t.kinds},
+		{commonAnnotation, t.commons},
+		{defaultAnnotation, t.defaults},
+	} {
+		if len(a.record) != l {
+			return fmt.Errorf("annotation %s has %d columns, expected %d", a.name, len(a.record), l)
+		}
+	}
+
+	t.name = t.defaults[1]
+	start, err := parseTime(t.defaults[2])
+	if err != nil {
+		return errors.Wrap(err, "invalid block start bound")
+	}
+	stop, err := parseTime(t.defaults[3])
+	if err != nil {
+		return errors.Wrap(err, "invalid block stop bound")
+	}
+
+	t.builder = NewColListBlockBuilder(t.alloc)
+	t.builder.SetBounds(Bounds{Start: start, Stop: stop})
+	t.cols = make([]ColMeta, l-recordStartIdx)
+	for j := range t.cols {
+		idx := recordStartIdx + j
+		typ, err := decodeDataType(t.datatypes[idx])
+		if err != nil {
+			return errors.Wrapf(err, "column %q", header[idx])
+		}
+		kind, err := decodeColKind(t.kinds[idx])
+		if err != nil {
+			return errors.Wrapf(err, "column %q", header[idx])
+		}
+		common, err := strconv.ParseBool(t.commons[idx])
+		if err != nil {
+			return errors.Wrapf(err, "column %q", header[idx])
+		}
+		c := ColMeta{
+			Label:  header[idx],
+			Type:   typ,
+			Kind:   kind,
+			Common: common,
+		}
+		t.cols[j] = c
+		t.builder.AddCol(c)
+		if c.Common && c.Type == TString {
+			t.builder.SetCommonString(j, t.defaults[idx])
+		}
+	}
+	t.headerRead = true
+	return nil
+}
+
+func (t *csvTable) appendRecord(record []string) error {
+	if len(record) != recordStartIdx+len(t.cols) {
+		return fmt.Errorf("row has %d columns, expected %d", len(record), recordStartIdx+len(t.cols))
+	}
+	for j, c := range t.cols {
+		if c.Common && c.Type == TString {
+			continue
+		}
+		field := record[recordStartIdx+j]
+		if field == "" && c.Type != TString {
+			t.builder.AppendNil(j)
+			continue
+		}
+		switch c.Type {
+		case TBool:
+			v, err := strconv.ParseBool(field)
+			if err != nil {
+				return err
+			}
+			t.builder.AppendBool(j, v)
+		case TInt:
+			v, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return err
+			}
+			t.builder.AppendInt(j, v)
+		case TUInt:
+			v, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return err
+			}
+			t.builder.AppendUInt(j, v)
+		case TFloat:
+			v, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return err
+			}
+			t.builder.AppendFloat(j, v)
+		case TString:
+			t.builder.AppendString(j, field)
+		case TTime:
+			v, err := parseTime(field)
+			if err != nil {
+				return err
+			}
+			t.builder.AppendTime(j, v)
+		default:
+			PanicUnknownType(c.Type)
+		}
+	}
+	return nil
+}
+
+// blockResult is a Result whose blocks are all held in memory.
+type blockResult struct {
+	blocks []Block
+}
+
+func (r *blockResult) Blocks() BlockIterator {
+	return r
+}
+
+func (r *blockResult) Do(f func(Block) error) error {
+	for _, b := range r.blocks {
+		if err := f(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *blockResult) abort(error) {}
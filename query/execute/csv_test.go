@@ -0,0 +1,357 @@
+package execute_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+)
+
+func TestCSVResult_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded string
+		want    map[string][]*executetest.Block
+	}{
+		{
+			name: "single block",
+			encoded: `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string
+#kind,,,,time,value,tag,tag
+#common,,,,false,false,true,false
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,,a,
+,result,_start,_stop,_time,_value,t1,t2
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00Z,1.5,a,x
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000001Z,-2,a,y
+`,
+			want: map[string][]*executetest.Block{
+				"_result": {{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  100,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "t2", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), 1.5, "a", "x"},
+						{execute.Time(10), -2.0, "a", "y"},
+					},
+				}},
+			},
+		},
+		{
+			name: "all types",
+			encoded: `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,boolean,long,unsignedLong,string,dateTime:RFC3339
+#kind,,,,time,value,value,value,value,value
+#common,,,,false,false,false,false,false,false
+#default,r,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,,,,,
+,result,_start,_stop,_time,b,i,u,s,t
+,r,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000005Z,true,-42,42,"hello, world",2018-02-01T12:00:00Z
+`,
+			want: map[string][]*executetest.Block{
+				"r": {{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  100,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "b", Type: execute.TBool, Kind: execute.ValueColKind},
+						{Label: "i", Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "u", Type: execute.TUInt, Kind: execute.ValueColKind},
+						{Label: "s", Type: execute.TString, Kind: execute.ValueColKind},
+						{Label: "t", Type: execute.TTime, Kind: execute.ValueColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(50), true, int64(-42), uint64(42), "hello, world", execute.Time(1517486400000000000)},
+					},
+				}},
+			},
+		},
+		{
+			name: "null values",
+			encoded: `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,long,string
+#kind,,,,time,value,value,tag
+#common,,,,false,false,false,false
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,,,
+,result,_start,_stop,_time,_value,count,host
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00Z,,1,
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000001Z,2.5,,a
+`,
+			want: map[string][]*executetest.Block{
+				"_result": {{
+					Bnds: execute.Bounds{
+						Start: 0,
+						Stop:  100,
+					},
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "count", Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), nil, int64(1), ""},
+						{execute.Time(10), 2.5, nil, "a"},
+					},
+				}},
+			},
+		},
+		{
+			name: "multiple blocks",
+			encoded: `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string
+#kind,,,,time,value,tag
+#common,,,,false,false,true
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,,a
+,result,_start,_stop,_time,_value,t1
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000001Z,1,a
+
+#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string
+#kind,,,,time,value,tag
+#common,,,,false,false,true
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,,b
+,result,_start,_stop,_time,_value,t1
+
+#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string
+#kind,,,,time,value,tag
+#common,,,,false,false,true
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,,c
+,result,_start,_stop,_time,_value,t1
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000002Z,2,c
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000003Z,3,c
+`,
+			want: map[string][]*executetest.Block{
+				"_result": {
+					{
+						Bnds: execute.Bounds{
+							Start: 0,
+							Stop:  100,
+						},
+						ColMeta: []execute.ColMeta{
+							{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+							{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+							{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						},
+						Data: [][]interface{}{
+							{execute.Time(10), int64(1), "a"},
+						},
+					},
+					{
+						Bnds: execute.Bounds{
+							Start: 0,
+							Stop:  100,
+						},
+						ColMeta: []execute.ColMeta{
+							{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+							{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+							{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						},
+					},
+					{
+						Bnds: execute.Bounds{
+							Start: 0,
+							Stop:  100,
+						},
+						ColMeta: []execute.ColMeta{
+							{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+							{Label: "_value", Type: execute.TInt, Kind: execute.ValueColKind},
+							{Label: "t1", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						},
+						Data: [][]interface{}{
+							{execute.Time(20), int64(2), "c"},
+							{execute.Time(30), int64(3), "c"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := execute.NewCSVResultDecoder().Decode(strings.NewReader(tc.encoded))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make(map[string][]*executetest.Block, len(results))
+			for name, r := range results {
+				if err := r.Blocks().Do(func(b execute.Block) error {
+					got[name] = append(got[name], executetest.ConvertBlock(b))
+					return nil
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected decoded blocks -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+
+			var buf bytes.Buffer
+			encoder := execute.NewCSVResultEncoder()
+			for name, r := range results {
+				if err := encoder.Encode(&buf, name, r); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got, want := buf.String(), tc.encoded; got != want {
+				t.Fatalf("unexpected encoding -want/+got\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestCSVResultDecoder_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded string
+	}{
+		{
+			name:    "missing annotations",
+			encoded: ",result,_start,_stop,_time,_value\n",
+		},
+		{
+			name: "unknown data type",
+			encoded: `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,complex
+#kind,,,,time,value
+#common,,,,false,false
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00Z,,
+,result,_start,_stop,_time,_value
+`,
+		},
+		{
+			name: "invalid value",
+			encoded: `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long
+#kind,,,,time,value
+#common,,,,false,false
+#default,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00Z,,
+,result,_start,_stop,_time,_value
+,_result,1970-01-01T00:00:00Z,1970-01-01T00:00:00Z,1970-01-01T00:00:00Z,1.5
+`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := execute.NewCSVResultDecoder().Decode(strings.NewReader(tc.encoded)); err == nil {
+				t.Fatal("expected error decoding results")
+			}
+		})
+	}
+}
+
+func TestCSVResultDecoder_Results(t *testing.T) {
+	encoded := `#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long
+#kind,,,,time,value
+#common,,,,false,false
+#default,a,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,
+,result,_start,_stop,_time,_value
+,a,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000001Z,1
+
+#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long
+#kind,,,,time,value
+#common,,,,false,false
+#default,a,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,
+,result,_start,_stop,_time,_value
+,a,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000002Z,2
+
+#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double
+#kind,,,,time,value
+#common,,,,false,false
+#default,b,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,
+,result,_start,_stop,_time,_value
+,b,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000003Z,3
+
+#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double
+#kind,,,,time,value
+#common,,,,false,false
+#default,c,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,
+,result,_start,_stop,_time,_value
+,c,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000004Z,4
+`
+	// Consume result "a" completely, skip result "b" and consume only the first block of "c".
+	want := map[string][]int{
+		"a": {1, 1},
+		"b": nil,
+		"c": {1},
+	}
+	got := make(map[string][]int)
+	var names []string
+	itr := execute.NewCSVResultDecoder().Results(strings.NewReader(encoded))
+	for itr.More() {
+		name, r := itr.Next()
+		names = append(names, name)
+		got[name] = nil
+		switch name {
+		case "a", "c":
+			r.Blocks().Do(func(b execute.Block) error {
+				got[name] = append(got[name], len(executetest.ConvertBlock(b).Data))
+				if name == "c" {
+					return errors.New("stop")
+				}
+				return nil
+			})
+		}
+	}
+	if err := itr.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "c"}; !cmp.Equal(want, names) {
+		t.Errorf("unexpected result names -want/+got\n%s", cmp.Diff(want, names))
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected block lengths -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestCSVResultEncoder_EncodeError(t *testing.T) {
+	results, err := execute.NewCSVResultDecoder().Decode(strings.NewReader(`#datatype,string,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long
+#kind,,,,time,value
+#common,,,,false,false
+#default,a,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,,
+,result,_start,_stop,_time,_value
+,a,1970-01-01T00:00:00Z,1970-01-01T00:00:00.0000001Z,1970-01-01T00:00:00.00000001Z,1
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoder := execute.NewCSVResultEncoder()
+	var buf bytes.Buffer
+	if err := encoder.Encode(&buf, "a", results["a"]); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("\n")
+	if err := encoder.EncodeError(&buf, errors.New("failed to execute query")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The blocks before the error are decoded, then the error ends the results.
+	n := 0
+	itr := execute.NewCSVResultDecoder().Results(&buf)
+	for itr.More() {
+		_, r := itr.Next()
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			n++
+			return nil
+		}); err != nil && err.Error() != "failed to execute query" {
+			t.Fatal(err)
+		}
+	}
+	if n != 1 {
+		t.Errorf("unexpected number of blocks got %d want 1", n)
+	}
+	err = itr.Err()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := err.Error(), "failed to execute query"; got != want {
+		t.Errorf("unexpected error got %q want %q", got, want)
+	}
+}
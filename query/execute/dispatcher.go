@@ -3,6 +3,7 @@ package execute
 import (
 	"context"
 	"fmt"
+	"log"
 	"runtime/debug"
 	"sync"
 )
@@ -31,15 +32,24 @@ type poolDispatcher struct {
 	wg      sync.WaitGroup
 	err     error
 	errC    chan error
+
+	// throttleMu guards the state used to pause results while they are not being consumed.
+	throttleMu   sync.Mutex
+	throttleCond *sync.Cond
+	// waiting is the number of result consumers waiting for blocks.
+	waiting int
+	stopped bool
 }
 
 func newPoolDispatcher(throughput int) *poolDispatcher {
-	return &poolDispatcher{
+	d := &poolDispatcher{
 		throughput: throughput,
 		work:       make(chan ScheduleFunc, 100),
 		closing:    make(chan struct{}),
 		errC:       make(chan error, 1),
 	}
+	d.throttleCond = sync.NewCond(&d.throttleMu)
+	return d
 }
 
 func (d *poolDispatcher) Schedule(fn ScheduleFunc) {
@@ -64,7 +74,9 @@ func (d *poolDispatcher) Start(n int, ctx context.Context) {
 					default:
 						err = fmt.Errorf("%v", e)
 					}
-					d.setErr(fmt.Errorf("panic: %v\n%s", err, debug.Stack()))
+					// The stack is logged, it is not sent to the client.
+					log.Printf("E! panic: %v\n%s", err, debug.Stack())
+					d.setErr(fmt.Errorf("panic: %v", err))
 				}
 			}()
 			d.run(ctx)
@@ -98,10 +110,50 @@ func (d *poolDispatcher) Stop() error {
 	}
 	d.closed = true
 	close(d.closing)
+
+	d.throttleMu.Lock()
+	d.stopped = true
+	d.throttleCond.Broadcast()
+	d.throttleMu.Unlock()
+
 	d.wg.Wait()
 	return d.err
 }
 
+// capacityAvailable wakes the results that wait for their consumers to make room for more blocks.
+func (d *poolDispatcher) capacityAvailable() {
+	d.throttleMu.Lock()
+	d.throttleCond.Broadcast()
+	d.throttleMu.Unlock()
+}
+
+// startWaiting records that a result consumer is waiting for blocks.
+// A full result does not wait while a consumer is waiting for the blocks of another result,
+// otherwise a consumer that reads the results one after another could deadlock with a result it has not read yet.
+func (d *poolDispatcher) startWaiting() {
+	d.throttleMu.Lock()
+	d.waiting++
+	d.throttleCond.Broadcast()
+	d.throttleMu.Unlock()
+}
+
+func (d *poolDispatcher) stopWaiting() {
+	d.throttleMu.Lock()
+	d.waiting--
+	d.throttleMu.Unlock()
+}
+
+// waitForCapacity blocks while full reports that a result buffers its capacity of blocks,
+// until a consumer waits for blocks or the dispatcher is stopped.
+// The result must call capacityAvailable once it is no longer full.
+func (d *poolDispatcher) waitForCapacity(full func() bool) {
+	d.throttleMu.Lock()
+	for full() && d.waiting == 0 && !d.stopped {
+		d.throttleCond.Wait()
+	}
+	d.throttleMu.Unlock()
+}
+
 // run is the logic executed by each worker goroutine in the pool.
 func (d *poolDispatcher) run(ctx context.Context) {
 	for {
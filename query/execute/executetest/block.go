@@ -64,58 +64,81 @@ func (v *ValueIterator) Cols() []execute.ColMeta {
 }
 func (v *ValueIterator) DoBool(f func([]bool, execute.RowReader)) {
 	for v.row = 0; v.row < len(v.b.Data); v.row++ {
-		f([]bool{v.b.Data[v.row][v.col].(bool)}, v)
+		f([]bool{v.AtBool(v.row, v.col)}, v)
 	}
 }
 func (v *ValueIterator) DoInt(f func([]int64, execute.RowReader)) {
 	for v.row = 0; v.row < len(v.b.Data); v.row++ {
-		f([]int64{v.b.Data[v.row][v.col].(int64)}, v)
+		f([]int64{v.AtInt(v.row, v.col)}, v)
 	}
 }
 func (v *ValueIterator) DoUInt(f func([]uint64, execute.RowReader)) {
 	for v.row = 0; v.row < len(v.b.Data); v.row++ {
-		f([]uint64{v.b.Data[v.row][v.col].(uint64)}, v)
+		f([]uint64{v.AtUInt(v.row, v.col)}, v)
 	}
 }
 func (v *ValueIterator) DoFloat(f func([]float64, execute.RowReader)) {
 	for v.row = 0; v.row < len(v.b.Data); v.row++ {
-		f([]float64{v.b.Data[v.row][v.col].(float64)}, v)
+		f([]float64{v.AtFloat(v.row, v.col)}, v)
 	}
 }
 
 func (v *ValueIterator) DoString(f func([]string, execute.RowReader)) {
 	for v.row = 0; v.row < len(v.b.Data); v.row++ {
-		f([]string{v.b.Data[v.row][v.col].(string)}, v)
+		f([]string{v.AtString(v.row, v.col)}, v)
 	}
 }
 
 func (v *ValueIterator) DoTime(f func([]execute.Time, execute.RowReader)) {
 	for v.row = 0; v.row < len(v.b.Data); v.row++ {
-		f([]execute.Time{v.b.Data[v.row][v.col].(execute.Time)}, v)
+		f([]execute.Time{v.AtTime(v.row, v.col)}, v)
 	}
 }
 
 func (v *ValueIterator) AtBool(i int, j int) bool {
+	if v.IsNull(i, j) {
+		return false
+	}
 	return v.b.Data[v.row][j].(bool)
 }
 func (v *ValueIterator) AtInt(i int, j int) int64 {
+	if v.IsNull(i, j) {
+		return 0
+	}
 	return v.b.Data[v.row][j].(int64)
 }
 func (v *ValueIterator) AtUInt(i int, j int) uint64 {
+	if v.IsNull(i, j) {
+		return 0
+	}
 	return v.b.Data[v.row][j].(uint64)
 }
 func (v *ValueIterator) AtFloat(i int, j int) float64 {
+	if v.IsNull(i, j) {
+		return 0
+	}
 	return v.b.Data[v.row][j].(float64)
 }
 
 func (v *ValueIterator) AtString(i int, j int) string {
+	if v.IsNull(i, j) {
+		return ""
+	}
 	return v.b.Data[v.row][j].(string)
 }
 
 func (v *ValueIterator) AtTime(i int, j int) execute.Time {
+	if v.IsNull(i, j) {
+		return 0
+	}
 	return v.b.Data[v.row][j].(execute.Time)
 }
 
+// IsNull reports whether the value is nil, which represents a null value.
+func (v *ValueIterator) IsNull(i int, j int) bool {
+	return v.b.Data[v.row][j] == nil
+}
+
 func BlocksFromCache(c execute.DataCache) []*Block {
 	var blocks []*Block
 	c.ForEach(func(key execute.BlockKey) {
@@ -138,6 +161,10 @@ func ConvertBlock(b execute.Block) *Block {
 		for i := range ts {
 			row := make([]interface{}, len(blk.ColMeta))
 			for j, c := range blk.ColMeta {
+				if rr.IsNull(i, j) {
+					// Nulls are represented as nil
+					continue
+				}
 				var v interface{}
 				switch c.Type {
 				case execute.TBool:
@@ -3,7 +3,9 @@ package execute
 import (
 	"context"
 	"fmt"
+	"log"
 	"runtime/debug"
+	"time"
 
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/plan"
@@ -20,6 +22,10 @@ type executor struct {
 
 type Config struct {
 	StorageReader StorageReader
+	// PointsWriter is used by transformations that write their results back to a database.
+	PointsWriter PointsWriter
+	// CSVDir is the directory of the files that sources may read, reading files is disabled if it is empty.
+	CSVDir string
 }
 
 func NewExecutor(c Config) Executor {
@@ -77,17 +83,14 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.PlanSpec) (
 		results:   make(map[string]Result, len(p.Results)),
 		// TODO(nathanielc): Have the planner specify the dispatcher throughput
 		dispatcher: newPoolDispatcher(10),
-		bounds: Bounds{
-			Start: Time(p.Bounds.Start.Time(p.Now).UnixNano()),
-			Stop:  Time(p.Bounds.Stop.Time(p.Now).UnixNano()),
-		},
+		bounds:     resolveBounds(p.Bounds, p.Now),
 	}
 	for name, yield := range p.Results {
 		ds, err := es.createNode(ctx, p.Procedures[yield.ID])
 		if err != nil {
 			return nil, err
 		}
-		rs := newResultSink(yield)
+		rs := newResultSink(yield, es.dispatcher)
 		ds.AddTransformation(rs)
 		es.results[name] = rs
 	}
@@ -105,7 +108,8 @@ type triggeringSpec interface {
 func (es *executionState) createNode(ctx context.Context, pr *plan.Procedure) (Node, error) {
 	// Build execution context
 	ec := executionContext{
-		es: es,
+		ctx: ctx,
+		es:  es,
 	}
 	if len(pr.Parents) > 0 {
 		ec.parents = make([]DatasetID, len(pr.Parents))
@@ -173,7 +177,9 @@ func (es *executionState) do(ctx context.Context) {
 					default:
 						err = fmt.Errorf("%v", e)
 					}
-					es.abort(fmt.Errorf("panic: %v\n%s", err, debug.Stack()))
+					// The stack is logged, it is not sent to the client.
+					log.Printf("E! panic: %v\n%s", err, debug.Stack())
+					es.abort(fmt.Errorf("panic: %v", err))
 				}
 			}()
 			src.Run(ctx)
@@ -202,6 +208,7 @@ func (es *executionState) do(ctx context.Context) {
 }
 
 type executionContext struct {
+	ctx     context.Context
 	es      *executionState
 	parents []DatasetID
 }
@@ -211,6 +218,18 @@ type executionContext struct {
 func (ec executionContext) ResolveTime(qt query.Time) Time {
 	return Time(qt.Time(ec.es.p.Now).UnixNano())
 }
+func (ec executionContext) ResolveBounds(b plan.BoundsSpec) Bounds {
+	return resolveBounds(b, ec.es.p.Now)
+}
+
+func resolveBounds(b plan.BoundsSpec, now time.Time) Bounds {
+	start, stop := b.Resolve(now)
+	return Bounds{
+		Start: Time(start.UnixNano()),
+		Stop:  Time(stop.UnixNano()),
+	}
+}
+
 func (ec executionContext) Bounds() Bounds {
 	return ec.es.bounds
 }
@@ -219,6 +238,18 @@ func (ec executionContext) Allocator() *Allocator {
 	return ec.es.alloc
 }
 
+func (ec executionContext) PointsWriter() PointsWriter {
+	return ec.es.c.PointsWriter
+}
+
+func (ec executionContext) Context() context.Context {
+	return ec.ctx
+}
+
+func (ec executionContext) CSVDir() string {
+	return ec.es.c.CSVDir
+}
+
 func (ec executionContext) Parents() []DatasetID {
 	return ec.parents
 }
@@ -202,6 +202,10 @@ func (f *Formatter) writeHeaderSeparator(w *writeToHelper) {
 }
 
 func (f *Formatter) valueBuf(i, j int, typ DataType, rr RowReader) (buf []byte) {
+	if rr.IsNull(i, j) {
+		// Null values are left blank.
+		return f.fmtBuf[0:0]
+	}
 	switch typ {
 	case TBool:
 		buf = strconv.AppendBool(f.fmtBuf[0:0], rr.AtBool(i, j))
@@ -0,0 +1,776 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/influxdata/ifql/query/execute/storage"
+	"github.com/influxdata/influxdb/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// memMeasurementKey and memFieldKey are the tags used to identify
+	// the measurement and field of a series, they match the tags produced by the storage service.
+	memMeasurementKey = "_measurement"
+	memFieldKey       = "_field"
+
+	// memFrameSize is the maximum number of points in a single points frame.
+	memFrameSize = 1000
+	// memResponseSize is the maximum number of frames in a single read response.
+	memResponseSize = 100
+)
+
+// MemoryStorageReader is a StorageReader that reads data held in memory.
+// It is useful for running queries without a storage service, for example in tests
+// or for small datasets embedded in tools.
+//
+// Each field of a written point is stored as its own series,
+// identified by the tags of the point and the _measurement and _field tags.
+type MemoryStorageReader struct {
+	mu  sync.RWMutex
+	dbs map[string]map[string]*memSeries
+}
+
+// NewMemoryStorageReader creates an empty MemoryStorageReader.
+func NewMemoryStorageReader() *MemoryStorageReader {
+	return &MemoryStorageReader{
+		dbs: make(map[string]map[string]*memSeries),
+	}
+}
+
+// WriteLineProtocol parses the line protocol data and writes the points to the database.
+func (s *MemoryStorageReader) WriteLineProtocol(db, lp string) error {
+	points, err := models.ParsePointsString(lp)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse line protocol")
+	}
+	return s.WritePoints(db, points)
+}
+
+// WritePoints writes the points to the database.
+// A point with the same series and time as an existing point replaces its value.
+func (s *MemoryStorageReader) WritePoints(db string, points []models.Point) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	series, ok := s.dbs[db]
+	if !ok {
+		series = make(map[string]*memSeries)
+		s.dbs[db] = series
+	}
+	for _, p := range points {
+		fields, err := p.Fields()
+		if err != nil {
+			return err
+		}
+		for field, v := range fields {
+			typ, v, err := memValue(v)
+			if err != nil {
+				return errors.Wrapf(err, "field %q", field)
+			}
+			pointTags := p.Tags()
+			tags := make([]storage.Tag, 0, len(pointTags)+2)
+			tags = append(tags,
+				storage.Tag{Key: []byte(memMeasurementKey), Value: p.Name()},
+				storage.Tag{Key: []byte(memFieldKey), Value: []byte(field)},
+			)
+			for _, t := range pointTags {
+				tags = append(tags, storage.Tag{Key: t.Key, Value: t.Value})
+			}
+			sort.Slice(tags, func(i, j int) bool {
+				return bytes.Compare(tags[i].Key, tags[j].Key) < 0
+			})
+			key := memSeriesKey(tags)
+
+			ms, ok := series[key]
+			if !ok {
+				ms = &memSeries{
+					tags: tags,
+					typ:  typ,
+				}
+				series[key] = ms
+			} else if ms.typ != typ {
+				return fmt.Errorf("field type conflict for series %s: %v != %v", key, typ, ms.typ)
+			}
+			ms.write(Time(p.UnixNano()), v)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStorageReader) Read(ctx context.Context, trace map[string]string, readSpec ReadSpec, start, stop Time) (BlockIterator, error) {
+	var predicate *storage.Predicate
+	if readSpec.Predicate != nil {
+		p, err := ToStoragePredicate(readSpec.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		predicate = p
+	}
+	agg, err := determineAggregateMethod(readSpec.AggregateMethod)
+	if err != nil {
+		return nil, err
+	}
+	return &memBlockIterator{
+		s: s,
+		bounds: Bounds{
+			Start: start,
+			Stop:  stop,
+		},
+		readSpec:  readSpec,
+		predicate: newMemPredicate(predicate),
+		agg:       agg,
+	}, nil
+}
+
+// HasCapability reports whether the reader has the capability, it computes all aggregates.
+func (s *MemoryStorageReader) HasCapability(name string) bool {
+	for t := range storage.Aggregate_AggregateType_name {
+		if t != int32(storage.AggregateTypeNone) && name == aggregateCapability(storage.Aggregate_AggregateType(t)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStorageReader) Close() {}
+
+type memBlockIterator struct {
+	s         *MemoryStorageReader
+	bounds    Bounds
+	readSpec  ReadSpec
+	predicate *memPredicate
+	agg       storage.Aggregate_AggregateType
+}
+
+func (bi *memBlockIterator) Do(f func(Block) error) error {
+	frames, err := bi.frames()
+	if err != nil {
+		return err
+	}
+	streams := []*streamState{{
+		stream:   &memReadStream{frames: frames},
+		readSpec: &bi.readSpec,
+	}}
+	return readBlocks(bi.bounds, &bi.readSpec, streams, f)
+}
+
+// frames produces the read response frames the storage service would produce for the read.
+func (bi *memBlockIterator) frames() ([]storage.ReadResponse_Frame, error) {
+	reads, err := bi.read()
+	if err != nil {
+		return nil, err
+	}
+
+	// Order series by their group key so that groups are contiguous.
+	for _, r := range reads {
+		r.groupKey = appendSeriesKey(nil, &storage.ReadResponse_SeriesFrame{Tags: r.series.tags}, &bi.readSpec)
+	}
+	sort.SliceStable(reads, func(i, j int) bool {
+		if cmp := bytes.Compare(reads[i].groupKey, reads[j].groupKey); cmp != 0 {
+			return cmp < 0
+		}
+		return memSeriesKey(reads[i].series.tags) < memSeriesKey(reads[j].series.tags)
+	})
+
+	if offset := int(bi.readSpec.SeriesOffset); offset > 0 {
+		if offset > len(reads) {
+			offset = len(reads)
+		}
+		reads = reads[offset:]
+	}
+	if limit := int(bi.readSpec.SeriesLimit); limit > 0 && limit < len(reads) {
+		reads = reads[:limit]
+	}
+
+	for _, r := range reads {
+		if bi.readSpec.Descending {
+			r.reverse()
+		}
+		if limit := int(bi.readSpec.PointsLimit); limit > 0 && limit < len(r.times) {
+			r.times = r.times[:limit]
+			r.values = r.values[:limit]
+		}
+		if bi.agg != storage.AggregateTypeNone {
+			if err := r.aggregate(bi.agg, bi.bounds.Stop); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var frames []storage.ReadResponse_Frame
+	if !bi.readSpec.OrderByTime {
+		for _, r := range reads {
+			frames = r.appendFrames(frames, 0, len(r.times))
+		}
+		return frames, nil
+	}
+
+	// Produce the points of each group in time order,
+	// switching between series as needed.
+	for i := 0; i < len(reads); {
+		j := i + 1
+		for j < len(reads) && bytes.Equal(reads[i].groupKey, reads[j].groupKey) {
+			j++
+		}
+		group := reads[i:j]
+
+		type ref struct{ r, p int }
+		var refs []ref
+		for r, read := range group {
+			for p := range read.times {
+				refs = append(refs, ref{r: r, p: p})
+			}
+		}
+		sort.SliceStable(refs, func(a, b int) bool {
+			ta, tb := group[refs[a].r].times[refs[a].p], group[refs[b].r].times[refs[b].p]
+			if bi.readSpec.Descending {
+				return ta > tb
+			}
+			return ta < tb
+		})
+		for k := 0; k < len(refs); {
+			l := k + 1
+			for l < len(refs) && refs[l].r == refs[k].r && refs[l].p == refs[l-1].p+1 {
+				l++
+			}
+			frames = group[refs[k].r].appendFrames(frames, refs[k].p, refs[k].p+l-k)
+			k = l
+		}
+		i = j
+	}
+	return frames, nil
+}
+
+// read selects the points of each series within the bounds that match the predicate.
+func (bi *memBlockIterator) read() ([]*memRead, error) {
+	bi.s.mu.RLock()
+	defer bi.s.mu.RUnlock()
+
+	var reads []*memRead
+	for _, ms := range bi.s.dbs[bi.readSpec.Database] {
+		r := &memRead{series: ms}
+		lo := sort.Search(len(ms.times), func(i int) bool { return ms.times[i] >= bi.bounds.Start })
+		hi := sort.Search(len(ms.times), func(i int) bool { return ms.times[i] >= bi.bounds.Stop })
+		for i := lo; i < hi; i++ {
+			if bi.predicate != nil {
+				ok, err := bi.predicate.eval(ms.tags, ms.values[i])
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			r.times = append(r.times, ms.times[i])
+			r.values = append(r.values, ms.values[i])
+		}
+		if len(r.times) > 0 {
+			reads = append(reads, r)
+		}
+	}
+	return reads, nil
+}
+
+// memSeries is a single series sorted by time.
+type memSeries struct {
+	tags   []storage.Tag
+	typ    DataType
+	times  []Time
+	values []interface{}
+}
+
+func (s *memSeries) write(t Time, v interface{}) {
+	i := sort.Search(len(s.times), func(i int) bool { return s.times[i] >= t })
+	if i < len(s.times) && s.times[i] == t {
+		s.values[i] = v
+		return
+	}
+	s.times = append(s.times, 0)
+	s.values = append(s.values, nil)
+	copy(s.times[i+1:], s.times[i:])
+	copy(s.values[i+1:], s.values[i:])
+	s.times[i] = t
+	s.values[i] = v
+}
+
+func memSeriesKey(tags []storage.Tag) string {
+	var b strings.Builder
+	for i, t := range tags {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		b.Write(t.Key)
+		b.WriteByte('=')
+		b.Write(t.Value)
+	}
+	return b.String()
+}
+
+func memValue(v interface{}) (DataType, interface{}, error) {
+	switch v := v.(type) {
+	case bool:
+		return TBool, v, nil
+	case int64:
+		return TInt, v, nil
+	case int:
+		return TInt, int64(v), nil
+	case uint64:
+		return TUInt, v, nil
+	case float64:
+		return TFloat, v, nil
+	case string:
+		return TString, v, nil
+	default:
+		return TInvalid, nil, fmt.Errorf("unsupported field value type %T", v)
+	}
+}
+
+// memRead is the selected data of a single series for a read.
+type memRead struct {
+	series   *memSeries
+	groupKey key
+	typ      DataType
+	times    []Time
+	values   []interface{}
+}
+
+func (r *memRead) reverse() {
+	for i, j := 0, len(r.times)-1; i < j; i, j = i+1, j-1 {
+		r.times[i], r.times[j] = r.times[j], r.times[i]
+		r.values[i], r.values[j] = r.values[j], r.values[i]
+	}
+}
+
+func (r *memRead) dataType() DataType {
+	if r.typ != TInvalid {
+		return r.typ
+	}
+	return r.series.typ
+}
+
+// aggregate replaces the points with a single point containing the aggregate value.
+// The aggregate point is timestamped with the stop time of the read.
+func (r *memRead) aggregate(agg storage.Aggregate_AggregateType, stop Time) error {
+	typ := r.series.typ
+	var v interface{}
+	switch agg {
+	case storage.AggregateTypeCount:
+		typ, v = TInt, int64(len(r.values))
+	case storage.AggregateTypeFirst, storage.AggregateTypeLast:
+		// Points may be in descending order, select by time.
+		idx := 0
+		for i, t := range r.times {
+			if agg == storage.AggregateTypeFirst && t < r.times[idx] ||
+				agg == storage.AggregateTypeLast && t > r.times[idx] {
+				idx = i
+			}
+		}
+		v = r.values[idx]
+	case storage.AggregateTypeSum, storage.AggregateTypeMean, storage.AggregateTypeMin, storage.AggregateTypeMax:
+		switch typ {
+		case TInt:
+			vs := make([]int64, len(r.values))
+			for i, v := range r.values {
+				vs[i] = v.(int64)
+			}
+			v = memAggregateInt(agg, vs)
+		case TUInt:
+			vs := make([]uint64, len(r.values))
+			for i, v := range r.values {
+				vs[i] = v.(uint64)
+			}
+			v = memAggregateUInt(agg, vs)
+		case TFloat:
+			vs := make([]float64, len(r.values))
+			for i, v := range r.values {
+				vs[i] = v.(float64)
+			}
+			v = memAggregateFloat(agg, vs)
+		default:
+			return fmt.Errorf("unsupported aggregate %v for %v values", agg, typ)
+		}
+		if agg == storage.AggregateTypeMean {
+			typ = TFloat
+		}
+	default:
+		return fmt.Errorf("unsupported aggregate %v", agg)
+	}
+	r.typ = typ
+	r.times = []Time{stop}
+	r.values = []interface{}{v}
+	return nil
+}
+
+func memAggregateInt(agg storage.Aggregate_AggregateType, vs []int64) interface{} {
+	switch agg {
+	case storage.AggregateTypeMin, storage.AggregateTypeMax:
+		m := vs[0]
+		for _, v := range vs[1:] {
+			if agg == storage.AggregateTypeMin && v < m || agg == storage.AggregateTypeMax && v > m {
+				m = v
+			}
+		}
+		return m
+	}
+	var sum int64
+	for _, v := range vs {
+		sum += v
+	}
+	if agg == storage.AggregateTypeMean {
+		return float64(sum) / float64(len(vs))
+	}
+	return sum
+}
+
+func memAggregateUInt(agg storage.Aggregate_AggregateType, vs []uint64) interface{} {
+	switch agg {
+	case storage.AggregateTypeMin, storage.AggregateTypeMax:
+		m := vs[0]
+		for _, v := range vs[1:] {
+			if agg == storage.AggregateTypeMin && v < m || agg == storage.AggregateTypeMax && v > m {
+				m = v
+			}
+		}
+		return m
+	}
+	var sum uint64
+	for _, v := range vs {
+		sum += v
+	}
+	if agg == storage.AggregateTypeMean {
+		return float64(sum) / float64(len(vs))
+	}
+	return sum
+}
+
+func memAggregateFloat(agg storage.Aggregate_AggregateType, vs []float64) interface{} {
+	switch agg {
+	case storage.AggregateTypeMin, storage.AggregateTypeMax:
+		m := vs[0]
+		for _, v := range vs[1:] {
+			if agg == storage.AggregateTypeMin && v < m || agg == storage.AggregateTypeMax && v > m {
+				m = v
+			}
+		}
+		return m
+	}
+	var sum float64
+	for _, v := range vs {
+		sum += v
+	}
+	if agg == storage.AggregateTypeMean {
+		return sum / float64(len(vs))
+	}
+	return sum
+}
+
+// appendFrames appends a series frame followed by the points frames for the points in [i, j).
+func (r *memRead) appendFrames(frames []storage.ReadResponse_Frame, i, j int) []storage.ReadResponse_Frame {
+	typ := r.dataType()
+	frames = append(frames, storage.ReadResponse_Frame{
+		Data: &storage.ReadResponse_Frame_Series{
+			Series: &storage.ReadResponse_SeriesFrame{
+				Tags:     r.series.tags,
+				DataType: toStorageDataType(typ),
+			},
+		},
+	})
+	for ; i < j; i += memFrameSize {
+		n := j
+		if n > i+memFrameSize {
+			n = i + memFrameSize
+		}
+		frames = append(frames, memPointsFrame(typ, r.times[i:n], r.values[i:n]))
+	}
+	return frames
+}
+
+func memPointsFrame(typ DataType, times []Time, values []interface{}) storage.ReadResponse_Frame {
+	ts := make([]int64, len(times))
+	for i, t := range times {
+		ts[i] = int64(t)
+	}
+	switch typ {
+	case TBool:
+		vs := make([]bool, len(values))
+		for i, v := range values {
+			vs[i] = v.(bool)
+		}
+		return storage.ReadResponse_Frame{Data: &storage.ReadResponse_Frame_BooleanPoints{
+			BooleanPoints: &storage.ReadResponse_BooleanPointsFrame{Timestamps: ts, Values: vs},
+		}}
+	case TInt:
+		vs := make([]int64, len(values))
+		for i, v := range values {
+			vs[i] = v.(int64)
+		}
+		return storage.ReadResponse_Frame{Data: &storage.ReadResponse_Frame_IntegerPoints{
+			IntegerPoints: &storage.ReadResponse_IntegerPointsFrame{Timestamps: ts, Values: vs},
+		}}
+	case TUInt:
+		vs := make([]uint64, len(values))
+		for i, v := range values {
+			vs[i] = v.(uint64)
+		}
+		return storage.ReadResponse_Frame{Data: &storage.ReadResponse_Frame_UnsignedPoints{
+			UnsignedPoints: &storage.ReadResponse_UnsignedPointsFrame{Timestamps: ts, Values: vs},
+		}}
+	case TFloat:
+		vs := make([]float64, len(values))
+		for i, v := range values {
+			vs[i] = v.(float64)
+		}
+		return storage.ReadResponse_Frame{Data: &storage.ReadResponse_Frame_FloatPoints{
+			FloatPoints: &storage.ReadResponse_FloatPointsFrame{Timestamps: ts, Values: vs},
+		}}
+	case TString:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			vs[i] = v.(string)
+		}
+		return storage.ReadResponse_Frame{Data: &storage.ReadResponse_Frame_StringPoints{
+			StringPoints: &storage.ReadResponse_StringPointsFrame{Timestamps: ts, Values: vs},
+		}}
+	default:
+		PanicUnknownType(typ)
+		return storage.ReadResponse_Frame{}
+	}
+}
+
+func toStorageDataType(t DataType) storage.ReadResponse_DataType {
+	switch t {
+	case TFloat:
+		return storage.DataTypeFloat
+	case TInt:
+		return storage.DataTypeInteger
+	case TUInt:
+		return storage.DataTypeUnsigned
+	case TBool:
+		return storage.DataTypeBoolean
+	case TString:
+		return storage.DataTypeString
+	default:
+		PanicUnknownType(t)
+		return 0
+	}
+}
+
+// memReadStream streams precomputed frames as read responses.
+type memReadStream struct {
+	frames []storage.ReadResponse_Frame
+}
+
+func (s *memReadStream) RecvMsg(m interface{}) error {
+	if len(s.frames) == 0 {
+		return io.EOF
+	}
+	n := len(s.frames)
+	if n > memResponseSize {
+		n = memResponseSize
+	}
+	rep := m.(*storage.ReadResponse)
+	rep.Frames = s.frames[:n:n]
+	s.frames = s.frames[n:]
+	return nil
+}
+
+// memPredicate evaluates a storage predicate against the points of a series.
+type memPredicate struct {
+	root    *storage.Node
+	regexps map[string]*regexp.Regexp
+}
+
+func newMemPredicate(p *storage.Predicate) *memPredicate {
+	if p == nil {
+		return nil
+	}
+	return &memPredicate{
+		root:    p.Root,
+		regexps: make(map[string]*regexp.Regexp),
+	}
+}
+
+func (p *memPredicate) eval(tags []storage.Tag, v interface{}) (bool, error) {
+	return p.evalBool(p.root, tags, v)
+}
+
+func (p *memPredicate) evalBool(n *storage.Node, tags []storage.Tag, v interface{}) (bool, error) {
+	switch n.NodeType {
+	case storage.NodeTypeParenExpression:
+		if len(n.Children) != 1 {
+			return false, errors.New("paren expression must have exactly one child")
+		}
+		return p.evalBool(n.Children[0], tags, v)
+	case storage.NodeTypeLogicalExpression:
+		if len(n.Children) != 2 {
+			return false, errors.New("logical expression must have exactly two children")
+		}
+		left, err := p.evalBool(n.Children[0], tags, v)
+		if err != nil {
+			return false, err
+		}
+		switch n.GetLogical() {
+		case storage.LogicalAnd:
+			if !left {
+				return false, nil
+			}
+		case storage.LogicalOr:
+			if left {
+				return true, nil
+			}
+		default:
+			return false, fmt.Errorf("unknown logical operator %v", n.GetLogical())
+		}
+		return p.evalBool(n.Children[1], tags, v)
+	case storage.NodeTypeComparisonExpression:
+		if len(n.Children) != 2 {
+			return false, errors.New("comparison expression must have exactly two children")
+		}
+		left, err := p.evalValue(n.Children[0], tags, v)
+		if err != nil {
+			return false, err
+		}
+		right, err := p.evalValue(n.Children[1], tags, v)
+		if err != nil {
+			return false, err
+		}
+		return memCompare(n.GetComparison(), left, right)
+	default:
+		return false, fmt.Errorf("unexpected predicate node type %v", n.NodeType)
+	}
+}
+
+func (p *memPredicate) evalValue(n *storage.Node, tags []storage.Tag, v interface{}) (interface{}, error) {
+	switch value := n.Value.(type) {
+	case *storage.Node_TagRefValue:
+		for _, t := range tags {
+			if string(t.Key) == value.TagRefValue {
+				return string(t.Value), nil
+			}
+		}
+		// Missing tags compare as the empty string.
+		return "", nil
+	case *storage.Node_FieldRefValue:
+		return v, nil
+	case *storage.Node_StringValue:
+		return value.StringValue, nil
+	case *storage.Node_BooleanValue:
+		return value.BooleanValue, nil
+	case *storage.Node_IntegerValue:
+		return value.IntegerValue, nil
+	case *storage.Node_UnsignedValue:
+		return value.UnsignedValue, nil
+	case *storage.Node_FloatValue:
+		return value.FloatValue, nil
+	case *storage.Node_RegexValue:
+		re, ok := p.regexps[value.RegexValue]
+		if !ok {
+			var err error
+			re, err = regexp.Compile(value.RegexValue)
+			if err != nil {
+				return nil, err
+			}
+			p.regexps[value.RegexValue] = re
+		}
+		return re, nil
+	default:
+		return nil, fmt.Errorf("unexpected predicate value %T", n.Value)
+	}
+}
+
+func memCompare(op storage.Node_Comparison, left, right interface{}) (bool, error) {
+	switch op {
+	case storage.ComparisonRegex, storage.ComparisonNotRegex:
+		s, ok := left.(string)
+		if !ok {
+			return false, nil
+		}
+		re, ok := right.(*regexp.Regexp)
+		if !ok {
+			return false, fmt.Errorf("regex comparison requires a regex, got %T", right)
+		}
+		return re.MatchString(s) == (op == storage.ComparisonRegex), nil
+	case storage.ComparisonStartsWith:
+		l, lok := left.(string)
+		r, rok := right.(string)
+		return lok && rok && strings.HasPrefix(l, r), nil
+	}
+
+	c, ok := memCompareValues(left, right)
+	switch op {
+	case storage.ComparisonEqual:
+		return ok && c == 0, nil
+	case storage.ComparisonNotEqual:
+		return !ok || c != 0, nil
+	case storage.ComparisonLess:
+		return ok && c < 0, nil
+	case storage.ComparisonLessEqual:
+		return ok && c <= 0, nil
+	case storage.ComparisonGreater:
+		return ok && c > 0, nil
+	case storage.ComparisonGreaterEqual:
+		return ok && c >= 0, nil
+	default:
+		return false, fmt.Errorf("unknown comparison operator %v", op)
+	}
+}
+
+// memCompareValues compares two values, numeric values of different types are compared as floats.
+// The returned bool is false if the values are not comparable.
+func memCompareValues(left, right interface{}) (int, bool) {
+	switch l := left.(type) {
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(l, r), true
+	case bool:
+		r, ok := right.(bool)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case l == r:
+			return 0, true
+		case !l:
+			return -1, true
+		default:
+			return 1, true
+		}
+	}
+	l, lok := memFloat(left)
+	r, rok := memFloat(right)
+	if !lok || !rok {
+		return 0, false
+	}
+	switch {
+	case l < r:
+		return -1, true
+	case l > r:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+func memFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
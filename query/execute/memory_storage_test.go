@@ -0,0 +1,426 @@
+package execute_test
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/query/control"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/semantic"
+)
+
+const memoryStorageData = `
+cpu,host=a,region=west usage=1 1
+cpu,host=a,region=west usage=2 2
+cpu,host=b,region=east usage=10 1
+cpu,host=b,region=east usage=20 3
+mem,host=a free=5i 2
+`
+
+func memoryStoragePredicate(body semantic.Expression) *semantic.FunctionExpression {
+	return &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+		Body:   body,
+	}
+}
+
+func memoryStorageRef(property string) *semantic.MemberExpression {
+	return &semantic.MemberExpression{
+		Object:   &semantic.IdentifierExpression{Name: "r"},
+		Property: property,
+	}
+}
+
+var (
+	memoryStorageBounds  = execute.Bounds{Start: 0, Stop: 10}
+	memoryStorageCPUTags = []execute.ColMeta{
+		{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		{Label: "region", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+	}
+	memoryStorageFloatCols = append([]execute.ColMeta{
+		execute.TimeCol,
+		{Label: execute.DefaultValueColLabel, Type: execute.TFloat, Kind: execute.ValueColKind},
+	}, memoryStorageCPUTags...)
+)
+
+func TestMemoryStorageReader_Read(t *testing.T) {
+	testCases := []struct {
+		name     string
+		readSpec execute.ReadSpec
+		want     []*executetest.Block
+	}{
+		{
+			name: "all series",
+			readSpec: execute.ReadSpec{
+				Database: "db",
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: memoryStorageBounds,
+					ColMeta: []execute.ColMeta{
+						execute.TimeCol,
+						{Label: execute.DefaultValueColLabel, Type: execute.TInt, Kind: execute.ValueColKind},
+						{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), int64(5), "free", "mem", "a"},
+					},
+				},
+				{
+					Bnds:    memoryStorageBounds,
+					ColMeta: memoryStorageFloatCols,
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "usage", "cpu", "a", "west"},
+						{execute.Time(2), 2.0, "usage", "cpu", "a", "west"},
+					},
+				},
+				{
+					Bnds:    memoryStorageBounds,
+					ColMeta: memoryStorageFloatCols,
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "usage", "cpu", "b", "east"},
+						{execute.Time(3), 20.0, "usage", "cpu", "b", "east"},
+					},
+				},
+			},
+		},
+		{
+			name: "predicate group keys",
+			readSpec: execute.ReadSpec{
+				Database: "db",
+				Predicate: memoryStoragePredicate(&semantic.LogicalExpression{
+					Operator: ast.AndOperator,
+					Left: &semantic.BinaryExpression{
+						Operator: ast.EqualOperator,
+						Left:     memoryStorageRef("_measurement"),
+						Right:    &semantic.StringLiteral{Value: "cpu"},
+					},
+					Right: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanOperator,
+						Left:     memoryStorageRef("_value"),
+						Right:    &semantic.FloatLiteral{Value: 1.5},
+					},
+				}),
+				GroupKeys: []string{"host"},
+			},
+			want: []*executetest.Block{
+				{
+					Bnds: memoryStorageBounds,
+					ColMeta: []execute.ColMeta{
+						execute.TimeCol,
+						{Label: execute.DefaultValueColLabel, Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+				{
+					Bnds: memoryStorageBounds,
+					ColMeta: []execute.ColMeta{
+						execute.TimeCol,
+						{Label: execute.DefaultValueColLabel, Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "b"},
+						{execute.Time(3), 20.0, "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "merge all order by time",
+			readSpec: execute.ReadSpec{
+				Database: "db",
+				Predicate: memoryStoragePredicate(&semantic.BinaryExpression{
+					Operator: ast.EqualOperator,
+					Left:     memoryStorageRef("_field"),
+					Right:    &semantic.StringLiteral{Value: "usage"},
+				}),
+				MergeAll:    true,
+				GroupKeep:   []string{"host"},
+				OrderByTime: true,
+			},
+			want: []*executetest.Block{{
+				Bnds: memoryStorageBounds,
+				ColMeta: []execute.ColMeta{
+					execute.TimeCol,
+					{Label: execute.DefaultValueColLabel, Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: false},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "a"},
+					{execute.Time(1), 10.0, "b"},
+					{execute.Time(2), 2.0, "a"},
+					{execute.Time(3), 20.0, "b"},
+				},
+			}},
+		},
+		{
+			name: "descending points limit",
+			readSpec: execute.ReadSpec{
+				Database: "db",
+				Predicate: memoryStoragePredicate(&semantic.BinaryExpression{
+					Operator: ast.RegexpMatchOperator,
+					Left:     memoryStorageRef("region"),
+					Right:    &semantic.RegexpLiteral{Value: regexp.MustCompile(`^(east|west)$`)},
+				}),
+				Descending:  true,
+				PointsLimit: 1,
+			},
+			want: []*executetest.Block{
+				{
+					Bnds:    memoryStorageBounds,
+					ColMeta: memoryStorageFloatCols,
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, "usage", "cpu", "a", "west"},
+					},
+				},
+				{
+					Bnds:    memoryStorageBounds,
+					ColMeta: memoryStorageFloatCols,
+					Data: [][]interface{}{
+						{execute.Time(3), 20.0, "usage", "cpu", "b", "east"},
+					},
+				},
+			},
+		},
+		{
+			name: "series limit offset",
+			readSpec: execute.ReadSpec{
+				Database:     "db",
+				SeriesLimit:  1,
+				SeriesOffset: 2,
+			},
+			want: []*executetest.Block{{
+				Bnds:    memoryStorageBounds,
+				ColMeta: memoryStorageFloatCols,
+				Data: [][]interface{}{
+					{execute.Time(1), 10.0, "usage", "cpu", "b", "east"},
+					{execute.Time(3), 20.0, "usage", "cpu", "b", "east"},
+				},
+			}},
+		},
+		{
+			name: "aggregate",
+			readSpec: execute.ReadSpec{
+				Database: "db",
+				Predicate: memoryStoragePredicate(&semantic.BinaryExpression{
+					Operator: ast.EqualOperator,
+					Left:     memoryStorageRef("_measurement"),
+					Right:    &semantic.StringLiteral{Value: "cpu"},
+				}),
+				GroupExcept:     []string{"host", "region"},
+				AggregateMethod: "mean",
+			},
+			want: []*executetest.Block{{
+				Bnds: memoryStorageBounds,
+				ColMeta: []execute.ColMeta{
+					execute.TimeCol,
+					{Label: execute.DefaultValueColLabel, Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "_field", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+					{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), 1.5, "usage", "cpu"},
+					{execute.Time(10), 15.0, "usage", "cpu"},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s := execute.NewMemoryStorageReader()
+			if err := s.WriteLineProtocol("db", memoryStorageData); err != nil {
+				t.Fatal(err)
+			}
+			bi, err := s.Read(context.Background(), nil, tc.readSpec, memoryStorageBounds.Start, memoryStorageBounds.Stop)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []*executetest.Block
+			if err := bi.Do(func(b execute.Block) error {
+				got = append(got, executetest.ConvertBlock(b))
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestMemoryStorageReader_Errors(t *testing.T) {
+	s := execute.NewMemoryStorageReader()
+	if err := s.WriteLineProtocol("db", "cpu v=1 1\ncpu v=\"a\" 2"); err == nil {
+		t.Error("expected field type conflict error")
+	}
+	if err := s.WriteLineProtocol("db", "cpu s=\"a\" 1"); err != nil {
+		t.Fatal(err)
+	}
+	bi, err := s.Read(context.Background(), nil, execute.ReadSpec{Database: "db", AggregateMethod: "sum"}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bi.Do(func(execute.Block) error { return nil }); err == nil {
+		t.Error("expected error summing string values")
+	}
+}
+
+func TestMemoryStorageReader_Controller(t *testing.T) {
+	s := execute.NewMemoryStorageReader()
+	if err := s.WriteLineProtocol("db", memoryStorageData); err != nil {
+		t.Fatal(err)
+	}
+	got := memoryStorageQuery(t, s, `
+from(db:"db")
+	|> range(start:1970-01-01T00:00:00Z, stop:1970-01-01T00:00:01Z)
+	|> filter(fn: (r) => r._measurement == "cpu")
+	|> group(by:["host"])
+	|> max()`)
+
+	bounds := execute.Bounds{Start: 0, Stop: 1e9}
+	cols := []execute.ColMeta{
+		execute.TimeCol,
+		{Label: execute.DefaultValueColLabel, Type: execute.TFloat, Kind: execute.ValueColKind},
+		{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+	}
+	want := []*executetest.Block{
+		{
+			Bnds:    bounds,
+			ColMeta: cols,
+			Data:    [][]interface{}{{execute.Time(1e9), 2.0, "a"}},
+		},
+		{
+			Bnds:    bounds,
+			ColMeta: cols,
+			Data:    [][]interface{}{{execute.Time(1e9), 20.0, "b"}},
+		},
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+// The aggregate of windows read from storage has the same blocks as the aggregate transformation of the windows.
+func TestMemoryStorageReader_WindowAggregate(t *testing.T) {
+	s := execute.NewMemoryStorageReader()
+	if err := s.WriteLineProtocol("db", memoryStorageData); err != nil {
+		t.Fatal(err)
+	}
+	const windows = `
+from(db:"db")
+	|> range(start:1970-01-01T00:00:00Z, stop:1970-01-01T00:00:00.000000004Z)
+	|> filter(fn: (r) => r._measurement == "cpu")
+	|> window(every:2ns)`
+	// The max is pushed down to storage.
+	pushedDown := memoryStorageQuery(t, s, windows+` |> max()`)
+	// The sort cannot be pushed down, so the max is computed by its transformation.
+	transformed := memoryStorageQuery(t, s, windows+` |> sort(cols:["_time"]) |> max()`)
+
+	bounds := execute.Bounds{Start: 0, Stop: 4}
+	want := []*executetest.Block{
+		{
+			Bnds:    bounds,
+			ColMeta: memoryStorageFloatCols,
+			Data: [][]interface{}{
+				{execute.Time(2), 1.0, "usage", "cpu", "a", "west"},
+				{execute.Time(4), 2.0, "usage", "cpu", "a", "west"},
+			},
+		},
+		{
+			Bnds:    bounds,
+			ColMeta: memoryStorageFloatCols,
+			Data: [][]interface{}{
+				{execute.Time(2), 10.0, "usage", "cpu", "b", "east"},
+				{execute.Time(4), 20.0, "usage", "cpu", "b", "east"},
+			},
+		},
+	}
+	if !cmp.Equal(want, pushedDown) {
+		t.Errorf("unexpected pushed down blocks -want/+got\n%s", cmp.Diff(want, pushedDown))
+	}
+	if !cmp.Equal(want, transformed) {
+		t.Errorf("unexpected transformed blocks -want/+got\n%s", cmp.Diff(want, transformed))
+	}
+}
+
+// memoryStorageQuery runs the query against the storage and returns its blocks in order.
+func memoryStorageQuery(t *testing.T, s execute.StorageReader, query string) []*executetest.Block {
+	t.Helper()
+	c := control.New(control.Config{
+		ConcurrencyQuota: 1,
+		MemoryBytesQuota: 1 << 20,
+		ExecutorConfig: execute.Config{
+			StorageReader: s,
+		},
+	})
+	q, err := c.QueryWithCompile(context.Background(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	results, ok := <-q.Ready
+	if !ok {
+		t.Fatal(q.Err())
+	}
+	var got []*executetest.Block
+	for _, r := range results {
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			got = append(got, executetest.ConvertBlock(b))
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sort.Sort(executetest.SortedBlocks(got))
+	return got
+}
+
+// The fields of a series are read together for a pivot, even when they have different types.
+func TestMemoryStorageReader_FieldPivot(t *testing.T) {
+	s := execute.NewMemoryStorageReader()
+	if err := s.WriteLineProtocol("db", `
+cpu,host=a usage=1.5,count=2i,busy=true 1
+cpu,host=a usage=2.5,count=3i,busy=false 2
+`); err != nil {
+		t.Fatal(err)
+	}
+	got := memoryStorageQuery(t, s, `
+from(db:"db")
+	|> range(start:1970-01-01T00:00:00Z, stop:1970-01-01T00:00:00.000000004Z)
+	|> pivot(rowKey:["_time"], colKey:["_field"], valueCol:"_value")`)
+
+	want := []*executetest.Block{{
+		Bnds: execute.Bounds{Start: 0, Stop: 4},
+		ColMeta: []execute.ColMeta{
+			execute.TimeCol,
+			{Label: "busy", Type: execute.TBool, Kind: execute.ValueColKind},
+			{Label: "count", Type: execute.TInt, Kind: execute.ValueColKind},
+			{Label: "usage", Type: execute.TFloat, Kind: execute.ValueColKind},
+			{Label: "_measurement", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+			{Label: "host", Type: execute.TString, Kind: execute.TagColKind, Common: true},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), true, int64(2), 1.5, "cpu", "a"},
+			{execute.Time(2), false, int64(3), 2.5, "cpu", "a"},
+		},
+	}}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,164 @@
+package execute
+
+// nullBitmap records which rows of a column are null.
+// The zero value is a bitmap without any null rows.
+type nullBitmap struct {
+	bits []uint64
+}
+
+func (n *nullBitmap) IsNull(i int) bool {
+	w := i / 64
+	if w >= len(n.bits) {
+		return false
+	}
+	return n.bits[w]&(1<<uint(i%64)) != 0
+}
+
+func (n *nullBitmap) SetNull(i int) {
+	w := i / 64
+	for w >= len(n.bits) {
+		n.bits = append(n.bits, 0)
+	}
+	n.bits[w] |= 1 << uint(i%64)
+}
+
+func (n *nullBitmap) unsetNull(i int) {
+	w := i / 64
+	if w < len(n.bits) {
+		n.bits[w] &^= 1 << uint(i%64)
+	}
+}
+
+func (n *nullBitmap) swapNulls(i, j int) {
+	ni, nj := n.IsNull(i), n.IsNull(j)
+	if ni == nj {
+		return
+	}
+	if ni {
+		n.unsetNull(i)
+		n.SetNull(j)
+	} else {
+		n.SetNull(i)
+		n.unsetNull(j)
+	}
+}
+
+func (n *nullBitmap) clearNulls() {
+	n.bits = n.bits[0:0]
+}
+
+func (n *nullBitmap) copyNulls() nullBitmap {
+	if len(n.bits) == 0 {
+		return nullBitmap{}
+	}
+	cpy := make([]uint64, len(n.bits))
+	copy(cpy, n.bits)
+	return nullBitmap{bits: cpy}
+}
+
+// equalNulls reports whether the rows i and j compare equal based on their null status alone.
+// The ok return value is false if neither row is null and the values must be compared.
+func (n *nullBitmap) equalNulls(i, j int) (equal, ok bool) {
+	ni, nj := n.IsNull(i), n.IsNull(j)
+	if !ni && !nj {
+		return false, false
+	}
+	return ni == nj, true
+}
+
+// lessNulls reports whether row i is less than row j based on their null status alone.
+// Null values sort before all other values.
+// The ok return value is false if neither row is null and the values must be compared.
+func (n *nullBitmap) lessNulls(i, j int) (less, ok bool) {
+	ni, nj := n.IsNull(i), n.IsNull(j)
+	if !ni && !nj {
+		return false, false
+	}
+	return ni && !nj, true
+}
+
+// NonNullRows returns the indexes of the n rows of rr for which column j is not null.
+// If no row is null, nil is returned so callers may use the rows directly.
+func NonNullRows(n int, rr RowReader, j int) []int {
+	var rows []int
+	for i := 0; i < n; i++ {
+		if rr.IsNull(i, j) {
+			if rows == nil {
+				rows = make([]int, i, n)
+				for k := range rows {
+					rows[k] = k
+				}
+			}
+			continue
+		}
+		if rows != nil {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
+
+// nonNullRowReader presents a subset of the rows of a RowReader,
+// so that row k of the nonNullRowReader is row rows[k] of the underlying RowReader.
+type nonNullRowReader struct {
+	RowReader
+	rows []int
+}
+
+func (r nonNullRowReader) IsNull(i, j int) bool {
+	return r.RowReader.IsNull(r.rows[i], j)
+}
+func (r nonNullRowReader) AtBool(i, j int) bool {
+	return r.RowReader.AtBool(r.rows[i], j)
+}
+func (r nonNullRowReader) AtInt(i, j int) int64 {
+	return r.RowReader.AtInt(r.rows[i], j)
+}
+func (r nonNullRowReader) AtUInt(i, j int) uint64 {
+	return r.RowReader.AtUInt(r.rows[i], j)
+}
+func (r nonNullRowReader) AtFloat(i, j int) float64 {
+	return r.RowReader.AtFloat(r.rows[i], j)
+}
+func (r nonNullRowReader) AtString(i, j int) string {
+	return r.RowReader.AtString(r.rows[i], j)
+}
+func (r nonNullRowReader) AtTime(i, j int) Time {
+	return r.RowReader.AtTime(r.rows[i], j)
+}
+
+func nonNullBools(vs []bool, rows []int) []bool {
+	nvs := make([]bool, len(rows))
+	for k, i := range rows {
+		nvs[k] = vs[i]
+	}
+	return nvs
+}
+func nonNullInts(vs []int64, rows []int) []int64 {
+	nvs := make([]int64, len(rows))
+	for k, i := range rows {
+		nvs[k] = vs[i]
+	}
+	return nvs
+}
+func nonNullUInts(vs []uint64, rows []int) []uint64 {
+	nvs := make([]uint64, len(rows))
+	for k, i := range rows {
+		nvs[k] = vs[i]
+	}
+	return nvs
+}
+func nonNullFloats(vs []float64, rows []int) []float64 {
+	nvs := make([]float64, len(rows))
+	for k, i := range rows {
+		nvs[k] = vs[i]
+	}
+	return nvs
+}
+func nonNullStrings(vs []string, rows []int) []string {
+	nvs := make([]string, len(rows))
+	for k, i := range rows {
+		nvs[k] = vs[i]
+	}
+	return nvs
+}
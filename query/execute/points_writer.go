@@ -0,0 +1,114 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/pkg/errors"
+)
+
+// PointsWriter writes points to a database.
+type PointsWriter interface {
+	// WritePoints writes the points, giving up once the context is done.
+	WritePoints(ctx context.Context, db string, points []models.Point) error
+}
+
+// httpWriteTimeout is the time a write to the HTTP API may take.
+const httpWriteTimeout = 30 * time.Second
+
+// NewHTTPPointsWriter creates a PointsWriter that writes line protocol to the /write endpoint of the InfluxDB HTTP API at addr.
+func NewHTTPPointsWriter(addr string) (PointsWriter, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid write address")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid write address %q, scheme must be http or https", addr)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/write"
+	return &httpPointsWriter{
+		url:    u.String(),
+		client: &http.Client{Timeout: httpWriteTimeout},
+	}, nil
+}
+
+type httpPointsWriter struct {
+	url    string
+	client *http.Client
+}
+
+func (w *httpPointsWriter) WritePoints(ctx context.Context, db string, points []models.Point) error {
+	if len(points) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, p := range points {
+		buf.WriteString(p.String())
+		buf.WriteByte('\n')
+	}
+	params := url.Values{}
+	params.Set("db", db)
+	params.Set("precision", "ns")
+	req, err := http.NewRequest("POST", w.url+"?"+params.Encode(), &buf)
+	if err != nil {
+		return errors.Wrap(err, "failed to write points")
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	resp, err := w.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to write points")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("failed to write points: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// NewFilePointsWriter creates a PointsWriter that appends line protocol to the file at path.
+// Each write is preceded by "# DML" and "# CONTEXT-DATABASE:" comments naming its database,
+// so the file can be loaded with `influx -import`.
+func NewFilePointsWriter(path string) PointsWriter {
+	return &filePointsWriter{
+		path: path,
+	}
+}
+
+type filePointsWriter struct {
+	mu   sync.Mutex
+	path string
+}
+
+func (w *filePointsWriter) WritePoints(ctx context.Context, db string, points []models.Point) error {
+	if len(points) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# DML\n# CONTEXT-DATABASE: %s\n", db)
+	for _, p := range points {
+		buf.WriteString(p.String())
+		buf.WriteByte('\n')
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	f, err := os.OpenFile(w.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return errors.Wrap(err, "failed to open points file")
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return errors.Wrap(err, "failed to write points")
+	}
+	return f.Close()
+}
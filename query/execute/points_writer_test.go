@@ -0,0 +1,122 @@
+package execute_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/influxdb/models"
+)
+
+func TestHTTPPointsWriter(t *testing.T) {
+	var gotPath, gotDB, gotPrecision, gotBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotDB = r.URL.Query().Get("db")
+		gotPrecision = r.URL.Query().Get("precision")
+		body, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(body)
+		if gotDB == "missing" {
+			http.Error(w, `{"error":"database not found: \"missing\""}`, http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	w, err := execute.NewHTTPPointsWriter(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, err := models.ParsePointsString("cpu,host=a usage=1 1\ncpu,host=b usage=2 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WritePoints(context.Background(), "mydb", points); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/write" {
+		t.Errorf("unexpected path: got %q", gotPath)
+	}
+	if gotDB != "mydb" || gotPrecision != "ns" {
+		t.Errorf("unexpected params: db=%q precision=%q", gotDB, gotPrecision)
+	}
+	if want := "cpu,host=a usage=1 1\ncpu,host=b usage=2 2\n"; gotBody != want {
+		t.Errorf("unexpected body: -want/+got\n%q\n%q", want, gotBody)
+	}
+
+	if err := w.WritePoints(context.Background(), "missing", points); err == nil {
+		t.Error("expected error writing to a missing database")
+	}
+
+	if _, err := execute.NewHTTPPointsWriter("localhost:8086"); err == nil {
+		t.Error("expected error for address without scheme")
+	}
+}
+
+func TestHTTPPointsWriter_Cancel(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The write endpoint hangs until the test is done.
+		<-release
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	w, err := execute.NewHTTPPointsWriter(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, err := models.ParsePointsString("cpu,host=a usage=1 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error, 1)
+	go func() {
+		errC <- w.WritePoints(ctx, "mydb", points)
+	}()
+	cancel()
+	select {
+	case err := <-errC:
+		if err == nil {
+			t.Error("expected error writing with a cancelled context")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("write did not stop when its context was cancelled")
+	}
+}
+
+func TestFilePointsWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "points")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "points.lp")
+
+	w := execute.NewFilePointsWriter(path)
+	for _, lp := range []string{"cpu usage=1 1", "mem free=2i 2"} {
+		points, err := models.ParsePointsString(lp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.WritePoints(context.Background(), "mydb", points); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "# DML\n# CONTEXT-DATABASE: mydb\ncpu usage=1 1\n# DML\n# CONTEXT-DATABASE: mydb\nmem free=2i 2\n"
+	if string(got) != want {
+		t.Errorf("unexpected file contents: -want/+got\n%q\n%q", want, string(got))
+	}
+}
@@ -2,37 +2,54 @@ package execute
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/influxdata/ifql/query/plan"
 )
 
+// Result is the set of blocks produced by a yield of a query.
+// Blocks passed to the BlockIterator are only valid until the iterating function returns.
 type Result interface {
 	Blocks() BlockIterator
 	abort(error)
 }
 
+// defaultResultCapacity is the number of blocks a result buffers before it pauses the
+// transformation that produces them, until the blocks have been consumed.
+const defaultResultCapacity = 64
+
 // resultSink implements both the Transformation and Result interfaces,
 // mapping the pushed based Transformation API to the pull based Result interface.
+//
+// Blocks are buffered until they are consumed, at which point their memory is released to the Allocator.
+// Once the buffer reaches its capacity Process blocks until the consumer catches up.
 type resultSink struct {
-	mu     sync.Mutex
-	blocks chan resultMessage
+	d        *poolDispatcher
+	capacity int
 
-	abortErr chan error
-	aborted  chan struct{}
-}
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	blocks []Block
+	// full is 1 while the buffer holds at least its capacity of blocks, it is accessed atomically.
+	full int32
 
-type resultMessage struct {
-	block Block
-	err   error
+	finished  bool
+	finishErr error
+
+	// discarding is set once the blocks are no longer going to be consumed,
+	// either because the result was aborted or the consumer returned early.
+	discarding bool
+	abortErr   error
 }
 
-func newResultSink(plan.YieldSpec) *resultSink {
-	return &resultSink{
-		// TODO(nathanielc): Currently this buffer needs to be big enough hold all result blocks :(
-		blocks:   make(chan resultMessage, 1000),
-		abortErr: make(chan error, 1),
-		aborted:  make(chan struct{}),
+func newResultSink(_ plan.YieldSpec, d *poolDispatcher) *resultSink {
+	s := &resultSink{
+		d:        d,
+		capacity: defaultResultCapacity,
 	}
+	s.cond = sync.NewCond(&s.mu)
+	return s
 }
 
 func (s *resultSink) RetractBlock(DatasetID, BlockMetadata) error {
@@ -41,38 +58,108 @@ func (s *resultSink) RetractBlock(DatasetID, BlockMetadata) error {
 }
 
 func (s *resultSink) Process(id DatasetID, b Block) error {
-	select {
-	case s.blocks <- resultMessage{
-		block: b,
-	}:
-	case <-s.aborted:
+	s.mu.Lock()
+	if s.discarding {
+		s.mu.Unlock()
+		discardBlock(b)
+		return nil
 	}
+	s.blocks = append(s.blocks, b)
+	s.updateFull()
+	s.cond.Broadcast()
+	s.mu.Unlock()
+
+	// Wait for the consumer to make room before more blocks are produced.
+	s.d.waitForCapacity(s.isFull)
 	return nil
 }
 
+// isFull reports whether the buffer holds at least its capacity of blocks, s.mu need not be held.
+func (s *resultSink) isFull() bool {
+	return atomic.LoadInt32(&s.full) == 1
+}
+
+// updateFull records whether the buffer is full and wakes the producer once it has room again.
+// The caller must hold s.mu.
+func (s *resultSink) updateFull() {
+	if !s.discarding && len(s.blocks) >= s.capacity {
+		atomic.StoreInt32(&s.full, 1)
+		return
+	}
+	if atomic.SwapInt32(&s.full, 0) == 1 {
+		s.d.capacityAvailable()
+	}
+}
+
 func (s *resultSink) Blocks() BlockIterator {
 	return s
 }
 
 func (s *resultSink) Do(f func(Block) error) error {
 	for {
-		select {
-		case err := <-s.abortErr:
+		b, err, ok := s.next()
+		if !ok {
+			return err
+		}
+		err = f(b)
+		b.RefCount(-1)
+		if err != nil {
+			s.discard(nil)
 			return err
-		case msg, more := <-s.blocks:
-			if !more {
-				return nil
-			}
-			if msg.err != nil {
-				return msg.err
-			}
-			if err := f(msg.block); err != nil {
-				return err
-			}
 		}
 	}
 }
 
+// next removes the next block from the buffer, waiting for one to arrive.
+// If there are no more blocks, ok is false and err reports why the result ended.
+func (s *resultSink) next() (b Block, err error, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.blocks) == 0 && !s.finished && !s.discarding {
+		s.d.startWaiting()
+		s.cond.Wait()
+		s.d.stopWaiting()
+	}
+	if s.discarding {
+		return nil, s.abortErr, false
+	}
+	if len(s.blocks) == 0 {
+		return nil, s.finishErr, false
+	}
+	b = s.blocks[0]
+	s.blocks[0] = nil
+	s.blocks = s.blocks[1:]
+	s.updateFull()
+	return b, nil, true
+}
+
+// discard releases all buffered blocks, and any that arrive later.
+func (s *resultSink) discard(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.discarding {
+		return
+	}
+	s.discarding = true
+	s.abortErr = err
+	for i, b := range s.blocks {
+		discardBlock(b)
+		s.blocks[i] = nil
+	}
+	s.blocks = nil
+	s.updateFull()
+	s.cond.Broadcast()
+}
+
+// discardBlock releases a block that will not be consumed.
+// A OneTimeBlock is drained first, since its source waits until it has been read.
+func discardBlock(b Block) {
+	if _, ok := b.(OneTimeBlock); ok {
+		b.Times().DoTime(func([]Time, RowReader) {})
+	}
+	b.RefCount(-1)
+}
+
 func (s *resultSink) UpdateWatermark(id DatasetID, mark Time) error {
 	//Nothing to do
 	return nil
@@ -87,32 +174,13 @@ func (s *resultSink) setTrigger(Trigger) {
 }
 
 func (s *resultSink) Finish(id DatasetID, err error) {
-	if err != nil {
-		select {
-		case s.blocks <- resultMessage{
-			err: err,
-		}:
-		case <-s.aborted:
-		}
-	}
-	close(s.blocks)
-}
-
-func (s *resultSink) abort(err error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.finished = true
+	s.finishErr = err
+	s.cond.Broadcast()
+}
 
-	// Check if we have already aborted
-	aborted := false
-	select {
-	case <-s.aborted:
-		aborted = true
-	default:
-	}
-	if aborted {
-		return // already aborted
-	}
-
-	s.abortErr <- err
-	close(s.aborted)
+func (s *resultSink) abort(err error) {
+	s.discard(err)
 }
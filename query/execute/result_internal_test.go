@@ -0,0 +1,153 @@
+package execute
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/query/plan"
+)
+
+// newTestResultBlock creates a block with a single row, allocated from a.
+func newTestResultBlock(a *Allocator, t Time) Block {
+	b := NewColListBlockBuilder(a)
+	b.SetBounds(Bounds{Start: 0, Stop: 10})
+	b.AddCol(TimeCol)
+	b.AddCol(ColMeta{Label: DefaultValueColLabel, Type: TFloat, Kind: ValueColKind})
+	b.AppendTime(0, t)
+	b.AppendFloat(1, 1)
+	blk, _ := b.Block()
+	b.ClearData()
+	// The result sink is the only transformation of the dataset.
+	blk.RefCount(1)
+	return blk
+}
+
+func TestResultSink_ReleasesMemory(t *testing.T) {
+	a := &Allocator{Limit: math.MaxInt64}
+	s := newResultSink(plan.YieldSpec{}, newPoolDispatcher(10))
+
+	for i := 0; i < 3; i++ {
+		if err := s.Process(DatasetID{}, newTestResultBlock(a, Time(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.Finish(DatasetID{}, nil)
+	if got := atomic.LoadInt64(&a.bytesAllocated); got == 0 {
+		t.Fatal("expected buffered blocks to be allocated")
+	}
+
+	var allocated []int64
+	if err := s.Do(func(b Block) error {
+		allocated = append(allocated, atomic.LoadInt64(&a.bytesAllocated))
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < len(allocated); i++ {
+		if allocated[i] >= allocated[i-1] {
+			t.Errorf("expected memory to be released as blocks are consumed, got %v", allocated)
+		}
+	}
+	if got := atomic.LoadInt64(&a.bytesAllocated); got != 0 {
+		t.Errorf("unexpected allocated bytes after consuming all blocks: %d", got)
+	}
+}
+
+func TestResultSink_Abort(t *testing.T) {
+	a := &Allocator{Limit: math.MaxInt64}
+	d := newPoolDispatcher(10)
+	s := newResultSink(plan.YieldSpec{}, d)
+	s.capacity = 2
+
+	if err := s.Process(DatasetID{}, newTestResultBlock(a, 0)); err != nil {
+		t.Fatal(err)
+	}
+	done := processAsync(s, newTestResultBlock(a, 1))
+	s.abort(AllocError{})
+	if !returns(done) {
+		t.Fatal("expected Process to return after abort")
+	}
+	// Blocks that arrive after the abort are released immediately.
+	if err := s.Process(DatasetID{}, newTestResultBlock(a, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt64(&a.bytesAllocated); got != 0 {
+		t.Errorf("unexpected allocated bytes after abort: %d", got)
+	}
+	if err := s.Do(func(Block) error { return nil }); err != (AllocError{}) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// processAsync processes the block on a new goroutine, the returned channel is closed once Process returns.
+func processAsync(s *resultSink, b Block) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		s.Process(DatasetID{}, b)
+		close(done)
+	}()
+	return done
+}
+
+// returns reports whether done is closed within a short time.
+func returns(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	case <-time.After(10 * time.Millisecond):
+		return false
+	}
+}
+
+func TestResultSink_Backpressure(t *testing.T) {
+	a := &Allocator{Limit: math.MaxInt64}
+	d := newPoolDispatcher(10)
+	defer d.Stop()
+	s := newResultSink(plan.YieldSpec{}, d)
+	s.capacity = 2
+
+	if !returns(processAsync(s, newTestResultBlock(a, 0))) {
+		t.Fatal("expected block to be processed below capacity")
+	}
+	done := processAsync(s, newTestResultBlock(a, 1))
+	if returns(done) {
+		t.Fatal("expected Process to wait at capacity")
+	}
+
+	// Consuming a block makes room in the buffer.
+	b, _, _ := s.next()
+	b.RefCount(-1)
+	if !returns(done) {
+		t.Fatal("expected Process to return once a block is consumed")
+	}
+	if got := len(s.blocks); got != 1 {
+		t.Errorf("unexpected number of buffered blocks: got %d want 1", got)
+	}
+}
+
+func TestResultSink_BackpressureOtherResult(t *testing.T) {
+	a := &Allocator{Limit: math.MaxInt64}
+	d := newPoolDispatcher(10)
+	s := newResultSink(plan.YieldSpec{}, d)
+	s.capacity = 1
+	other := newResultSink(plan.YieldSpec{}, d)
+
+	done := processAsync(s, newTestResultBlock(a, 0))
+	if returns(done) {
+		t.Fatal("expected Process to wait at capacity")
+	}
+
+	// A consumer waiting for the blocks of another result cannot deadlock with the full result.
+	go other.next()
+	if !returns(done) {
+		t.Fatal("expected Process to return while a consumer waits for another result")
+	}
+	other.Finish(DatasetID{}, nil)
+
+	d.Stop()
+	if !returns(processAsync(s, newTestResultBlock(a, 1))) {
+		t.Fatal("expected Process to return once the dispatcher is stopped")
+	}
+}
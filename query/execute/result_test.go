@@ -0,0 +1,104 @@
+package execute_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/control"
+	"github.com/influxdata/ifql/query/execute"
+)
+
+// newHighCardinalityController creates a controller over a database with n series of cpu usage.
+func newHighCardinalityController(t *testing.T, n int, memoryQuota int64) *control.Controller {
+	t.Helper()
+	var buf bytes.Buffer
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&buf, "cpu,host=host%05d usage=%d 0\n", i, i)
+		fmt.Fprintf(&buf, "cpu,host=host%05d usage=%d 1\n", i, i)
+	}
+	s := execute.NewMemoryStorageReader()
+	if err := s.WriteLineProtocol("db", buf.String()); err != nil {
+		t.Fatal(err)
+	}
+	return control.New(control.Config{
+		ConcurrencyQuota: 1,
+		MemoryBytesQuota: memoryQuota,
+		ExecutorConfig: execute.Config{
+			StorageReader: s,
+		},
+	})
+}
+
+// countBlocks reads the named results of the query in order, reporting the number of blocks in each.
+func countBlocks(q *control.Query, names []string) (map[string]int, error) {
+	results, ok := <-q.Ready
+	if !ok {
+		return nil, q.Err()
+	}
+	counts := make(map[string]int, len(names))
+	for _, name := range names {
+		r, ok := results[name]
+		if !ok {
+			return nil, fmt.Errorf("missing result %q", name)
+		}
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			b.Times().DoTime(func([]execute.Time, execute.RowReader) {})
+			counts[name]++
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return counts, nil
+}
+
+func TestResult_MoreBlocksThanCapacity(t *testing.T) {
+	const n = 2000
+	c := newHighCardinalityController(t, n, math.MaxInt64)
+	// The results are read one after the other, so one result must hold all of its blocks
+	// while the other is being read. Both orders are read since either result may be processed first.
+	for _, names := range [][]string{{"double", "inc"}, {"inc", "double"}} {
+		spec, err := query.Compile(context.Background(), `
+data = from(db:"db") |> range(start:1970-01-01T00:00:00Z, stop:1970-01-01T00:00:01Z)
+data |> map(fn: (r) => r._value * 2.0) |> yield(name:"double")
+data |> map(fn: (r) => r._value + 1.0) |> yield(name:"inc")`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// With a single worker, a worker blocked on the unread result stops all progress.
+		spec.Resources = query.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		}
+		q, err := c.Query(context.Background(), spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		done := make(chan struct{})
+		var counts map[string]int
+		go func() {
+			defer close(done)
+			counts, err = countBlocks(q, names)
+		}()
+		select {
+		case <-done:
+		case <-time.After(30 * time.Second):
+			t.Fatalf("timed out reading results in order %v", names)
+		}
+		q.Done()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range names {
+			if counts[name] != n {
+				t.Errorf("unexpected number of %s blocks: got %d want %d", name, counts[name], n)
+			}
+		}
+	}
+}
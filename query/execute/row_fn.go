@@ -8,6 +8,46 @@ import (
 	"github.com/pkg/errors"
 )
 
+// RowType is the type of the records passed to row functions.
+// The type of the value column is only known once a block is read, so it is left to inference.
+var RowType = semantic.NewRowType(map[string]semantic.Type{
+	TimeColLabel:         semantic.Time,
+	DefaultValueColLabel: semantic.Invalid,
+	"_measurement":       semantic.String,
+	"_field":             semantic.String,
+	// The metric name tag of Prometheus data, see the promql package.
+	"_metric": semantic.String,
+})
+
+// RowPredicateType is the type of functions that evaluate a row to a boolean, see RowPredicateFn.
+var RowPredicateType = semantic.NewFunctionType(semantic.FunctionSignature{
+	Params: map[string]semantic.Type{
+		"r": RowType,
+	},
+	ReturnType: semantic.Bool,
+})
+
+// RowMapType is the type of functions that map a row to a new record or value, see RowMapFn.
+var RowMapType = semantic.NewFunctionType(semantic.FunctionSignature{
+	Params: map[string]semantic.Type{
+		"r": RowType,
+	},
+	ReturnType: semantic.Invalid,
+})
+
+// AccumulatorParam is the name of the parameter of reduce functions that is passed the accumulator, see RowReduceFn.
+const AccumulatorParam = "accumulator"
+
+// RowReduceType is the type of functions that fold a row into an accumulator record, see RowReduceFn.
+// The type of the accumulator is only known once the identity of the reduction is known, so it is left to inference.
+var RowReduceType = semantic.NewFunctionType(semantic.FunctionSignature{
+	Params: map[string]semantic.Type{
+		"r":              RowType,
+		AccumulatorParam: semantic.Invalid,
+	},
+	ReturnType: semantic.Invalid,
+})
+
 type rowFn struct {
 	fn               *semantic.FunctionExpression
 	compilationCache *compiler.CompilationCache
@@ -26,19 +66,26 @@ func newRowFn(fn *semantic.FunctionExpression) (rowFn, error) {
 	if len(fn.Params) != 1 {
 		return rowFn{}, fmt.Errorf("function should only have a single parameter, got %d", len(fn.Params))
 	}
+	return newRowFnWithRecord(fn, fn.Params[0].Key.Name), nil
+}
+
+// newRowFnWithRecord creates a row function whose record is passed to the parameter recordName.
+func newRowFnWithRecord(fn *semantic.FunctionExpression, recordName string) rowFn {
 	return rowFn{
 		compilationCache: compiler.NewCompilationCache(fn),
-		scope:            make(compiler.Scope, 1),
-		recordName:       fn.Params[0].Key.Name,
-		references:       findColReferences(fn),
+		scope:            make(compiler.Scope, len(fn.Params)),
+		recordName:       recordName,
+		references:       findColReferences(fn, recordName),
 		recordCols:       make(map[string]int),
 		record:           compiler.NewObject(),
-	}, nil
+	}
 }
 
-func (f *rowFn) prepare(cols []ColMeta) error {
+// prepare compiles the function for the columns of the record and the types of its other parameters.
+func (f *rowFn) prepare(cols []ColMeta, paramTypes map[string]semantic.Type) error {
 	// Prepare types and recordCols
 	propertyTypes := make(map[string]semantic.Type, len(f.references))
+	var missing []string
 	for _, r := range f.references {
 		found := false
 		for j, c := range cols {
@@ -50,17 +97,31 @@ func (f *rowFn) prepare(cols []ColMeta) error {
 			}
 		}
 		if !found {
-			return fmt.Errorf("function references unknown column %q", r)
+			// The column may only be used where it is known to exist, see the exists operator.
+			delete(f.recordCols, r)
+			missing = append(missing, r)
 		}
 	}
 	// Compile fn for given types
-	fn, err := f.compilationCache.Compile(map[string]semantic.Type{
-		f.recordName: semantic.NewObjectType(propertyTypes),
-	})
+	types := make(map[string]semantic.Type, len(paramTypes)+1)
+	for k, t := range paramTypes {
+		types[k] = t
+	}
+	types[f.recordName] = semantic.NewObjectType(propertyTypes)
+	fn, err := f.compilationCache.Compile(types)
 	if err != nil {
+		if len(missing) > 0 {
+			return fmt.Errorf("function references unknown column %q", missing[0])
+		}
 		return err
 	}
 	f.preparedFn = fn
+	// The record only has the columns of the block.
+	// Their types are set up front, so that null columns keep their type.
+	f.record = compiler.NewObject()
+	for r, t := range propertyTypes {
+		f.record.SetPropertyType(r, t)
+	}
 	return nil
 }
 
@@ -110,7 +171,14 @@ func ConvertFromKind(k semantic.Kind) DataType {
 
 func (f *rowFn) eval(row int, rr RowReader) (compiler.Value, error) {
 	for _, r := range f.references {
-		f.record.Set(r, ValueForRow(row, f.recordCols[r], rr))
+		if j, ok := f.recordCols[r]; ok {
+			if rr.IsNull(row, j) {
+				// A null column does not exist in the record.
+				f.record.Unset(r)
+				continue
+			}
+			f.record.Set(r, ValueForRow(row, j, rr))
+		}
 	}
 	f.scope[f.recordName] = f.record
 	return f.preparedFn.Eval(f.scope)
@@ -131,7 +199,7 @@ func NewRowPredicateFn(fn *semantic.FunctionExpression) (*RowPredicateFn, error)
 }
 
 func (f *RowPredicateFn) Prepare(cols []ColMeta) error {
-	err := f.rowFn.prepare(cols)
+	err := f.rowFn.prepare(cols, nil)
 	if err != nil {
 		return err
 	}
@@ -146,6 +214,10 @@ func (f *RowPredicateFn) Eval(row int, rr RowReader) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if v.Type() == semantic.Nil {
+		// A row for which the predicate is null does not pass.
+		return false, nil
+	}
 	return v.Bool(), nil
 }
 
@@ -168,7 +240,7 @@ func NewRowMapFn(fn *semantic.FunctionExpression) (*RowMapFn, error) {
 }
 
 func (f *RowMapFn) Prepare(cols []ColMeta) error {
-	err := f.rowFn.prepare(cols)
+	err := f.rowFn.prepare(cols, nil)
 	if err != nil {
 		return err
 	}
@@ -193,9 +265,69 @@ func (f *RowMapFn) Eval(row int, rr RowReader) (*compiler.Object, error) {
 		return nil, err
 	}
 	if f.isWrap {
+		if v.Type() == semantic.Nil {
+			f.wrapObj.Unset(DefaultValueColLabel)
+			return f.wrapObj, nil
+		}
 		f.wrapObj.Set(DefaultValueColLabel, v)
 		return f.wrapObj, nil
 	}
+	if v.Type() == semantic.Nil {
+		return nil, compiler.ErrNull
+	}
+	return v.Object(), nil
+}
+
+// RowReduceFn folds the rows of a block into an accumulator record.
+// The function is passed each row and the accumulator, and returns the accumulator for the next row.
+type RowReduceFn struct {
+	rowFn
+}
+
+func NewRowReduceFn(fn *semantic.FunctionExpression) (*RowReduceFn, error) {
+	if len(fn.Params) != 2 {
+		return nil, fmt.Errorf("reduce function should have two parameters, got %d", len(fn.Params))
+	}
+	// The record is passed to the parameter that is not the accumulator.
+	var recordName string
+	switch {
+	case fn.Params[0].Key.Name == AccumulatorParam:
+		recordName = fn.Params[1].Key.Name
+	case fn.Params[1].Key.Name == AccumulatorParam:
+		recordName = fn.Params[0].Key.Name
+	default:
+		return nil, fmt.Errorf("reduce function should have a parameter %q", AccumulatorParam)
+	}
+	return &RowReduceFn{
+		rowFn: newRowFnWithRecord(fn, recordName),
+	}, nil
+}
+
+// Prepare compiles the function for the columns and the type of the accumulator.
+// The function must return a record of the type of the accumulator.
+func (f *RowReduceFn) Prepare(cols []ColMeta, accumulatorType semantic.Type) error {
+	err := f.rowFn.prepare(cols, map[string]semantic.Type{
+		AccumulatorParam: accumulatorType,
+	})
+	if err != nil {
+		return err
+	}
+	if t := f.preparedFn.Type(); t != accumulatorType {
+		return fmt.Errorf("reduce function returns %v, which is not the type of the accumulator %v", t, accumulatorType)
+	}
+	return nil
+}
+
+// Eval folds the row into the accumulator, and returns the new accumulator.
+func (f *RowReduceFn) Eval(row int, rr RowReader, accumulator *compiler.Object) (*compiler.Object, error) {
+	f.scope[AccumulatorParam] = accumulator
+	v, err := f.rowFn.eval(row, rr)
+	if err != nil {
+		return nil, err
+	}
+	if v.Type() == semantic.Nil {
+		return nil, compiler.ErrNull
+	}
 	return v.Object(), nil
 }
 
@@ -220,7 +352,12 @@ func ValueForRow(i, j int, rr RowReader) compiler.Value {
 	}
 }
 
+// AppendValue appends the value v to the column j, a nil value is appended as null.
 func AppendValue(builder BlockBuilder, j int, v compiler.Value) {
+	if v == nil {
+		builder.AppendNil(j)
+		return
+	}
 	switch k := v.Type().Kind(); k {
 	case semantic.Bool:
 		builder.AppendBool(j, v.Bool())
@@ -234,14 +371,16 @@ func AppendValue(builder BlockBuilder, j int, v compiler.Value) {
 		builder.AppendString(j, v.Str())
 	case semantic.Time:
 		builder.AppendTime(j, Time(v.Time()))
+	case semantic.Nil:
+		builder.AppendNil(j)
 	default:
 		PanicUnknownType(ConvertFromKind(k))
 	}
 }
 
-func findColReferences(fn *semantic.FunctionExpression) []string {
+func findColReferences(fn *semantic.FunctionExpression, recordName string) []string {
 	v := &colReferenceVisitor{
-		recordName: fn.Params[0].Key.Name,
+		recordName: recordName,
 	}
 	semantic.Walk(v, fn)
 	return v.refs
@@ -253,10 +392,26 @@ type colReferenceVisitor struct {
 }
 
 func (c *colReferenceVisitor) Visit(node semantic.Node) semantic.Visitor {
-	if me, ok := node.(*semantic.MemberExpression); ok {
-		if obj, ok := me.Object.(*semantic.IdentifierExpression); ok && obj.Name == c.recordName {
-			c.refs = append(c.refs, me.Property)
+	switch n := node.(type) {
+	case *semantic.MemberExpression:
+		if obj, ok := n.Object.(*semantic.IdentifierExpression); ok && obj.Name == c.recordName {
+			c.refs = append(c.refs, n.Property)
+		}
+	case *semantic.CallExpression:
+		fn, ok := n.Callee.(*semantic.FunctionExpression)
+		if !ok {
+			break
 		}
+		// A called function references the columns of the record passed to it by the name of its parameter.
+		for _, p := range n.Arguments.Properties {
+			if id, ok := p.Value.(*semantic.IdentifierExpression); ok && id.Name == c.recordName {
+				called := &colReferenceVisitor{recordName: p.Key.Name}
+				semantic.Walk(called, fn.Body)
+				c.refs = append(c.refs, called.refs...)
+			}
+		}
+		semantic.Walk(c, n.Arguments)
+		return nil
 	}
 	return c
 }
@@ -93,38 +93,66 @@ func (t *indexSelectorTransformation) Process(id DatasetID, b Block) error {
 	valueCol := builder.Cols()[valueIdx]
 
 	values := b.Col(valueIdx)
+	// n counts the rows of the block, which can only be read once.
+	n := 0
 	switch valueCol.Type {
 	case TBool:
 		s := t.selector.NewBoolSelector()
 		values.DoBool(func(vs []bool, rr RowReader) {
+			n += len(vs)
+			rows := NonNullRows(len(vs), rr, valueIdx)
+			if rows != nil {
+				vs = nonNullBools(vs, rows)
+			}
 			selected := s.DoBool(vs)
-			t.appendSelected(selected, builder, rr, b.Bounds().Stop)
+			t.appendSelected(selectedRows(selected, rows), builder, rr, b.Bounds().Stop)
 		})
 	case TInt:
 		s := t.selector.NewIntSelector()
 		values.DoInt(func(vs []int64, rr RowReader) {
+			n += len(vs)
+			rows := NonNullRows(len(vs), rr, valueIdx)
+			if rows != nil {
+				vs = nonNullInts(vs, rows)
+			}
 			selected := s.DoInt(vs)
-			t.appendSelected(selected, builder, rr, b.Bounds().Stop)
+			t.appendSelected(selectedRows(selected, rows), builder, rr, b.Bounds().Stop)
 		})
 	case TUInt:
 		s := t.selector.NewUIntSelector()
 		values.DoUInt(func(vs []uint64, rr RowReader) {
+			n += len(vs)
+			rows := NonNullRows(len(vs), rr, valueIdx)
+			if rows != nil {
+				vs = nonNullUInts(vs, rows)
+			}
 			selected := s.DoUInt(vs)
-			t.appendSelected(selected, builder, rr, b.Bounds().Stop)
+			t.appendSelected(selectedRows(selected, rows), builder, rr, b.Bounds().Stop)
 		})
 	case TFloat:
 		s := t.selector.NewFloatSelector()
 		values.DoFloat(func(vs []float64, rr RowReader) {
+			n += len(vs)
+			rows := NonNullRows(len(vs), rr, valueIdx)
+			if rows != nil {
+				vs = nonNullFloats(vs, rows)
+			}
 			selected := s.DoFloat(vs)
-			t.appendSelected(selected, builder, rr, b.Bounds().Stop)
+			t.appendSelected(selectedRows(selected, rows), builder, rr, b.Bounds().Stop)
 		})
 	case TString:
 		s := t.selector.NewStringSelector()
 		values.DoString(func(vs []string, rr RowReader) {
+			n += len(vs)
+			rows := NonNullRows(len(vs), rr, valueIdx)
+			if rows != nil {
+				vs = nonNullStrings(vs, rows)
+			}
 			selected := s.DoString(vs)
-			t.appendSelected(selected, builder, rr, b.Bounds().Stop)
+			t.appendSelected(selectedRows(selected, rows), builder, rr, b.Bounds().Stop)
 		})
 	}
+	t.appendEmpty(n, builder, b.Bounds().Stop)
 	return nil
 }
 
@@ -136,35 +164,99 @@ func (t *rowSelectorTransformation) Process(id DatasetID, b Block) error {
 	valueCol := builder.Cols()[valueIdx]
 
 	values := b.Col(valueIdx)
+	// n counts the rows of the block, which can only be read once.
+	n := 0
 	var rower Rower
 	switch valueCol.Type {
 	case TBool:
 		s := t.selector.NewBoolSelector()
-		values.DoBool(s.DoBool)
+		values.DoBool(func(vs []bool, rr RowReader) {
+			n += len(vs)
+			if rows := NonNullRows(len(vs), rr, valueIdx); rows != nil {
+				vs, rr = nonNullBools(vs, rows), nonNullRowReader{RowReader: rr, rows: rows}
+			}
+			s.DoBool(vs, rr)
+		})
 		rower = s
 	case TInt:
 		s := t.selector.NewIntSelector()
-		values.DoInt(s.DoInt)
+		values.DoInt(func(vs []int64, rr RowReader) {
+			n += len(vs)
+			if rows := NonNullRows(len(vs), rr, valueIdx); rows != nil {
+				vs, rr = nonNullInts(vs, rows), nonNullRowReader{RowReader: rr, rows: rows}
+			}
+			s.DoInt(vs, rr)
+		})
 		rower = s
 	case TUInt:
 		s := t.selector.NewUIntSelector()
-		values.DoUInt(s.DoUInt)
+		values.DoUInt(func(vs []uint64, rr RowReader) {
+			n += len(vs)
+			if rows := NonNullRows(len(vs), rr, valueIdx); rows != nil {
+				vs, rr = nonNullUInts(vs, rows), nonNullRowReader{RowReader: rr, rows: rows}
+			}
+			s.DoUInt(vs, rr)
+		})
 		rower = s
 	case TFloat:
 		s := t.selector.NewFloatSelector()
-		values.DoFloat(s.DoFloat)
+		values.DoFloat(func(vs []float64, rr RowReader) {
+			n += len(vs)
+			if rows := NonNullRows(len(vs), rr, valueIdx); rows != nil {
+				vs, rr = nonNullFloats(vs, rows), nonNullRowReader{RowReader: rr, rows: rows}
+			}
+			s.DoFloat(vs, rr)
+		})
 		rower = s
 	case TString:
 		s := t.selector.NewStringSelector()
-		values.DoString(s.DoString)
+		values.DoString(func(vs []string, rr RowReader) {
+			n += len(vs)
+			if rows := NonNullRows(len(vs), rr, valueIdx); rows != nil {
+				vs, rr = nonNullStrings(vs, rows), nonNullRowReader{RowReader: rr, rows: rows}
+			}
+			s.DoString(vs, rr)
+		})
 		rower = s
 	}
 
 	rows := rower.Rows()
 	t.appendRows(builder, rows, b.Bounds().Stop)
+	t.appendEmpty(n, builder, b.Bounds().Stop)
 	return nil
 }
 
+// appendEmpty appends a row of nulls at the stop time when the block had no rows, n is its number of rows,
+// so that an empty window has a row like it does for aggregates.
+// Selectors that use the time of the selected row have no time for such a row.
+func (t *selectorTransformation) appendEmpty(n int, builder BlockBuilder, stop Time) {
+	if t.useRowTime || n > 0 {
+		return
+	}
+	for j, c := range builder.Cols() {
+		switch {
+		case c.Common:
+			continue
+		case c.Type == TTime:
+			builder.AppendTime(j, stop)
+		default:
+			builder.AppendNil(j)
+		}
+	}
+}
+
+// selectedRows maps the indexes selected from the non-null values back to the rows they were read from.
+func selectedRows(selected, rows []int) []int {
+	if rows == nil {
+		return selected
+	}
+	mapped := make([]int, len(selected))
+	for k, i := range selected {
+		mapped[k] = rows[i]
+	}
+	return mapped
+}
+
 func (t *indexSelectorTransformation) appendSelected(selected []int, builder BlockBuilder, rr RowReader, stop Time) {
 	if len(selected) == 0 {
 		return
@@ -172,6 +264,10 @@ func (t *indexSelectorTransformation) appendSelected(selected []int, builder Blo
 	cols := builder.Cols()
 	for j, c := range cols {
 		for _, i := range selected {
+			if rr.IsNull(i, j) && (c.Type != TTime || t.useRowTime) {
+				builder.AppendNil(j)
+				continue
+			}
 			switch c.Type {
 			case TBool:
 				builder.AppendBool(j, rr.AtBool(i, j))
@@ -201,6 +297,10 @@ func (t *rowSelectorTransformation) appendRows(builder BlockBuilder, rows []Row,
 	for j, c := range cols {
 		for _, row := range rows {
 			v := row.Values[j]
+			if v == nil && (c.Type != TTime || t.useRowTime) {
+				builder.AppendNil(j)
+				continue
+			}
 			switch c.Type {
 			case TBool:
 				builder.AppendBool(j, v.(bool))
@@ -290,6 +390,10 @@ func ReadRow(i int, rr RowReader) (row Row) {
 	cols := rr.Cols()
 	row.Values = make([]interface{}, len(cols))
 	for j, c := range cols {
+		if rr.IsNull(i, j) {
+			// Null values are read as nil
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			row.Values[j] = rr.AtBool(i, j)
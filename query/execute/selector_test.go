@@ -62,6 +62,75 @@ func TestRowSelector_Process(t *testing.T) {
 				}}
 			},
 		},
+		{
+			name: "null values",
+			bounds: execute.Bounds{
+				Start: 0,
+				Stop:  100,
+			},
+			useRowTime: true,
+			data: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  100,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "t1", Type: execute.TString, Kind: execute.TagColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), nil, "a"},
+					{execute.Time(10), 3.0, nil},
+					{execute.Time(20), nil, "b"},
+					{execute.Time(30), 5.0, "c"},
+				},
+			}},
+			want: func(b execute.Bounds) []*executetest.Block {
+				return []*executetest.Block{{
+					Bnds: b,
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), 3.0, nil},
+					},
+				}}
+			},
+		},
+		{
+			name: "empty block",
+			bounds: execute.Bounds{
+				Start: 0,
+				Stop:  100,
+			},
+			data: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  100,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "t1", Type: execute.TString, Kind: execute.TagColKind},
+				},
+			}},
+			want: func(b execute.Bounds) []*executetest.Block {
+				return []*executetest.Block{{
+					Bnds: b,
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(100), nil, nil},
+					},
+				}}
+			},
+		},
 		{
 			name:       "single useRowTime",
 			useRowTime: true,
@@ -428,6 +497,44 @@ func TestIndexSelector_Process(t *testing.T) {
 				}}
 			},
 		},
+		{
+			name: "null values",
+			bounds: execute.Bounds{
+				Start: 0,
+				Stop:  100,
+			},
+			useRowTime: true,
+			data: []*executetest.Block{{
+				Bnds: execute.Bounds{
+					Start: 0,
+					Stop:  100,
+				},
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+					{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+					{Label: "t1", Type: execute.TString, Kind: execute.TagColKind},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), nil, "a"},
+					{execute.Time(10), 3.0, nil},
+					{execute.Time(20), nil, "b"},
+					{execute.Time(30), 5.0, "c"},
+				},
+			}},
+			want: func(b execute.Bounds) []*executetest.Block {
+				return []*executetest.Block{{
+					Bnds: b,
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime, Kind: execute.TimeColKind},
+						{Label: "_value", Type: execute.TFloat, Kind: execute.ValueColKind},
+						{Label: "t1", Type: execute.TString, Kind: execute.TagColKind},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), 3.0, nil},
+					},
+				}}
+			},
+		},
 		{
 			name:       "single useRowTime",
 			useRowTime: true,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/influxdata/ifql/query/plan"
 	"github.com/opentracing/opentracing-go"
@@ -40,9 +41,11 @@ type storageSource struct {
 	ts []Transformation
 
 	currentTime Time
+
+	a Administration
 }
 
-func NewStorageSource(id DatasetID, r StorageReader, readSpec ReadSpec, bounds Bounds, w Window, currentTime Time) Source {
+func NewStorageSource(id DatasetID, r StorageReader, readSpec ReadSpec, bounds Bounds, w Window, currentTime Time, a Administration) Source {
 	return &storageSource{
 		id:          id,
 		reader:      r,
@@ -50,6 +53,7 @@ func NewStorageSource(id DatasetID, r StorageReader, readSpec ReadSpec, bounds B
 		bounds:      bounds,
 		window:      w,
 		currentTime: currentTime,
+		a:           a,
 	}
 }
 
@@ -72,28 +76,81 @@ func (s *storageSource) run(ctx context.Context) error {
 		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(trace))
 	}
 
+	// An aggregate is read as a point per window.
+	// The points of a series are merged into a single block with the bounds of the query,
+	// the same way the aggregate transformations merge the windows of a series.
+	if s.readSpec.AggregateMethod != "" {
+		return s.runAggregate(ctx, trace)
+	}
+
 	//TODO(nathanielc): Pass through context to actual network I/O.
 	for blocks, mark, ok := s.Next(ctx, trace); ok; blocks, mark, ok = s.Next(ctx, trace) {
+		if err := blocks.Do(s.process); err != nil {
+			return err
+		}
+		if err := s.updateWatermark(mark); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *storageSource) runAggregate(ctx context.Context, trace map[string]string) error {
+	builders := make(map[TagsKey]*ColListBlockBuilder)
+	for blocks, _, ok := s.Next(ctx, trace); ok; blocks, _, ok = s.Next(ctx, trace) {
 		err := blocks.Do(func(b Block) error {
-			for _, t := range s.ts {
-				if err := t.Process(s.id, b); err != nil {
-					return err
-				}
-				//TODO(nathanielc): Also add mechanism to send UpdateProcessingTime calls, when no data is arriving.
-				// This is probably not needed for this source, but other sources should do so.
-				if err := t.UpdateProcessingTime(s.id, Now()); err != nil {
-					return err
-				}
+			key := b.Tags().Key()
+			builder, ok := builders[key]
+			if !ok {
+				builder = NewColListBlockBuilder(s.a.Allocator())
+				builder.SetBounds(s.a.Bounds())
+				builders[key] = builder
 			}
+			colMap := AddNewCols(b, builder)
+			AppendBlock(b, builder, colMap)
 			return nil
 		})
 		if err != nil {
 			return err
 		}
-		for _, t := range s.ts {
-			if err := t.UpdateWatermark(s.id, mark); err != nil {
-				return err
-			}
+	}
+
+	// Produce the blocks in a deterministic order.
+	keys := make([]TagsKey, 0, len(builders))
+	for k := range builders {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, k := range keys {
+		b, err := builders[k].Block()
+		if err != nil {
+			return err
+		}
+		if err := s.process(b); err != nil {
+			return err
+		}
+	}
+	return s.updateWatermark(s.bounds.Stop)
+}
+
+func (s *storageSource) process(b Block) error {
+	for _, t := range s.ts {
+		if err := t.Process(s.id, b); err != nil {
+			return err
+		}
+		//TODO(nathanielc): Also add mechanism to send UpdateProcessingTime calls, when no data is arriving.
+		// This is probably not needed for this source, but other sources should do so.
+		if err := t.UpdateProcessingTime(s.id, Now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *storageSource) updateWatermark(mark Time) error {
+	for _, t := range s.ts {
+		if err := t.UpdateWatermark(s.id, mark); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -105,7 +162,14 @@ func (s *storageSource) Next(ctx context.Context, trace map[string]string) (Bloc
 
 	s.currentTime = s.currentTime + Time(s.window.Every)
 	if stop > s.bounds.Stop {
-		return nil, 0, false
+		if start >= s.bounds.Stop {
+			return nil, 0, false
+		}
+		// Clamp the last window to the read bounds.
+		stop = s.bounds.Stop
+	}
+	if start < s.bounds.Start {
+		start = s.bounds.Start
 	}
 	bi, err := s.reader.Read(
 		ctx,
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/query/execute/storage"
+	"github.com/influxdata/ifql/query/plan"
 	"github.com/influxdata/ifql/semantic"
 	"github.com/influxdata/yarpc"
 	"github.com/pkg/errors"
@@ -62,13 +65,30 @@ func NewStorageReader(hosts []string) (StorageReader, error) {
 			client: storage.NewStorageClient(conn),
 		}
 	}
+	return newStorageReader(conns), nil
+}
+
+// capabilitiesTimeout bounds the time to request the capabilities of the storage hosts.
+const capabilitiesTimeout = 5 * time.Second
+
+// newStorageReader creates a reader of the storage hosts and requests their capabilities once.
+// A storage layer that cannot report its capabilities has none.
+func newStorageReader(conns []connection) *storageReader {
+	ctx, cancel := context.WithTimeout(context.Background(), capabilitiesTimeout)
+	defer cancel()
+	caps, err := capabilities(ctx, conns)
+	if err != nil {
+		caps = make(map[string]bool)
+	}
 	return &storageReader{
 		conns: conns,
-	}, nil
+		caps:  caps,
+	}
 }
 
 type storageReader struct {
 	conns []connection
+	caps  map[string]bool
 }
 
 type connection struct {
@@ -101,6 +121,50 @@ func (sr *storageReader) Read(ctx context.Context, trace map[string]string, read
 	return bi, nil
 }
 
+// storageAggregatesCapability is the key of the capabilities of a storage host
+// that lists the names of the aggregate types it supports, separated by commas.
+// All hosts support the SUM and COUNT aggregates.
+const storageAggregatesCapability = "aggregates"
+
+// HasCapability reports whether all storage hosts have the capability.
+func (sr *storageReader) HasCapability(name string) bool {
+	return sr.caps[name]
+}
+
+// capabilities requests the capabilities of the storage hosts and returns those that all of them have.
+func capabilities(ctx context.Context, conns []connection) (map[string]bool, error) {
+	var caps map[string]bool
+	for _, c := range conns {
+		resp, err := c.client.Capabilities(ctx, new(types.Empty))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read capabilities of %s", c.host)
+		}
+		hostCaps := map[string]bool{
+			aggregateCapability(storage.AggregateTypeSum):   true,
+			aggregateCapability(storage.AggregateTypeCount): true,
+		}
+		for _, name := range strings.Split(resp.Caps[storageAggregatesCapability], ",") {
+			if t, ok := storage.Aggregate_AggregateType_value[strings.TrimSpace(name)]; ok {
+				hostCaps[aggregateCapability(storage.Aggregate_AggregateType(t))] = true
+			}
+		}
+		if caps == nil {
+			caps = hostCaps
+			continue
+		}
+		for name := range caps {
+			if !hostCaps[name] {
+				delete(caps, name)
+			}
+		}
+	}
+	return caps, nil
+}
+
+func aggregateCapability(t storage.Aggregate_AggregateType) string {
+	return plan.AggregateCapability(strings.ToLower(t.String()))
+}
+
 func (sr *storageReader) Close() {
 	for _, conn := range sr.conns {
 		_ = conn.conn.Close()
@@ -161,6 +225,11 @@ func (bi *storageBlockIterator) Do(f func(Block) error) error {
 			readSpec: &bi.readSpec,
 		})
 	}
+	return readBlocks(bi.bounds, &bi.readSpec, streams, f)
+}
+
+// readBlocks merges the frame streams into blocks, calling f for each block.
+func readBlocks(bounds Bounds, readSpec *ReadSpec, streams []*streamState, f func(Block) error) error {
 	ms := &mergedStreams{
 		streams: streams,
 	}
@@ -173,9 +242,9 @@ func (bi *storageBlockIterator) Do(f func(Block) error) error {
 		frame := ms.next()
 		s := frame.GetSeries()
 		typ := convertDataType(s.DataType)
-		tags, keptTags := bi.determineBlockTags(s)
-		k := appendSeriesKey(nil, s, &bi.readSpec)
-		block := newStorageBlock(bi.bounds, tags, keptTags, k, ms, &bi.readSpec, typ)
+		tags, keptTags := determineBlockTags(readSpec, s)
+		k := appendSeriesKey(nil, s, readSpec)
+		block := newStorageBlock(bounds, tags, keptTags, k, ms, readSpec, typ)
 
 		if err := f(block); err != nil {
 			// TODO(nathanielc): Close streams since we have abandoned the request
@@ -214,10 +283,10 @@ func convertDataType(t storage.ReadResponse_DataType) DataType {
 	}
 }
 
-func (bi *storageBlockIterator) determineBlockTags(s *storage.ReadResponse_SeriesFrame) (tags, keptTags Tags) {
-	if len(bi.readSpec.GroupKeys) > 0 {
-		tags = make(Tags, len(bi.readSpec.GroupKeys))
-		for _, key := range bi.readSpec.GroupKeys {
+func determineBlockTags(readSpec *ReadSpec, s *storage.ReadResponse_SeriesFrame) (tags, keptTags Tags) {
+	if len(readSpec.GroupKeys) > 0 {
+		tags = make(Tags, len(readSpec.GroupKeys))
+		for _, key := range readSpec.GroupKeys {
 			for _, tag := range s.Tags {
 				if string(tag.Key) == key {
 					tags[key] = string(tag.Value)
@@ -225,9 +294,9 @@ func (bi *storageBlockIterator) determineBlockTags(s *storage.ReadResponse_Serie
 				}
 			}
 		}
-		if len(bi.readSpec.GroupKeep) > 0 {
-			keptTags = make(Tags, len(bi.readSpec.GroupKeep))
-			for _, key := range bi.readSpec.GroupKeep {
+		if len(readSpec.GroupKeep) > 0 {
+			keptTags = make(Tags, len(readSpec.GroupKeep))
+			for _, key := range readSpec.GroupKeep {
 				for _, tag := range s.Tags {
 					if string(tag.Key) == key {
 						keptTags[key] = string(tag.Value)
@@ -236,35 +305,35 @@ func (bi *storageBlockIterator) determineBlockTags(s *storage.ReadResponse_Serie
 				}
 			}
 		}
-	} else if len(bi.readSpec.GroupExcept) > 0 {
-		tags = make(Tags, len(s.Tags)-len(bi.readSpec.GroupExcept))
-		keptTags = make(Tags, len(bi.readSpec.GroupKeep))
+	} else if len(readSpec.GroupExcept) > 0 {
+		tags = make(Tags, len(s.Tags)-len(readSpec.GroupExcept))
+		keptTags = make(Tags, len(readSpec.GroupKeep))
 	TAGS:
 		for _, t := range s.Tags {
 			k := string(t.Key)
-			for _, key := range bi.readSpec.GroupKeep {
+			for _, key := range readSpec.GroupKeep {
 				if k == key {
 					keptTags[key] = string(t.Value)
 					continue TAGS
 				}
 			}
-			for _, key := range bi.readSpec.GroupExcept {
+			for _, key := range readSpec.GroupExcept {
 				if k == key {
 					continue TAGS
 				}
 			}
 			tags[k] = string(t.Value)
 		}
-	} else if !bi.readSpec.MergeAll {
+	} else if !readSpec.MergeAll {
 		tags = make(Tags, len(s.Tags))
 		for _, t := range s.Tags {
 			tags[string(t.Key)] = string(t.Value)
 		}
 	} else {
-		keptTags = make(Tags, len(bi.readSpec.GroupKeep))
+		keptTags = make(Tags, len(readSpec.GroupKeep))
 		for _, t := range s.Tags {
 			k := string(t.Key)
-			for _, key := range bi.readSpec.GroupKeep {
+			for _, key := range readSpec.GroupKeep {
 				if k == key {
 					keptTags[key] = string(t.Value)
 				}
@@ -536,6 +605,16 @@ func (b *storageBlock) AtTime(i, j int) Time {
 	return b.colBufs[j].([]Time)[i]
 }
 
+func (b *storageBlock) IsNull(i, j int) bool {
+	meta := b.colMeta[j]
+	if meta.IsTag() && !meta.Common {
+		// The kept tag is null if the current series does not have it.
+		_, ok := b.keptTags[meta.Label]
+		return !ok
+	}
+	return false
+}
+
 func (b *storageBlock) advance() bool {
 	for b.ms.more() {
 		//reset buffers
@@ -553,6 +632,11 @@ func (b *storageBlock) advance() bool {
 				return false
 			}
 			s := p.GetSeries()
+			if convertDataType(s.DataType) != b.colMeta[1].Type {
+				// The series of a group can have different types,
+				// the series starts a new block with the same tags.
+				return false
+			}
 			// Populate keptTags with new series values
 			b.keptTags = make(Tags, len(b.readSpec.GroupKeep))
 			for _, t := range s.Tags {
@@ -712,8 +796,13 @@ func (b *storageBlock) advance() bool {
 	return false
 }
 
+// readStream is the part of storage.Storage_ReadClient needed to consume read responses.
+type readStream interface {
+	RecvMsg(m interface{}) error
+}
+
 type streamState struct {
-	stream     storage.Storage_ReadClient
+	stream     readStream
 	rep        storage.ReadResponse
 	currentKey key
 	readSpec   *ReadSpec
@@ -47,17 +47,32 @@ const (
 	AggregateTypeNone  Aggregate_AggregateType = 0
 	AggregateTypeSum   Aggregate_AggregateType = 1
 	AggregateTypeCount Aggregate_AggregateType = 2
+	AggregateTypeMin   Aggregate_AggregateType = 3
+	AggregateTypeMax   Aggregate_AggregateType = 4
+	AggregateTypeFirst Aggregate_AggregateType = 5
+	AggregateTypeLast  Aggregate_AggregateType = 6
+	AggregateTypeMean  Aggregate_AggregateType = 7
 )
 
 var Aggregate_AggregateType_name = map[int32]string{
 	0: "NONE",
 	1: "SUM",
 	2: "COUNT",
+	3: "MIN",
+	4: "MAX",
+	5: "FIRST",
+	6: "LAST",
+	7: "MEAN",
 }
 var Aggregate_AggregateType_value = map[string]int32{
 	"NONE":  0,
 	"SUM":   1,
 	"COUNT": 2,
+	"MIN":   3,
+	"MAX":   4,
+	"FIRST": 5,
+	"LAST":  6,
+	"MEAN":  7,
 }
 
 func (x Aggregate_AggregateType) String() string {
@@ -3791,81 +3806,84 @@ var (
 func init() { proto.RegisterFile("storage.proto", fileDescriptorStorage) }
 
 var fileDescriptorStorage = []byte{
-	// 1206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xb6, 0xd7, 0x4e, 0x76, 0xf3, 0x92, 0xec, 0x7a, 0xa7, 0xdb, 0x25, 0x72, 0x69, 0xe2, 0xe6,
-	0x50, 0xc2, 0xa1, 0x69, 0x15, 0x40, 0x14, 0x2a, 0x24, 0x9a, 0x36, 0xed, 0x2e, 0xdd, 0x26, 0xd5,
-	0x24, 0x2b, 0x71, 0x40, 0x5a, 0x26, 0x9b, 0x89, 0x6b, 0x91, 0xd8, 0xc6, 0x9e, 0xa0, 0xee, 0x8d,
-	0x23, 0x5a, 0x71, 0xe0, 0xc0, 0x35, 0x27, 0x7e, 0x03, 0x5c, 0x90, 0x38, 0x70, 0xea, 0x91, 0x23,
-	0xa7, 0x08, 0xc2, 0x1f, 0x41, 0x33, 0x63, 0x3b, 0xf6, 0x6e, 0x5a, 0x69, 0x2f, 0xd1, 0xbc, 0xf7,
-	0xbe, 0xf7, 0xbd, 0xf7, 0x66, 0xde, 0x7b, 0x31, 0x94, 0x43, 0xe6, 0x05, 0xc4, 0xa6, 0x4d, 0x3f,
-	0xf0, 0x98, 0x87, 0x36, 0x23, 0xd1, 0xbc, 0x63, 0x3b, 0xec, 0xe5, 0x6c, 0xd8, 0x3c, 0xf5, 0xa6,
-	0x77, 0x6d, 0xcf, 0xf6, 0xee, 0x0a, 0xfb, 0x70, 0x36, 0x16, 0x92, 0x10, 0xc4, 0x49, 0xfa, 0x99,
-	0x37, 0x6c, 0xcf, 0xb3, 0x27, 0x74, 0x85, 0xa2, 0x53, 0x9f, 0x9d, 0x45, 0xc6, 0x56, 0x8a, 0xcb,
-	0x71, 0xc7, 0x93, 0xd9, 0xab, 0x11, 0x61, 0xe4, 0xee, 0x19, 0x09, 0xfc, 0x53, 0xf9, 0x2b, 0xf9,
-	0xc4, 0x31, 0xf2, 0xd9, 0xf1, 0x03, 0x3a, 0x72, 0x4e, 0x09, 0x8b, 0x32, 0xab, 0xff, 0xa1, 0x43,
-	0x11, 0x53, 0x32, 0xc2, 0xf4, 0xdb, 0x19, 0x0d, 0x19, 0x32, 0x61, 0x8b, 0xb3, 0x0c, 0x49, 0x48,
-	0x2b, 0xaa, 0xa5, 0x36, 0x0a, 0x38, 0x91, 0xd1, 0x97, 0xb0, 0xc3, 0x9c, 0x29, 0x0d, 0x19, 0x99,
-	0xfa, 0x27, 0x01, 0x71, 0x6d, 0x5a, 0xd9, 0xb0, 0xd4, 0x46, 0xb1, 0xf5, 0x4e, 0x33, 0x2e, 0x77,
-	0x10, 0xdb, 0x31, 0x37, 0xb7, 0xf7, 0x5f, 0x2f, 0x6a, 0xca, 0x72, 0x51, 0xdb, 0xce, 0xea, 0xf1,
-	0x36, 0xcb, 0xc8, 0xa8, 0x0a, 0x30, 0xa2, 0xe1, 0x29, 0x75, 0x47, 0x8e, 0x6b, 0x57, 0x34, 0x4b,
-	0x6d, 0x6c, 0xe1, 0x94, 0x86, 0x67, 0x65, 0x07, 0xde, 0xcc, 0xe7, 0x56, 0xdd, 0xd2, 0x78, 0x56,
-	0xb1, 0x8c, 0xee, 0x41, 0x21, 0x29, 0xaa, 0x92, 0x13, 0xf9, 0xa0, 0x24, 0x9f, 0x17, 0xb1, 0x05,
-	0xaf, 0x40, 0xa8, 0x05, 0xa5, 0x90, 0x06, 0x0e, 0x0d, 0x4f, 0x26, 0xce, 0xd4, 0x61, 0x95, 0xbc,
-	0xa5, 0x36, 0xf4, 0xf6, 0xce, 0x72, 0x51, 0x2b, 0xf6, 0x85, 0xfe, 0x88, 0xab, 0x71, 0x31, 0x5c,
-	0x09, 0xe8, 0x23, 0x28, 0x47, 0x3e, 0xde, 0x78, 0x1c, 0x52, 0x56, 0xd9, 0x14, 0x4e, 0xc6, 0x72,
-	0x51, 0x2b, 0x49, 0xa7, 0x9e, 0xd0, 0xe3, 0x88, 0x5a, 0x4a, 0x3c, 0x94, 0xef, 0x39, 0x2e, 0x8b,
-	0x43, 0x6d, 0xad, 0x42, 0xbd, 0x10, 0xfa, 0x28, 0x94, 0xbf, 0x12, 0x78, 0x41, 0xc4, 0xb6, 0x03,
-	0x6a, 0xf3, 0x82, 0x0a, 0x17, 0x0a, 0x7a, 0x18, 0x5b, 0xf0, 0x0a, 0x84, 0x3e, 0x87, 0x1c, 0x0b,
-	0xc8, 0x29, 0xad, 0x80, 0xa5, 0x35, 0x8a, 0xad, 0x5a, 0x82, 0x4e, 0xbd, 0x6c, 0x73, 0xc0, 0x11,
-	0x1d, 0x97, 0x05, 0x67, 0xed, 0xc2, 0x72, 0x51, 0xcb, 0x09, 0x19, 0x4b, 0x47, 0xf3, 0x3e, 0xc0,
-	0xca, 0x8e, 0x0c, 0xd0, 0xbe, 0xa1, 0x67, 0xd1, 0xfb, 0xf3, 0x23, 0xda, 0x83, 0xdc, 0x77, 0x64,
-	0x32, 0x93, 0x0f, 0x5e, 0xc0, 0x52, 0xf8, 0x74, 0xe3, 0xbe, 0x5a, 0xff, 0x5d, 0x85, 0x42, 0x92,
-	0x14, 0xfa, 0x10, 0x74, 0x76, 0xe6, 0xcb, 0xd6, 0xd9, 0x6e, 0x59, 0x97, 0xd3, 0x5e, 0x9d, 0x06,
-	0x67, 0x3e, 0xc5, 0x02, 0x5d, 0x7f, 0x05, 0xe5, 0x8c, 0x1a, 0xd5, 0x40, 0xef, 0xf6, 0xba, 0x1d,
-	0x43, 0x31, 0xaf, 0x9f, 0xcf, 0xad, 0xdd, 0x8c, 0xb1, 0xeb, 0xb9, 0x14, 0xdd, 0x04, 0xad, 0x7f,
-	0xfc, 0xdc, 0x50, 0xcd, 0xbd, 0xf3, 0xb9, 0x65, 0x64, 0xec, 0xfd, 0xd9, 0x14, 0xdd, 0x82, 0xdc,
-	0xa3, 0xde, 0x71, 0x77, 0x60, 0x6c, 0x98, 0xfb, 0xe7, 0x73, 0x0b, 0x65, 0x00, 0x8f, 0xbc, 0x99,
-	0xcb, 0x4c, 0xfd, 0x87, 0x5f, 0xaa, 0x4a, 0xfd, 0x0e, 0x68, 0x03, 0x62, 0xa7, 0x0b, 0x2e, 0xad,
-	0x29, 0xb8, 0x14, 0x15, 0x5c, 0xff, 0xb9, 0x08, 0x25, 0x79, 0xa7, 0xa1, 0xef, 0xb9, 0x21, 0x45,
-	0x9f, 0x40, 0x7e, 0x1c, 0x90, 0x29, 0x0d, 0x2b, 0xaa, 0xb8, 0xfa, 0x1b, 0x17, 0xae, 0x5e, 0xc2,
-	0x9a, 0x4f, 0x38, 0xa6, 0xad, 0xf3, 0x69, 0xc0, 0x91, 0x83, 0xf9, 0xa7, 0x0e, 0x39, 0xa1, 0x47,
-	0x0f, 0x20, 0x2f, 0x9b, 0x46, 0x24, 0x50, 0x6c, 0xdd, 0x5a, 0x4f, 0x22, 0xdb, 0x4c, 0xb8, 0x1c,
-	0x28, 0x38, 0x72, 0x41, 0x5f, 0x41, 0x69, 0x3c, 0xf1, 0x08, 0x3b, 0x91, 0x2d, 0x14, 0x4d, 0xe4,
-	0xed, 0x37, 0xe4, 0xc1, 0x91, 0xb2, 0xf1, 0x64, 0x4a, 0xa2, 0x13, 0x53, 0xda, 0x03, 0x05, 0x17,
-	0xc7, 0x2b, 0x11, 0x8d, 0x60, 0xdb, 0x71, 0x19, 0xb5, 0x69, 0x10, 0xf3, 0x6b, 0x82, 0xbf, 0xb1,
-	0x9e, 0xff, 0x50, 0x62, 0xd3, 0x11, 0x76, 0x97, 0x8b, 0x5a, 0x39, 0xa3, 0x3f, 0x50, 0x70, 0xd9,
-	0x49, 0x2b, 0xd0, 0x4b, 0xd8, 0x99, 0xb9, 0xa1, 0x63, 0xbb, 0x74, 0x14, 0x87, 0xd1, 0x45, 0x98,
-	0xf7, 0xd7, 0x87, 0x39, 0x8e, 0xc0, 0xe9, 0x38, 0x88, 0xaf, 0x99, 0xac, 0xe1, 0x40, 0xc1, 0xdb,
-	0xb3, 0x8c, 0x86, 0xd7, 0x33, 0xf4, 0xbc, 0x09, 0x25, 0x6e, 0x1c, 0x28, 0xf7, 0xb6, 0x7a, 0xda,
-	0x12, 0x7b, 0xa9, 0x9e, 0x8c, 0x9e, 0xd7, 0x33, 0x4c, 0x2b, 0xd0, 0xd7, 0x7c, 0xff, 0x07, 0x8e,
-	0x6b, 0xc7, 0x41, 0xf2, 0x22, 0xc8, 0x7b, 0x6f, 0x78, 0x57, 0x01, 0x4d, 0xc7, 0x90, 0x5b, 0x25,
-	0xa5, 0x3e, 0x50, 0x70, 0x29, 0x4c, 0xc9, 0xed, 0x3c, 0xe8, 0x7c, 0x2d, 0x9b, 0x01, 0x14, 0x53,
-	0x6d, 0x81, 0x6e, 0x83, 0xce, 0x88, 0x1d, 0x37, 0x63, 0x69, 0xb5, 0x96, 0x89, 0x1d, 0x75, 0x9f,
-	0xb0, 0xa3, 0x07, 0x50, 0xe0, 0xee, 0x27, 0x62, 0x56, 0x37, 0xc4, 0xac, 0x56, 0xd7, 0x27, 0xf7,
-	0x98, 0x30, 0x22, 0x26, 0x55, 0xfc, 0x0d, 0xf0, 0x93, 0xf9, 0x05, 0x18, 0x17, 0xfb, 0x88, 0x2f,
-	0xf0, 0x64, 0xa5, 0xcb, 0xf0, 0x06, 0x4e, 0x69, 0xd0, 0x3e, 0xe4, 0xc5, 0x04, 0xf1, 0xfe, 0xd4,
-	0x1a, 0x2a, 0x8e, 0x24, 0xf3, 0x08, 0xd0, 0xe5, 0x9e, 0xb9, 0x22, 0x9b, 0x96, 0xb0, 0x3d, 0x87,
-	0x6b, 0x6b, 0x5a, 0xe3, 0x8a, 0x74, 0x7a, 0x3a, 0xb9, 0xcb, 0x0d, 0x70, 0x45, 0xb6, 0xad, 0x84,
-	0xed, 0x19, 0xec, 0x5e, 0x7a, 0xe9, 0x2b, 0x92, 0x15, 0x62, 0xb2, 0x7a, 0x1f, 0x0a, 0x82, 0x20,
-	0xda, 0x96, 0xf9, 0x7e, 0x07, 0x1f, 0x76, 0xfa, 0x86, 0x62, 0x5e, 0x3b, 0x9f, 0x5b, 0x3b, 0x89,
-	0x49, 0xf6, 0x06, 0x07, 0xbc, 0xe8, 0x1d, 0x76, 0x07, 0x7d, 0x43, 0xbd, 0x00, 0x90, 0xb9, 0x44,
-	0xcb, 0xf0, 0x37, 0x15, 0xb6, 0xe2, 0xf7, 0x46, 0xef, 0x42, 0xee, 0xc9, 0x51, 0xef, 0xe1, 0xc0,
-	0x50, 0xcc, 0xdd, 0xf3, 0xb9, 0x55, 0x8e, 0x0d, 0xe2, 0xe9, 0x91, 0x05, 0x9b, 0x87, 0xdd, 0x41,
-	0xe7, 0x69, 0x07, 0xc7, 0x94, 0xb1, 0x3d, 0x7a, 0x4e, 0x54, 0x87, 0xad, 0xe3, 0x6e, 0xff, 0xf0,
-	0x69, 0xb7, 0xf3, 0xd8, 0xd8, 0x90, 0x6b, 0x3a, 0x86, 0xc4, 0x6f, 0xc4, 0x59, 0xda, 0xbd, 0xde,
-	0x51, 0xe7, 0x61, 0xd7, 0xd0, 0xb2, 0x2c, 0xd1, 0xbd, 0xa3, 0x2a, 0xe4, 0xfb, 0x03, 0x7c, 0xd8,
-	0x7d, 0x6a, 0xe8, 0x26, 0x3a, 0x9f, 0x5b, 0xdb, 0x31, 0x40, 0x5e, 0x65, 0x94, 0xf8, 0x8f, 0x2a,
-	0xec, 0x3d, 0x22, 0x3e, 0x19, 0x3a, 0x13, 0x87, 0x39, 0x34, 0x4c, 0xd6, 0xf3, 0x03, 0xd0, 0x4f,
-	0x89, 0x1f, 0xcf, 0xc3, 0x6a, 0xfe, 0xd6, 0x81, 0xb9, 0x32, 0x14, 0xff, 0x7f, 0x58, 0x38, 0x99,
-	0x1f, 0x43, 0x21, 0x51, 0x5d, 0xe9, 0x2f, 0x71, 0x07, 0xca, 0x07, 0xfc, 0x5a, 0x63, 0xe6, 0xfa,
-	0x7d, 0xb8, 0xf0, 0x01, 0xc4, 0x9d, 0x43, 0x46, 0x02, 0x26, 0x08, 0x35, 0x2c, 0x05, 0x1e, 0x84,
-	0xba, 0x23, 0x41, 0xa8, 0x61, 0x7e, 0x6c, 0xfd, 0xad, 0xc2, 0x66, 0x5f, 0x26, 0xcd, 0x8b, 0xe1,
-	0xa3, 0x89, 0xf6, 0xd6, 0xfd, 0xbd, 0x9b, 0xd7, 0xd7, 0xce, 0x6f, 0x5d, 0xff, 0xfe, 0xd7, 0x8a,
-	0x72, 0x4f, 0x45, 0xcf, 0xa0, 0x94, 0x2e, 0x1a, 0xed, 0x37, 0xe5, 0xa7, 0x65, 0x33, 0xfe, 0xb4,
-	0x6c, 0x76, 0xf8, 0xa7, 0xa5, 0x79, 0xf3, 0xad, 0x77, 0x24, 0xe8, 0x54, 0xf4, 0x19, 0xe4, 0x44,
-	0x81, 0x6f, 0x64, 0xd9, 0x4f, 0x58, 0xb2, 0x17, 0xc1, 0xdd, 0x37, 0x4c, 0x91, 0x53, 0x7b, 0xef,
-	0xf5, 0xbf, 0x55, 0xe5, 0xf5, 0xb2, 0xaa, 0xfe, 0xb5, 0xac, 0xaa, 0xff, 0x2c, 0xab, 0xea, 0x4f,
-	0xff, 0x55, 0x95, 0x61, 0x5e, 0x30, 0x7d, 0xf0, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0xdc,
-	0x3a, 0xb6, 0x41, 0x0b, 0x00, 0x00,
+	// 1260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xde, 0xf5, 0xae, 0x9d, 0xf8, 0xd9, 0x4e, 0x36, 0xd3, 0x34, 0x58, 0x5b, 0x6a, 0x6f, 0x7d,
+	0x28, 0xe6, 0x50, 0xb7, 0x32, 0x20, 0x0a, 0x15, 0x12, 0x71, 0xeb, 0x34, 0xa6, 0x89, 0x5d, 0x8d,
+	0x1d, 0xa9, 0x07, 0xa4, 0x30, 0x8e, 0xc7, 0xdb, 0x15, 0xf6, 0xee, 0xb2, 0x3b, 0x46, 0xcd, 0x8d,
+	0x23, 0x8a, 0x38, 0x70, 0xe0, 0x86, 0x72, 0xe2, 0x37, 0xc0, 0x91, 0x03, 0xa7, 0x1e, 0x39, 0x72,
+	0x0a, 0x60, 0xfe, 0x08, 0x9a, 0x99, 0x5d, 0x7b, 0x9d, 0x6c, 0x2b, 0xe5, 0x62, 0xcd, 0x7b, 0xef,
+	0x7b, 0xdf, 0x7b, 0xcf, 0xef, 0xcd, 0x9b, 0x85, 0x52, 0xc8, 0xbc, 0x80, 0xd8, 0xb4, 0xe1, 0x07,
+	0x1e, 0xf3, 0xd0, 0x5a, 0x24, 0x9a, 0xf7, 0x6c, 0x87, 0xbd, 0x9c, 0x0d, 0x1b, 0x27, 0xde, 0xf4,
+	0xbe, 0xed, 0xd9, 0xde, 0x7d, 0x61, 0x1f, 0xce, 0xc6, 0x42, 0x12, 0x82, 0x38, 0x49, 0x3f, 0xf3,
+	0x96, 0xed, 0x79, 0xf6, 0x84, 0x2e, 0x51, 0x74, 0xea, 0xb3, 0xd3, 0xc8, 0xd8, 0x4c, 0x70, 0x39,
+	0xee, 0x78, 0x32, 0x7b, 0x35, 0x22, 0x8c, 0xdc, 0x3f, 0x25, 0x81, 0x7f, 0x22, 0x7f, 0x25, 0x9f,
+	0x38, 0x46, 0x3e, 0x9b, 0x7e, 0x40, 0x47, 0xce, 0x09, 0x61, 0x51, 0x66, 0xb5, 0xdf, 0x75, 0x28,
+	0x60, 0x4a, 0x46, 0x98, 0x7e, 0x33, 0xa3, 0x21, 0x43, 0x26, 0xac, 0x73, 0x96, 0x21, 0x09, 0x69,
+	0x59, 0xb5, 0xd4, 0x7a, 0x1e, 0x2f, 0x64, 0xf4, 0x02, 0x36, 0x99, 0x33, 0xa5, 0x21, 0x23, 0x53,
+	0xff, 0x38, 0x20, 0xae, 0x4d, 0xcb, 0x19, 0x4b, 0xad, 0x17, 0x9a, 0xef, 0x34, 0xe2, 0x72, 0x07,
+	0xb1, 0x1d, 0x73, 0x73, 0x6b, 0xe7, 0xf5, 0x45, 0x55, 0x99, 0x5f, 0x54, 0x37, 0x56, 0xf5, 0x78,
+	0x83, 0xad, 0xc8, 0xa8, 0x02, 0x30, 0xa2, 0xe1, 0x09, 0x75, 0x47, 0x8e, 0x6b, 0x97, 0x35, 0x4b,
+	0xad, 0xaf, 0xe3, 0x84, 0x86, 0x67, 0x65, 0x07, 0xde, 0xcc, 0xe7, 0x56, 0xdd, 0xd2, 0x78, 0x56,
+	0xb1, 0x8c, 0x1e, 0x40, 0x7e, 0x51, 0x54, 0x39, 0x2b, 0xf2, 0x41, 0x8b, 0x7c, 0x9e, 0xc7, 0x16,
+	0xbc, 0x04, 0xa1, 0x26, 0x14, 0x43, 0x1a, 0x38, 0x34, 0x3c, 0x9e, 0x38, 0x53, 0x87, 0x95, 0x73,
+	0x96, 0x5a, 0xd7, 0x5b, 0x9b, 0xf3, 0x8b, 0x6a, 0xa1, 0x2f, 0xf4, 0x07, 0x5c, 0x8d, 0x0b, 0xe1,
+	0x52, 0x40, 0x1f, 0x41, 0x29, 0xf2, 0xf1, 0xc6, 0xe3, 0x90, 0xb2, 0xf2, 0x9a, 0x70, 0x32, 0xe6,
+	0x17, 0xd5, 0xa2, 0x74, 0xea, 0x09, 0x3d, 0x2e, 0x86, 0x09, 0x89, 0x87, 0xf2, 0x3d, 0xc7, 0x65,
+	0x71, 0xa8, 0xf5, 0x65, 0xa8, 0xe7, 0x42, 0x1f, 0x85, 0xf2, 0x97, 0x02, 0x2f, 0x88, 0xd8, 0x76,
+	0x40, 0x6d, 0x5e, 0x50, 0xfe, 0x52, 0x41, 0xbb, 0xb1, 0x05, 0x2f, 0x41, 0xe8, 0x73, 0xc8, 0xb2,
+	0x80, 0x9c, 0xd0, 0x32, 0x58, 0x5a, 0xbd, 0xd0, 0xac, 0x2e, 0xd0, 0x89, 0xce, 0x36, 0x06, 0x1c,
+	0xd1, 0x76, 0x59, 0x70, 0xda, 0xca, 0xcf, 0x2f, 0xaa, 0x59, 0x21, 0x63, 0xe9, 0x68, 0x3e, 0x04,
+	0x58, 0xda, 0x91, 0x01, 0xda, 0xd7, 0xf4, 0x34, 0xea, 0x3f, 0x3f, 0xa2, 0x6d, 0xc8, 0x7e, 0x4b,
+	0x26, 0x33, 0xd9, 0xf0, 0x3c, 0x96, 0xc2, 0xa7, 0x99, 0x87, 0x6a, 0xed, 0xef, 0x0c, 0xe4, 0x17,
+	0x49, 0xa1, 0x0f, 0x41, 0x67, 0xa7, 0xbe, 0x1c, 0x9d, 0x8d, 0xa6, 0x75, 0x35, 0xed, 0xe5, 0x69,
+	0x70, 0xea, 0x53, 0x2c, 0xd0, 0xb5, 0x9f, 0x33, 0x50, 0x5a, 0xd1, 0xa3, 0x2a, 0xe8, 0xdd, 0x5e,
+	0xb7, 0x6d, 0x28, 0xe6, 0xcd, 0xb3, 0x73, 0x6b, 0x6b, 0xc5, 0xd8, 0xf5, 0x5c, 0x8a, 0x6e, 0x83,
+	0xd6, 0x3f, 0x3a, 0x34, 0x54, 0x73, 0xfb, 0xec, 0xdc, 0x32, 0x56, 0xec, 0xfd, 0xd9, 0x14, 0xdd,
+	0x81, 0xec, 0xe3, 0xde, 0x51, 0x77, 0x60, 0x64, 0xcc, 0x9d, 0xb3, 0x73, 0x0b, 0xad, 0x00, 0x1e,
+	0x7b, 0x33, 0x97, 0x71, 0x86, 0xc3, 0x4e, 0xd7, 0xd0, 0x52, 0x18, 0x0e, 0x1d, 0x57, 0x98, 0x77,
+	0x5f, 0x18, 0x7a, 0x9a, 0x99, 0xbc, 0xe2, 0x01, 0xf6, 0x3a, 0xb8, 0x3f, 0x30, 0xb2, 0x29, 0x01,
+	0xf6, 0x9c, 0x20, 0x64, 0xbc, 0x86, 0x83, 0xdd, 0xfe, 0xc0, 0xc8, 0xa5, 0xd4, 0x70, 0x40, 0x24,
+	0xe0, 0xb0, 0xbd, 0xdb, 0x35, 0xd6, 0x52, 0x00, 0x87, 0x94, 0xb8, 0xa6, 0xfe, 0xfd, 0x2f, 0x15,
+	0xa5, 0x76, 0x0f, 0xb4, 0x01, 0xb1, 0x93, 0x4d, 0x29, 0xa6, 0x34, 0xa5, 0x18, 0x35, 0xa5, 0xf6,
+	0x53, 0x01, 0x8a, 0xb2, 0xef, 0xa1, 0xef, 0xb9, 0x21, 0x45, 0x9f, 0x40, 0x6e, 0x1c, 0x90, 0x29,
+	0x0d, 0xcb, 0xaa, 0x18, 0x8f, 0x5b, 0x97, 0xc6, 0x43, 0xc2, 0x1a, 0x7b, 0x1c, 0xd3, 0xd2, 0xf9,
+	0x8d, 0xc5, 0x91, 0x83, 0xf9, 0x87, 0x0e, 0x59, 0xa1, 0x47, 0x8f, 0x20, 0x27, 0x07, 0x5b, 0x24,
+	0x50, 0x68, 0xde, 0x49, 0x27, 0x91, 0x57, 0x41, 0xb8, 0xec, 0x2b, 0x38, 0x72, 0x41, 0x5f, 0x42,
+	0x71, 0x3c, 0xf1, 0x08, 0x3b, 0x96, 0x63, 0x1e, 0x6d, 0x8d, 0xbb, 0x6f, 0xc8, 0x83, 0x23, 0xe5,
+	0xe5, 0x90, 0x29, 0x89, 0xdb, 0x92, 0xd0, 0xee, 0x2b, 0xb8, 0x30, 0x5e, 0x8a, 0x68, 0x04, 0x1b,
+	0x8e, 0xcb, 0xa8, 0x4d, 0x83, 0x98, 0x5f, 0x13, 0xfc, 0xf5, 0x74, 0xfe, 0x8e, 0xc4, 0x26, 0x23,
+	0x6c, 0xcd, 0x2f, 0xaa, 0xa5, 0x15, 0xfd, 0xbe, 0x82, 0x4b, 0x4e, 0x52, 0x81, 0x5e, 0xc2, 0xe6,
+	0xcc, 0x0d, 0x1d, 0xdb, 0xa5, 0xa3, 0x38, 0x8c, 0x2e, 0xc2, 0xbc, 0x9f, 0x1e, 0xe6, 0x28, 0x02,
+	0x27, 0xe3, 0x20, 0xbe, 0x0a, 0x57, 0x0d, 0xfb, 0x0a, 0xde, 0x98, 0xad, 0x68, 0x78, 0x3d, 0x43,
+	0xcf, 0x9b, 0x50, 0xe2, 0xc6, 0x81, 0xb2, 0x6f, 0xab, 0xa7, 0x25, 0xb1, 0x57, 0xea, 0x59, 0xd1,
+	0xf3, 0x7a, 0x86, 0x49, 0x05, 0xfa, 0x8a, 0xbf, 0x51, 0x81, 0xe3, 0xda, 0x71, 0x90, 0x9c, 0x08,
+	0xf2, 0xde, 0x1b, 0xfa, 0x2a, 0xa0, 0xc9, 0x18, 0x72, 0xf3, 0x25, 0xd4, 0xfb, 0x0a, 0x2e, 0x86,
+	0x09, 0xb9, 0x95, 0x03, 0x9d, 0x3f, 0x1d, 0x66, 0x00, 0x85, 0xc4, 0x58, 0xa0, 0xbb, 0xa0, 0x33,
+	0x62, 0xc7, 0xc3, 0x58, 0x5c, 0x3e, 0x1d, 0xc4, 0x8e, 0xa6, 0x4f, 0xd8, 0xd1, 0x23, 0xc8, 0x73,
+	0xf7, 0x63, 0xb1, 0x4f, 0x32, 0x62, 0x9f, 0x54, 0xd2, 0x93, 0x7b, 0x42, 0x18, 0x11, 0xdb, 0x64,
+	0x7d, 0x14, 0x9d, 0xcc, 0x2f, 0xc0, 0xb8, 0x3c, 0x47, 0xfc, 0x91, 0x59, 0x3c, 0x3b, 0x32, 0xbc,
+	0x81, 0x13, 0x1a, 0xb4, 0x03, 0x39, 0x71, 0x83, 0xf8, 0x7c, 0x6a, 0x75, 0x15, 0x47, 0x92, 0x79,
+	0x00, 0xe8, 0xea, 0xcc, 0x5c, 0x93, 0x4d, 0x5b, 0xb0, 0x1d, 0xc2, 0x8d, 0x94, 0xd1, 0xb8, 0x26,
+	0x9d, 0x9e, 0x4c, 0xee, 0xea, 0x00, 0x5c, 0x93, 0x6d, 0x7d, 0xc1, 0xf6, 0x0c, 0xb6, 0xae, 0x74,
+	0xfa, 0x9a, 0x64, 0xf9, 0x98, 0xac, 0xd6, 0x87, 0xbc, 0x20, 0x88, 0x16, 0x7a, 0xae, 0xdf, 0xc6,
+	0x9d, 0x76, 0xdf, 0x50, 0xcc, 0x1b, 0x67, 0xe7, 0xd6, 0xe6, 0xc2, 0x24, 0x67, 0x83, 0x03, 0x9e,
+	0xf7, 0x3a, 0xdd, 0x41, 0xdf, 0x50, 0x2f, 0x01, 0x64, 0x2e, 0xd1, 0x32, 0xfc, 0x4d, 0x85, 0xf5,
+	0xb8, 0xdf, 0xe8, 0x5d, 0xc8, 0xee, 0x1d, 0xf4, 0x76, 0x07, 0x86, 0x62, 0x6e, 0x9d, 0x9d, 0x5b,
+	0xa5, 0xd8, 0x20, 0x5a, 0x8f, 0x2c, 0x58, 0xeb, 0x74, 0x07, 0xed, 0xa7, 0x6d, 0x1c, 0x53, 0xc6,
+	0xf6, 0xa8, 0x9d, 0xa8, 0x06, 0xeb, 0x47, 0xdd, 0x7e, 0xe7, 0x69, 0xb7, 0xfd, 0xc4, 0xc8, 0xc8,
+	0x45, 0x1f, 0x43, 0xe2, 0x1e, 0x71, 0x96, 0x56, 0xaf, 0x77, 0xc0, 0xf7, 0xb4, 0xb6, 0xca, 0x12,
+	0xfd, 0xef, 0xa8, 0x02, 0xb9, 0xfe, 0x00, 0x77, 0xba, 0x4f, 0x0d, 0xdd, 0x44, 0x67, 0xe7, 0xd6,
+	0x46, 0x0c, 0x90, 0x7f, 0x65, 0x94, 0xf8, 0x0f, 0x2a, 0x6c, 0x3f, 0x26, 0x3e, 0x19, 0x3a, 0x13,
+	0x87, 0x39, 0x34, 0x5c, 0xac, 0xe7, 0x47, 0xa0, 0x9f, 0x10, 0x3f, 0xbe, 0x0f, 0xcb, 0xfb, 0x97,
+	0x06, 0xe6, 0xca, 0x50, 0xbc, 0xd1, 0x58, 0x38, 0x99, 0x1f, 0x43, 0x7e, 0xa1, 0xba, 0xd6, 0xb3,
+	0xbd, 0x09, 0xa5, 0x7d, 0xfe, 0xb7, 0xc6, 0xcc, 0xb5, 0x87, 0x70, 0xe9, 0x23, 0x8d, 0x3b, 0x87,
+	0x8c, 0x04, 0x4c, 0x10, 0x6a, 0x58, 0x0a, 0x3c, 0x08, 0x75, 0x47, 0x82, 0x50, 0xc3, 0xfc, 0xd8,
+	0xfc, 0x4b, 0x85, 0xb5, 0xbe, 0x4c, 0x9a, 0x17, 0xc3, 0xaf, 0x26, 0xda, 0x4e, 0xfb, 0x04, 0x31,
+	0x6f, 0xa6, 0xde, 0xdf, 0x9a, 0xfe, 0xdd, 0xaf, 0x65, 0xe5, 0x81, 0x8a, 0x9e, 0x41, 0x31, 0x59,
+	0x34, 0xda, 0x69, 0xc8, 0xcf, 0xdf, 0x46, 0xfc, 0xf9, 0xdb, 0x68, 0xf3, 0xcf, 0x5f, 0xf3, 0xf6,
+	0x5b, 0xff, 0x23, 0x41, 0xa7, 0xa2, 0xcf, 0x20, 0x2b, 0x0a, 0x7c, 0x23, 0xcb, 0xce, 0x82, 0x65,
+	0xf5, 0x8f, 0xe0, 0xee, 0x19, 0x53, 0xe4, 0xd4, 0xda, 0x7e, 0xfd, 0x6f, 0x45, 0x79, 0x3d, 0xaf,
+	0xa8, 0x7f, 0xce, 0x2b, 0xea, 0x3f, 0xf3, 0x8a, 0xfa, 0xe3, 0x7f, 0x15, 0x65, 0x98, 0x13, 0x4c,
+	0x1f, 0xfc, 0x3f, 0x00, 0x2c, 0xb0, 0x0f, 0x46, 0xe5, 0x0b, 0x00, 0x00,
 }
@@ -0,0 +1,71 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/influxdata/ifql/query/execute/storage"
+	"github.com/influxdata/ifql/query/plan"
+)
+
+// capsClient is a storage client that only reports its capabilities.
+type capsClient struct {
+	storage.StorageClient
+	caps  map[string]string
+	err   error
+	calls int
+}
+
+func (c *capsClient) Capabilities(ctx context.Context, in *types.Empty) (*storage.CapabilitiesResponse, error) {
+	c.calls++
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, errors.New("capabilities requested without a deadline")
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &storage.CapabilitiesResponse{Caps: c.caps}, nil
+}
+
+func TestStorageReader_HasCapability(t *testing.T) {
+	a := &capsClient{caps: map[string]string{"aggregates": "MIN,MAX,FIRST"}}
+	b := &capsClient{caps: map[string]string{"aggregates": "MAX, FIRST,LAST"}}
+	sr := newStorageReader([]connection{{host: "a", client: a}, {host: "b", client: b}})
+
+	for method, want := range map[string]bool{
+		"sum":   true,
+		"count": true,
+		"min":   false,
+		"max":   true,
+		"first": true,
+		"last":  false,
+		"mean":  false,
+	} {
+		if got := sr.HasCapability(plan.AggregateCapability(method)); got != want {
+			t.Errorf("unexpected capability of aggregate %q: got %t want %t", method, got, want)
+		}
+	}
+	if a.calls != 1 || b.calls != 1 {
+		t.Errorf("unexpected number of capabilities requests: got %d and %d want 1", a.calls, b.calls)
+	}
+}
+
+func TestStorageReader_HasCapability_Error(t *testing.T) {
+	c := &capsClient{err: errors.New("not implemented")}
+	sr := newStorageReader([]connection{{host: "a", client: c}})
+
+	if sr.HasCapability(plan.AggregateCapability("sum")) {
+		t.Error("unexpected capability of a storage layer that cannot report its capabilities")
+	}
+
+	// The capabilities are not requested again, planning a query must not wait for the storage layer.
+	c.err = nil
+	if sr.HasCapability(plan.AggregateCapability("sum")) {
+		t.Error("unexpected capability of a storage layer that could not report its capabilities")
+	}
+	if c.calls != 1 {
+		t.Errorf("unexpected number of capabilities requests: got %d want 1", c.calls)
+	}
+}
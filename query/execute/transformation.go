@@ -1,6 +1,7 @@
 package execute
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/influxdata/ifql/query"
@@ -17,8 +18,14 @@ type Transformation interface {
 
 type Administration interface {
 	ResolveTime(qt query.Time) Time
+	ResolveBounds(b plan.BoundsSpec) Bounds
 	Bounds() Bounds
 	Allocator() *Allocator
+	PointsWriter() PointsWriter
+	// Context is the context of the query, which is done once the query is cancelled.
+	Context() context.Context
+	// CSVDir is the directory of the files that sources may read, reading files is disabled if it is empty.
+	CSVDir() string
 	Parents() []DatasetID
 	ConvertID(plan.ProcedureID) DatasetID
 }
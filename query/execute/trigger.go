@@ -27,7 +27,7 @@ func NewTriggerFromSpec(spec query.TriggerSpec) Trigger {
 	switch s := spec.(type) {
 	case query.AfterWatermarkTriggerSpec:
 		return &afterWatermarkTrigger{
-			allowedLateness: Duration(s.AllowedLateness),
+			allowedLateness: Duration(s.AllowedLateness.Fixed),
 		}
 	case query.RepeatedTriggerSpec:
 		return &repeatedlyForever{
@@ -35,7 +35,7 @@ func NewTriggerFromSpec(spec query.TriggerSpec) Trigger {
 		}
 	case query.AfterProcessingTimeTriggerSpec:
 		return &afterProcessingTimeTrigger{
-			duration: Duration(s.Duration),
+			duration: Duration(s.Duration.Fixed),
 		}
 	case query.AfterAtLeastCountTriggerSpec:
 		return &afterAtLeastCount{
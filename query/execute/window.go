@@ -1,8 +1,23 @@
 package execute
 
+import "time"
+
 type Window struct {
 	Every  Duration
 	Period Duration
 	Round  Duration
 	Start  Time
+
+	// EveryMonths and PeriodMonths are the calendar months of every and period,
+	// they are added before the fixed durations.
+	EveryMonths  int64
+	PeriodMonths int64
+	// Location is the time zone in which the window boundaries are computed.
+	// Windows without a location and calendar months are fixed in UTC.
+	Location *time.Location
+}
+
+// IsCalendar reports whether the window boundaries are computed on the wall clock of a calendar.
+func (w Window) IsCalendar() bool {
+	return w.EveryMonths != 0 || w.PeriodMonths != 0 || w.Location != nil
 }
@@ -0,0 +1,275 @@
+package query
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/diagnostic"
+	"github.com/influxdata/ifql/interpreter"
+	"github.com/influxdata/ifql/parser"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/pkg/errors"
+)
+
+// packages are the registered packages by their path, they are loaded by FinalizeRegistration.
+var packages = make(map[string]*pkg)
+
+// list of registered package scripts by the path of their package
+var packageScripts = make(map[string][]string)
+
+// RegisterPackage adds the script to the package with the path, so that programs can import it.
+// The script must name the package with a package clause.
+// A package can be made up of several scripts, each script can use the declarations of the scripts registered before it.
+func RegisterPackage(path, script string) {
+	if finalized {
+		panic(errors.New("already finalized, cannot register package"))
+	}
+	packageScripts[path] = append(packageScripts[path], script)
+}
+
+// Import loads the registered packages imported by the program and declares them in the scope and the declarations.
+// Use a Loader to also import the packages of a search path.
+func Import(prog *ast.Program, scope *interpreter.Scope, declarations semantic.DeclarationScope) error {
+	return NewLoader().Import(prog, scope, declarations)
+}
+
+// Loader imports the registered packages and the packages of its search path.
+// The packages of the search path are loaded once and kept for later imports,
+// changes to their files are not seen by the loader.
+// A Loader is safe for concurrent use.
+type Loader struct {
+	searchPath []string
+
+	mu       sync.Mutex
+	packages map[string]*pkg
+}
+
+// NewLoader creates a loader that searches the directories for the packages that are not registered.
+// The package with the path "a/b" is made up of the .ifql files of the directory a/b,
+// in the first of the directories that has such files.
+func NewLoader(searchPath ...string) *Loader {
+	return &Loader{
+		searchPath: searchPath,
+		packages:   make(map[string]*pkg),
+	}
+}
+
+// Import loads the packages imported by the program and declares them in the scope and the declarations.
+// Each package is declared as an object of its exported members, the declarations whose names do not start with an underscore.
+// Problems loading the packages are returned as a diagnostic.List.
+func (l *Loader) Import(prog *ast.Program, scope *interpreter.Scope, declarations semantic.DeclarationScope) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ld := &loader{
+		packages:   l.packages,
+		loading:    make(map[string]bool),
+		searchPath: l.searchPath,
+	}
+	return ld.importPackages(prog, scope, declarations)
+}
+
+// pkg is a loaded package.
+type pkg struct {
+	name string
+	// values and declarations of every member of the package, including those that are not exported.
+	values       map[string]interpreter.Value
+	declarations semantic.DeclarationScope
+	// scope are the declarations of the members and of the packages that the files of the package import.
+	scope semantic.DeclarationScope
+}
+
+func exported(name string) bool {
+	return !strings.HasPrefix(name, "_")
+}
+
+// object returns the value that programs which import the package use.
+func (p *pkg) object() interpreter.Object {
+	obj := interpreter.Object{
+		Properties: make(map[string]interpreter.Value, len(p.values)),
+	}
+	for name, v := range p.values {
+		if exported(name) {
+			obj.Properties[name] = v
+		}
+	}
+	return obj
+}
+
+// declaration declares the package object with the name.
+func (p *pkg) declaration(name string) semantic.VariableDeclaration {
+	members := make(semantic.DeclarationScope, len(p.declarations))
+	for n, d := range p.declarations {
+		if exported(n) {
+			members[n] = d
+		}
+	}
+	return semantic.NewPackageDeclaration(name, members, p.scope)
+}
+
+// file is a parsed script of a package.
+type file struct {
+	name string
+	prog *ast.Program
+}
+
+// loader loads packages and the packages they import.
+type loader struct {
+	// packages are the packages loaded by the loader, in addition to the registered packages.
+	packages map[string]*pkg
+	// loading are the paths of the packages being loaded, an import of one of them is a cycle.
+	loading map[string]bool
+	// searchPath are the directories searched for the packages that are not registered.
+	searchPath []string
+}
+
+func (l *loader) importPackages(prog *ast.Program, scope *interpreter.Scope, declarations semantic.DeclarationScope) error {
+	var diags diagnostic.List
+	for _, imp := range prog.Imports {
+		p, err := l.load(imp.Path.Value)
+		if err != nil {
+			diags.Add(diagnostic.Errorf(diagnostic.ImportError, imp.Location(), "cannot import %q: %v", imp.Path.Value, err), diagnostic.ImportError)
+			continue
+		}
+		name := p.name
+		if imp.As != nil {
+			name = imp.As.Name
+		}
+		scope.Set(name, p.object())
+		declarations[name] = p.declaration(name)
+	}
+	return diags.Err()
+}
+
+func (l *loader) load(path string) (*pkg, error) {
+	if p, ok := packages[path]; ok {
+		return p, nil
+	}
+	if p, ok := l.packages[path]; ok {
+		return p, nil
+	}
+	if l.loading[path] {
+		return nil, fmt.Errorf("import cycle through package %q", path)
+	}
+	l.loading[path] = true
+	defer delete(l.loading, path)
+
+	files, err := l.files(path)
+	if err != nil {
+		return nil, err
+	}
+	p, err := l.eval(files)
+	if err != nil {
+		return nil, err
+	}
+	if p.name == "" {
+		return nil, fmt.Errorf("package %q does not declare its name", path)
+	}
+	l.packages[path] = p
+	return p, nil
+}
+
+// files finds the scripts of the package, either registered or in the search path.
+func (l *loader) files(pkgPath string) ([]file, error) {
+	if scripts, ok := packageScripts[pkgPath]; ok {
+		files := make([]file, len(scripts))
+		for i, script := range scripts {
+			prog, err := parser.NewAST(script)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse script %d of package %q", i, pkgPath)
+			}
+			files[i] = file{name: pkgPath, prog: prog}
+		}
+		return files, nil
+	}
+
+	// Packages in the search path cannot be outside of the search path.
+	if clean := path.Clean(pkgPath); pkgPath == "" || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return nil, fmt.Errorf("invalid package path %q", pkgPath)
+	}
+	for _, dir := range l.searchPath {
+		names, err := filepath.Glob(filepath.Join(dir, filepath.FromSlash(pkgPath), "*.ifql"))
+		if err != nil {
+			return nil, err
+		}
+		if len(names) == 0 {
+			continue
+		}
+		sort.Strings(names)
+		files := make([]file, len(names))
+		for i, name := range names {
+			src, err := ioutil.ReadFile(name)
+			if err != nil {
+				return nil, err
+			}
+			prog, err := parser.NewAST(string(src))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s", name)
+			}
+			files[i] = file{name: name, prog: prog}
+		}
+		return files, nil
+	}
+	return nil, fmt.Errorf("package %q not found", pkgPath)
+}
+
+// eval evaluates the files of a package in order.
+// Each file imports its own packages, and can use the declarations of the files before it.
+func (l *loader) eval(files []file) (*pkg, error) {
+	p := &pkg{
+		values:       make(map[string]interpreter.Value),
+		declarations: make(semantic.DeclarationScope),
+		scope:        builtinDeclarations.Copy(),
+	}
+	scope := builtinScope.Nest()
+	declarations := builtinDeclarations.Copy()
+	for _, f := range files {
+		if f.prog.Package != nil {
+			name := f.prog.Package.Name.Name
+			if p.name != "" && p.name != name {
+				return nil, fmt.Errorf("%s: found package %q, expected package %q", f.name, name, p.name)
+			}
+			p.name = name
+		}
+
+		fileScope := scope.Nest()
+		fileDeclarations := declarations.Copy()
+		if err := l.importPackages(f.prog, fileScope, fileDeclarations); err != nil {
+			return nil, errors.Wrapf(err, "failed to import packages of %s", f.name)
+		}
+		semProg, err := semantic.New(f.prog, fileDeclarations.Copy())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create semantic graph of %s", f.name)
+		}
+		if err := semantic.Infer(semProg, fileDeclarations); err != nil {
+			return nil, errors.Wrapf(err, "failed to check types of %s", f.name)
+		}
+		if err := interpreter.Eval(semProg, fileScope, new(queryDomain)); err != nil {
+			return nil, errors.Wrapf(err, "failed to evaluate %s", f.name)
+		}
+
+		// The members of the file are typed with the packages it imports.
+		for name, d := range fileDeclarations {
+			p.scope[name] = d
+		}
+		for _, s := range semProg.Body {
+			d, ok := s.(*semantic.NativeVariableDeclaration)
+			if !ok {
+				continue
+			}
+			name := d.Identifier.Name
+			v, _ := fileScope.Lookup(name)
+			p.values[name] = v
+			p.declarations[name] = d
+			p.scope[name] = d
+			scope.Set(name, v)
+			declarations[name] = d
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,215 @@
+package query_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/influxdata/ifql/diagnostic"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func init() {
+	query.RegisterPackage("testing/helpers", `
+package helpers
+
+_n = 5
+
+firstN = (table=<-) => table |> limit(n:_n)
+`)
+	query.FinalizeRegistration()
+}
+
+func TestImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ifql-packages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"mylib/a.ifql": `
+package mylib
+
+total = (table=<-) => table |> count()
+`,
+		// Files are evaluated in order, so b can use the declarations of a.
+		"mylib/b.ifql": `
+package mylib
+
+import "testing/helpers"
+
+anomaly = (table=<-) => table |> helpers.firstN() |> total()
+`,
+	}
+	for name, src := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	loader := query.NewLoader(dir)
+
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "builtin package",
+			Raw: `import "selectors"
+			from(db:"mydb") |> selectors.top(n:2)`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from0",
+						Spec: &functions.FromOpSpec{Database: "mydb"},
+					},
+					{
+						ID:   "sort1",
+						Spec: &functions.SortOpSpec{Cols: []string{"_value"}, Desc: true},
+					},
+					{
+						ID:   "limit2",
+						Spec: &functions.LimitOpSpec{N: 2},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "sort1"},
+					{Parent: "sort1", Child: "limit2"},
+				},
+			},
+		},
+		{
+			Name: "registered package with name",
+			Raw: `import h "testing/helpers"
+			from(db:"mydb") |> h.firstN()`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from0",
+						Spec: &functions.FromOpSpec{Database: "mydb"},
+					},
+					{
+						ID:   "limit1",
+						Spec: &functions.LimitOpSpec{N: 5},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "limit1"},
+				},
+			},
+		},
+		{
+			Name: "package in search path",
+			Raw: `import "mylib"
+			from(db:"mydb") |> mylib.anomaly()`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   "from0",
+						Spec: &functions.FromOpSpec{Database: "mydb"},
+					},
+					{
+						ID:   "limit1",
+						Spec: &functions.LimitOpSpec{N: 5},
+					},
+					{
+						ID:   "count2",
+						Spec: &functions.CountOpSpec{},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "limit1"},
+					{Parent: "limit1", Child: "count2"},
+				},
+			},
+		},
+		{
+			Name: "unexported member",
+			Raw: `import "testing/helpers"
+			helpers._n`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			querytest.NewQueryTestHelper(t, tc, query.WithLoader(loader))
+		})
+	}
+}
+
+func TestLoader_Cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ifql-packages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "mylib"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "mylib", "mylib.ifql")
+	if err := ioutil.WriteFile(path, []byte("package mylib\nn = 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loader := query.NewLoader(dir)
+
+	raw := `import "mylib"
+	from(db:"mydb") |> limit(n:mylib.n)`
+	if _, err := query.Compile(context.Background(), raw, query.WithLoader(loader)); err != nil {
+		t.Fatal(err)
+	}
+	// The package is not read again once it is loaded.
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := query.Compile(context.Background(), raw, query.WithLoader(loader)); err != nil {
+		t.Fatal(err)
+	}
+	// Other loaders do not share the packages.
+	if _, err := query.Compile(context.Background(), raw, query.WithLoader(query.NewLoader(dir))); err == nil {
+		t.Fatal("expected error importing a package that was removed")
+	}
+}
+
+func TestImport_Errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ifql-packages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "cycle"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "cycle", "cycle.ifql"), []byte("package cycle\nimport \"cycle\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loader := query.NewLoader(dir)
+
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "not found", raw: `import "missing"`},
+		{name: "outside of search path", raw: `import "../cycle"`},
+		{name: "cycle", raw: `import "cycle"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := query.Compile(context.Background(), tc.raw, query.WithLoader(loader))
+			diags, ok := err.(diagnostic.List)
+			if !ok || len(diags) != 1 {
+				t.Fatalf("expected a single diagnostic, got %v", err)
+			}
+			if got, want := diags[0].Code, diagnostic.ImportError; got != want {
+				t.Errorf("unexpected code: got %q want %q", got, want)
+			}
+			if diags[0].Loc == nil || diags[0].Loc.Start.Line != 1 {
+				t.Errorf("unexpected location: %v", diags[0].Loc)
+			}
+		})
+	}
+}
@@ -53,14 +53,22 @@ type PlanRewriter interface {
 
 type planner struct {
 	plan *PlanSpec
+	caps Capabilities
 
 	modified bool
 }
 
+// NewPlanner creates a planner that only applies the push down rules that do not need a storage capability.
 func NewPlanner() Planner {
 	return new(planner)
 }
 
+// NewPlannerWithCapabilities creates a planner that also applies the push down rules
+// that need one of the capabilities of the storage layer.
+func NewPlannerWithCapabilities(caps Capabilities) Planner {
+	return &planner{caps: caps}
+}
+
 func (p *planner) Plan(lp *LogicalPlanSpec, s Storage, now time.Time) (*PlanSpec, error) {
 	p.plan = &PlanSpec{
 		Now:        now,
@@ -96,12 +104,24 @@ func (p *planner) Plan(lp *LogicalPlanSpec, s Storage, now time.Time) (*PlanSpec
 			if pd, ok := pr.Spec.(PushDownProcedureSpec); ok {
 				rules := pd.PushDownRules()
 				for _, rule := range rules {
-					if remove, err := p.pushDownAndSearch(pr, rule, pd.PushDown); err != nil {
+					if !p.hasCapability(rule.Capability) {
+						continue
+					}
+					do := pd.PushDown
+					if rule.PushDown != nil {
+						do = rule.PushDown
+					}
+					if remove, err := p.pushDownAndSearch(pr, rule, do); err != nil {
 						return nil, err
 					} else if remove {
 						if err := p.removeProcedure(pr); err != nil {
 							return nil, errors.Wrap(err, "failed to remove procedure")
 						}
+						break
+					}
+					if p.plan.Procedures[id] == nil {
+						// Procedure was replaced by a duplicate, its rules are applied to the duplicate.
+						break
 					}
 				}
 			}
@@ -182,6 +202,13 @@ func (p *planner) Plan(lp *LogicalPlanSpec, s Storage, now time.Time) (*PlanSpec
 	return p.plan, nil
 }
 
+func (p *planner) hasCapability(name string) bool {
+	if name == "" {
+		return true
+	}
+	return p.caps != nil && p.caps.HasCapability(name)
+}
+
 func hasKind(kind ProcedureKind, kinds []ProcedureKind) bool {
 	for _, k := range kinds {
 		if k == kind {
@@ -15,6 +15,7 @@ import (
 func TestPhysicalPlanner_Plan(t *testing.T) {
 	testCases := []struct {
 		name string
+		caps plan.Capabilities
 		lp   *plan.LogicalPlanSpec
 		pp   *plan.PlanSpec
 	}{
@@ -166,6 +167,70 @@ func TestPhysicalPlanner_Plan(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "single push down with match and aggregate capability",
+			caps: plantest.Capabilities{
+				plan.AggregateCapability(functions.LastKind): true,
+			},
+			lp: &plan.LogicalPlanSpec{
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("last")},
+					},
+					plan.ProcedureIDFromOperationID("last"): {
+						ID:   plan.ProcedureIDFromOperationID("last"),
+						Spec: &functions.LastProcedureSpec{},
+						Parents: []plan.ProcedureID{
+							(plan.ProcedureIDFromOperationID("from")),
+						},
+						Children: nil,
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("last"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 1,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Bounds: plan.BoundsSpec{
+					Start: query.MinTime,
+					Stop:  query.Now,
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.MinTime,
+								Stop:  query.Now,
+							},
+							AggregateSet:    true,
+							AggregateMethod: functions.LastKind,
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{},
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: plan.ProcedureIDFromOperationID("from")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+				},
+			},
+		},
 		{
 			name: "multiple push down",
 			lp: &plan.LogicalPlanSpec{
@@ -489,11 +554,306 @@ func TestPhysicalPlanner_Plan(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "window with aggregate",
+			caps: plantest.Capabilities{
+				plan.AggregateCapability(functions.MaxKind): true,
+			},
+			lp: &plan.LogicalPlanSpec{
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+					},
+					plan.ProcedureIDFromOperationID("range"): {
+						ID: plan.ProcedureIDFromOperationID("range"),
+						Spec: &functions.RangeProcedureSpec{
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+					},
+					plan.ProcedureIDFromOperationID("window"): {
+						ID: plan.ProcedureIDFromOperationID("window"),
+						Spec: &functions.WindowProcedureSpec{
+							Window: plan.WindowSpec{
+								Every:  query.Duration{Fixed: time.Minute},
+								Period: query.Duration{Fixed: time.Minute},
+							},
+							Triggering: query.DefaultTrigger,
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("max")},
+					},
+					plan.ProcedureIDFromOperationID("max"): {
+						ID:       plan.ProcedureIDFromOperationID("max"),
+						Spec:     &functions.MaxProcedureSpec{},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+						Children: nil,
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("range"),
+					plan.ProcedureIDFromOperationID("window"),
+					plan.ProcedureIDFromOperationID("max"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 1,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Bounds: plan.BoundsSpec{
+					Start: query.Time{
+						IsRelative: true,
+						Relative:   -1 * time.Hour,
+					},
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+							WindowSet: true,
+							Window: plan.WindowSpec{
+								Every:  query.Duration{Fixed: time.Minute},
+								Period: query.Duration{Fixed: time.Minute},
+							},
+							AggregateSet:    true,
+							AggregateMethod: functions.MaxKind,
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{},
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: plan.ProcedureIDFromOperationID("from")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+				},
+			},
+		},
+		{
+			name: "window with aggregate without capability",
+			lp: &plan.LogicalPlanSpec{
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+					},
+					plan.ProcedureIDFromOperationID("range"): {
+						ID: plan.ProcedureIDFromOperationID("range"),
+						Spec: &functions.RangeProcedureSpec{
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+					},
+					plan.ProcedureIDFromOperationID("window"): {
+						ID: plan.ProcedureIDFromOperationID("window"),
+						Spec: &functions.WindowProcedureSpec{
+							Window: plan.WindowSpec{
+								Every:  query.Duration{Fixed: time.Minute},
+								Period: query.Duration{Fixed: time.Minute},
+							},
+							Triggering: query.DefaultTrigger,
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("max")},
+					},
+					plan.ProcedureIDFromOperationID("max"): {
+						ID:       plan.ProcedureIDFromOperationID("max"),
+						Spec:     &functions.MaxProcedureSpec{},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+						Children: nil,
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("range"),
+					plan.ProcedureIDFromOperationID("window"),
+					plan.ProcedureIDFromOperationID("max"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 2,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Bounds: plan.BoundsSpec{
+					Start: query.Time{
+						IsRelative: true,
+						Relative:   -1 * time.Hour,
+					},
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+							WindowSet: true,
+							Window: plan.WindowSpec{
+								Every:  query.Duration{Fixed: time.Minute},
+								Period: query.Duration{Fixed: time.Minute},
+							},
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("max")},
+					},
+					plan.ProcedureIDFromOperationID("max"): {
+						ID:       plan.ProcedureIDFromOperationID("max"),
+						Spec:     &functions.MaxProcedureSpec{},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: nil,
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: plan.ProcedureIDFromOperationID("max")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("max"),
+				},
+			},
+		},
+		{
+			name: "field pivot",
+			lp: &plan.LogicalPlanSpec{
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+					},
+					plan.ProcedureIDFromOperationID("range"): {
+						ID: plan.ProcedureIDFromOperationID("range"),
+						Spec: &functions.RangeProcedureSpec{
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("pivot")},
+					},
+					plan.ProcedureIDFromOperationID("pivot"): {
+						ID: plan.ProcedureIDFromOperationID("pivot"),
+						Spec: &functions.PivotProcedureSpec{
+							RowKey:   []string{"_time"},
+							ColKey:   []string{"_field"},
+							ValueCol: "_value",
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+						Children: nil,
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("range"),
+					plan.ProcedureIDFromOperationID("pivot"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 2,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Bounds: plan.BoundsSpec{
+					Start: query.Time{
+						IsRelative: true,
+						Relative:   -1 * time.Hour,
+					},
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+							GroupingSet: true,
+							GroupExcept: []string{"_field"},
+							GroupKeep:   []string{"_field"},
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("pivot")},
+					},
+					plan.ProcedureIDFromOperationID("pivot"): {
+						ID: plan.ProcedureIDFromOperationID("pivot"),
+						Spec: &functions.PivotProcedureSpec{
+							RowKey:   []string{"_time"},
+							ColKey:   []string{"_field"},
+							ValueCol: "_value",
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: nil,
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: plan.ProcedureIDFromOperationID("pivot")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("pivot"),
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			PhysicalPlanTestHelper(t, tc.lp, tc.pp)
+			planner := plan.NewPlanner()
+			if tc.caps != nil {
+				planner = plan.NewPlannerWithCapabilities(tc.caps)
+			}
+			physicalPlanTestHelper(t, planner, tc.lp, tc.pp)
 		})
 	}
 }
@@ -735,13 +1095,151 @@ func TestPhysicalPlanner_Plan_PushDown_Mixed(t *testing.T) {
 	PhysicalPlanTestHelper(t, lp, want)
 }
 
+func TestPhysicalPlanner_Plan_PushDown_Mixed_Stddev(t *testing.T) {
+	lp := &plan.LogicalPlanSpec{
+		Procedures: map[plan.ProcedureID]*plan.Procedure{
+			plan.ProcedureIDFromOperationID("from"): {
+				ID: plan.ProcedureIDFromOperationID("from"),
+				Spec: &functions.FromProcedureSpec{
+					Database: "mydb",
+				},
+				Parents:  nil,
+				Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+			},
+			plan.ProcedureIDFromOperationID("range"): {
+				ID: plan.ProcedureIDFromOperationID("range"),
+				Spec: &functions.RangeProcedureSpec{
+					Bounds: plan.BoundsSpec{
+						Start: query.Time{
+							IsRelative: true,
+							Relative:   -1 * time.Hour,
+						},
+					},
+				},
+				Parents: []plan.ProcedureID{
+					(plan.ProcedureIDFromOperationID("from")),
+				},
+				Children: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("sum"),
+					plan.ProcedureIDFromOperationID("stddev"),
+				},
+			},
+			plan.ProcedureIDFromOperationID("sum"): {
+				ID:       plan.ProcedureIDFromOperationID("sum"),
+				Spec:     &functions.SumProcedureSpec{},
+				Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+				Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("yieldSum")},
+			},
+			plan.ProcedureIDFromOperationID("yieldSum"): {
+				ID:       plan.ProcedureIDFromOperationID("yieldSum"),
+				Spec:     &functions.YieldProcedureSpec{Name: "sum"},
+				Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("sum")},
+				Children: nil,
+			},
+			plan.ProcedureIDFromOperationID("stddev"): {
+				ID:       plan.ProcedureIDFromOperationID("stddev"),
+				Spec:     &functions.StddevProcedureSpec{},
+				Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+				Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("yieldStddev")},
+			},
+			plan.ProcedureIDFromOperationID("yieldStddev"): {
+				ID:       plan.ProcedureIDFromOperationID("yieldStddev"),
+				Spec:     &functions.YieldProcedureSpec{Name: "stddev"},
+				Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("stddev")},
+				Children: nil,
+			},
+		},
+		Order: []plan.ProcedureID{
+			plan.ProcedureIDFromOperationID("from"),
+			plan.ProcedureIDFromOperationID("range"),
+			plan.ProcedureIDFromOperationID("sum"),
+			plan.ProcedureIDFromOperationID("yieldSum"),
+			plan.ProcedureIDFromOperationID("stddev"), // Stddev can't be pushed down with any capability, but sum can
+			plan.ProcedureIDFromOperationID("yieldStddev"),
+		},
+	}
+
+	fromID := plan.ProcedureIDFromOperationID("from")
+	fromIDDup := plan.ProcedureIDForDuplicate(fromID)
+	want := &plan.PlanSpec{
+		Bounds: plan.BoundsSpec{
+			Start: query.Time{
+				IsRelative: true,
+				Relative:   -1 * time.Hour,
+			},
+		},
+		Resources: query.ResourceManagement{
+			ConcurrencyQuota: 3,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Procedures: map[plan.ProcedureID]*plan.Procedure{
+			fromIDDup: {
+				ID: fromIDDup,
+				Spec: &functions.FromProcedureSpec{
+					Database:  "mydb",
+					BoundsSet: true,
+					Bounds: plan.BoundsSpec{
+						Start: query.Time{
+							IsRelative: true,
+							Relative:   -1 * time.Hour,
+						},
+					},
+					AggregateSet:    true,
+					AggregateMethod: "sum",
+				},
+				Parents:  []plan.ProcedureID{},
+				Children: []plan.ProcedureID{},
+			},
+			plan.ProcedureIDFromOperationID("from"): {
+				ID: plan.ProcedureIDFromOperationID("from"),
+				Spec: &functions.FromProcedureSpec{
+					Database:  "mydb",
+					BoundsSet: true,
+					Bounds: plan.BoundsSpec{
+						Start: query.Time{
+							IsRelative: true,
+							Relative:   -1 * time.Hour,
+						},
+					},
+				},
+				Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("stddev")},
+			},
+			plan.ProcedureIDFromOperationID("stddev"): {
+				ID:       plan.ProcedureIDFromOperationID("stddev"),
+				Spec:     &functions.StddevProcedureSpec{},
+				Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+				Children: []plan.ProcedureID{},
+			},
+		},
+		Results: map[string]plan.YieldSpec{
+			"sum":    {ID: fromIDDup},
+			"stddev": {ID: plan.ProcedureIDFromOperationID("stddev")},
+		},
+		Order: []plan.ProcedureID{
+			fromID,
+			fromIDDup,
+			plan.ProcedureIDFromOperationID("stddev"),
+		},
+	}
+
+	caps := plantest.Capabilities{
+		plan.AggregateCapability("mean"):   true,
+		plan.AggregateCapability("stddev"): true,
+	}
+	physicalPlanTestHelper(t, plan.NewPlannerWithCapabilities(caps), lp, want)
+}
+
 func PhysicalPlanTestHelper(t *testing.T, lp *plan.LogicalPlanSpec, want *plan.PlanSpec) {
+	t.Helper()
+	physicalPlanTestHelper(t, plan.NewPlanner(), lp, want)
+}
+
+func physicalPlanTestHelper(t *testing.T, planner plan.Planner, lp *plan.LogicalPlanSpec, want *plan.PlanSpec) {
 	t.Helper()
 	// Setup expected now time
 	now := time.Now()
 	want.Now = now
 
-	planner := plan.NewPlanner()
 	got, err := planner.Plan(lp, nil, now)
 	if err != nil {
 		t.Fatal(err)
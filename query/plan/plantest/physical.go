@@ -13,6 +13,13 @@ var CmpOptions = []cmp.Option{
 	cmpopts.IgnoreUnexported(plan.Procedure{}),
 }
 
+// Capabilities is a set of storage capabilities, see plan.Capabilities.
+type Capabilities map[string]bool
+
+func (c Capabilities) HasCapability(name string) bool {
+	return c[name]
+}
+
 func PhysicalPlan_PushDown_Match_TestHelper(t *testing.T, spec plan.PushDownProcedureSpec, matchSpec plan.ProcedureSpec, want []bool) {
 	t.Helper()
 
@@ -30,9 +37,26 @@ func PhysicalPlan_PushDown_Match_TestHelper(t *testing.T, spec plan.PushDownProc
 
 func PhysicalPlan_PushDown_TestHelper(t *testing.T, spec plan.PushDownProcedureSpec, root *plan.Procedure, wantDuplicated bool, want *plan.Procedure) {
 	t.Helper()
+	pushDownTestHelper(t, spec.PushDown, root, wantDuplicated, want)
+}
+
+// PhysicalPlan_PushDownRule_TestHelper is like PhysicalPlan_PushDown_TestHelper
+// for the push down of the i-th rule of the spec.
+func PhysicalPlan_PushDownRule_TestHelper(t *testing.T, spec plan.PushDownProcedureSpec, i int, root *plan.Procedure, wantDuplicated bool, want *plan.Procedure) {
+	t.Helper()
+	rule := spec.PushDownRules()[i]
+	do := spec.PushDown
+	if rule.PushDown != nil {
+		do = rule.PushDown
+	}
+	pushDownTestHelper(t, do, root, wantDuplicated, want)
+}
+
+func pushDownTestHelper(t *testing.T, do func(root *plan.Procedure, dup func() *plan.Procedure), root *plan.Procedure, wantDuplicated bool, want *plan.Procedure) {
+	t.Helper()
 
 	var duplicate *plan.Procedure
-	spec.PushDown(root, func() *plan.Procedure {
+	do(root, func() *plan.Procedure {
 		duplicate = root.Copy()
 		return duplicate
 	})
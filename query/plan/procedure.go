@@ -96,6 +96,23 @@ type PushDownRule struct {
 	Root    ProcedureKind
 	Through []ProcedureKind
 	Match   func(ProcedureSpec) bool
+
+	// Capability is the name of the capability the storage layer must have for the rule to apply.
+	// The rule applies to any storage layer when it is empty.
+	Capability string
+	// PushDown, when set, is called in place of the PushDown method of the procedure spec.
+	PushDown func(root *Procedure, dup func() *Procedure)
+}
+
+// Capabilities reports the optional capabilities of a storage layer.
+type Capabilities interface {
+	HasCapability(name string) bool
+}
+
+// AggregateCapability is the name of the capability of a storage layer to compute the aggregate method,
+// see AggregateProcedureSpec.
+func AggregateCapability(method string) string {
+	return "aggregate:" + method
 }
 
 // ProcedureKind denotes the kind of operations.
@@ -104,9 +121,26 @@ type ProcedureKind string
 type BoundsSpec struct {
 	Start query.Time
 	Stop  query.Time
+	// Location is the name of the time zone in which calendar months of relative bounds are counted.
+	// The zero value is UTC.
+	Location string
+}
+
+// Resolve returns the start and stop times of the bounds relative to now.
+func (b BoundsSpec) Resolve(now time.Time) (start, stop time.Time) {
+	if b.Location != "" {
+		if loc, err := time.LoadLocation(b.Location); err == nil {
+			now = now.In(loc)
+		}
+	}
+	return b.Start.Time(now), b.Stop.Time(now)
 }
 
 func (b BoundsSpec) Union(o BoundsSpec, now time.Time) (u BoundsSpec) {
+	u.Location = b.Location
+	if u.Location == "" {
+		u.Location = o.Location
+	}
 	u.Start = b.Start
 	if u.Start.IsZero() || (!o.Start.IsZero() && o.Start.Time(now).Before(b.Start.Time(now))) {
 		u.Start = o.Start
@@ -123,6 +157,15 @@ type WindowSpec struct {
 	Period query.Duration
 	Round  query.Duration
 	Start  query.Time
+	// Location is the name of the time zone in which window boundaries are computed.
+	// The zero value is UTC.
+	Location string
+}
+
+// IsCalendar reports whether the window boundaries depend on the calendar,
+// either because its durations have calendar months or it is in a time zone.
+func (w WindowSpec) IsCalendar() bool {
+	return w.Every.IsCalendar() || w.Period.IsCalendar() || w.Location != ""
 }
 
 var kindToProcedure = make(map[ProcedureKind]CreateProcedureSpec)
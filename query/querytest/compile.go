@@ -23,10 +23,11 @@ var opts = append(
 	cmpopts.IgnoreUnexported(query.Spec{}),
 )
 
-func NewQueryTestHelper(t *testing.T, tc NewQueryTestCase) {
+// NewQueryTestHelper compiles the query of the test case with the compile options.
+func NewQueryTestHelper(t *testing.T, tc NewQueryTestCase, compileOpts ...query.Option) {
 	t.Helper()
 
-	got, err := query.Compile(context.Background(), tc.Raw)
+	got, err := query.Compile(context.Background(), tc.Raw, compileOpts...)
 	if (err != nil) != tc.WantErr {
 		t.Errorf("ifql.NewQuery() error = %v, wantErr %v", err, tc.WantErr)
 		return
@@ -1,7 +1,9 @@
 package query
 
 import (
+	"fmt"
 	"math"
+	"strconv"
 	"time"
 )
 
@@ -20,16 +22,19 @@ var (
 // Time represents either a relavite or absolute time.
 // If Time is its zero value then it represents a time.Time{}.
 // To represent the now time you must set IsRelative to true.
+// A relative time may include calendar months, which are added to now before Relative.
 type Time struct {
 	IsRelative bool
+	Months     int64
 	Relative   time.Duration
 	Absolute   time.Time
 }
 
 // Time returns the time specified relative to now.
+// Calendar months are added in the location of now.
 func (t Time) Time(now time.Time) time.Time {
 	if t.IsRelative {
-		return now.Add(t.Relative)
+		return Duration{Months: t.Months, Fixed: t.Relative}.AddTo(now)
 	}
 	return t.Absolute
 }
@@ -40,28 +45,25 @@ func (t Time) IsZero() bool {
 
 func (t *Time) UnmarshalText(data []byte) error {
 	if len(data) == 0 {
-		t.Absolute = time.Time{}
-		t.Relative = 0
-		t.IsRelative = false
+		*t = Time{}
 		return nil
 	}
 
 	str := string(data)
 	if str == "now" {
-		t.Relative = 0
-		t.Absolute = time.Time{}
-		t.IsRelative = true
+		*t = Now
 		return nil
 	}
-	d, err := time.ParseDuration(str)
+	d, err := parseDuration(str)
 	if err == nil {
-		t.Relative = d
-		t.Absolute = time.Time{}
-		t.IsRelative = true
+		*t = Time{
+			IsRelative: true,
+			Months:     d.Months,
+			Relative:   d.Fixed,
+		}
 		return nil
 	}
-	t.IsRelative = false
-	t.Relative = 0
+	*t = Time{}
 	t.Absolute, err = time.Parse(time.RFC3339Nano, str)
 	if err != nil {
 		return err
@@ -72,27 +74,140 @@ func (t *Time) UnmarshalText(data []byte) error {
 
 func (t Time) MarshalText() ([]byte, error) {
 	if t.IsRelative {
-		if t.Relative == 0 {
+		if t.Relative == 0 && t.Months == 0 {
 			return []byte("now"), nil
 		}
-		return []byte(t.Relative.String()), nil
+		return Duration{Months: t.Months, Fixed: t.Relative}.MarshalText()
 	}
 	return []byte(t.Absolute.Format(time.RFC3339Nano)), nil
 }
 
 // Duration is a marshalable duration type.
-//TODO make this the real duration parsing not just time.ParseDuration
-type Duration time.Duration
+// A duration is a number of calendar months followed by a fixed length of time,
+// the length of a month depends on the time the duration is added to.
+type Duration struct {
+	Months int64
+	Fixed  time.Duration
+}
+
+// IsZero reports whether the duration has no length.
+func (d Duration) IsZero() bool {
+	return d.Months == 0 && d.Fixed == 0
+}
+
+// IsCalendar reports whether the length of the duration depends on the calendar.
+func (d Duration) IsCalendar() bool {
+	return d.Months != 0
+}
+
+// AddTo returns the time t plus the duration, months are added in the location of t.
+func (d Duration) AddTo(t time.Time) time.Time {
+	if d.Months != 0 {
+		t = t.AddDate(0, int(d.Months), 0)
+	}
+	return t.Add(d.Fixed)
+}
+
+func (d Duration) String() string {
+	if d.Months == 0 {
+		return d.Fixed.String()
+	}
+	s := strconv.FormatInt(d.Months, 10) + "mo"
+	switch {
+	case d.Fixed == 0:
+	case d.Fixed < 0 && d.Months < 0:
+		s += (-d.Fixed).String()
+	case d.Fixed > 0 && d.Months < 0:
+		s += "+" + d.Fixed.String()
+	default:
+		s += d.Fixed.String()
+	}
+	return s
+}
 
 func (d *Duration) UnmarshalText(data []byte) error {
-	dur, err := time.ParseDuration(string(data))
+	dur, err := parseDuration(string(data))
 	if err != nil {
 		return err
 	}
-	*d = Duration(dur)
+	*d = dur
 	return nil
 }
 
 func (d Duration) MarshalText() ([]byte, error) {
-	return []byte(time.Duration(d).String()), nil
+	return []byte(d.String()), nil
+}
+
+// parseDuration parses a duration in the format of time.ParseDuration
+// extended with the units d (day), w (week), mo (month) and y (year).
+// Days and weeks are fixed multiples of 24 hours, months and years are calendar months.
+// A sign applies to all following components until the next sign, so -1mo2h is minus one month and two hours.
+func parseDuration(s string) (Duration, error) {
+	var d Duration
+	if s == "" {
+		return d, fmt.Errorf("invalid duration %q", s)
+	}
+	orig := s
+	neg := false
+	for s != "" {
+		switch s[0] {
+		case '-':
+			neg = true
+			s = s[1:]
+		case '+':
+			neg = false
+			s = s[1:]
+		}
+		i := 0
+		for i < len(s) && (s[i] == '.' || '0' <= s[i] && s[i] <= '9') {
+			i++
+		}
+		j := i
+		for j < len(s) && s[j] != '.' && s[j] != '-' && s[j] != '+' && (s[j] < '0' || s[j] > '9') {
+			j++
+		}
+		magnitude, unit := s[:i], s[i:j]
+		if magnitude == "" || unit == "" {
+			return Duration{}, fmt.Errorf("invalid duration %q", orig)
+		}
+		s = s[j:]
+
+		switch unit {
+		case "y", "mo":
+			n, err := strconv.ParseInt(magnitude, 10, 64)
+			if err != nil {
+				return Duration{}, fmt.Errorf("invalid duration %q: months must be whole numbers", orig)
+			}
+			if unit == "y" {
+				n *= 12
+			}
+			if neg {
+				n = -n
+			}
+			d.Months += n
+		case "w", "d":
+			hours, err := time.ParseDuration(magnitude + "h")
+			if err != nil {
+				return Duration{}, fmt.Errorf("invalid duration %q", orig)
+			}
+			fixed := 24 * hours
+			if unit == "w" {
+				fixed *= 7
+			}
+			if neg {
+				fixed = -fixed
+			}
+			d.Fixed += fixed
+		default:
+			fixed, err := time.ParseDuration(magnitude + unit)
+			if err != nil {
+				return Duration{}, fmt.Errorf("invalid duration %q", orig)
+			}
+			if neg {
+				fixed = -fixed
+			}
+			d.Fixed += fixed
+		}
+	}
+	return d, nil
 }
@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 
 	prompt "github.com/c-bata/go-prompt"
+	"github.com/influxdata/ifql/diagnostic"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/interpreter"
 	"github.com/influxdata/ifql/parser"
@@ -125,7 +126,10 @@ func (r *REPL) Input(t string) error {
 // input processes a line of input and prints the result.
 func (r *REPL) input(t string) {
 	v, err := r.executeLine(t, true)
-	if err != nil {
+	if _, ok := err.(*scriptError); ok {
+		// The diagnostics already read as errors.
+		fmt.Println(err)
+	} else if err != nil {
 		fmt.Println("Error:", err)
 	} else if v != nil {
 		fmt.Println(v)
@@ -149,16 +153,20 @@ func (r *REPL) executeLine(t string, expectYield bool) (interpreter.Value, error
 
 	astProg, err := parser.NewAST(t)
 	if err != nil {
-		return nil, err
+		return nil, newScriptError(t, err, diagnostic.SyntaxError)
+	}
+
+	if err := r.c.Loader().Import(astProg, r.scope, r.declarations); err != nil {
+		return nil, newScriptError(t, err, diagnostic.ImportError)
 	}
 
 	semProg, err := semantic.New(astProg, r.declarations)
 	if err != nil {
-		return nil, err
+		return nil, newScriptError(t, err, diagnostic.SemanticError)
 	}
 
 	if err := interpreter.Eval(semProg, r.scope, r.d); err != nil {
-		return nil, err
+		return nil, newScriptError(t, err, diagnostic.EvaluationError)
 	}
 
 	v := r.scope.Return()
@@ -182,6 +190,23 @@ func (r *REPL) executeLine(t string, expectYield bool) (interpreter.Value, error
 	return nil, nil
 }
 
+// scriptError is an error in the input, its message shows where in the input each problem is.
+type scriptError struct {
+	source string
+	diags  diagnostic.List
+}
+
+func newScriptError(source string, err error, code diagnostic.Code) error {
+	return &scriptError{
+		source: source,
+		diags:  diagnostic.FromError(err, code),
+	}
+}
+
+func (e *scriptError) Error() string {
+	return strings.TrimSuffix(e.diags.Render(e.source), "\n")
+}
+
 func (r *REPL) doQuery(spec *query.Spec) error {
 	// Setup cancel context
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -0,0 +1,184 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronDescriptors are the shorthand cron expressions that are supported.
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSchedule is a parsed five field cron expression, evaluated in UTC.
+type cronSchedule struct {
+	minute,
+	hour,
+	dom,
+	month,
+	dow bitset
+
+	// domStar and dowStar record whether the day fields were unrestricted.
+	// When both day fields are restricted a day matches if either field matches.
+	domStar,
+	dowStar bool
+}
+
+type bitset uint64
+
+func (b bitset) has(i int) bool {
+	return b&(1<<uint(i)) != 0
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12}
+	// Both 0 and 7 are Sunday.
+	dowField = cronField{name: "day of week", min: 0, max: 7}
+)
+
+// parseCron parses a cron expression of the form "minute hour day-of-month month day-of-week".
+// Each field may be a *, a value, a range a-b, or a list of these separated by commas,
+// optionally followed by a step /n.
+func parseCron(expr string) (*cronSchedule, error) {
+	if d, ok := cronDescriptors[expr]; ok {
+		expr = d
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q, expected 5 fields got %d", expr, len(fields))
+	}
+	c := new(cronSchedule)
+	var err error
+	if c.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if c.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if c.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if c.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if c.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if c.dow.has(7) {
+		c.dow |= 1
+	}
+	c.domStar = strings.HasPrefix(fields[2], "*")
+	c.dowStar = strings.HasPrefix(fields[4], "*")
+	return c, nil
+}
+
+func (f cronField) parse(s string) (bitset, error) {
+	var b bitset
+	for _, part := range strings.Split(s, ",") {
+		r, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, s)
+			}
+			r, step = part[:i], n
+		}
+		lo, hi := f.min, f.max
+		if r != "*" {
+			var err error
+			if i := strings.IndexByte(r, '-'); i >= 0 {
+				if lo, err = f.value(r[:i]); err != nil {
+					return 0, err
+				}
+				if hi, err = f.value(r[i+1:]); err != nil {
+					return 0, err
+				}
+				if lo > hi {
+					return 0, fmt.Errorf("invalid range in %s field %q", f.name, s)
+				}
+			} else {
+				if lo, err = f.value(r); err != nil {
+					return 0, err
+				}
+				// A single value with a step starts a range that runs to the maximum.
+				hi = lo
+				if step > 1 {
+					hi = f.max
+				}
+			}
+		}
+		for i := lo; i <= hi; i += step {
+			b |= 1 << uint(i)
+		}
+	}
+	return b, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s field %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d] for %s field", v, f.min, f.max, f.name)
+	}
+	return v, nil
+}
+
+// next returns the first time strictly after t that matches the schedule.
+// The zero time is returned if nothing matches within the next five years.
+func (c *cronSchedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !c.month.has(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.hour.has(t.Hour()) {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !c.minute.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom.has(t.Day())
+	dow := c.dow.has(int(t.Weekday()))
+	switch {
+	case c.domStar && c.dowStar:
+		return true
+	case c.domStar:
+		return dow
+	case c.dowStar:
+		return dom
+	default:
+		return dom || dow
+	}
+}
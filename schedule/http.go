@@ -0,0 +1,156 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Handler serves the tasks of a scheduler over HTTP.
+//
+//	GET    <prefix>                list the tasks
+//	POST   <prefix>                create a task
+//	GET    <prefix>/<name>         get a task
+//	PUT    <prefix>/<name>         update a task
+//	DELETE <prefix>/<name>         delete a task
+//	GET    <prefix>/<name>/runs    list the recent runs of a task
+type Handler struct {
+	s      *Scheduler
+	prefix string
+}
+
+// NewHandler creates a handler for the scheduler's tasks, served at the path prefix.
+func NewHandler(s *Scheduler, prefix string) *Handler {
+	return &Handler{
+		s:      s,
+		prefix: strings.TrimSuffix(prefix, "/"),
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.HasPrefix(req.URL.Path, h.prefix) {
+		http.NotFound(w, req)
+		return
+	}
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, h.prefix), "/")
+	parts := strings.Split(path, "/")
+	switch {
+	case path == "":
+		switch req.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, h.s.Tasks())
+		case http.MethodPost:
+			t, ok := decodeTask(w, req)
+			if !ok {
+				return
+			}
+			if err := h.s.Validate(t); err != nil {
+				writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+				return
+			}
+			if err := h.s.Create(t); err != nil {
+				writeError(w, err)
+				return
+			}
+			h.writeTask(w, http.StatusCreated, t.Name)
+		default:
+			methodNotAllowed(w, "GET, POST")
+		}
+	case len(parts) == 1:
+		name := parts[0]
+		switch req.Method {
+		case http.MethodGet:
+			h.writeTask(w, http.StatusOK, name)
+		case http.MethodPut:
+			t, ok := decodeTask(w, req)
+			if !ok {
+				return
+			}
+			if t.Name == "" {
+				t.Name = name
+			}
+			if t.Name != name {
+				writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("task name %q does not match path %q", t.Name, name)})
+				return
+			}
+			if err := h.s.Validate(t); err != nil {
+				writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+				return
+			}
+			if err := h.s.Update(t); err != nil {
+				writeError(w, err)
+				return
+			}
+			h.writeTask(w, http.StatusOK, name)
+		case http.MethodDelete:
+			if err := h.s.Delete(name); err != nil {
+				writeError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w, "GET, PUT, DELETE")
+		}
+	case len(parts) == 2 && parts[1] == "runs":
+		if req.Method != http.MethodGet {
+			methodNotAllowed(w, "GET")
+			return
+		}
+		runs, err := h.s.Runs(parts[0])
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, runs)
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (h *Handler) writeTask(w http.ResponseWriter, code int, name string) {
+	st, err := h.s.Task(name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, code, st)
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func decodeTask(w http.ResponseWriter, req *http.Request) (Task, bool) {
+	var t Task
+	if err := json.NewDecoder(req.Body).Decode(&t); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("invalid task: %v", err)})
+		return Task{}, false
+	}
+	return t, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch err {
+	case ErrTaskNotFound:
+		code = http.StatusNotFound
+	case ErrTaskExists:
+		code = http.StatusConflict
+	}
+	writeJSON(w, code, errorResponse{Error: err.Error()})
+}
+
+func methodNotAllowed(w http.ResponseWriter, allow string) {
+	w.Header().Set("Allow", allow)
+	writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("failed to encode response:", err)
+	}
+}
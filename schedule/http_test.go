@@ -0,0 +1,107 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/schedule"
+)
+
+func TestHandler(t *testing.T) {
+	s, err := schedule.New(schedule.Config{
+		Controller: newTestController(t, nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	ts := httptest.NewServer(schedule.NewHandler(s, "/tasks"))
+	defer ts.Close()
+
+	do := func(method, path, body string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		octets, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(octets)
+	}
+
+	task := `{"name":"hourly","query":"from(db:\"test\") |> range(start:-1h) |> count()","every":"1h","offset":"5m"}`
+	if code, body := do("POST", "/tasks", task); code != http.StatusCreated {
+		t.Fatalf("unexpected create status %d: %s", code, body)
+	}
+	if code, body := do("POST", "/tasks", task); code != http.StatusConflict {
+		t.Errorf("unexpected duplicate create status %d: %s", code, body)
+	}
+	if code, body := do("POST", "/tasks", `{"name":"bad","query":"from(db:\"test\")","cron":"bad"}`); code != http.StatusBadRequest {
+		t.Errorf("unexpected invalid create status %d: %s", code, body)
+	}
+
+	code, body := do("GET", "/tasks", "")
+	if code != http.StatusOK {
+		t.Fatalf("unexpected list status %d: %s", code, body)
+	}
+	var statuses []schedule.Status
+	if err := json.Unmarshal([]byte(body), &statuses); err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 1 {
+		t.Fatalf("unexpected number of tasks: %d", len(statuses))
+	}
+	if got := statuses[0]; got.Name != "hourly" || got.Every != (query.Duration{Fixed: time.Hour}) || got.Offset != (query.Duration{Fixed: 5 * time.Minute}) {
+		t.Errorf("unexpected task: %+v", got)
+	}
+	// The next run is scheduled on the hour, the offset only delays it.
+	if got := statuses[0]; got.NextRun.Minute() != 0 || !got.NextRun.Add(5*time.Minute).After(time.Now()) {
+		t.Errorf("unexpected next run %v", got.NextRun)
+	}
+
+	if code, body := do("PUT", "/tasks/hourly", `{"query":"from(db:\"test\") |> range(start:-24h) |> count()","cron":"@daily"}`); code != http.StatusOK {
+		t.Fatalf("unexpected update status %d: %s", code, body)
+	}
+	code, body = do("GET", "/tasks/hourly", "")
+	if code != http.StatusOK {
+		t.Fatalf("unexpected get status %d: %s", code, body)
+	}
+	var st schedule.Status
+	if err := json.Unmarshal([]byte(body), &st); err != nil {
+		t.Fatal(err)
+	}
+	if st.Cron != "@daily" || !st.Every.IsZero() {
+		t.Errorf("unexpected updated task: %+v", st)
+	}
+	if code, body := do("PUT", "/tasks/other", `{"query":"from(db:\"test\")","every":"1h"}`); code != http.StatusNotFound {
+		t.Errorf("unexpected update of missing task status %d: %s", code, body)
+	}
+
+	code, body = do("GET", "/tasks/hourly/runs", "")
+	if code != http.StatusOK {
+		t.Fatalf("unexpected runs status %d: %s", code, body)
+	}
+	if strings.TrimSpace(body) != "[]" {
+		t.Errorf("unexpected runs: %s", body)
+	}
+
+	if code, body := do("DELETE", "/tasks/hourly", ""); code != http.StatusNoContent {
+		t.Errorf("unexpected delete status %d: %s", code, body)
+	}
+	if code, body := do("GET", "/tasks/hourly", ""); code != http.StatusNotFound {
+		t.Errorf("unexpected get of deleted task status %d: %s", code, body)
+	}
+}
@@ -0,0 +1,401 @@
+// Package schedule runs IFQL queries on a schedule.
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/control"
+	"github.com/influxdata/ifql/query/execute"
+)
+
+const defaultHistoryLimit = 10
+
+var (
+	ErrTaskNotFound = errors.New("task not found")
+	ErrTaskExists   = errors.New("task already exists")
+)
+
+// Controller executes the queries of tasks.
+type Controller interface {
+	QueryWithCompileAt(ctx context.Context, queryStr string, now time.Time) (*control.Query, error)
+	// Loader returns the loader of the packages that queries import.
+	Loader() *query.Loader
+}
+
+type Config struct {
+	Controller Controller
+	// Path is the file where tasks and their run history are persisted.
+	// Nothing is persisted if the path is empty.
+	Path string
+	// HistoryLimit is the number of runs kept for each task, defaults to 10.
+	HistoryLimit int
+	Verbose      bool
+}
+
+// Run records a single execution of a task.
+type Run struct {
+	// ScheduledFor is the time the run was scheduled for, the query is run with now set to this time.
+	ScheduledFor time.Time `json:"scheduledFor"`
+	StartedAt    time.Time `json:"startedAt"`
+	FinishedAt   time.Time `json:"finishedAt"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// Status reports a task and the state of its runs.
+type Status struct {
+	Task
+	// LastScheduled is the scheduled time of the last run, or the time the task was created less its offset if it has not run.
+	LastScheduled time.Time `json:"lastScheduled"`
+	// NextRun is the scheduled time of the next run, which starts once the offset of the task has passed.
+	NextRun time.Time `json:"nextRun"`
+	LastRun *Run      `json:"lastRun,omitempty"`
+}
+
+// Scheduler runs tasks through a controller according to their schedules.
+type Scheduler struct {
+	c Config
+
+	// updateMu serializes changes to the set of tasks.
+	updateMu sync.Mutex
+
+	mu     sync.Mutex
+	tasks  map[string]*task
+	closed bool
+}
+
+type task struct {
+	Task          Task      `json:"task"`
+	LastScheduled time.Time `json:"lastScheduled"`
+	Runs          []Run     `json:"runs"`
+
+	cancel func()
+	done   chan struct{}
+}
+
+// stop cancels the task and waits for any in progress run to finish.
+func (t *task) stop() {
+	t.cancel()
+	<-t.done
+}
+
+// persistedState is the format of the file at Config.Path.
+type persistedState struct {
+	Tasks []*task `json:"tasks"`
+}
+
+// New creates a scheduler, loading any tasks persisted at c.Path, and starts running the tasks.
+func New(c Config) (*Scheduler, error) {
+	if c.HistoryLimit <= 0 {
+		c.HistoryLimit = defaultHistoryLimit
+	}
+	s := &Scheduler{
+		c:     c,
+		tasks: make(map[string]*task),
+	}
+	if c.Path != "" {
+		octets, err := ioutil.ReadFile(c.Path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			var state persistedState
+			if err := json.Unmarshal(octets, &state); err != nil {
+				return nil, err
+			}
+			for _, t := range state.Tasks {
+				s.tasks[t.Task.Name] = t
+			}
+		}
+	}
+	for _, t := range s.tasks {
+		s.start(t)
+	}
+	return s, nil
+}
+
+// Close stops all tasks, waiting for in progress runs to be canceled.
+func (s *Scheduler) Close() {
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+
+	s.mu.Lock()
+	s.closed = true
+	tasks := make([]*task, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		tasks = append(tasks, t)
+	}
+	s.mu.Unlock()
+
+	for _, t := range tasks {
+		t.stop()
+	}
+}
+
+// Tasks reports the status of all tasks sorted by name.
+func (s *Scheduler) Tasks() []Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]Status, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		statuses = append(statuses, t.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// Task reports the status of the named task.
+func (s *Scheduler) Task(name string) (Status, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[name]
+	if !ok {
+		return Status{}, ErrTaskNotFound
+	}
+	return t.status(), nil
+}
+
+// Runs reports the recent runs of the named task, most recent first.
+func (s *Scheduler) Runs(name string) ([]Run, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[name]
+	if !ok {
+		return nil, ErrTaskNotFound
+	}
+	runs := make([]Run, len(t.Runs))
+	for i, r := range t.Runs {
+		runs[len(runs)-1-i] = r
+	}
+	return runs, nil
+}
+
+// Validate reports whether the task is well formed and its query compiles with the packages of the controller.
+func (s *Scheduler) Validate(tk Task) error {
+	return tk.Validate(query.WithLoader(s.c.Controller.Loader()))
+}
+
+// Create adds a new task, its first run is the first run that starts after now.
+func (s *Scheduler) Create(tk Task) error {
+	if err := s.Validate(tk); err != nil {
+		return err
+	}
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tasks[tk.Name]; ok {
+		return ErrTaskExists
+	}
+	t := &task{
+		Task:          tk,
+		LastScheduled: time.Now().UTC().Add(-tk.Offset.Fixed),
+	}
+	s.tasks[tk.Name] = t
+	if err := s.save(); err != nil {
+		delete(s.tasks, tk.Name)
+		return err
+	}
+	s.start(t)
+	return nil
+}
+
+// Update replaces the definition of an existing task, keeping its run history.
+// A run of the task that is in progress is canceled.
+func (s *Scheduler) Update(tk Task) error {
+	if err := s.Validate(tk); err != nil {
+		return err
+	}
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+
+	s.mu.Lock()
+	old, ok := s.tasks[tk.Name]
+	s.mu.Unlock()
+	if !ok {
+		return ErrTaskNotFound
+	}
+	old.stop()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := &task{
+		Task:          tk,
+		LastScheduled: old.LastScheduled,
+		Runs:          old.Runs,
+	}
+	s.tasks[tk.Name] = t
+	s.start(t)
+	return s.save()
+}
+
+// Delete removes the named task.
+// A run of the task that is in progress is canceled.
+func (s *Scheduler) Delete(name string) error {
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+
+	s.mu.Lock()
+	t, ok := s.tasks[name]
+	if !ok {
+		s.mu.Unlock()
+		return ErrTaskNotFound
+	}
+	delete(s.tasks, name)
+	err := s.save()
+	s.mu.Unlock()
+
+	t.stop()
+	return err
+}
+
+// start begins running the task in its own goroutine.
+// The caller must hold s.mu.
+func (s *Scheduler) start(t *task) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	t.done = make(chan struct{})
+	if s.closed {
+		cancel()
+		close(t.done)
+		return
+	}
+	go func() {
+		defer close(t.done)
+		s.run(ctx, t)
+	}()
+}
+
+func (s *Scheduler) run(ctx context.Context, t *task) {
+	for {
+		s.mu.Lock()
+		tk := t.Task
+		next := tk.Next(t.LastScheduled)
+		s.mu.Unlock()
+		if next.IsZero() {
+			return
+		}
+
+		// Skip runs that were missed, for example while ifqld was not running, except the most recent one.
+		offset := tk.Offset.Fixed
+		now := time.Now()
+		for {
+			n := tk.Next(next)
+			if n.IsZero() || n.Add(offset).After(now) {
+				break
+			}
+			next = n
+		}
+
+		timer := time.NewTimer(next.Add(offset).Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		r := Run{
+			ScheduledFor: next,
+			StartedAt:    time.Now().UTC(),
+		}
+		err := s.execute(ctx, tk.Query, next)
+		r.FinishedAt = time.Now().UTC()
+		if ctx.Err() != nil {
+			// The task was stopped during the run, do not record it.
+			return
+		}
+		if err != nil {
+			r.Error = err.Error()
+			log.Printf("task %q scheduled for %v failed: %v", tk.Name, next, err)
+		} else if s.c.Verbose {
+			log.Printf("task %q scheduled for %v finished in %v", tk.Name, next, r.FinishedAt.Sub(r.StartedAt))
+		}
+
+		s.mu.Lock()
+		t.LastScheduled = next
+		t.Runs = append(t.Runs, r)
+		if len(t.Runs) > s.c.HistoryLimit {
+			t.Runs = t.Runs[len(t.Runs)-s.c.HistoryLimit:]
+		}
+		if err := s.save(); err != nil {
+			log.Println("failed to save task state:", err)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// execute runs the query and reads all of its results.
+func (s *Scheduler) execute(ctx context.Context, queryStr string, now time.Time) error {
+	q, err := s.c.Controller.QueryWithCompileAt(ctx, queryStr, now)
+	if err != nil {
+		return err
+	}
+	defer q.Done()
+
+	results, ok := <-q.Ready
+	if !ok {
+		return q.Err()
+	}
+	for _, r := range results {
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			// Blocks read directly from storage must be read before the next one is produced.
+			b.Times().DoTime(func([]execute.Time, execute.RowReader) {})
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return q.Err()
+}
+
+// save writes the tasks to c.Path.
+// The caller must hold s.mu.
+func (s *Scheduler) save() error {
+	if s.c.Path == "" {
+		return nil
+	}
+	state := persistedState{
+		Tasks: make([]*task, 0, len(s.tasks)),
+	}
+	for _, t := range s.tasks {
+		state.Tasks = append(state.Tasks, t)
+	}
+	sort.Slice(state.Tasks, func(i, j int) bool {
+		return state.Tasks[i].Task.Name < state.Tasks[j].Task.Name
+	})
+	octets, err := json.MarshalIndent(state, "", "    ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so a crash cannot leave a partially written state file.
+	tmp := s.c.Path + ".tmp"
+	if err := ioutil.WriteFile(tmp, octets, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.c.Path)
+}
+
+func (t *task) status() Status {
+	st := Status{
+		Task:          t.Task,
+		LastScheduled: t.LastScheduled,
+		NextRun:       t.Task.Next(t.LastScheduled),
+	}
+	if len(t.Runs) > 0 {
+		r := t.Runs[len(t.Runs)-1]
+		st.LastRun = &r
+	}
+	return st
+}
@@ -0,0 +1,263 @@
+package schedule_test
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/control"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/schedule"
+	"github.com/influxdata/influxdb/models"
+)
+
+// recordingPointsWriter records the times of the points written to it.
+type recordingPointsWriter struct {
+	mu    sync.Mutex
+	times []time.Time
+}
+
+func (w *recordingPointsWriter) WritePoints(ctx context.Context, db string, points []models.Point) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, p := range points {
+		w.times = append(w.times, p.Time())
+	}
+	return nil
+}
+
+func (w *recordingPointsWriter) Times() []time.Time {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]time.Time(nil), w.times...)
+}
+
+func newTestController(t *testing.T, w execute.PointsWriter) *control.Controller {
+	t.Helper()
+	s := execute.NewMemoryStorageReader()
+	if err := s.WriteLineProtocol("test", "cpu,host=a usage=1 0"); err != nil {
+		t.Fatal(err)
+	}
+	return control.New(control.Config{
+		ConcurrencyQuota: 1,
+		MemoryBytesQuota: math.MaxInt64,
+		ExecutorConfig: execute.Config{
+			StorageReader: s,
+			PointsWriter:  w,
+		},
+	})
+}
+
+// waitForRuns waits until the named task has at least n runs.
+func waitForRuns(t *testing.T, s *schedule.Scheduler, name string, n int) []schedule.Run {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		runs, err := s.Runs(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(runs) >= n {
+			return runs
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d runs of task %q", n, name)
+	return nil
+}
+
+func TestScheduler_Run(t *testing.T) {
+	w := new(recordingPointsWriter)
+	s, err := schedule.New(schedule.Config{
+		Controller: newTestController(t, w),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	every := 100 * time.Millisecond
+	if err := s.Create(schedule.Task{
+		Name:  "counts",
+		Query: `from(db:"test") |> range(start:1970-01-01T00:00:00Z) |> count() |> to(db:"out", measurement:"counts")`,
+		Every: query.Duration{Fixed: every},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	runs := waitForRuns(t, s, "counts", 2)
+	for _, r := range runs {
+		if r.Error != "" {
+			t.Errorf("unexpected run error: %s", r.Error)
+		}
+		if r.ScheduledFor.UnixNano()%int64(every) != 0 {
+			t.Errorf("run scheduled for %v is not aligned to %v", r.ScheduledFor, every)
+		}
+	}
+	if !runs[0].ScheduledFor.After(runs[1].ScheduledFor) {
+		t.Errorf("expected most recent run first, got %v before %v", runs[0].ScheduledFor, runs[1].ScheduledFor)
+	}
+
+	// The count is stamped with the stop time of the range, which is now for the query.
+	// Points are written before the run is recorded so every recorded run has a point.
+	written := make(map[time.Time]bool)
+	for _, tm := range w.Times() {
+		written[tm.UTC()] = true
+	}
+	for _, r := range runs {
+		if !written[r.ScheduledFor] {
+			t.Errorf("no point written at scheduled time %v, got %v", r.ScheduledFor, w.Times())
+		}
+	}
+}
+
+func TestScheduler_RunOffset(t *testing.T) {
+	w := new(recordingPointsWriter)
+	s, err := schedule.New(schedule.Config{
+		Controller: newTestController(t, w),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	every := 100 * time.Millisecond
+	offset := 50 * time.Millisecond
+	if err := s.Create(schedule.Task{
+		Name:   "counts",
+		Query:  `from(db:"test") |> range(start:1970-01-01T00:00:00Z) |> count() |> to(db:"out", measurement:"counts")`,
+		Every:  query.Duration{Fixed: every},
+		Offset: query.Duration{Fixed: offset},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	runs := waitForRuns(t, s, "counts", 2)
+	written := make(map[time.Time]bool)
+	for _, tm := range w.Times() {
+		written[tm.UTC()] = true
+	}
+	for _, r := range runs {
+		if r.ScheduledFor.UnixNano()%int64(every) != 0 {
+			t.Errorf("run scheduled for %v is not aligned to %v", r.ScheduledFor, every)
+		}
+		if r.StartedAt.Before(r.ScheduledFor.Add(offset)) {
+			t.Errorf("run scheduled for %v started at %v, before its offset", r.ScheduledFor, r.StartedAt)
+		}
+		// The query is run with now set to the scheduled time, not the delayed time.
+		if !written[r.ScheduledFor] {
+			t.Errorf("no point written at scheduled time %v, got %v", r.ScheduledFor, w.Times())
+		}
+	}
+}
+
+func TestScheduler_RunError(t *testing.T) {
+	s, err := schedule.New(schedule.Config{
+		Controller: newTestController(t, nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	// The to function fails without a points writer.
+	if err := s.Create(schedule.Task{
+		Name:  "fails",
+		Query: `from(db:"test") |> range(start:-1h) |> to(db:"out")`,
+		Every: query.Duration{Fixed: 100 * time.Millisecond},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	runs := waitForRuns(t, s, "fails", 1)
+	if runs[0].Error == "" {
+		t.Error("expected run error")
+	}
+	st, err := s.Task("fails")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.LastRun == nil || st.LastRun.Error == "" {
+		t.Errorf("expected last run to report error, got %+v", st.LastRun)
+	}
+}
+
+func TestScheduler_Persist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schedule")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tasks")
+
+	c := newTestController(t, new(recordingPointsWriter))
+	s, err := schedule.New(schedule.Config{
+		Controller:   c,
+		Path:         path,
+		HistoryLimit: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	task := schedule.Task{
+		Name:  "counts",
+		Query: `from(db:"test") |> range(start:-1h) |> count() |> to(db:"out")`,
+		Every: query.Duration{Fixed: 50 * time.Millisecond},
+	}
+	if err := s.Create(task); err != nil {
+		t.Fatal(err)
+	}
+	waitForRuns(t, s, "counts", 2)
+	// Let more runs happen than the history limit.
+	time.Sleep(150 * time.Millisecond)
+	s.Close()
+
+	before, err := s.Task("counts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runsBefore, err := s.Runs("counts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runsBefore) != 2 {
+		t.Errorf("unexpected number of runs kept: got %d want 2", len(runsBefore))
+	}
+
+	s, err = schedule.New(schedule.Config{
+		Controller: c,
+		Path:       path,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	after, err := s.Task("counts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Task != task {
+		t.Errorf("unexpected task after reload: got %+v want %+v", after.Task, task)
+	}
+	if after.LastScheduled.Before(before.LastScheduled) {
+		t.Errorf("last scheduled time went backwards after reload: got %v want at least %v", after.LastScheduled, before.LastScheduled)
+	}
+	runsAfter, err := s.Runs("counts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, r := range runsAfter {
+		if r.ScheduledFor.Equal(runsBefore[0].ScheduledFor) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected run scheduled for %v to be reloaded, got %+v", runsBefore[0].ScheduledFor, runsAfter)
+	}
+}
@@ -0,0 +1,88 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+)
+
+// Task is a named IFQL query that is run on a schedule.
+// Exactly one of Every or Cron must be set.
+type Task struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+	// Every runs the task at multiples of the duration since the Unix epoch.
+	Every query.Duration `json:"every,omitempty"`
+	// Cron runs the task at the times matched by a five field cron expression, evaluated in UTC.
+	Cron string `json:"cron,omitempty"`
+	// Offset delays each run by the duration, for example to wait for late data.
+	// The query is still run with now set to the scheduled time.
+	Offset query.Duration `json:"offset,omitempty"`
+}
+
+// Validate reports whether the task is well formed and its query compiles with the options.
+func (t Task) Validate(opts ...query.Option) error {
+	if t.Name == "" {
+		return errors.New("task name is required")
+	}
+	if strings.Contains(t.Name, "/") {
+		return fmt.Errorf("task name %q must not contain '/'", t.Name)
+	}
+	if t.Query == "" {
+		return errors.New("task query is required")
+	}
+	if t.Offset.Fixed < 0 {
+		return errors.New("task offset must not be negative")
+	}
+	if t.Every.IsCalendar() || t.Offset.IsCalendar() {
+		return errors.New("task every and offset must be fixed durations")
+	}
+	switch {
+	case !t.Every.IsZero() && t.Cron != "":
+		return errors.New("task must specify only one of every or cron")
+	case t.Every.Fixed < 0:
+		return errors.New("task every must be positive")
+	case t.Cron != "":
+		c, err := parseCron(t.Cron)
+		if err != nil {
+			return err
+		}
+		if c.next(time.Now()).IsZero() {
+			return fmt.Errorf("cron expression %q never matches", t.Cron)
+		}
+	case t.Every.IsZero():
+		return errors.New("task must specify one of every or cron")
+	}
+	if _, err := query.Compile(context.Background(), t.Query, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Next returns the first scheduled time of the task strictly after the given time.
+// The run scheduled for that time starts once the offset has passed.
+// The zero time is returned if the task is invalid.
+func (t Task) Next(after time.Time) time.Time {
+	after = after.UTC()
+	if t.Cron != "" {
+		c, err := parseCron(t.Cron)
+		if err != nil {
+			return time.Time{}
+		}
+		return c.next(after)
+	}
+	every := int64(t.Every.Fixed)
+	if every <= 0 {
+		return time.Time{}
+	}
+	ns := after.UnixNano()
+	rem := ns % every
+	if rem < 0 {
+		rem += every
+	}
+	return time.Unix(0, ns-rem+every).UTC()
+}
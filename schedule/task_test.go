@@ -0,0 +1,190 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/schedule"
+)
+
+func init() {
+	query.FinalizeRegistration()
+}
+
+func mustParseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestTask_Next(t *testing.T) {
+	testCases := []struct {
+		name  string
+		task  schedule.Task
+		after string
+		want  []string
+	}{
+		{
+			name:  "every",
+			task:  schedule.Task{Every: query.Duration{Fixed: 10 * time.Minute}},
+			after: "2018-01-01T00:05:00Z",
+			want: []string{
+				"2018-01-01T00:10:00Z",
+				"2018-01-01T00:20:00Z",
+				"2018-01-01T00:30:00Z",
+			},
+		},
+		{
+			name:  "every on boundary",
+			task:  schedule.Task{Every: query.Duration{Fixed: time.Hour}},
+			after: "2018-01-01T01:00:00Z",
+			want: []string{
+				"2018-01-01T02:00:00Z",
+				"2018-01-01T03:00:00Z",
+			},
+		},
+		{
+			// The offset delays the runs, but not the times they are scheduled for.
+			name:  "every with offset",
+			task:  schedule.Task{Every: query.Duration{Fixed: time.Hour}, Offset: query.Duration{Fixed: 5 * time.Minute}},
+			after: "2018-01-01T01:02:00Z",
+			want: []string{
+				"2018-01-01T02:00:00Z",
+				"2018-01-01T03:00:00Z",
+			},
+		},
+		{
+			name:  "cron minutes",
+			task:  schedule.Task{Cron: "*/15 * * * *"},
+			after: "2018-01-01T00:07:30Z",
+			want: []string{
+				"2018-01-01T00:15:00Z",
+				"2018-01-01T00:30:00Z",
+				"2018-01-01T00:45:00Z",
+				"2018-01-01T01:00:00Z",
+			},
+		},
+		{
+			name: "cron weekdays",
+			task: schedule.Task{Cron: "30 9 * * 1-5"},
+			// 2018-01-05 is a Friday.
+			after: "2018-01-05T10:00:00Z",
+			want: []string{
+				"2018-01-08T09:30:00Z",
+				"2018-01-09T09:30:00Z",
+			},
+		},
+		{
+			name:  "cron day of month or day of week",
+			task:  schedule.Task{Cron: "0 0 1 * 0"},
+			after: "2018-01-01T00:00:00Z",
+			want: []string{
+				"2018-01-07T00:00:00Z",
+				"2018-01-14T00:00:00Z",
+				"2018-01-21T00:00:00Z",
+				"2018-01-28T00:00:00Z",
+				"2018-02-01T00:00:00Z",
+			},
+		},
+		{
+			name:  "cron leap day",
+			task:  schedule.Task{Cron: "0 12 29 2 *"},
+			after: "2018-01-01T00:00:00Z",
+			want: []string{
+				"2020-02-29T12:00:00Z",
+			},
+		},
+		{
+			name:  "cron descriptor with offset",
+			task:  schedule.Task{Cron: "@daily", Offset: query.Duration{Fixed: time.Hour}},
+			after: "2018-01-01T00:30:00Z",
+			want: []string{
+				"2018-01-02T00:00:00Z",
+				"2018-01-03T00:00:00Z",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			after := mustParseTime(tc.after)
+			for _, w := range tc.want {
+				want := mustParseTime(w)
+				got := tc.task.Next(after)
+				if !got.Equal(want) {
+					t.Fatalf("unexpected next time after %v: got %v want %v", after, got, want)
+				}
+				after = got
+			}
+		})
+	}
+}
+
+func TestTask_Validate(t *testing.T) {
+	valid := `from(db:"mydb") |> range(start:-1h) |> count()`
+	testCases := []struct {
+		name    string
+		task    schedule.Task
+		wantErr bool
+	}{
+		{
+			name: "every",
+			task: schedule.Task{Name: "a", Query: valid, Every: query.Duration{Fixed: time.Minute}},
+		},
+		{
+			name: "cron",
+			task: schedule.Task{Name: "a", Query: valid, Cron: "0 * * * *"},
+		},
+		{
+			name:    "missing name",
+			task:    schedule.Task{Query: valid, Every: query.Duration{Fixed: time.Minute}},
+			wantErr: true,
+		},
+		{
+			name:    "name with slash",
+			task:    schedule.Task{Name: "a/b", Query: valid, Every: query.Duration{Fixed: time.Minute}},
+			wantErr: true,
+		},
+		{
+			name:    "missing schedule",
+			task:    schedule.Task{Name: "a", Query: valid},
+			wantErr: true,
+		},
+		{
+			name:    "every and cron",
+			task:    schedule.Task{Name: "a", Query: valid, Every: query.Duration{Fixed: time.Minute}, Cron: "* * * * *"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cron",
+			task:    schedule.Task{Name: "a", Query: valid, Cron: "60 * * * *"},
+			wantErr: true,
+		},
+		{
+			name:    "cron never matches",
+			task:    schedule.Task{Name: "a", Query: valid, Cron: "0 0 30 2 *"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid query",
+			task:    schedule.Task{Name: "a", Query: `from(db:"mydb") |>`, Every: query.Duration{Fixed: time.Minute}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.task.Validate()
+			if tc.wantErr && err == nil {
+				t.Error("expected error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package semantic
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/influxdata/ifql/ast"
+)
+
+// ToAST converts a semantic node back to an AST node, so that it can be written as IFQL source.
+// The AST has no locations and does not preserve the syntax of the original source, such as parentheses.
+func ToAST(n Node) (ast.Node, error) {
+	switch n := n.(type) {
+	case *Program:
+		p := &ast.Program{
+			Body: make([]ast.Statement, len(n.Body)),
+		}
+		if n.Package != nil {
+			p.Package = &ast.PackageClause{Name: &ast.Identifier{Name: n.Package.Name.Name}}
+		}
+		for _, imp := range n.Imports {
+			i := &ast.ImportDeclaration{Path: &ast.StringLiteral{Value: imp.Path.Value}}
+			if imp.As != nil {
+				i.As = &ast.Identifier{Name: imp.As.Name}
+			}
+			p.Imports = append(p.Imports, i)
+		}
+		for i, s := range n.Body {
+			node, err := ToAST(s)
+			if err != nil {
+				return nil, err
+			}
+			p.Body[i] = node.(ast.Statement)
+		}
+		return p, nil
+	case *BlockStatement:
+		b := &ast.BlockStatement{
+			Body: make([]ast.Statement, len(n.Body)),
+		}
+		for i, s := range n.Body {
+			node, err := ToAST(s)
+			if err != nil {
+				return nil, err
+			}
+			b.Body[i] = node.(ast.Statement)
+		}
+		return b, nil
+	case *ExpressionStatement:
+		e, err := toASTExpression(n.Expression)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.ExpressionStatement{Expression: e}, nil
+	case *ReturnStatement:
+		e, err := toASTExpression(n.Argument)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.ReturnStatement{Argument: e}, nil
+	case *NativeVariableDeclaration:
+		e, err := toASTExpression(n.Init)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.VariableDeclaration{
+			Declarations: []*ast.VariableDeclarator{{
+				ID:   &ast.Identifier{Name: n.Identifier.Name},
+				Init: e,
+			}},
+		}, nil
+	case *FunctionExpression:
+		f := &ast.ArrowFunctionExpression{
+			Params: make([]*ast.Property, len(n.Params)),
+		}
+		for i, p := range n.Params {
+			param := &ast.Property{Key: &ast.Identifier{Name: p.Key.Name}}
+			if p.Piped {
+				param.Value = &ast.PipeLiteral{}
+			} else if p.Default != nil {
+				e, err := toASTExpression(p.Default)
+				if err != nil {
+					return nil, err
+				}
+				param.Value = e
+			}
+			f.Params[i] = param
+		}
+		body, err := ToAST(n.Body)
+		if err != nil {
+			return nil, err
+		}
+		f.Body = body
+		return f, nil
+	case *ArrayExpression:
+		a := &ast.ArrayExpression{
+			Elements: make([]ast.Expression, len(n.Elements)),
+		}
+		for i, el := range n.Elements {
+			e, err := toASTExpression(el)
+			if err != nil {
+				return nil, err
+			}
+			a.Elements[i] = e
+		}
+		return a, nil
+	case *BinaryExpression:
+		left, err := toASTExpression(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := toASTExpression(n.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.BinaryExpression{
+			Operator: n.Operator,
+			Left:     left,
+			Right:    right,
+		}, nil
+	case *LogicalExpression:
+		left, err := toASTExpression(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := toASTExpression(n.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.LogicalExpression{
+			Operator: n.Operator,
+			Left:     left,
+			Right:    right,
+		}, nil
+	case *UnaryExpression:
+		arg, err := toASTExpression(n.Argument)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.UnaryExpression{
+			Operator: n.Operator,
+			Argument: arg,
+		}, nil
+	case *ConditionalExpression:
+		test, err := toASTExpression(n.Test)
+		if err != nil {
+			return nil, err
+		}
+		consequent, err := toASTExpression(n.Consequent)
+		if err != nil {
+			return nil, err
+		}
+		alternate, err := toASTExpression(n.Alternate)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.ConditionalExpression{
+			Test:       test,
+			Consequent: consequent,
+			Alternate:  alternate,
+		}, nil
+	case *CallExpression:
+		callee, err := toASTExpression(n.Callee)
+		if err != nil {
+			return nil, err
+		}
+		c := &ast.CallExpression{Callee: callee}
+		if n.Arguments != nil && len(n.Arguments.Properties) > 0 {
+			args, err := toASTExpression(n.Arguments)
+			if err != nil {
+				return nil, err
+			}
+			c.Arguments = []ast.Expression{args}
+		}
+		return c, nil
+	case *MemberExpression:
+		obj, err := toASTExpression(n.Object)
+		if err != nil {
+			return nil, err
+		}
+		m := &ast.MemberExpression{Object: obj}
+		if isIdentifier(n.Property) {
+			m.Property = &ast.Identifier{Name: n.Property}
+		} else {
+			m.Property = &ast.StringLiteral{Value: n.Property}
+		}
+		return m, nil
+	case *ObjectExpression:
+		o := &ast.ObjectExpression{
+			Properties: make([]*ast.Property, len(n.Properties)),
+		}
+		for i, p := range n.Properties {
+			node, err := ToAST(p)
+			if err != nil {
+				return nil, err
+			}
+			o.Properties[i] = node.(*ast.Property)
+		}
+		return o, nil
+	case *Property:
+		v, err := toASTExpression(n.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.Property{
+			Key:   &ast.Identifier{Name: n.Key.Name},
+			Value: v,
+		}, nil
+	case *IdentifierExpression:
+		return &ast.Identifier{Name: n.Name}, nil
+	case *Identifier:
+		return &ast.Identifier{Name: n.Name}, nil
+	case *BooleanLiteral:
+		return &ast.BooleanLiteral{Value: n.Value}, nil
+	case *NullLiteral:
+		return &ast.NullLiteral{}, nil
+	case *DateTimeLiteral:
+		return &ast.DateTimeLiteral{Value: n.Value}, nil
+	case *DurationLiteral:
+		return &ast.DurationLiteral{Value: n.Value, Months: n.Months}, nil
+	case *FloatLiteral:
+		return &ast.FloatLiteral{Value: n.Value}, nil
+	case *IntegerLiteral:
+		return &ast.IntegerLiteral{Value: n.Value}, nil
+	case *RegexpLiteral:
+		return &ast.RegexpLiteral{Value: n.Value}, nil
+	case *StringLiteral:
+		return &ast.StringLiteral{Value: n.Value}, nil
+	case *UnsignedIntegerLiteral:
+		return &ast.UnsignedIntegerLiteral{Value: n.Value}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert node of type %T to AST", n)
+	}
+}
+
+func toASTExpression(e Expression) (ast.Expression, error) {
+	n, err := ToAST(e)
+	if err != nil {
+		return nil, err
+	}
+	expr, ok := n.(ast.Expression)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert expression of type %T to AST", e)
+	}
+	return expr, nil
+}
+
+// isIdentifier reports whether the name is valid as an identifier of the grammar.
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
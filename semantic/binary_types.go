@@ -115,4 +115,36 @@ var binaryTypesLookup = map[binarySignature]Kind{
 
 	{operator: ast.NotRegexpMatchOperator, left: String, right: Regexp}: Bool,
 	{operator: ast.NotRegexpMatchOperator, left: Regexp, right: String}: Bool,
+
+	// Like in InfluxQL, comparing a string to a regular expression for equality matches it.
+
+	{operator: ast.EqualOperator, left: String, right: Regexp}:    Bool,
+	{operator: ast.EqualOperator, left: Regexp, right: String}:    Bool,
+	{operator: ast.NotEqualOperator, left: String, right: Regexp}: Bool,
+	{operator: ast.NotEqualOperator, left: Regexp, right: String}: Bool,
+
+	//----------------------------
+	// Time and Duration Operators
+	//----------------------------
+
+	{operator: ast.AdditionOperator, left: Time, right: Duration}:        Time,
+	{operator: ast.AdditionOperator, left: Duration, right: Time}:        Time,
+	{operator: ast.AdditionOperator, left: Duration, right: Duration}:    Duration,
+	{operator: ast.SubtractionOperator, left: Time, right: Duration}:     Time,
+	{operator: ast.SubtractionOperator, left: Time, right: Time}:         Duration,
+	{operator: ast.SubtractionOperator, left: Duration, right: Duration}: Duration,
+
+	{operator: ast.LessThanEqualOperator, left: Time, right: Time}:    Bool,
+	{operator: ast.LessThanOperator, left: Time, right: Time}:         Bool,
+	{operator: ast.GreaterThanEqualOperator, left: Time, right: Time}: Bool,
+	{operator: ast.GreaterThanOperator, left: Time, right: Time}:      Bool,
+	{operator: ast.EqualOperator, left: Time, right: Time}:            Bool,
+	{operator: ast.NotEqualOperator, left: Time, right: Time}:         Bool,
+
+	{operator: ast.LessThanEqualOperator, left: Duration, right: Duration}:    Bool,
+	{operator: ast.LessThanOperator, left: Duration, right: Duration}:         Bool,
+	{operator: ast.GreaterThanEqualOperator, left: Duration, right: Duration}: Bool,
+	{operator: ast.GreaterThanOperator, left: Duration, right: Duration}:      Bool,
+	{operator: ast.EqualOperator, left: Duration, right: Duration}:            Bool,
+	{operator: ast.NotEqualOperator, left: Duration, right: Duration}:         Bool,
 }
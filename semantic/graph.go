@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/diagnostic"
 )
 
 type Node interface {
@@ -16,16 +17,23 @@ type Node interface {
 	NodeType() string
 	Copy() Node
 
+	// Location reports where in the source the node was analyzed from.
+	// It is nil for nodes that were not created from an AST.
+	Location() *ast.SourceLocation
+
 	json.Marshaler
 }
 
-func (*Program) node() {}
+func (*Program) node()           {}
+func (*PackageClause) node()     {}
+func (*ImportDeclaration) node() {}
 
 func (*BlockStatement) node()              {}
 func (*ExpressionStatement) node()         {}
 func (*ReturnStatement) node()             {}
 func (*NativeVariableDeclaration) node()   {}
 func (*ExternalVariableDeclaration) node() {}
+func (*PackageDeclaration) node()          {}
 
 func (*ArrayExpression) node()       {}
 func (*FunctionExpression) node()    {}
@@ -47,10 +55,22 @@ func (*DateTimeLiteral) node()        {}
 func (*DurationLiteral) node()        {}
 func (*FloatLiteral) node()           {}
 func (*IntegerLiteral) node()         {}
+func (*NullLiteral) node()            {}
 func (*StringLiteral) node()          {}
 func (*RegexpLiteral) node()          {}
 func (*UnsignedIntegerLiteral) node() {}
 
+// loc is embedded into all nodes to record their source location.
+type loc struct {
+	location *ast.SourceLocation
+}
+
+func (l loc) Location() *ast.SourceLocation { return l.location }
+
+func locOf(n ast.Node) loc {
+	return loc{location: n.Location()}
+}
+
 type Statement interface {
 	Node
 	stmt()
@@ -80,6 +100,7 @@ func (*FunctionExpression) expression()     {}
 func (*IdentifierExpression) expression()   {}
 func (*IntegerLiteral) expression()         {}
 func (*LogicalExpression) expression()      {}
+func (*NullLiteral) expression()            {}
 func (*MemberExpression) expression()       {}
 func (*ObjectExpression) expression()       {}
 func (*RegexpLiteral) expression()          {}
@@ -97,12 +118,17 @@ func (*DateTimeLiteral) literal()        {}
 func (*DurationLiteral) literal()        {}
 func (*FloatLiteral) literal()           {}
 func (*IntegerLiteral) literal()         {}
+func (*NullLiteral) literal()            {}
 func (*RegexpLiteral) literal()          {}
 func (*StringLiteral) literal()          {}
 func (*UnsignedIntegerLiteral) literal() {}
 
 type Program struct {
-	Body []Statement `json:"body"`
+	loc
+
+	Package *PackageClause       `json:"package,omitempty"`
+	Imports []*ImportDeclaration `json:"imports,omitempty"`
+	Body    []Statement          `json:"body"`
 }
 
 func (*Program) NodeType() string { return "Program" }
@@ -114,6 +140,15 @@ func (p *Program) Copy() Node {
 	np := new(Program)
 	*np = *p
 
+	if p.Package != nil {
+		np.Package = p.Package.Copy().(*PackageClause)
+	}
+	if len(p.Imports) > 0 {
+		np.Imports = make([]*ImportDeclaration, len(p.Imports))
+		for i, imp := range p.Imports {
+			np.Imports[i] = imp.Copy().(*ImportDeclaration)
+		}
+	}
 	if len(p.Body) > 0 {
 		np.Body = make([]Statement, len(p.Body))
 		for i, s := range p.Body {
@@ -124,7 +159,57 @@ func (p *Program) Copy() Node {
 	return np
 }
 
+// PackageClause names the package a program is part of.
+type PackageClause struct {
+	loc
+
+	Name *Identifier `json:"name"`
+}
+
+func (*PackageClause) NodeType() string { return "PackageClause" }
+
+func (c *PackageClause) Copy() Node {
+	if c == nil {
+		return c
+	}
+	nc := new(PackageClause)
+	*nc = *c
+
+	nc.Name = c.Name.Copy().(*Identifier)
+
+	return nc
+}
+
+// ImportDeclaration imports the package at Path.
+// The package is declared as As, or by the name of the package when As is nil.
+// Imported packages must be declared before the program is analyzed.
+type ImportDeclaration struct {
+	loc
+
+	As   *Identifier    `json:"as,omitempty"`
+	Path *StringLiteral `json:"path"`
+}
+
+func (*ImportDeclaration) NodeType() string { return "ImportDeclaration" }
+
+func (d *ImportDeclaration) Copy() Node {
+	if d == nil {
+		return d
+	}
+	nd := new(ImportDeclaration)
+	*nd = *d
+
+	if d.As != nil {
+		nd.As = d.As.Copy().(*Identifier)
+	}
+	nd.Path = d.Path.Copy().(*StringLiteral)
+
+	return nd
+}
+
 type BlockStatement struct {
+	loc
+
 	Body []Statement `json:"body"`
 }
 
@@ -152,6 +237,8 @@ func (s *BlockStatement) Copy() Node {
 }
 
 type ExpressionStatement struct {
+	loc
+
 	Expression Expression `json:"expression"`
 }
 
@@ -170,6 +257,8 @@ func (s *ExpressionStatement) Copy() Node {
 }
 
 type ReturnStatement struct {
+	loc
+
 	Argument Expression `json:"argument"`
 }
 
@@ -194,6 +283,8 @@ type VariableDeclaration interface {
 }
 
 type NativeVariableDeclaration struct {
+	loc
+
 	Identifier *Identifier `json:"identifier"`
 	Init       Expression  `json:"init"`
 }
@@ -224,6 +315,8 @@ func (s *NativeVariableDeclaration) Copy() Node {
 }
 
 type ExternalVariableDeclaration struct {
+	loc
+
 	Identifier *Identifier `json:"identifier"`
 	Type       Type        `json:"type"`
 }
@@ -256,7 +349,55 @@ func (s *ExternalVariableDeclaration) Copy() Node {
 	return ns
 }
 
+// PackageDeclaration declares an imported package.
+// The package is an object whose properties are the members of the package.
+type PackageDeclaration struct {
+	loc
+
+	Identifier *Identifier `json:"identifier"`
+	// Members are the declarations of the package that are exported.
+	Members DeclarationScope `json:"-"`
+	// Declarations are the declarations the members were declared with,
+	// the types of the members are inferred with them.
+	Declarations DeclarationScope `json:"-"`
+}
+
+func NewPackageDeclaration(name string, members, declarations DeclarationScope) *PackageDeclaration {
+	return &PackageDeclaration{
+		Identifier:   &Identifier{Name: name},
+		Members:      members,
+		Declarations: declarations,
+	}
+}
+
+func (d *PackageDeclaration) ID() *Identifier {
+	return d.Identifier
+}
+func (d *PackageDeclaration) InitType() Type {
+	types := make(map[string]Type, len(d.Members))
+	for name, m := range d.Members {
+		types[name] = m.InitType()
+	}
+	return NewObjectType(types)
+}
+
+func (*PackageDeclaration) NodeType() string { return "PackageDeclaration" }
+
+func (d *PackageDeclaration) Copy() Node {
+	if d == nil {
+		return d
+	}
+	nd := new(PackageDeclaration)
+	*nd = *d
+
+	nd.Identifier = d.Identifier.Copy().(*Identifier)
+
+	return nd
+}
+
 type ArrayExpression struct {
+	loc
+
 	Elements []Expression `json:"elements"`
 	typ      Type
 }
@@ -287,6 +428,8 @@ func (e *ArrayExpression) Copy() Node {
 }
 
 type FunctionExpression struct {
+	loc
+
 	Params []*FunctionParam `json:"params"`
 	Body   Node             `json:"body"`
 	typ    Type
@@ -320,6 +463,8 @@ func (e *FunctionExpression) Copy() Node {
 }
 
 type FunctionParam struct {
+	loc
+
 	Key         *Identifier `json:"key"`
 	Default     Expression  `json:"default"`
 	Piped       bool        `json:"piped,omitempty"`
@@ -358,6 +503,8 @@ func (p *FunctionParam) Copy() Node {
 }
 
 type BinaryExpression struct {
+	loc
+
 	Operator ast.OperatorKind `json:"operator"`
 	Left     Expression       `json:"left"`
 	Right    Expression       `json:"right"`
@@ -386,6 +533,8 @@ func (e *BinaryExpression) Copy() Node {
 }
 
 type CallExpression struct {
+	loc
+
 	Callee    Expression        `json:"callee"`
 	Arguments *ObjectExpression `json:"arguments"`
 }
@@ -409,6 +558,8 @@ func (e *CallExpression) Copy() Node {
 }
 
 type ConditionalExpression struct {
+	loc
+
 	Test       Expression `json:"test"`
 	Alternate  Expression `json:"alternate"`
 	Consequent Expression `json:"consequent"`
@@ -416,6 +567,11 @@ type ConditionalExpression struct {
 
 func (*ConditionalExpression) NodeType() string { return "ConditionalExpression" }
 
+// Type is the type of the consequent, both branches of a conditional have the same type.
+func (e *ConditionalExpression) Type() Type {
+	return e.Consequent.Type()
+}
+
 func (e *ConditionalExpression) Copy() Node {
 	if e == nil {
 		return e
@@ -431,6 +587,8 @@ func (e *ConditionalExpression) Copy() Node {
 }
 
 type LogicalExpression struct {
+	loc
+
 	Operator ast.LogicalOperatorKind `json:"operator"`
 	Left     Expression              `json:"left"`
 	Right    Expression              `json:"right"`
@@ -453,6 +611,8 @@ func (e *LogicalExpression) Copy() Node {
 }
 
 type MemberExpression struct {
+	loc
+
 	Object   Expression `json:"object"`
 	Property string     `json:"property"`
 }
@@ -480,6 +640,8 @@ func (e *MemberExpression) Copy() Node {
 }
 
 type ObjectExpression struct {
+	loc
+
 	Properties []*Property `json:"properties"`
 	typ        Type
 }
@@ -510,12 +672,18 @@ func (e *ObjectExpression) Copy() Node {
 }
 
 type UnaryExpression struct {
+	loc
+
 	Operator ast.OperatorKind `json:"operator"`
 	Argument Expression       `json:"argument"`
 }
 
 func (*UnaryExpression) NodeType() string { return "UnaryExpression" }
 func (e *UnaryExpression) Type() Type {
+	switch e.Operator {
+	case ast.NotOperator, ast.ExistsOperator:
+		return Bool
+	}
 	return e.Argument.Type()
 }
 
@@ -532,6 +700,8 @@ func (e *UnaryExpression) Copy() Node {
 }
 
 type Property struct {
+	loc
+
 	Key   *Identifier `json:"key"`
 	Value Expression  `json:"value"`
 }
@@ -551,6 +721,8 @@ func (p *Property) Copy() Node {
 }
 
 type IdentifierExpression struct {
+	loc
+
 	Name string `json:"name"`
 	// declaration is the node that declares this identifier
 	declaration VariableDeclaration
@@ -580,6 +752,8 @@ func (e *IdentifierExpression) Copy() Node {
 }
 
 type Identifier struct {
+	loc
+
 	Name string `json:"name"`
 }
 
@@ -596,6 +770,8 @@ func (i *Identifier) Copy() Node {
 }
 
 type BooleanLiteral struct {
+	loc
+
 	Value bool `json:"value"`
 }
 
@@ -612,7 +788,27 @@ func (l *BooleanLiteral) Copy() Node {
 	return nl
 }
 
+// NullLiteral is the absence of a value.
+type NullLiteral struct {
+	loc
+}
+
+func (*NullLiteral) NodeType() string { return "NullLiteral" }
+func (*NullLiteral) Type() Type       { return Nil }
+
+func (l *NullLiteral) Copy() Node {
+	if l == nil {
+		return l
+	}
+	nl := new(NullLiteral)
+	*nl = *l
+
+	return nl
+}
+
 type DateTimeLiteral struct {
+	loc
+
 	Value time.Time `json:"value"`
 }
 
@@ -630,7 +826,11 @@ func (l *DateTimeLiteral) Copy() Node {
 }
 
 type DurationLiteral struct {
+	loc
+
 	Value time.Duration `json:"value"`
+	// Months is the number of calendar months of the duration, which are added to its Value.
+	Months int64 `json:"months,omitempty"`
 }
 
 func (*DurationLiteral) NodeType() string { return "DurationLiteral" }
@@ -647,6 +847,8 @@ func (l *DurationLiteral) Copy() Node {
 }
 
 type IntegerLiteral struct {
+	loc
+
 	Value int64 `json:"value"`
 }
 
@@ -664,6 +866,8 @@ func (l *IntegerLiteral) Copy() Node {
 }
 
 type FloatLiteral struct {
+	loc
+
 	Value float64 `json:"value"`
 }
 
@@ -681,6 +885,8 @@ func (l *FloatLiteral) Copy() Node {
 }
 
 type RegexpLiteral struct {
+	loc
+
 	Value *regexp.Regexp `json:"value"`
 }
 
@@ -700,6 +906,8 @@ func (l *RegexpLiteral) Copy() Node {
 }
 
 type StringLiteral struct {
+	loc
+
 	Value string `json:"value"`
 }
 
@@ -717,6 +925,8 @@ func (l *StringLiteral) Copy() Node {
 }
 
 type UnsignedIntegerLiteral struct {
+	loc
+
 	Value uint64 `json:"value"`
 }
 
@@ -756,16 +966,41 @@ func (s DeclarationScope) Copy() DeclarationScope {
 
 func analyzeProgram(prog *ast.Program, declarations DeclarationScope) (*Program, error) {
 	p := &Program{
-		Body: make([]Statement, len(prog.Body)),
+		loc:  locOf(prog),
+		Body: make([]Statement, 0, len(prog.Body)),
+	}
+	if prog.Package != nil {
+		p.Package = &PackageClause{
+			loc:  locOf(prog.Package),
+			Name: &Identifier{loc: locOf(prog.Package.Name), Name: prog.Package.Name.Name},
+		}
 	}
-	for i, s := range prog.Body {
+	for _, imp := range prog.Imports {
+		d := &ImportDeclaration{
+			loc:  locOf(imp),
+			Path: &StringLiteral{loc: locOf(imp.Path), Value: imp.Path.Value},
+		}
+		if imp.As != nil {
+			d.As = &Identifier{loc: locOf(imp.As), Name: imp.As.Name}
+		}
+		p.Imports = append(p.Imports, d)
+	}
+	// Statements are analyzed independently so that all of their errors are reported.
+	var diags diagnostic.List
+	for _, s := range prog.Body {
 		n, err := analyzeStatment(s, declarations)
 		if err != nil {
-			return nil, err
+			diags.Add(err, diagnostic.SemanticError)
+			continue
 		}
-		p.Body[i] = n
+		p.Body = append(p.Body, n)
 	}
-	return p, nil
+	return p, diags.Err()
+}
+
+// semanticError reports that the node n is not valid.
+func semanticError(n ast.Node, format string, args ...interface{}) error {
+	return diagnostic.Errorf(diagnostic.SemanticError, n.Location(), format, args...)
 }
 
 func analyzeNode(n ast.Node, declarations DeclarationScope) (Node, error) {
@@ -775,7 +1010,7 @@ func analyzeNode(n ast.Node, declarations DeclarationScope) (Node, error) {
 	case ast.Expression:
 		return analyzeExpression(n, declarations)
 	default:
-		return nil, fmt.Errorf("unsupported node %T", n)
+		return nil, semanticError(n, "unsupported node %T", n)
 	}
 }
 
@@ -790,17 +1025,18 @@ func analyzeStatment(s ast.Statement, declarations DeclarationScope) (Statement,
 	case *ast.VariableDeclaration:
 		// Expect a single declaration
 		if len(s.Declarations) != 1 {
-			return nil, fmt.Errorf("only single variable declarations are supported, found %d declarations", len(s.Declarations))
+			return nil, semanticError(s, "only single variable declarations are supported, found %d declarations", len(s.Declarations))
 		}
 		return analyzeVariableDeclaration(s.Declarations[0], declarations)
 	default:
-		return nil, fmt.Errorf("unsupported statement %T", s)
+		return nil, semanticError(s, "unsupported statement %T", s)
 	}
 }
 
 func analyzeBlockStatement(block *ast.BlockStatement, declarations DeclarationScope) (*BlockStatement, error) {
 	declarations = declarations.Copy()
 	b := &BlockStatement{
+		loc:  locOf(block),
 		Body: make([]Statement, len(block.Body)),
 	}
 	for i, s := range block.Body {
@@ -812,7 +1048,7 @@ func analyzeBlockStatement(block *ast.BlockStatement, declarations DeclarationSc
 	}
 	last := len(b.Body) - 1
 	if _, ok := b.Body[last].(*ReturnStatement); !ok {
-		return nil, errors.New("missing return statement in block")
+		return nil, semanticError(block, "missing return statement in block")
 	}
 	return b, nil
 }
@@ -823,6 +1059,7 @@ func analyzeExpressionStatement(expr *ast.ExpressionStatement, declarations Decl
 		return nil, err
 	}
 	return &ExpressionStatement{
+		loc:        locOf(expr),
 		Expression: e,
 	}, nil
 }
@@ -833,6 +1070,7 @@ func analyzeReturnStatement(ret *ast.ReturnStatement, declarations DeclarationSc
 		return nil, err
 	}
 	return &ReturnStatement{
+		loc:      locOf(ret),
 		Argument: arg,
 	}, nil
 }
@@ -847,6 +1085,7 @@ func analyzeVariableDeclaration(decl *ast.VariableDeclarator, declarations Decla
 		return nil, err
 	}
 	vd := &NativeVariableDeclaration{
+		loc:        locOf(decl),
 		Identifier: id,
 		Init:       init,
 	}
@@ -870,6 +1109,8 @@ func analyzeExpression(expr ast.Expression, declarations DeclarationScope) (Expr
 		return analyzeUnaryExpression(expr, declarations)
 	case *ast.LogicalExpression:
 		return analyzeLogicalExpression(expr, declarations)
+	case *ast.ConditionalExpression:
+		return analyzeConditionalExpression(expr, declarations)
 	case *ast.ObjectExpression:
 		return analyzeObjectExpression(expr, declarations)
 	case *ast.ArrayExpression:
@@ -879,7 +1120,7 @@ func analyzeExpression(expr ast.Expression, declarations DeclarationScope) (Expr
 	case ast.Literal:
 		return analyzeLiteral(expr, declarations)
 	default:
-		return nil, fmt.Errorf("unsupported expression %T", expr)
+		return nil, semanticError(expr, "unsupported expression %T", expr)
 	}
 }
 
@@ -889,6 +1130,8 @@ func analyzeLiteral(lit ast.Literal, declarations DeclarationScope) (Literal, er
 		return analyzeStringLiteral(lit, declarations)
 	case *ast.BooleanLiteral:
 		return analyzeBooleanLiteral(lit, declarations)
+	case *ast.NullLiteral:
+		return &NullLiteral{loc: locOf(lit)}, nil
 	case *ast.FloatLiteral:
 		return analyzeFloatLiteral(lit, declarations)
 	case *ast.IntegerLiteral:
@@ -902,15 +1145,16 @@ func analyzeLiteral(lit ast.Literal, declarations DeclarationScope) (Literal, er
 	case *ast.DateTimeLiteral:
 		return analyzeDateTimeLiteral(lit, declarations)
 	case *ast.PipeLiteral:
-		return nil, errors.New("a pipe literal may only be used as a default value for an argument in a function definition")
+		return nil, semanticError(lit, "a pipe literal may only be used as a default value for an argument in a function definition")
 	default:
-		return nil, fmt.Errorf("unsupported literal %T", lit)
+		return nil, semanticError(lit, "unsupported literal %T", lit)
 	}
 }
 
 func analyzeArrowFunctionExpression(arrow *ast.ArrowFunctionExpression, declarations DeclarationScope) (*FunctionExpression, error) {
 	declarations = declarations.Copy()
 	f := &FunctionExpression{
+		loc:    locOf(arrow),
 		Params: make([]*FunctionParam, len(arrow.Params)),
 	}
 	pipedCount := 0
@@ -931,7 +1175,7 @@ func analyzeArrowFunctionExpression(arrow *ast.ArrowFunctionExpression, declarat
 				piped = true
 				pipedCount++
 				if pipedCount > 1 {
-					return nil, errors.New("only a single argument may be piped")
+					return nil, semanticError(p, "only a single argument may be piped")
 				}
 			} else {
 				d, err := analyzeExpression(p.Value, declarations)
@@ -940,6 +1184,7 @@ func analyzeArrowFunctionExpression(arrow *ast.ArrowFunctionExpression, declarat
 				}
 				def = d
 				declaration = &NativeVariableDeclaration{
+					loc:        locOf(p),
 					Identifier: key,
 					Init:       def,
 				}
@@ -948,6 +1193,7 @@ func analyzeArrowFunctionExpression(arrow *ast.ArrowFunctionExpression, declarat
 		}
 
 		f.Params[i] = &FunctionParam{
+			loc:         locOf(p),
 			Key:         key,
 			Default:     def,
 			Piped:       piped,
@@ -972,11 +1218,11 @@ func analyzeCallExpression(call *ast.CallExpression, declarations DeclarationSco
 	}
 	var args *ObjectExpression
 	if l := len(call.Arguments); l > 1 {
-		return nil, fmt.Errorf("arguments are not a single object expression %v", args)
+		return nil, semanticError(call, "arguments are not a single object expression %v", args)
 	} else if l == 1 {
 		obj, ok := call.Arguments[0].(*ast.ObjectExpression)
 		if !ok {
-			return nil, fmt.Errorf("arguments not an object expression")
+			return nil, semanticError(call.Arguments[0], "arguments not an object expression")
 		}
 		var err error
 		args, err = analyzeObjectExpression(obj, declarations)
@@ -988,6 +1234,7 @@ func analyzeCallExpression(call *ast.CallExpression, declarations DeclarationSco
 	}
 
 	expr := &CallExpression{
+		loc:       locOf(call),
 		Callee:    callee,
 		Arguments: args,
 	}
@@ -1052,10 +1299,11 @@ func analyzeMemberExpression(member *ast.MemberExpression, declarations Declarat
 	case *ast.IntegerLiteral:
 		propertyName = strconv.FormatInt(p.Value, 10)
 	default:
-		return nil, fmt.Errorf("unsupported member property expression of type %T", member.Property)
+		return nil, semanticError(member.Property, "unsupported member property expression of type %T", member.Property)
 	}
 
 	return &MemberExpression{
+		loc:      locOf(member),
 		Object:   obj,
 		Property: propertyName,
 	}, nil
@@ -1069,15 +1317,15 @@ func analyzePipeExpression(pipe *ast.PipeExpression, declarations DeclarationSco
 
 	decl, err := resolveDeclaration(call.Callee)
 	if err != nil {
-		return nil, err
+		return nil, semanticError(pipe.Call.Callee, "%v", err)
 	}
 	fnTyp := decl.InitType()
 	if fnTyp.Kind() != Function {
-		return nil, fmt.Errorf("cannot pipe into non function %q", fnTyp.Kind())
+		return nil, semanticError(pipe.Call.Callee, "cannot pipe into non function %q", fnTyp.Kind())
 	}
 	key := fnTyp.PipeArgument()
 	if key == "" {
-		return nil, fmt.Errorf("function %q does not have a pipe argument", decl.ID().Name)
+		return nil, semanticError(pipe.Call.Callee, "function %q does not have a pipe argument", decl.ID().Name)
 	}
 
 	value, err := analyzeExpression(pipe.Argument, declarations)
@@ -1085,7 +1333,8 @@ func analyzePipeExpression(pipe *ast.PipeExpression, declarations DeclarationSco
 		return nil, err
 	}
 	property := &Property{
-		Key:   &Identifier{Name: key},
+		loc:   locOf(pipe.Argument),
+		Key:   &Identifier{loc: locOf(pipe.Argument), Name: key},
 		Value: value,
 	}
 
@@ -1121,6 +1370,30 @@ func resolveDeclaration(n Node) (VariableDeclaration, error) {
 			return resolveDeclaration(i)
 		}
 		return n, nil
+	case *PackageDeclaration:
+		return n, nil
+	case *MemberExpression:
+		// The member of an object, such as the function of an imported package.
+		obj, err := resolveDeclaration(n.Object)
+		if err != nil {
+			return nil, err
+		}
+		if pkg, ok := obj.(*PackageDeclaration); ok {
+			m, ok := pkg.Members[n.Property]
+			if !ok {
+				return nil, fmt.Errorf("package %q has no member %q", pkg.Identifier.Name, n.Property)
+			}
+			return resolveDeclaration(m)
+		}
+		t := obj.InitType()
+		if t.Kind() != Object {
+			return nil, fmt.Errorf("cannot access property %q of non object %q", n.Property, t.Kind())
+		}
+		pt := t.PropertyType(n.Property)
+		if pt.Kind() == Invalid {
+			return nil, fmt.Errorf("%q has no property %q", obj.ID().Name, n.Property)
+		}
+		return NewExternalVariableDeclaration(n.Property, pt), nil
 	}
 	return nil, errors.New("no declaration found")
 }
@@ -1135,6 +1408,7 @@ func analyzeBinaryExpression(binary *ast.BinaryExpression, declarations Declarat
 		return nil, err
 	}
 	return &BinaryExpression{
+		loc:      locOf(binary),
 		Operator: binary.Operator,
 		Left:     left,
 		Right:    right,
@@ -1146,16 +1420,44 @@ func analyzeUnaryExpression(unary *ast.UnaryExpression, declarations Declaration
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := arg.(*MemberExpression); unary.Operator == ast.ExistsOperator && !ok {
+		return nil, semanticError(unary, "operand to exists must be a member expression, got %T", unary.Argument)
+	}
 	// TODO(nathanielc): validate operand type once we have type inference working with functions.
 	//k := arg.Type().Kind()
 	//if k != Bool && k != Int && k != Float && k != Duration {
 	//	return nil, fmt.Errorf("invalid unary operator %v on type %v", unary.Operator, k)
 	//}
 	return &UnaryExpression{
+		loc:      locOf(unary),
 		Operator: unary.Operator,
 		Argument: arg,
 	}, nil
 }
+
+// analyzeConditionalExpression analyzes the test and both branches of a conditional,
+// the types of the branches are checked once they are inferred.
+func analyzeConditionalExpression(cond *ast.ConditionalExpression, declarations DeclarationScope) (*ConditionalExpression, error) {
+	test, err := analyzeExpression(cond.Test, declarations)
+	if err != nil {
+		return nil, err
+	}
+	consequent, err := analyzeExpression(cond.Consequent, declarations)
+	if err != nil {
+		return nil, err
+	}
+	alternate, err := analyzeExpression(cond.Alternate, declarations)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalExpression{
+		loc:        locOf(cond),
+		Test:       test,
+		Consequent: consequent,
+		Alternate:  alternate,
+	}, nil
+}
+
 func analyzeLogicalExpression(logical *ast.LogicalExpression, declarations DeclarationScope) (*LogicalExpression, error) {
 	left, err := analyzeExpression(logical.Left, declarations)
 	if err != nil {
@@ -1173,6 +1475,7 @@ func analyzeLogicalExpression(logical *ast.LogicalExpression, declarations Decla
 	//	return nil, fmt.Errorf("right operand to logical expression is not a boolean, got kind %v", k)
 	//}
 	return &LogicalExpression{
+		loc:      locOf(logical),
 		Operator: logical.Operator,
 		Left:     left,
 		Right:    right,
@@ -1180,6 +1483,7 @@ func analyzeLogicalExpression(logical *ast.LogicalExpression, declarations Decla
 }
 func analyzeObjectExpression(obj *ast.ObjectExpression, declarations DeclarationScope) (*ObjectExpression, error) {
 	o := &ObjectExpression{
+		loc:        locOf(obj),
 		Properties: make([]*Property, len(obj.Properties)),
 	}
 	for i, p := range obj.Properties {
@@ -1193,6 +1497,7 @@ func analyzeObjectExpression(obj *ast.ObjectExpression, declarations Declaration
 }
 func analyzeArrayExpression(array *ast.ArrayExpression, declarations DeclarationScope) (*ArrayExpression, error) {
 	a := &ArrayExpression{
+		loc:      locOf(array),
 		Elements: make([]Expression, len(array.Elements)),
 	}
 	for i, e := range array.Elements {
@@ -1207,12 +1512,14 @@ func analyzeArrayExpression(array *ast.ArrayExpression, declarations Declaration
 
 func analyzeIdentifier(ident *ast.Identifier, declarations DeclarationScope) (*Identifier, error) {
 	return &Identifier{
+		loc:  locOf(ident),
 		Name: ident.Name,
 	}, nil
 }
 
 func analyzeIdentifierExpression(ident *ast.Identifier, declarations DeclarationScope) (*IdentifierExpression, error) {
 	return &IdentifierExpression{
+		loc:         locOf(ident),
 		Name:        ident.Name,
 		declaration: declarations[ident.Name],
 	}, nil
@@ -1228,6 +1535,7 @@ func analyzeProperty(property *ast.Property, declarations DeclarationScope) (*Pr
 		return nil, err
 	}
 	return &Property{
+		loc:   locOf(property),
 		Key:   key,
 		Value: value,
 	}, nil
@@ -1235,41 +1543,50 @@ func analyzeProperty(property *ast.Property, declarations DeclarationScope) (*Pr
 
 func analyzeDateTimeLiteral(lit *ast.DateTimeLiteral, declarations DeclarationScope) (*DateTimeLiteral, error) {
 	return &DateTimeLiteral{
+		loc:   locOf(lit),
 		Value: lit.Value,
 	}, nil
 }
 func analyzeDurationLiteral(lit *ast.DurationLiteral, declarations DeclarationScope) (*DurationLiteral, error) {
 	return &DurationLiteral{
-		Value: lit.Value,
+		loc:    locOf(lit),
+		Value:  lit.Value,
+		Months: lit.Months,
 	}, nil
 }
 func analyzeFloatLiteral(lit *ast.FloatLiteral, declarations DeclarationScope) (*FloatLiteral, error) {
 	return &FloatLiteral{
+		loc:   locOf(lit),
 		Value: lit.Value,
 	}, nil
 }
 func analyzeIntegerLiteral(lit *ast.IntegerLiteral, declarations DeclarationScope) (*IntegerLiteral, error) {
 	return &IntegerLiteral{
+		loc:   locOf(lit),
 		Value: lit.Value,
 	}, nil
 }
 func analyzeUnsignedIntegerLiteral(lit *ast.UnsignedIntegerLiteral, declarations DeclarationScope) (*UnsignedIntegerLiteral, error) {
 	return &UnsignedIntegerLiteral{
+		loc:   locOf(lit),
 		Value: lit.Value,
 	}, nil
 }
 func analyzeStringLiteral(lit *ast.StringLiteral, declarations DeclarationScope) (*StringLiteral, error) {
 	return &StringLiteral{
+		loc:   locOf(lit),
 		Value: lit.Value,
 	}, nil
 }
 func analyzeBooleanLiteral(lit *ast.BooleanLiteral, declarations DeclarationScope) (*BooleanLiteral, error) {
 	return &BooleanLiteral{
+		loc:   locOf(lit),
 		Value: lit.Value,
 	}, nil
 }
 func analyzeRegexpLiteral(lit *ast.RegexpLiteral, declarations DeclarationScope) (*RegexpLiteral, error) {
 	return &RegexpLiteral{
+		loc:   locOf(lit),
 		Value: lit.Value,
 	}, nil
 }
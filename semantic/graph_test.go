@@ -50,6 +50,48 @@ func TestNew(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "conditional",
+			program: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.ConditionalExpression{
+							Test:       &ast.BooleanLiteral{Value: true},
+							Consequent: &ast.StringLiteral{Value: "a"},
+							Alternate:  &ast.StringLiteral{Value: "b"},
+						},
+					},
+				},
+			},
+			want: &semantic.Program{
+				Body: []semantic.Statement{
+					&semantic.ExpressionStatement{
+						Expression: &semantic.ConditionalExpression{
+							Test:       &semantic.BooleanLiteral{Value: true},
+							Consequent: &semantic.StringLiteral{Value: "a"},
+							Alternate:  &semantic.StringLiteral{Value: "b"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "exists of a non member",
+			program: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.UnaryExpression{
+							Operator: ast.ExistsOperator,
+							Argument: &ast.StringLiteral{Value: "a"},
+						},
+					},
+				},
+			},
+			want: &semantic.Program{
+				Body: []semantic.Statement{},
+			},
+			wantErr: true,
+		},
 		{
 			name: "function",
 			program: &ast.Program{
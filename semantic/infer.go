@@ -0,0 +1,1110 @@
+package semantic
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/diagnostic"
+)
+
+// Infer infers the types of the expressions of the program and checks that they are used consistently.
+// Identifiers the program does not declare are resolved with the declarations, such as the builtin functions.
+//
+// The parameters of arrow functions are typed by the functions they are passed to and by their use.
+// This way a row function that uses a column that cannot exist, or uses a column inconsistently,
+// is reported before the query is planned.
+// The first inconsistency of each statement is reported in a diagnostic.List.
+func Infer(prog *Program, declarations DeclarationScope) error {
+	in := &inferrer{
+		declarations: declarations,
+		builtins:     make(map[string]*scheme),
+		packages:     make(map[*PackageDeclaration]*scheme),
+	}
+	s := &scope{vars: make(map[string]*scheme)}
+	var diags diagnostic.List
+	for _, stmt := range prog.Body {
+		if _, err := in.statements([]Statement{stmt}, s); err != nil {
+			diags.Add(err, diagnostic.TypeError)
+			if d, ok := stmt.(*NativeVariableDeclaration); ok {
+				// Leave the uses of the variable unchecked, they would only repeat the error.
+				s.vars[d.Identifier.Name] = in.generalize(in.fresh(), s)
+			}
+		}
+	}
+	return diags.Err()
+}
+
+// monotype is a type that is being inferred.
+// It is one of Kind, *typeVar, *arrayMono, *recordMono or *functionMono.
+type monotype interface {
+	String() string
+}
+
+// typeVar is a type that is not known yet.
+type typeVar struct {
+	id int
+	// bound is the type the variable is known to be, once it is known.
+	bound monotype
+	// props are the properties the type has been used with, it must be a record that has all of them.
+	props map[string]monotype
+}
+
+func (v *typeVar) String() string {
+	if v.bound != nil {
+		return v.bound.String()
+	}
+	if len(v.props) == 0 {
+		return "unknown"
+	}
+	var buf bytes.Buffer
+	buf.WriteRune('{')
+	writeProperties(&buf, v.props)
+	buf.WriteString(", ...}")
+	return buf.String()
+}
+
+type arrayMono struct {
+	elem monotype
+}
+
+func (a *arrayMono) String() string {
+	return "[" + a.elem.String() + "]"
+}
+
+type recordMono struct {
+	props map[string]monotype
+	// row reports whether the record is a row, which also has any tag column, see NewRowType.
+	row bool
+	// columns are the columns created by the query, such as by map, when the record is a table.
+	// The rows passed to the row functions of the functions the table is piped to have them as well.
+	columns map[string]monotype
+	// tags are the time and tag columns of the rows of a table whose rows only have known columns,
+	// such as the table returned by a map that returns a record.
+	// The rows of a table with tags have no other columns than its columns and tags.
+	tags map[string]monotype
+	// closed reports whether a row has no other columns than its properties.
+	closed bool
+}
+
+func (r *recordMono) String() string {
+	var buf bytes.Buffer
+	if r.row {
+		buf.WriteString("row")
+	}
+	buf.WriteRune('{')
+	writeProperties(&buf, r.props)
+	buf.WriteRune('}')
+	return buf.String()
+}
+
+type functionMono struct {
+	params map[string]monotype
+	pipe   string
+	// columns is the parameter whose result is the columns of the returned table, see FunctionSignature.
+	columns string
+	ret     monotype
+}
+
+func (f *functionMono) String() string {
+	var buf bytes.Buffer
+	buf.WriteRune('(')
+	writeProperties(&buf, f.params)
+	buf.WriteString(") => ")
+	buf.WriteString(f.ret.String())
+	return buf.String()
+}
+
+// param returns the type of the parameter that receives the argument name when calling a function with n arguments.
+// Row functions are called with their single parameter whatever its name.
+func (f *functionMono) param(name string, n int) (monotype, bool) {
+	if t, ok := f.params[name]; ok {
+		return t, true
+	}
+	if n == 1 && len(f.params) == 1 {
+		for _, t := range f.params {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func writeProperties(buf *bytes.Buffer, props map[string]monotype) {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "%s: %v", name, props[name])
+	}
+}
+
+// resolve returns the type a variable is bound to, or the variable itself if it is unbound.
+func resolve(t monotype) monotype {
+	for {
+		v, ok := t.(*typeVar)
+		if !ok || v.bound == nil {
+			return t
+		}
+		t = v.bound
+	}
+}
+
+// scheme is the type of a declared variable.
+// The type is generalized over vars, each use of the variable replaces them with new variables.
+type scheme struct {
+	vars []*typeVar
+	t    monotype
+}
+
+type scope struct {
+	parent *scope
+	vars   map[string]*scheme
+}
+
+func (s *scope) nest() *scope {
+	return &scope{
+		parent: s,
+		vars:   make(map[string]*scheme),
+	}
+}
+
+func (s *scope) lookup(name string) (*scheme, bool) {
+	for ; s != nil; s = s.parent {
+		if sc, ok := s.vars[name]; ok {
+			return sc, true
+		}
+	}
+	return nil, false
+}
+
+type inferrer struct {
+	declarations DeclarationScope
+	// builtins are the schemes of the native declarations, they are inferred once they are used.
+	builtins map[string]*scheme
+	// packages are the schemes of the imported packages, they are inferred once they are used.
+	packages map[*PackageDeclaration]*scheme
+	nextID   int
+}
+
+func (in *inferrer) fresh() *typeVar {
+	in.nextID++
+	return &typeVar{id: in.nextID}
+}
+
+func typeErrorf(n Node, format string, args ...interface{}) error {
+	return diagnostic.Errorf(diagnostic.TypeError, n.Location(), format, args...)
+}
+
+// typeError locates an error found while unifying the types of n.
+// The code of a diagnostic is kept.
+func typeError(n Node, err error) error {
+	code := diagnostic.TypeError
+	if d, ok := err.(*diagnostic.Diagnostic); ok {
+		code = d.Code
+		err = errors.New(d.Message)
+	}
+	return diagnostic.Errorf(code, n.Location(), "%v", err)
+}
+
+// statements infers the types of a list of statements, returning the type of its return statement if any.
+func (in *inferrer) statements(body []Statement, s *scope) (monotype, error) {
+	for _, stmt := range body {
+		switch stmt := stmt.(type) {
+		case *NativeVariableDeclaration:
+			t, err := in.expression(stmt.Init, s)
+			if err != nil {
+				return nil, err
+			}
+			s.vars[stmt.Identifier.Name] = in.generalize(t, s)
+		case *ExternalVariableDeclaration:
+			s.vars[stmt.Identifier.Name] = in.generalize(in.monotypeOf(stmt.InitType()), s)
+		case *ExpressionStatement:
+			if _, err := in.expression(stmt.Expression, s); err != nil {
+				return nil, err
+			}
+		case *ReturnStatement:
+			return in.expression(stmt.Argument, s)
+		case *BlockStatement:
+			if _, err := in.statements(stmt.Body, s.nest()); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, typeErrorf(stmt, "unsupported statement %T", stmt)
+		}
+	}
+	return nil, nil
+}
+
+func (in *inferrer) expression(e Expression, s *scope) (monotype, error) {
+	switch e := e.(type) {
+	case *IdentifierExpression:
+		t, ok := in.lookup(e.Name, s)
+		if !ok {
+			return nil, diagnostic.Errorf(diagnostic.UndefinedIdentifier, e.Location(), "undefined identifier %q", e.Name)
+		}
+		return t, nil
+	case *MemberExpression:
+		obj, err := in.expression(e.Object, s)
+		if err != nil {
+			return nil, err
+		}
+		t, err := in.property(obj, e.Property)
+		if err != nil {
+			return nil, typeError(e, err)
+		}
+		return t, nil
+	case *CallExpression:
+		return in.call(e, s)
+	case *FunctionExpression:
+		return in.function(e, s, nil)
+	case *ObjectExpression:
+		props := make(map[string]monotype, len(e.Properties))
+		for _, p := range e.Properties {
+			t, err := in.expression(p.Value, s)
+			if err != nil {
+				return nil, err
+			}
+			props[p.Key.Name] = t
+		}
+		return &recordMono{props: props}, nil
+	case *ArrayExpression:
+		elem := monotype(in.fresh())
+		for _, el := range e.Elements {
+			t, err := in.expression(el, s)
+			if err != nil {
+				return nil, err
+			}
+			if err := in.unify(elem, t); err != nil {
+				return nil, typeError(el, err)
+			}
+		}
+		return &arrayMono{elem: elem}, nil
+	case *BinaryExpression:
+		l, err := in.expression(e.Left, s)
+		if err != nil {
+			return nil, err
+		}
+		r, err := in.expression(e.Right, s)
+		if err != nil {
+			return nil, err
+		}
+		t, err := in.binary(e.Operator, l, r)
+		if err != nil {
+			return nil, typeError(e, err)
+		}
+		return t, nil
+	case *UnaryExpression:
+		if e.Operator == ast.ExistsOperator {
+			// The property does not need to exist, only its object is checked.
+			if m, ok := e.Argument.(*MemberExpression); ok {
+				if _, err := in.expression(m.Object, s); err != nil {
+					return nil, err
+				}
+			}
+			return Bool, nil
+		}
+		t, err := in.expression(e.Argument, s)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Operator {
+		case ast.NotOperator:
+			if err := in.unify(Bool, t); err != nil {
+				return nil, typeError(e.Argument, err)
+			}
+			return Bool, nil
+		case ast.SubtractionOperator:
+			switch k := resolve(t); k {
+			case Int, Float, Duration:
+			default:
+				if _, ok := k.(*typeVar); !ok {
+					return nil, typeErrorf(e, "invalid operand to unary %v: %v", e.Operator, k)
+				}
+			}
+		}
+		return t, nil
+	case *ConditionalExpression:
+		test, err := in.expression(e.Test, s)
+		if err != nil {
+			return nil, err
+		}
+		if err := in.unify(Bool, test); err != nil {
+			return nil, typeError(e.Test, err)
+		}
+		t, err := in.expression(e.Consequent, s)
+		if err != nil {
+			return nil, err
+		}
+		alt, err := in.expression(e.Alternate, s)
+		if err != nil {
+			return nil, err
+		}
+		if err := in.unify(t, alt); err != nil {
+			return nil, typeError(e.Alternate, err)
+		}
+		return t, nil
+	case *LogicalExpression:
+		for _, operand := range []Expression{e.Left, e.Right} {
+			t, err := in.expression(operand, s)
+			if err != nil {
+				return nil, err
+			}
+			if err := in.unify(Bool, t); err != nil {
+				return nil, typeError(operand, err)
+			}
+		}
+		return Bool, nil
+	case *NullLiteral:
+		// A null may stand in for a value of any type.
+		return in.fresh(), nil
+	case Literal:
+		return e.Type().Kind(), nil
+	default:
+		return nil, typeErrorf(e, "unsupported expression %T", e)
+	}
+}
+
+// lookup returns the type of a new use of the variable name.
+func (in *inferrer) lookup(name string, s *scope) (monotype, bool) {
+	if sc, ok := s.lookup(name); ok {
+		return in.instantiate(sc), true
+	}
+	switch d := in.declarations[name].(type) {
+	case *ExternalVariableDeclaration:
+		// Each conversion creates new variables, so there is no need to generalize the type.
+		return in.monotypeOf(d.InitType()), true
+	case *NativeVariableDeclaration:
+		sc, ok := in.builtins[name]
+		if !ok {
+			// Mark the declaration as being inferred, in case it refers to itself.
+			in.builtins[name] = &scheme{t: in.fresh()}
+			// Native declarations are only the builtins declared before the program,
+			// so their types do not depend on the program and can be fully generalized.
+			root := &scope{vars: make(map[string]*scheme)}
+			t, err := in.expression(d.Init, root)
+			if err != nil {
+				// The builtin is checked on its own, see query.FinalizeRegistration.
+				t = in.fresh()
+			}
+			sc = in.generalize(t, root)
+			in.builtins[name] = sc
+		}
+		return in.instantiate(sc), true
+	case *PackageDeclaration:
+		sc, ok := in.packages[d]
+		if !ok {
+			sc = in.packageScheme(d)
+			in.packages[d] = sc
+		}
+		return in.instantiate(sc), true
+	}
+	return nil, false
+}
+
+// packageScheme infers the type of an imported package, a record of the types of its members.
+// The members are inferred with the declarations of the package, like the builtins they do not depend on the program.
+func (in *inferrer) packageScheme(d *PackageDeclaration) *scheme {
+	declarations, builtins := in.declarations, in.builtins
+	in.declarations, in.builtins = d.Declarations, make(map[string]*scheme)
+	defer func() {
+		in.declarations, in.builtins = declarations, builtins
+	}()
+
+	root := &scope{vars: make(map[string]*scheme)}
+	props := make(map[string]monotype, len(d.Members))
+	for name := range d.Members {
+		t, ok := in.lookup(name, root)
+		if !ok {
+			t = in.fresh()
+		}
+		props[name] = t
+	}
+	return in.generalize(&recordMono{props: props}, root)
+}
+
+func (in *inferrer) call(c *CallExpression, s *scope) (monotype, error) {
+	callee, err := in.expression(c.Callee, s)
+	if err != nil {
+		return nil, err
+	}
+	switch f := resolve(callee).(type) {
+	case *functionMono:
+		args := make(map[string]monotype, len(c.Arguments.Properties))
+		// The piped table is inferred first, so that its columns can be added to the rows of the row functions.
+		for _, p := range c.Arguments.Properties {
+			if p.Key.Name != f.pipe {
+				continue
+			}
+			t, err := in.expression(p.Value, s)
+			if err != nil {
+				return nil, err
+			}
+			args[p.Key.Name] = t
+		}
+		var columns, tags map[string]monotype
+		if table, ok := resolve(args[f.pipe]).(*recordMono); ok {
+			columns = table.columns
+			tags = table.tags
+		}
+		addColumns(f, columns, tags)
+
+		for _, p := range c.Arguments.Properties {
+			param, ok := f.params[p.Key.Name]
+			t, inferred := args[p.Key.Name]
+			if fn, isFn := p.Value.(*FunctionExpression); isFn && ok {
+				// Type the parameters of the arrow function with the parameters of the expected function,
+				// so that errors are found where the parameters are used.
+				expected, _ := resolve(param).(*functionMono)
+				t, err = in.function(fn, s, expected)
+			} else if !inferred {
+				t, err = in.expression(p.Value, s)
+			}
+			if err != nil {
+				return nil, err
+			}
+			args[p.Key.Name] = t
+			// Parameters that are not declared by the signature of the function are left to the function to check.
+			if !ok || isRelativeTime(param, t) {
+				continue
+			}
+			if err := in.unify(param, t); err != nil {
+				return nil, typeErrorf(p, "invalid argument %q: %v", p.Key.Name, err)
+			}
+			if p.Key.Name == f.columns {
+				if err := columnTypeError(t); err != nil {
+					return nil, typeErrorf(p, "invalid argument %q: %v", p.Key.Name, err)
+				}
+			}
+		}
+		if f.pipe == "" {
+			return f.ret, nil
+		}
+		table, ok := resolve(f.ret).(*recordMono)
+		if !ok {
+			return f.ret, nil
+		}
+		if f.columns != "" {
+			columns, tags = createdColumns(args[f.columns], columns, tags)
+		}
+		return &recordMono{props: table.props, columns: columns, tags: tags}, nil
+	case *typeVar:
+		params := make(map[string]monotype, len(c.Arguments.Properties))
+		for _, p := range c.Arguments.Properties {
+			t, err := in.expression(p.Value, s)
+			if err != nil {
+				return nil, err
+			}
+			params[p.Key.Name] = t
+		}
+		ret := in.fresh()
+		if err := in.bind(f, &functionMono{params: params, ret: ret}); err != nil {
+			return nil, typeError(c, err)
+		}
+		return ret, nil
+	default:
+		return nil, typeErrorf(c.Callee, "cannot call %v, it is not a function", f)
+	}
+}
+
+// addColumns adds the columns created upstream of the table piped to f to the rows passed to its row functions.
+// If the rows of the table have known tags, the rows only have the columns and the tags.
+func addColumns(f *functionMono, columns, tags map[string]monotype) {
+	for _, p := range f.params {
+		fn, ok := resolve(p).(*functionMono)
+		if !ok {
+			continue
+		}
+		for _, r := range fn.params {
+			row, ok := resolve(r).(*recordMono)
+			if !ok || !row.row {
+				continue
+			}
+			if tags != nil {
+				row.props = make(map[string]monotype, len(columns)+len(tags))
+				for name, t := range tags {
+					row.props[name] = t
+				}
+				for name, t := range columns {
+					row.props[name] = t
+				}
+				row.closed = true
+				continue
+			}
+			for name, t := range columns {
+				if _, ok := row.props[name]; !ok {
+					row.props[name] = t
+				}
+			}
+		}
+	}
+}
+
+// valueColumn is the value column of the rows, which a row function that returns a value creates.
+const valueColumn = "_value"
+
+// createdColumns returns the columns created by the row function t and the tags of the rows of the returned table,
+// given the columns and tags of the piped table.
+// A row function that returns a value creates the value column, which every row has.
+//
+// A row function that returns a record replaces the value columns of the rows with the properties of the record,
+// so the returned rows only have those columns and the time and tag columns of the rows passed to it.
+// Their tags are the columns of these rows other than the value columns.
+func createdColumns(t monotype, pipedColumns, pipedTags map[string]monotype) (map[string]monotype, map[string]monotype) {
+	fn, ok := resolve(t).(*functionMono)
+	if !ok {
+		return nil, nil
+	}
+	record, ok := resolve(fn.ret).(*recordMono)
+	if !ok {
+		if pipedTags != nil {
+			return map[string]monotype{valueColumn: fn.ret}, pipedTags
+		}
+		return nil, nil
+	}
+	columns := make(map[string]monotype, len(record.props))
+	for name, p := range record.props {
+		columns[name] = p
+	}
+	tags := make(map[string]monotype)
+	for _, p := range fn.params {
+		row, ok := resolve(p).(*recordMono)
+		if !ok || !row.row {
+			continue
+		}
+		for name, p := range row.props {
+			if _, ok := pipedColumns[name]; ok || name == valueColumn {
+				continue
+			}
+			if _, ok := record.props[name]; !ok {
+				tags[name] = p
+			}
+		}
+	}
+	return columns, tags
+}
+
+// columnTypeError returns an error if the row function t returns a value that a column cannot store,
+// since the values it returns become columns.
+// Values whose type is not known yet are checked once the block is read.
+func columnTypeError(t monotype) error {
+	fn, ok := resolve(t).(*functionMono)
+	if !ok {
+		return nil
+	}
+	record, ok := resolve(fn.ret).(*recordMono)
+	if !ok {
+		if !isColumnType(fn.ret) {
+			return fmt.Errorf("a column cannot have type %v", resolve(fn.ret))
+		}
+		return nil
+	}
+	names := make([]string, 0, len(record.props))
+	for name := range record.props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if p := record.props[name]; !isColumnType(p) {
+			return fmt.Errorf("column %q cannot have type %v", name, resolve(p))
+		}
+	}
+	return nil
+}
+
+// isColumnType reports whether a column can store values of the type t.
+func isColumnType(t monotype) bool {
+	switch t := resolve(t).(type) {
+	case Kind:
+		switch t {
+		case Bool, Int, UInt, Float, String, Time, Nil:
+			return true
+		}
+		return false
+	case *typeVar:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRelativeTime reports whether the argument is a duration passed as a time, which is then relative to now.
+func isRelativeTime(param, arg monotype) bool {
+	return resolve(param) == Time && resolve(arg) == Duration
+}
+
+// function infers the type of an arrow function.
+// If the function is passed as an argument, expected is the type of the parameter it is passed to.
+func (in *inferrer) function(f *FunctionExpression, s *scope, expected *functionMono) (monotype, error) {
+	s = s.nest()
+	ft := &functionMono{
+		params: make(map[string]monotype, len(f.Params)),
+	}
+	for _, p := range f.Params {
+		var t monotype
+		if p.Default != nil {
+			d, err := in.expression(p.Default, s)
+			if err != nil {
+				return nil, err
+			}
+			t = d
+		} else {
+			t = in.fresh()
+		}
+		if expected != nil {
+			if et, ok := expected.param(p.Key.Name, len(f.Params)); ok {
+				if err := in.unify(et, t); err != nil {
+					return nil, typeError(p, err)
+				}
+			}
+		}
+		if p.Piped {
+			ft.pipe = p.Key.Name
+		}
+		ft.params[p.Key.Name] = t
+		s.vars[p.Key.Name] = &scheme{t: t}
+	}
+
+	var (
+		ret monotype
+		err error
+	)
+	switch b := f.Body.(type) {
+	case Expression:
+		ret, err = in.expression(b, s)
+	case *BlockStatement:
+		ret, err = in.statements(b.Body, s)
+	default:
+		err = typeErrorf(f, "unsupported function body %T", f.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if expected != nil {
+		if err := in.unify(expected.ret, ret); err != nil {
+			return nil, typeError(f, err)
+		}
+	}
+	ft.ret = ret
+	return ft, nil
+}
+
+// binary returns the type of a binary expression, as listed by binaryTypesLookup.
+// Operands whose types are not known yet are inferred from the signatures the operator supports.
+func (in *inferrer) binary(op ast.OperatorKind, l, r monotype) (monotype, error) {
+	l, r = resolve(l), resolve(r)
+	lk, lKnown := l.(Kind)
+	rk, rKnown := r.(Kind)
+	_, lVar := l.(*typeVar)
+	_, rVar := r.(*typeVar)
+	var sigs []binarySignature
+	if (lKnown || lVar) && (rKnown || rVar) {
+		for sig := range binaryTypesLookup {
+			if sig.operator == op && (!lKnown || sig.left == lk) && (!rKnown || sig.right == rk) {
+				sigs = append(sigs, sig)
+			}
+		}
+	}
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("invalid binary operation %v %v %v", l, op, r)
+	}
+	// Regular expressions are mostly written as literals, so an operand whose type is not known yet
+	// is only taken to be one if the operator cannot be applied to anything else, like the operands of =~.
+	if lVar || rVar {
+		var plain []binarySignature
+		for _, sig := range sigs {
+			if !(lVar && sig.left == Regexp) && !(rVar && sig.right == Regexp) {
+				plain = append(plain, sig)
+			}
+		}
+		if len(plain) > 0 {
+			sigs = plain
+		}
+	}
+	left, right, result := sigs[0].left, sigs[0].right, binaryTypesLookup[sigs[0]]
+	for _, sig := range sigs[1:] {
+		if sig.left != left {
+			left = Invalid
+		}
+		if sig.right != right {
+			right = Invalid
+		}
+		if k := binaryTypesLookup[sig]; k != result {
+			result = Invalid
+		}
+	}
+	if left != Invalid {
+		if err := in.unify(left, l); err != nil {
+			return nil, err
+		}
+	}
+	if right != Invalid {
+		if err := in.unify(right, r); err != nil {
+			return nil, err
+		}
+	}
+	if result != Invalid {
+		return result, nil
+	}
+	// The operator is arithmetic, its operands have the same type
+	// and so does its result, unless the operands are times whose difference is a duration.
+	if err := in.unify(l, r); err != nil {
+		return nil, err
+	}
+	if k, ok := resolve(l).(Kind); ok {
+		if result, ok := binaryTypesLookup[binarySignature{operator: op, left: k, right: k}]; ok {
+			return result, nil
+		}
+	}
+	return l, nil
+}
+
+// property returns the type of the property name of t.
+func (in *inferrer) property(t monotype, name string) (monotype, error) {
+	switch t := resolve(t).(type) {
+	case *typeVar:
+		if p, ok := t.props[name]; ok {
+			return p, nil
+		}
+		p := in.fresh()
+		if t.props == nil {
+			t.props = make(map[string]monotype)
+		}
+		t.props[name] = p
+		return p, nil
+	case *recordMono:
+		if p, ok := t.props[name]; ok {
+			return p, nil
+		}
+		if t.row {
+			if t.closed || IsReservedColumn(name) {
+				return nil, diagnostic.Errorf(diagnostic.UnknownColumn, nil, "unknown column %q", name)
+			}
+			p := in.fresh()
+			t.props[name] = p
+			return p, nil
+		}
+		return nil, fmt.Errorf("%v has no property %q", t, name)
+	case *arrayMono:
+		if _, err := strconv.Atoi(name); err == nil {
+			return t.elem, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot access property %q of %v", name, t)
+}
+
+// unify makes the types a and b equal, or fails if they cannot be.
+// The type a is the expected type.
+func (in *inferrer) unify(a, b monotype) error {
+	a, b = resolve(a), resolve(b)
+	if a == b {
+		return nil
+	}
+	if v, ok := a.(*typeVar); ok {
+		return in.bind(v, b)
+	}
+	if v, ok := b.(*typeVar); ok {
+		return in.bind(v, a)
+	}
+	switch a := a.(type) {
+	case *arrayMono:
+		if b, ok := b.(*arrayMono); ok {
+			return in.unify(a.elem, b.elem)
+		}
+	case *recordMono:
+		if b, ok := b.(*recordMono); ok {
+			return in.unifyRecords(a, b)
+		}
+	case *functionMono:
+		if b, ok := b.(*functionMono); ok {
+			return in.unifyFunctions(a, b)
+		}
+	}
+	return fmt.Errorf("expected %v but found %v", a, b)
+}
+
+func (in *inferrer) unifyRecords(a, b *recordMono) error {
+	for _, name := range sortedNames(a.props) {
+		p, err := in.property(b, name)
+		if err != nil {
+			return err
+		}
+		if err := in.unify(a.props[name], p); err != nil {
+			return err
+		}
+	}
+	for _, name := range sortedNames(b.props) {
+		if _, ok := a.props[name]; ok {
+			continue
+		}
+		p, err := in.property(a, name)
+		if err != nil {
+			return err
+		}
+		if err := in.unify(p, b.props[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unifyFunctions unifies the parameters both functions have and their return types.
+// Parameters only one of the functions has are not checked, since they may have a default value.
+func (in *inferrer) unifyFunctions(a, b *functionMono) error {
+	for name, pa := range a.params {
+		if pb, ok := b.param(name, len(a.params)); ok {
+			if err := in.unify(pa, pb); err != nil {
+				return err
+			}
+		}
+	}
+	return in.unify(a.ret, b.ret)
+}
+
+// bind binds the variable to the type t.
+func (in *inferrer) bind(v *typeVar, t monotype) error {
+	if occurs(v, t) {
+		return fmt.Errorf("recursive type %v", t)
+	}
+	props := v.props
+	v.bound = t
+	v.props = nil
+	for _, name := range sortedNames(props) {
+		p, err := in.property(t, name)
+		if err != nil {
+			return err
+		}
+		if err := in.unify(props[name], p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func occurs(v *typeVar, t monotype) bool {
+	switch t := resolve(t).(type) {
+	case *typeVar:
+		if t == v {
+			return true
+		}
+		for _, p := range t.props {
+			if occurs(v, p) {
+				return true
+			}
+		}
+	case *arrayMono:
+		return occurs(v, t.elem)
+	case *recordMono:
+		for _, p := range t.props {
+			if occurs(v, p) {
+				return true
+			}
+		}
+		for _, p := range t.columns {
+			if occurs(v, p) {
+				return true
+			}
+		}
+		for _, p := range t.tags {
+			if occurs(v, p) {
+				return true
+			}
+		}
+	case *functionMono:
+		for _, p := range t.params {
+			if occurs(v, p) {
+				return true
+			}
+		}
+		return occurs(v, t.ret)
+	}
+	return false
+}
+
+func sortedNames(props map[string]monotype) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// freeVars adds the unbound variables of t to vars.
+func freeVars(t monotype, vars map[*typeVar]bool) {
+	switch t := resolve(t).(type) {
+	case *typeVar:
+		if vars[t] {
+			return
+		}
+		vars[t] = true
+		for _, p := range t.props {
+			freeVars(p, vars)
+		}
+	case *arrayMono:
+		freeVars(t.elem, vars)
+	case *recordMono:
+		for _, p := range t.props {
+			freeVars(p, vars)
+		}
+		for _, p := range t.columns {
+			freeVars(p, vars)
+		}
+		for _, p := range t.tags {
+			freeVars(p, vars)
+		}
+	case *functionMono:
+		for _, p := range t.params {
+			freeVars(p, vars)
+		}
+		freeVars(t.ret, vars)
+	}
+}
+
+// generalize creates the scheme of a variable declared in scope s with type t.
+// The scheme is generalized over the variables of t that are not used by the types of the scope.
+func (in *inferrer) generalize(t monotype, s *scope) *scheme {
+	vars := make(map[*typeVar]bool)
+	freeVars(t, vars)
+	if len(vars) == 0 {
+		return &scheme{t: t}
+	}
+	used := make(map[*typeVar]bool)
+	for ; s != nil; s = s.parent {
+		for _, sc := range s.vars {
+			sv := make(map[*typeVar]bool)
+			freeVars(sc.t, sv)
+			for _, v := range sc.vars {
+				delete(sv, v)
+			}
+			for v := range sv {
+				used[v] = true
+			}
+		}
+	}
+	sc := &scheme{t: t}
+	for v := range vars {
+		if !used[v] {
+			sc.vars = append(sc.vars, v)
+		}
+	}
+	return sc
+}
+
+// instantiate returns the type of a new use of a variable with the scheme.
+func (in *inferrer) instantiate(sc *scheme) monotype {
+	if len(sc.vars) == 0 {
+		return sc.t
+	}
+	vars := make(map[*typeVar]*typeVar, len(sc.vars))
+	for _, v := range sc.vars {
+		vars[v] = in.fresh()
+	}
+	for _, v := range sc.vars {
+		if len(v.props) > 0 {
+			props := make(map[string]monotype, len(v.props))
+			for name, p := range v.props {
+				props[name] = instantiateType(p, vars)
+			}
+			vars[v].props = props
+		}
+	}
+	return instantiateType(sc.t, vars)
+}
+
+func instantiateType(t monotype, vars map[*typeVar]*typeVar) monotype {
+	switch t := resolve(t).(type) {
+	case *typeVar:
+		if v, ok := vars[t]; ok {
+			return v
+		}
+		return t
+	case *arrayMono:
+		return &arrayMono{elem: instantiateType(t.elem, vars)}
+	case *recordMono:
+		props := make(map[string]monotype, len(t.props))
+		for name, p := range t.props {
+			props[name] = instantiateType(p, vars)
+		}
+		return &recordMono{
+			props:   props,
+			row:     t.row,
+			columns: instantiateColumns(t.columns, vars),
+			tags:    instantiateColumns(t.tags, vars),
+			closed:  t.closed,
+		}
+	case *functionMono:
+		params := make(map[string]monotype, len(t.params))
+		for name, p := range t.params {
+			params[name] = instantiateType(p, vars)
+		}
+		return &functionMono{
+			params:  params,
+			pipe:    t.pipe,
+			columns: t.columns,
+			ret:     instantiateType(t.ret, vars),
+		}
+	default:
+		return t
+	}
+}
+
+// instantiateColumns instantiates the types of the columns or tags of a table.
+func instantiateColumns(columns map[string]monotype, vars map[*typeVar]*typeVar) map[string]monotype {
+	if columns == nil {
+		return nil
+	}
+	instantiated := make(map[string]monotype, len(columns))
+	for name, p := range columns {
+		instantiated[name] = instantiateType(p, vars)
+	}
+	return instantiated
+}
+
+// monotypeOf converts a declared type into a monotype.
+// Kinds without any further type information, like Invalid, become new type variables.
+func (in *inferrer) monotypeOf(t Type) monotype {
+	if t == nil {
+		return in.fresh()
+	}
+	switch t := t.(type) {
+	case Kind:
+		switch t {
+		case Invalid, Nil, Array, Object, Function:
+			return in.fresh()
+		}
+		return t
+	case *arrayType:
+		return &arrayMono{elem: in.monotypeOf(t.elementType)}
+	case *objectType:
+		if len(t.properties) == 0 && !t.row {
+			// The empty object is used for objects whose type varies.
+			return in.fresh()
+		}
+		props := make(map[string]monotype, len(t.properties))
+		for name, p := range t.properties {
+			props[name] = in.monotypeOf(p)
+		}
+		return &recordMono{props: props, row: t.row}
+	case *functionType:
+		params := make(map[string]monotype, len(t.params))
+		for name, p := range t.params {
+			params[name] = in.monotypeOf(p)
+		}
+		return &functionMono{
+			params:  params,
+			pipe:    t.pipeArgument,
+			columns: t.columnsArgument,
+			ret:     in.monotypeOf(t.returnType),
+		}
+	default:
+		panic(fmt.Errorf("unknown type %T", t))
+	}
+}
@@ -0,0 +1,280 @@
+package semantic_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/parser"
+	"github.com/influxdata/ifql/semantic"
+)
+
+func TestInfer(t *testing.T) {
+	tableType := semantic.NewObjectType(map[string]semantic.Type{
+		"id": semantic.String,
+	})
+	rowType := semantic.NewRowType(map[string]semantic.Type{
+		"_time":  semantic.Time,
+		"_value": semantic.Invalid,
+	})
+	declarations := semantic.DeclarationScope{
+		"from": semantic.NewExternalVariableDeclaration("from", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"db": semantic.String,
+			},
+			ReturnType: tableType,
+		})),
+		"range": semantic.NewExternalVariableDeclaration("range", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"table": tableType,
+				"start": semantic.Time,
+			},
+			ReturnType:   tableType,
+			PipeArgument: "table",
+		})),
+		"filter": semantic.NewExternalVariableDeclaration("filter", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"table": tableType,
+				"fn": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params: map[string]semantic.Type{
+						"r": rowType,
+					},
+					ReturnType: semantic.Bool,
+				}),
+			},
+			ReturnType:   tableType,
+			PipeArgument: "table",
+		})),
+		"map": semantic.NewExternalVariableDeclaration("map", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"table": tableType,
+				"fn": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params: map[string]semantic.Type{
+						"r": rowType,
+					},
+					ReturnType: semantic.Invalid,
+				}),
+			},
+			ReturnType:      tableType,
+			PipeArgument:    "table",
+			ColumnsArgument: "fn",
+		})),
+	}
+	lib, err := parser.NewAST("inc = (x) => x + 1\n_one = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	libDeclarations := declarations.Copy()
+	if _, err := semantic.New(lib, libDeclarations); err != nil {
+		t.Fatal(err)
+	}
+	declarations["lib"] = semantic.NewPackageDeclaration("lib", semantic.DeclarationScope{
+		"inc": libDeclarations["inc"],
+	}, libDeclarations)
+
+	testCases := []struct {
+		name    string
+		program string
+		wantErr string
+	}{
+		{
+			name:    "pipeline",
+			program: `from(db:"telegraf") |> range(start:-1h) |> filter(fn: (r) => r._value > 1.0 and r.host == "a")`,
+		},
+		{
+			name:    "unknown column",
+			program: `from(db:"telegraf") |> filter(fn: (r) => r._valu + 1 > 0)`,
+			wantErr: `1:42: unknown column "_valu"`,
+		},
+		{
+			name:    "unknown column with any parameter name",
+			program: `from(db:"telegraf") |> filter(fn: (row) => row._valu == 1)`,
+			wantErr: `1:44: unknown column "_valu"`,
+		},
+		{
+			name:    "columns created by map",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({_foo: r._value * 2.0})) |> range(start:-1h) |> filter(fn: (r) => r._foo > 1.0)`,
+		},
+		{
+			name:    "inconsistent column created by map",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({_foo: "a"})) |> filter(fn: (r) => r._foo > 1)`,
+			wantErr: `1:75: invalid binary operation string > int`,
+		},
+		{
+			name:    "duration column created by map",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({d: r._time - r._time}))`,
+			wantErr: `1:28: invalid argument "fn": column "d" cannot have type duration`,
+		},
+		{
+			name:    "columns not created by the last map",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({_foo: 1})) |> map(fn: (r) => ({_bar: r._foo})) |> filter(fn: (r) => r._foo == 1)`,
+			wantErr: `1:109: unknown column "_foo"`,
+		},
+		{
+			name:    "misspelled column created by map",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({v: r._value * 2.0})) |> map(fn: (r) => r.w + 1.0)`,
+			wantErr: `1:80: unknown column "w"`,
+		},
+		{
+			name:    "tags after a map that returns a record",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({v: r._value * 2.0, h: r.host})) |> filter(fn: (r) => r.v > 1.0 and r.host == "a" and r._time > r._time)`,
+		},
+		{
+			name:    "value column after a map that returns a record",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({v: r._value * 2.0})) |> filter(fn: (r) => r._value > 1.0)`,
+			wantErr: `1:83: unknown column "_value"`,
+		},
+		{
+			name:    "value column after a map that returns a value",
+			program: `from(db:"telegraf") |> map(fn: (r) => ({v: r._value * 2.0})) |> map(fn: (r) => r.v + 1.0) |> filter(fn: (r) => r._value > 1.0 and r.v > 1.0)`,
+			wantErr: `1:131: unknown column "v"`,
+		},
+		{
+			name:    "columns created by map in a variable",
+			program: "t = from(db:\"telegraf\") |> map(fn: (r) => ({_foo: 1}))\nt |> filter(fn: (r) => r._foo > 1)",
+		},
+		{
+			name:    "inconsistent column",
+			program: `from(db:"telegraf") |> filter(fn: (r) => r.host == "a" or r.host > 1)`,
+			wantErr: `1:59: invalid binary operation string > int`,
+		},
+		{
+			name:    "regular expression equality",
+			program: `from(db:"telegraf") |> filter(fn: (r) => r.host == /a.*/ and r.region != /us/)`,
+		},
+		{
+			name:    "predicate is not a boolean",
+			program: `from(db:"telegraf") |> filter(fn: (r) => r._value + 1)`,
+			wantErr: `1:35: expected bool but found int`,
+		},
+		{
+			name:    "argument type",
+			program: `from(db:1)`,
+			wantErr: `1:6: invalid argument "db": expected string but found int`,
+		},
+		{
+			name:    "table argument",
+			program: `t = {id:"a"} |> range(start:-1h)`,
+		},
+		{
+			name:    "binary operation",
+			program: `a = 1 + "a"`,
+			wantErr: `1:5: invalid binary operation int + string`,
+		},
+		{
+			name:    "logical operand",
+			program: `a = 1 and true`,
+			wantErr: `1:5: expected bool but found int`,
+		},
+		{
+			name:    "undefined identifier",
+			program: `a = b + 1`,
+			wantErr: `1:5: undefined identifier "b"`,
+		},
+		{
+			name:    "missing property",
+			program: "o = {a:1}\no.b",
+			wantErr: `2:1: {a: int} has no property "b"`,
+		},
+		{
+			name:    "polymorphic function",
+			program: "f = (x) => x\na = f(x:1) + 1\nb = f(x:\"a\") == \"b\"",
+		},
+		{
+			name:    "inferred parameter",
+			program: "f = (x) => x + 1\nf(x:\"a\")",
+			wantErr: `2:3: invalid argument "x": expected int but found string`,
+		},
+		{
+			name:    "record parameter",
+			program: "f = (o) => o.a + 1\nf(o:{a:1.0})",
+			wantErr: `2:3: invalid argument "o": expected int but found float`,
+		},
+		{
+			name:    "function parameter",
+			program: "apply = (f, x) => f(v:x)\na = apply(f: (v) => v * 2, x: 3) + 1",
+		},
+		{
+			name:    "errors in several statements",
+			program: "a = 1 + \"a\"\nb = a + 1\nc = d",
+			wantErr: "1:5: invalid binary operation int + string\n3:5: undefined identifier \"d\"",
+		},
+		{
+			name:    "package member",
+			program: `a = lib.inc(x:1) + 1`,
+		},
+		{
+			name:    "package member argument",
+			program: `lib.inc(x:"a")`,
+			wantErr: `1:9: invalid argument "x": expected int but found string`,
+		},
+		{
+			name:    "array elements",
+			program: `a = [1, "a"]`,
+			wantErr: `1:9: expected int but found string`,
+		},
+		{
+			name:    "conditional",
+			program: `from(db:"telegraf") |> filter(fn: (r) => (if r._value > 90.0 then "crit" else "ok") == r.level)`,
+		},
+		{
+			name:    "conditional test",
+			program: `a = if 1 then "a" else "b"`,
+			wantErr: `1:8: expected bool but found int`,
+		},
+		{
+			name:    "conditional branches",
+			program: `a = if true then "a" else 1`,
+			wantErr: `1:27: expected string but found int`,
+		},
+		{
+			name:    "exists",
+			program: `from(db:"telegraf") |> filter(fn: (r) => exists r.host and r.host == "a")`,
+		},
+		{
+			name:    "exists unknown property",
+			program: "o = {a:1}\nb = exists o.b",
+		},
+		{
+			name:    "null",
+			program: `from(db:"telegraf") |> filter(fn: (r) => r._value != null and (if r._value > 1.0 then r.host else null) == "a")`,
+		},
+		{
+			name:    "null branch",
+			program: `a = (if true then 1 else null) + "a"`,
+			wantErr: `1:5: invalid binary operation int + string`,
+		},
+		{
+			name:    "time arithmetic",
+			program: `from(db:"telegraf") |> filter(fn: (r) => r._time - 1h > 2018-01-01T00:00:00Z)`,
+		},
+		{
+			name:    "time difference",
+			program: "since = (t) => 2018-01-02T00:00:00Z - t\nrecent = since(t: 2018-01-01T00:00:00Z) < 1h",
+		},
+		{
+			name:    "time and int",
+			program: `a = 2018-01-01T00:00:00Z + 1`,
+			wantErr: `1:5: invalid binary operation time + int`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			program, err := parser.NewAST(tc.program)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prog, err := semantic.New(program, declarations.Copy())
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = semantic.Infer(prog, declarations)
+			switch {
+			case err == nil && tc.wantErr != "":
+				t.Errorf("expected error %q", tc.wantErr)
+			case err != nil && err.Error() != tc.wantErr:
+				t.Errorf("unexpected error: got %q want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
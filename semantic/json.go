@@ -43,6 +43,28 @@ func (p *Program) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+func (c *PackageClause) MarshalJSON() ([]byte, error) {
+	type Alias PackageClause
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  c.NodeType(),
+		Alias: (*Alias)(c),
+	}
+	return json.Marshal(raw)
+}
+func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
+	type Alias ImportDeclaration
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  d.NodeType(),
+		Alias: (*Alias)(d),
+	}
+	return json.Marshal(raw)
+}
 func (s *BlockStatement) MarshalJSON() ([]byte, error) {
 	type Alias BlockStatement
 	raw := struct {
@@ -173,6 +195,9 @@ func (d *NativeVariableDeclaration) UnmarshalJSON(data []byte) error {
 func (d *ExternalVariableDeclaration) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("cannot marshal ExternalVariableDeclaration")
 }
+func (d *PackageDeclaration) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("cannot marshal PackageDeclaration")
+}
 func (e *CallExpression) MarshalJSON() ([]byte, error) {
 	type Alias CallExpression
 	raw := struct {
@@ -574,6 +599,17 @@ func (l *BooleanLiteral) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(raw)
 }
+func (l *NullLiteral) MarshalJSON() ([]byte, error) {
+	type Alias NullLiteral
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  l.NodeType(),
+		Alias: (*Alias)(l),
+	}
+	return json.Marshal(raw)
+}
 func (l *FloatLiteral) MarshalJSON() ([]byte, error) {
 	type Alias FloatLiteral
 	raw := struct {
@@ -804,6 +840,10 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 	switch typ.Type {
 	case "Program":
 		node = new(Program)
+	case "PackageClause":
+		node = new(PackageClause)
+	case "ImportDeclaration":
+		node = new(ImportDeclaration)
 	case "BlockStatement":
 		node = new(BlockStatement)
 	case "ExpressionStatement":
@@ -836,6 +876,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(StringLiteral)
 	case "BooleanLiteral":
 		node = new(BooleanLiteral)
+	case "NullLiteral":
+		node = new(NullLiteral)
 	case "FloatLiteral":
 		node = new(FloatLiteral)
 	case "IntegerLiteral":
@@ -30,6 +30,29 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"Program","body":[{"type":"ExpressionStatement","expression":{"type":"StringLiteral","value":"hello"}}]}`,
 		},
+		{
+			name: "program with package and imports",
+			node: &semantic.Program{
+				Package: &semantic.PackageClause{
+					Name: &semantic.Identifier{Name: "foo"},
+				},
+				Imports: []*semantic.ImportDeclaration{
+					{
+						Path: &semantic.StringLiteral{Value: "path/bar"},
+					},
+					{
+						As:   &semantic.Identifier{Name: "b"},
+						Path: &semantic.StringLiteral{Value: "baz"},
+					},
+				},
+				Body: []semantic.Statement{
+					&semantic.ExpressionStatement{
+						Expression: &semantic.StringLiteral{Value: "hello"},
+					},
+				},
+			},
+			want: `{"type":"Program","package":{"type":"PackageClause","name":{"type":"Identifier","name":"foo"}},"imports":[{"type":"ImportDeclaration","path":{"type":"StringLiteral","value":"path/bar"}},{"type":"ImportDeclaration","as":{"type":"Identifier","name":"b"},"path":{"type":"StringLiteral","value":"baz"}}],"body":[{"type":"ExpressionStatement","expression":{"type":"StringLiteral","value":"hello"}}]}`,
+		},
 		{
 			name: "block statement",
 			node: &semantic.BlockStatement{
@@ -168,6 +191,11 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"BooleanLiteral","value":true}`,
 		},
+		{
+			name: "null literal",
+			node: &semantic.NullLiteral{},
+			want: `{"type":"NullLiteral"}`,
+		},
 		{
 			name: "float literal",
 			node: &semantic.FloatLiteral{
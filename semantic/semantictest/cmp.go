@@ -15,4 +15,9 @@ var CmpOptions = []cmp.Option{
 	cmpopts.IgnoreUnexported(semantic.IdentifierExpression{}),
 	cmpopts.IgnoreUnexported(semantic.FunctionParam{}),
 	cmp.Comparer(func(x, y *regexp.Regexp) bool { return x.String() == y.String() }),
+	// Ignore the source locations of nodes, so graphs can be compared with graphs created without an AST.
+	cmp.FilterPath(func(p cmp.Path) bool {
+		f, ok := p.Last().(cmp.StructField)
+		return ok && f.Name() == "loc"
+	}, cmp.Ignore()),
 }
@@ -7,6 +7,7 @@ import (
 	"hash/fnv"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -184,10 +185,15 @@ func NewArrayType(elementType Type) Type {
 
 type objectType struct {
 	properties map[string]Type
+	// row reports whether the object is a row of a block, see NewRowType.
+	row bool
 }
 
 func (t *objectType) String() string {
 	var buf bytes.Buffer
+	if t.row {
+		buf.Write([]byte("row"))
+	}
 	buf.Write([]byte("{"))
 	for k, prop := range t.properties {
 		fmt.Fprintf(&buf, "%s:%v,", k, prop)
@@ -201,7 +207,12 @@ func (t *objectType) Kind() Kind {
 	return Object
 }
 func (t *objectType) PropertyType(name string) Type {
-	return t.properties[name]
+	typ, ok := t.properties[name]
+	if !ok && t.row && !IsReservedColumn(name) {
+		// The column is a tag of the block, its type is only known at runtime.
+		return Invalid
+	}
+	return typ
 }
 func (t *objectType) Properties() map[string]Type {
 	return t.properties
@@ -222,7 +233,7 @@ func (t *objectType) equal(o *objectType) bool {
 		return true
 	}
 
-	if len(t.properties) != len(o.properties) {
+	if t.row != o.row || len(t.properties) != len(o.properties) {
 		return false
 	}
 
@@ -263,6 +274,24 @@ func objectTypeOf(e *ObjectExpression) Type {
 var EmptyObject = NewObjectType(nil)
 
 func NewObjectType(propertyTypes map[string]Type) Type {
+	return newObjectType(propertyTypes, false)
+}
+
+// NewRowType returns the type of the rows of a block, such as the records passed to the functions of filter and map.
+// The columns of a block are only known once it is read, so a row has the given columns as well as any tag column.
+// The type of a tag column is Invalid until it can be inferred from its use.
+// The columns created by a query, such as by map, are added to the rows by Infer, see FunctionSignature.
+func NewRowType(columns map[string]Type) Type {
+	return newObjectType(columns, true)
+}
+
+// IsReservedColumn reports whether the column name is reserved for the columns IFQL itself creates.
+// Like in InfluxDB, a name starting with an underscore cannot be used by a tag.
+func IsReservedColumn(name string) bool {
+	return strings.HasPrefix(name, "_")
+}
+
+func newObjectType(propertyTypes map[string]Type, row bool) Type {
 	propertyNames := make([]string, 0, len(propertyTypes))
 	for name := range propertyTypes {
 		propertyNames = append(propertyNames, name)
@@ -270,6 +299,9 @@ func NewObjectType(propertyTypes map[string]Type) Type {
 	sort.Strings(propertyNames)
 
 	sum := fnv.New32a()
+	if row {
+		sum.Write([]byte("row"))
+	}
 	for _, p := range propertyNames {
 		t := propertyTypes[p]
 
@@ -281,6 +313,7 @@ func NewObjectType(propertyTypes map[string]Type) Type {
 	// Create new object type
 	ot := &objectType{
 		properties: propertyTypes,
+		row:        row,
 	}
 
 	// Simple linear search after hash lookup
@@ -322,9 +355,10 @@ func NewObjectType(propertyTypes map[string]Type) Type {
 }
 
 type functionType struct {
-	params       map[string]Type
-	returnType   Type
-	pipeArgument string
+	params          map[string]Type
+	returnType      Type
+	pipeArgument    string
+	columnsArgument string
 }
 
 func (t *functionType) String() string {
@@ -367,7 +401,7 @@ func (t *functionType) equal(o *functionType) bool {
 		return true
 	}
 
-	if t.returnType != o.returnType {
+	if t.returnType != o.returnType || t.columnsArgument != o.columnsArgument {
 		return false
 	}
 
@@ -428,6 +462,10 @@ type FunctionSignature struct {
 	Params       map[string]Type
 	ReturnType   Type
 	PipeArgument string
+	// ColumnsArgument is the name of the row function whose result is the record of columns
+	// of the rows of the returned table, like the function passed to map.
+	// The rows of the returned table otherwise have the columns of the piped table.
+	ColumnsArgument string
 }
 
 func NewFunctionType(sig FunctionSignature) Type {
@@ -439,6 +477,7 @@ func NewFunctionType(sig FunctionSignature) Type {
 
 	sum := fnv.New32a()
 	sum.Write([]byte(sig.PipeArgument))
+	sum.Write([]byte(sig.ColumnsArgument))
 	for _, p := range paramNames {
 		// track hash of parameter names and kinds
 		sum.Write([]byte(p))
@@ -448,9 +487,10 @@ func NewFunctionType(sig FunctionSignature) Type {
 
 	// Create new object type
 	ft := &functionType{
-		params:       sig.Params,
-		returnType:   sig.ReturnType,
-		pipeArgument: sig.PipeArgument,
+		params:          sig.Params,
+		returnType:      sig.ReturnType,
+		pipeArgument:    sig.PipeArgument,
+		columnsArgument: sig.ColumnsArgument,
 	}
 
 	// Simple linear search after hash lookup
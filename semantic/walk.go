@@ -15,10 +15,29 @@ func walk(v Visitor, n Node) {
 	case *Program:
 		w := v.Visit(n)
 		if w != nil {
+			if n.Package != nil {
+				walk(w, n.Package)
+			}
+			for _, imp := range n.Imports {
+				walk(w, imp)
+			}
 			for _, s := range n.Body {
 				walk(w, s)
 			}
 		}
+	case *PackageClause:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Name)
+		}
+	case *ImportDeclaration:
+		w := v.Visit(n)
+		if w != nil {
+			if n.As != nil {
+				walk(w, n.As)
+			}
+			walk(w, n.Path)
+		}
 	case *BlockStatement:
 		w := v.Visit(n)
 		if w != nil {
@@ -51,6 +70,13 @@ func walk(v Visitor, n Node) {
 				walk(w, n.Identifier)
 			}
 		}
+	case *PackageDeclaration:
+		if n != nil {
+			w := v.Visit(n)
+			if w != nil {
+				walk(w, n.Identifier)
+			}
+		}
 	case *ArrayExpression:
 		w := v.Visit(n)
 		if w != nil {
@@ -140,6 +166,8 @@ func walk(v Visitor, n Node) {
 		v.Visit(n)
 	case *IntegerLiteral:
 		v.Visit(n)
+	case *NullLiteral:
+		v.Visit(n)
 	case *RegexpLiteral:
 		v.Visit(n)
 	case *StringLiteral: